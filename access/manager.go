@@ -5,6 +5,7 @@ import (
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/config"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	usersUtils "github.com/jfrog/jfrog-client-go/utils/users"
 )
 
 type AccessServicesManager struct {
@@ -72,6 +73,12 @@ func (sm *AccessServicesManager) DeleteProject(projectKey string) error {
 	return projectService.Delete(projectKey)
 }
 
+func (sm *AccessServicesManager) GetProjectStorageQuota(projectKey string) (*services.ProjectStorageQuota, error) {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.GetStorageQuota(projectKey)
+}
+
 func (sm *AccessServicesManager) AssignRepoToProject(repoName, projectKey string, isForce bool) error {
 	projectService := services.NewProjectService(sm.client)
 	projectService.ServiceDetails = sm.config.GetServiceDetails()
@@ -84,6 +91,48 @@ func (sm *AccessServicesManager) UnassignRepoFromProject(repoName string) error
 	return projectService.UnassignRepo(repoName)
 }
 
+func (sm *AccessServicesManager) GetRepoProjectStatus(repoName string) (*services.RepoProjectStatus, error) {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.GetRepoProjectStatus(repoName)
+}
+
+func (sm *AccessServicesManager) ShareRepoWithProject(repoName, targetProjectKey string, readOnly bool) error {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.ShareRepo(repoName, targetProjectKey, readOnly)
+}
+
+func (sm *AccessServicesManager) UnshareRepoWithProject(repoName, targetProjectKey string) error {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.UnshareRepo(repoName, targetProjectKey)
+}
+
+func (sm *AccessServicesManager) ShareRepoWithAllProjects(repoName string, readOnly bool) error {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.ShareRepoWithAll(repoName, readOnly)
+}
+
+func (sm *AccessServicesManager) UnshareRepoWithAllProjects(repoName string) error {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.UnshareRepoWithAll(repoName)
+}
+
+func (sm *AccessServicesManager) MoveRepoToProject(repoName, targetProjectKey string) (string, error) {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.MoveRepo(repoName, targetProjectKey)
+}
+
+func (sm *AccessServicesManager) ListProjectRepos(artifactoryDetails auth.ServiceDetails, projectKey string) ([]services.ProjectRepository, error) {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.ListRepos(artifactoryDetails, projectKey)
+}
+
 func (sm *AccessServicesManager) GetProjectsGroups(projectKey string) (*[]services.ProjectGroup, error) {
 	projectService := services.NewProjectService(sm.client)
 	projectService.ServiceDetails = sm.config.GetServiceDetails()
@@ -108,6 +157,114 @@ func (sm *AccessServicesManager) DeleteExistingProjectGroup(projectKey string, g
 	return projectService.DeleteExistingGroup(projectKey, groupName)
 }
 
+func (sm *AccessServicesManager) GetProjectRoles(projectKey string) ([]services.ProjectRole, error) {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.GetRoles(projectKey)
+}
+
+func (sm *AccessServicesManager) GetProjectRole(projectKey, roleName string) (*services.ProjectRole, error) {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.GetRole(projectKey, roleName)
+}
+
+func (sm *AccessServicesManager) CreateProjectRole(projectKey string, role services.ProjectRole) error {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.CreateRole(projectKey, role)
+}
+
+func (sm *AccessServicesManager) UpdateProjectRole(projectKey string, role services.ProjectRole) error {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.UpdateRole(projectKey, role)
+}
+
+func (sm *AccessServicesManager) DeleteProjectRole(projectKey, roleName string) error {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.DeleteRole(projectKey, roleName)
+}
+
+func (sm *AccessServicesManager) GetProjectUsers(projectKey string) ([]services.ProjectUser, error) {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.GetUsers(projectKey)
+}
+
+func (sm *AccessServicesManager) GetProjectUser(projectKey, username string) (*services.ProjectUser, error) {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.GetUser(projectKey, username)
+}
+
+func (sm *AccessServicesManager) UpdateUserInProject(projectKey, username string, user services.ProjectUser) error {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.UpdateUser(projectKey, username, user)
+}
+
+func (sm *AccessServicesManager) DeleteExistingProjectUser(projectKey, username string) error {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.DeleteExistingUser(projectKey, username)
+}
+
+func (sm *AccessServicesManager) ListProjectMemberships(params services.ListProjectMembershipsParams) (*services.ProjectMembershipsPage, error) {
+	projectService := services.NewProjectService(sm.client)
+	projectService.ServiceDetails = sm.config.GetServiceDetails()
+	return projectService.ListMemberships(params)
+}
+
+func (sm *AccessServicesManager) GetGlobalRoles() ([]services.GlobalRole, error) {
+	rolesService := services.NewRolesService(sm.client)
+	rolesService.ServiceDetails = sm.config.GetServiceDetails()
+	return rolesService.GetRoles()
+}
+
+func (sm *AccessServicesManager) GetGlobalRole(roleName string) (*services.GlobalRole, error) {
+	rolesService := services.NewRolesService(sm.client)
+	rolesService.ServiceDetails = sm.config.GetServiceDetails()
+	return rolesService.GetRole(roleName)
+}
+
+func (sm *AccessServicesManager) CreateGlobalRole(role services.GlobalRole) error {
+	rolesService := services.NewRolesService(sm.client)
+	rolesService.ServiceDetails = sm.config.GetServiceDetails()
+	return rolesService.CreateRole(role)
+}
+
+func (sm *AccessServicesManager) UpdateGlobalRole(role services.GlobalRole) error {
+	rolesService := services.NewRolesService(sm.client)
+	rolesService.ServiceDetails = sm.config.GetServiceDetails()
+	return rolesService.UpdateRole(role)
+}
+
+func (sm *AccessServicesManager) DeleteGlobalRole(roleName string) error {
+	rolesService := services.NewRolesService(sm.client)
+	rolesService.ServiceDetails = sm.config.GetServiceDetails()
+	return rolesService.DeleteRole(roleName)
+}
+
+func (sm *AccessServicesManager) GetGlobalRoleAssignments(roleName string) ([]services.RoleAssignment, error) {
+	rolesService := services.NewRolesService(sm.client)
+	rolesService.ServiceDetails = sm.config.GetServiceDetails()
+	return rolesService.GetRoleAssignments(roleName)
+}
+
+func (sm *AccessServicesManager) AssignGlobalRole(roleName string, memberType services.ProjectMemberType, memberName string) error {
+	rolesService := services.NewRolesService(sm.client)
+	rolesService.ServiceDetails = sm.config.GetServiceDetails()
+	return rolesService.AssignRole(roleName, memberType, memberName)
+}
+
+func (sm *AccessServicesManager) UnassignGlobalRole(roleName string, memberType services.ProjectMemberType, memberName string) error {
+	rolesService := services.NewRolesService(sm.client)
+	rolesService.ServiceDetails = sm.config.GetServiceDetails()
+	return rolesService.UnassignRole(roleName, memberType, memberName)
+}
+
 func (sm *AccessServicesManager) CreateAccessToken(params services.CreateTokenParams) (auth.CreateTokenResponseData, error) {
 	tokenService := services.NewTokenService(sm.client)
 	tokenService.ServiceDetails = sm.config.GetServiceDetails()
@@ -120,6 +277,30 @@ func (sm *AccessServicesManager) RefreshAccessToken(params services.CreateTokenP
 	return tokenService.RefreshAccessToken(params)
 }
 
+func (sm *AccessServicesManager) GetAccessTokens(params services.ListTokensParams) ([]services.TokenInfo, error) {
+	tokenService := services.NewTokenService(sm.client)
+	tokenService.ServiceDetails = sm.config.GetServiceDetails()
+	return tokenService.GetTokens(params)
+}
+
+func (sm *AccessServicesManager) GetAccessToken(tokenId string) (*services.TokenInfo, error) {
+	tokenService := services.NewTokenService(sm.client)
+	tokenService.ServiceDetails = sm.config.GetServiceDetails()
+	return tokenService.GetToken(tokenId)
+}
+
+func (sm *AccessServicesManager) RevokeAccessToken(tokenId string) error {
+	tokenService := services.NewTokenService(sm.client)
+	tokenService.ServiceDetails = sm.config.GetServiceDetails()
+	return tokenService.RevokeTokenById(tokenId)
+}
+
+func (sm *AccessServicesManager) RevokeAccessTokens(params services.ListTokensParams) ([]string, error) {
+	tokenService := services.NewTokenService(sm.client)
+	tokenService.ServiceDetails = sm.config.GetServiceDetails()
+	return tokenService.RevokeTokens(params)
+}
+
 func (sm *AccessServicesManager) InviteUser(email, source string) error {
 	inviteService := services.NewInviteService(sm.client)
 	inviteService.ServiceDetails = sm.config.GetServiceDetails()
@@ -143,3 +324,183 @@ func (sm *AccessServicesManager) ExchangeOidcToken(params services.CreateOidcTok
 	tokenService.ServiceDetails = sm.config.GetServiceDetails()
 	return tokenService.ExchangeOidcToken(params)
 }
+
+func (sm *AccessServicesManager) GetOidcIntegrations() ([]services.OidcIntegration, error) {
+	oidcService := services.NewOidcService(sm.client)
+	oidcService.ServiceDetails = sm.config.GetServiceDetails()
+	return oidcService.GetIntegrations()
+}
+
+func (sm *AccessServicesManager) GetOidcIntegration(integrationName string) (*services.OidcIntegration, error) {
+	oidcService := services.NewOidcService(sm.client)
+	oidcService.ServiceDetails = sm.config.GetServiceDetails()
+	return oidcService.GetIntegration(integrationName)
+}
+
+func (sm *AccessServicesManager) CreateOidcIntegration(integration services.OidcIntegration) error {
+	oidcService := services.NewOidcService(sm.client)
+	oidcService.ServiceDetails = sm.config.GetServiceDetails()
+	return oidcService.CreateIntegration(integration)
+}
+
+func (sm *AccessServicesManager) UpdateOidcIntegration(integration services.OidcIntegration) error {
+	oidcService := services.NewOidcService(sm.client)
+	oidcService.ServiceDetails = sm.config.GetServiceDetails()
+	return oidcService.UpdateIntegration(integration)
+}
+
+func (sm *AccessServicesManager) DeleteOidcIntegration(integrationName string) error {
+	oidcService := services.NewOidcService(sm.client)
+	oidcService.ServiceDetails = sm.config.GetServiceDetails()
+	return oidcService.DeleteIntegration(integrationName)
+}
+
+func (sm *AccessServicesManager) GetOidcIdentityMappings(integrationName string) ([]services.OidcIdentityMapping, error) {
+	oidcService := services.NewOidcService(sm.client)
+	oidcService.ServiceDetails = sm.config.GetServiceDetails()
+	return oidcService.GetIdentityMappings(integrationName)
+}
+
+func (sm *AccessServicesManager) GetOidcIdentityMapping(integrationName, mappingName string) (*services.OidcIdentityMapping, error) {
+	oidcService := services.NewOidcService(sm.client)
+	oidcService.ServiceDetails = sm.config.GetServiceDetails()
+	return oidcService.GetIdentityMapping(integrationName, mappingName)
+}
+
+func (sm *AccessServicesManager) CreateOidcIdentityMapping(integrationName string, mapping services.OidcIdentityMapping) error {
+	oidcService := services.NewOidcService(sm.client)
+	oidcService.ServiceDetails = sm.config.GetServiceDetails()
+	return oidcService.CreateIdentityMapping(integrationName, mapping)
+}
+
+func (sm *AccessServicesManager) UpdateOidcIdentityMapping(integrationName string, mapping services.OidcIdentityMapping) error {
+	oidcService := services.NewOidcService(sm.client)
+	oidcService.ServiceDetails = sm.config.GetServiceDetails()
+	return oidcService.UpdateIdentityMapping(integrationName, mapping)
+}
+
+func (sm *AccessServicesManager) DeleteOidcIdentityMapping(integrationName, mappingName string) error {
+	oidcService := services.NewOidcService(sm.client)
+	oidcService.ServiceDetails = sm.config.GetServiceDetails()
+	return oidcService.DeleteIdentityMapping(integrationName, mappingName)
+}
+
+func (sm *AccessServicesManager) ListScimUsers(params services.ScimListParams) (*services.ScimListResponse[services.ScimUser], error) {
+	scimService := services.NewScimService(sm.client)
+	scimService.ServiceDetails = sm.config.GetServiceDetails()
+	return scimService.ListUsers(params)
+}
+
+func (sm *AccessServicesManager) GetScimUser(username string) (*services.ScimUser, error) {
+	scimService := services.NewScimService(sm.client)
+	scimService.ServiceDetails = sm.config.GetServiceDetails()
+	return scimService.GetUser(username)
+}
+
+func (sm *AccessServicesManager) CreateScimUser(user services.ScimUser) (*services.ScimUser, error) {
+	scimService := services.NewScimService(sm.client)
+	scimService.ServiceDetails = sm.config.GetServiceDetails()
+	return scimService.CreateUser(user)
+}
+
+func (sm *AccessServicesManager) ReplaceScimUser(user services.ScimUser) (*services.ScimUser, error) {
+	scimService := services.NewScimService(sm.client)
+	scimService.ServiceDetails = sm.config.GetServiceDetails()
+	return scimService.ReplaceUser(user)
+}
+
+func (sm *AccessServicesManager) DeactivateScimUser(username string) error {
+	scimService := services.NewScimService(sm.client)
+	scimService.ServiceDetails = sm.config.GetServiceDetails()
+	return scimService.DeactivateUser(username)
+}
+
+func (sm *AccessServicesManager) ActivateScimUser(username string) error {
+	scimService := services.NewScimService(sm.client)
+	scimService.ServiceDetails = sm.config.GetServiceDetails()
+	return scimService.ActivateUser(username)
+}
+
+func (sm *AccessServicesManager) DeleteScimUser(username string) error {
+	scimService := services.NewScimService(sm.client)
+	scimService.ServiceDetails = sm.config.GetServiceDetails()
+	return scimService.DeleteUser(username)
+}
+
+func (sm *AccessServicesManager) ListScimGroups(params services.ScimListParams) (*services.ScimListResponse[services.ScimGroup], error) {
+	scimService := services.NewScimService(sm.client)
+	scimService.ServiceDetails = sm.config.GetServiceDetails()
+	return scimService.ListGroups(params)
+}
+
+func (sm *AccessServicesManager) GetScimGroup(groupName string) (*services.ScimGroup, error) {
+	scimService := services.NewScimService(sm.client)
+	scimService.ServiceDetails = sm.config.GetServiceDetails()
+	return scimService.GetGroup(groupName)
+}
+
+func (sm *AccessServicesManager) CreateScimGroup(group services.ScimGroup) (*services.ScimGroup, error) {
+	scimService := services.NewScimService(sm.client)
+	scimService.ServiceDetails = sm.config.GetServiceDetails()
+	return scimService.CreateGroup(group)
+}
+
+func (sm *AccessServicesManager) DeleteScimGroup(groupName string) error {
+	scimService := services.NewScimService(sm.client)
+	scimService.ServiceDetails = sm.config.GetServiceDetails()
+	return scimService.DeleteGroup(groupName)
+}
+
+func (sm *AccessServicesManager) SyncScimGroupMembers(groupName string, usernames []string) error {
+	scimService := services.NewScimService(sm.client)
+	scimService.ServiceDetails = sm.config.GetServiceDetails()
+	return scimService.SyncGroupMembers(groupName, usernames)
+}
+
+func (sm *AccessServicesManager) ListUsers(params usersUtils.ListUsersParams) (*usersUtils.UsersPage, error) {
+	usersService := services.NewUsersService(sm.client)
+	usersService.ServiceDetails = sm.config.GetServiceDetails()
+	return usersService.ListUsers(params)
+}
+
+func (sm *AccessServicesManager) GetUser(username string) (*services.AccessUser, error) {
+	usersService := services.NewUsersService(sm.client)
+	usersService.ServiceDetails = sm.config.GetServiceDetails()
+	return usersService.GetAccessUser(username)
+}
+
+func (sm *AccessServicesManager) CreateUser(params usersUtils.UserParams) error {
+	usersService := services.NewUsersService(sm.client)
+	usersService.ServiceDetails = sm.config.GetServiceDetails()
+	return usersService.CreateUser(params)
+}
+
+func (sm *AccessServicesManager) UpdateUser(params usersUtils.UserParams) error {
+	usersService := services.NewUsersService(sm.client)
+	usersService.ServiceDetails = sm.config.GetServiceDetails()
+	return usersService.UpdateUser(params)
+}
+
+func (sm *AccessServicesManager) DeleteUser(username string) error {
+	usersService := services.NewUsersService(sm.client)
+	usersService.ServiceDetails = sm.config.GetServiceDetails()
+	return usersService.DeleteUser(username)
+}
+
+func (sm *AccessServicesManager) DisableUser(username string) error {
+	usersService := services.NewUsersService(sm.client)
+	usersService.ServiceDetails = sm.config.GetServiceDetails()
+	return usersService.DisableUser(username)
+}
+
+func (sm *AccessServicesManager) EnableUser(username string) error {
+	usersService := services.NewUsersService(sm.client)
+	usersService.ServiceDetails = sm.config.GetServiceDetails()
+	return usersService.EnableUser(username)
+}
+
+func (sm *AccessServicesManager) ImportUsers(params usersUtils.ImportUsersParams) error {
+	usersService := services.NewUsersService(sm.client)
+	usersService.ServiceDetails = sm.config.GetServiceDetails()
+	return usersUtils.ImportUsers(usersService, params)
+}
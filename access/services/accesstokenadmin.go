@@ -0,0 +1,173 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The metadata of an issued access token. The token itself is never returned after its creation.
+type TokenInfo struct {
+	TokenId     string `json:"token_id,omitempty"`
+	Subject     string `json:"subject,omitempty"`
+	Issuer      string `json:"issuer,omitempty"`
+	Scope       string `json:"scope,omitempty"`
+	Description string `json:"description,omitempty"`
+	Refreshable bool   `json:"refreshable,omitempty"`
+	ProjectKey  string `json:"project_key,omitempty"`
+	// Seconds since the epoch. Zero if the token never expires.
+	Expiry int64 `json:"expiry,omitempty"`
+	// Seconds since the epoch.
+	IssuedAt int64 `json:"issued_at,omitempty"`
+	// Seconds since the epoch. Zero if the token was never used.
+	LastUsed int64 `json:"last_used,omitempty"`
+}
+
+// Returns the name of the user the token was issued to, or empty if it wasn't issued to a user.
+// User subjects look like "jfac@<service-id>/users/<username>".
+func (ti *TokenInfo) Username() string {
+	_, username, found := strings.Cut(ti.Subject, "/users/")
+	if !found {
+		return ""
+	}
+	return username
+}
+
+// Returns the expiry time of the token, or the zero time if it never expires.
+func (ti *TokenInfo) ExpiryTime() time.Time {
+	if ti.Expiry == 0 {
+		return time.Time{}
+	}
+	return time.Unix(ti.Expiry, 0)
+}
+
+type tokensResponse struct {
+	Tokens []TokenInfo `json:"tokens"`
+}
+
+// Filters the issued tokens. The criteria are combined, and a token must match all the criteria which are set.
+type ListTokensParams struct {
+	// The name of the user the tokens were issued to.
+	Username string
+	// A part of the subject of the tokens.
+	SubjectContains string
+	// A part of the description of the tokens, case-insensitive.
+	DescriptionContains string
+	// Only tokens which expire before this time. Tokens which never expire are excluded.
+	ExpiresBefore time.Time
+	// Only tokens which expire after this time. Tokens which never expire are included.
+	ExpiresAfter time.Time
+}
+
+func (tp *ListTokensParams) isEmpty() bool {
+	return tp.Username == "" && tp.SubjectContains == "" && tp.DescriptionContains == "" && tp.ExpiresBefore.IsZero() && tp.ExpiresAfter.IsZero()
+}
+
+func (tp *ListTokensParams) Matches(token TokenInfo) bool {
+	if tp.Username != "" && token.Username() != tp.Username {
+		return false
+	}
+	if tp.SubjectContains != "" && !strings.Contains(token.Subject, tp.SubjectContains) {
+		return false
+	}
+	if tp.DescriptionContains != "" && !strings.Contains(strings.ToLower(token.Description), strings.ToLower(tp.DescriptionContains)) {
+		return false
+	}
+	expiry := token.ExpiryTime()
+	if !tp.ExpiresBefore.IsZero() && (expiry.IsZero() || !expiry.Before(tp.ExpiresBefore)) {
+		return false
+	}
+	if !tp.ExpiresAfter.IsZero() && !expiry.IsZero() && !expiry.After(tp.ExpiresAfter) {
+		return false
+	}
+	return true
+}
+
+// Returns the issued tokens which match the params. Requires admin permissions to list the tokens of all the users,
+// otherwise only the tokens of the current user are returned.
+// The tokens API doesn't support filtering, so the tokens are filtered client-side.
+func (ps *TokenService) GetTokens(params ListTokensParams) ([]TokenInfo, error) {
+	httpDetails := ps.ServiceDetails.CreateHttpClientDetails()
+	resp, body, _, err := ps.client.SendGet(ps.ServiceDetails.GetUrl()+tokensApi, true, &httpDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	var response tokensResponse
+	if err = json.Unmarshal(body, &response); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var tokens []TokenInfo
+	for _, token := range response.Tokens {
+		if params.Matches(token) {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens, nil
+}
+
+// Returns the metadata of the token, or nil if it doesn't exist.
+func (ps *TokenService) GetToken(tokenId string) (*TokenInfo, error) {
+	httpDetails := ps.ServiceDetails.CreateHttpClientDetails()
+	resp, body, _, err := ps.client.SendGet(ps.getTokenUrl(tokenId), true, &httpDetails)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	token := &TokenInfo{}
+	return token, errorutils.CheckError(json.Unmarshal(body, token))
+}
+
+func (ps *TokenService) RevokeTokenById(tokenId string) error {
+	httpDetails := ps.ServiceDetails.CreateHttpClientDetails()
+	resp, body, err := ps.client.SendDelete(ps.getTokenUrl(tokenId), nil, &httpDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent); err != nil {
+		return fmt.Errorf("failed to revoke token '%s': %w", tokenId, err)
+	}
+	return nil
+}
+
+// Revokes all the tokens which match the params, for example all the tokens of a user who left the organization.
+// At least one criterion is required, so that all the tokens are never revoked by mistake.
+// A failure to revoke a token doesn't stop the revocation of the others. Returns the IDs of the revoked tokens.
+func (ps *TokenService) RevokeTokens(params ListTokensParams) ([]string, error) {
+	if params.isEmpty() {
+		return nil, errorutils.CheckErrorf("at least one criterion is required to revoke tokens")
+	}
+	tokens, err := ps.GetTokens(params)
+	if err != nil {
+		return nil, err
+	}
+	var revoked []string
+	var errs []error
+	for _, token := range tokens {
+		if err = ps.RevokeTokenById(token.TokenId); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		revoked = append(revoked, token.TokenId)
+	}
+	log.Info(fmt.Sprintf("Revoked %d out of %d matching tokens.", len(revoked), len(tokens)))
+	return revoked, errorutils.CheckError(errors.Join(errs...))
+}
+
+func (ps *TokenService) getTokenUrl(tokenId string) string {
+	return fmt.Sprintf("%s%s/%s", ps.ServiceDetails.GetUrl(), tokensApi, url.PathEscape(tokenId))
+}
@@ -0,0 +1,235 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const oidcApi = "api/v1/oidc"
+
+type OidcProviderType string
+
+// GitLab and other providers which aren't listed, are configured as generic providers.
+const (
+	GenericOidcProvider          OidcProviderType = "generic"
+	GitHubOidcProvider           OidcProviderType = "GitHub"
+	GitHubEnterpriseOidcProvider OidcProviderType = "GitHubEnterprise"
+	AzureOidcProvider            OidcProviderType = "Azure"
+)
+
+// An OIDC integration, which trusts the ID tokens issued by a provider, so that they can be exchanged for access tokens.
+type OidcIntegration struct {
+	Name         string           `json:"name"`
+	ProviderType OidcProviderType `json:"provider_type,omitempty"`
+	IssuerUrl    string           `json:"issuer_url"`
+	Description  string           `json:"description,omitempty"`
+	// The audience the ID tokens must be issued to. Any audience if empty.
+	Audience string `json:"audience,omitempty"`
+	// The issuer of the ID tokens, if it's different from the issuer URL.
+	TokenIssuer string `json:"token_issuer,omitempty"`
+	// The GitHub organization the ID tokens must be issued for. Relevant only to GitHub providers.
+	Organization                  string `json:"organization,omitempty"`
+	EnablePermissiveConfiguration *bool  `json:"enable_permissive_configuration,omitempty"`
+	UseDefaultProxy               *bool  `json:"use_default_proxy,omitempty"`
+	ProjectKey                    string `json:"project_key,omitempty"`
+}
+
+func (oi *OidcIntegration) validate() error {
+	if oi.Name == "" || oi.IssuerUrl == "" {
+		return errorutils.CheckErrorf("an OIDC integration name and an issuer URL are required")
+	}
+	if !strings.HasPrefix(oi.IssuerUrl, "https://") {
+		return errorutils.CheckErrorf("the issuer URL of OIDC integration '%s' must use https, got '%s'", oi.Name, oi.IssuerUrl)
+	}
+	return nil
+}
+
+// Maps the claims of ID tokens to the access tokens they are exchanged for.
+// An ID token matches a mapping if it includes all the claims of the mapping. If it matches several mappings, the
+// mapping with the lowest priority value is used.
+type OidcIdentityMapping struct {
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	ProviderName string `json:"provider_name,omitempty"`
+	Priority     int    `json:"priority"`
+	// The claims an ID token must include, for example {"repository": "my-org/my-repo", "ref": "refs/heads/main"}.
+	Claims    map[string]any `json:"claims"`
+	TokenSpec OidcTokenSpec  `json:"token_spec"`
+	// The project the mapping belongs to, if it's a project mapping.
+	ProjectKey string `json:"project_key,omitempty"`
+}
+
+// The access token issued for an ID token which matches an identity mapping.
+type OidcTokenSpec struct {
+	// Issues the token for this user. Either a username or groups in the scope are required.
+	Username string `json:"username,omitempty"`
+	// For example "applied-permissions/user", or "applied-permissions/groups:readers,deployers".
+	Scope    string `json:"scope,omitempty"`
+	Audience string `json:"audience,omitempty"`
+	// In seconds.
+	ExpiresIn *uint `json:"expires_in,omitempty"`
+}
+
+func (im *OidcIdentityMapping) validate() error {
+	if im.Name == "" {
+		return errorutils.CheckErrorf("an identity mapping name is required")
+	}
+	if len(im.Claims) == 0 {
+		return errorutils.CheckErrorf("identity mapping '%s' must include at least one claim, otherwise it matches the ID tokens of any identity", im.Name)
+	}
+	if im.TokenSpec.Scope == "" {
+		return errorutils.CheckErrorf("the token scope of identity mapping '%s' is required", im.Name)
+	}
+	return nil
+}
+
+// Returns a token scope which applies the permissions of the groups.
+func NewGroupsTokenScope(groups ...string) string {
+	return "applied-permissions/groups:" + strings.Join(groups, ",")
+}
+
+type OidcService struct {
+	client         *jfroghttpclient.JfrogHttpClient
+	ServiceDetails auth.ServiceDetails
+}
+
+func NewOidcService(client *jfroghttpclient.JfrogHttpClient) *OidcService {
+	return &OidcService{client: client}
+}
+
+func (oidcs *OidcService) getIntegrationsBaseUrl() string {
+	return fmt.Sprintf("%s%s", oidcs.ServiceDetails.GetUrl(), oidcApi)
+}
+
+func (oidcs *OidcService) getIntegrationUrl(integrationName string) string {
+	return fmt.Sprintf("%s/%s", oidcs.getIntegrationsBaseUrl(), url.PathEscape(integrationName))
+}
+
+func (oidcs *OidcService) getIdentityMappingsUrl(integrationName string) string {
+	return fmt.Sprintf("%s/identity_mappings", oidcs.getIntegrationUrl(integrationName))
+}
+
+func (oidcs *OidcService) GetIntegrations() ([]OidcIntegration, error) {
+	var integrations []OidcIntegration
+	if _, err := oidcs.sendGet(oidcs.getIntegrationsBaseUrl(), &integrations); err != nil {
+		return nil, err
+	}
+	return integrations, nil
+}
+
+// Returns nil if the integration doesn't exist.
+func (oidcs *OidcService) GetIntegration(integrationName string) (*OidcIntegration, error) {
+	integration := &OidcIntegration{}
+	found, err := oidcs.sendGet(oidcs.getIntegrationUrl(integrationName), integration)
+	if err != nil || !found {
+		return nil, err
+	}
+	return integration, nil
+}
+
+func (oidcs *OidcService) CreateIntegration(integration OidcIntegration) error {
+	if err := integration.validate(); err != nil {
+		return err
+	}
+	return oidcs.sendCreateOrUpdate(http.MethodPost, oidcs.getIntegrationsBaseUrl(), integration, http.StatusCreated)
+}
+
+func (oidcs *OidcService) UpdateIntegration(integration OidcIntegration) error {
+	if err := integration.validate(); err != nil {
+		return err
+	}
+	return oidcs.sendCreateOrUpdate(http.MethodPut, oidcs.getIntegrationUrl(integration.Name), integration, http.StatusOK)
+}
+
+// Deletes the integration, including its identity mappings.
+func (oidcs *OidcService) DeleteIntegration(integrationName string) error {
+	return oidcs.sendDelete(oidcs.getIntegrationUrl(integrationName))
+}
+
+// Returns the identity mappings of the integration, ordered by their priority.
+func (oidcs *OidcService) GetIdentityMappings(integrationName string) ([]OidcIdentityMapping, error) {
+	var mappings []OidcIdentityMapping
+	found, err := oidcs.sendGet(oidcs.getIdentityMappingsUrl(integrationName), &mappings)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorutils.CheckErrorf("OIDC integration '%s' does not exist", integrationName)
+	}
+	return mappings, nil
+}
+
+// Returns nil if the identity mapping doesn't exist.
+func (oidcs *OidcService) GetIdentityMapping(integrationName, mappingName string) (*OidcIdentityMapping, error) {
+	mapping := &OidcIdentityMapping{}
+	found, err := oidcs.sendGet(fmt.Sprintf("%s/%s", oidcs.getIdentityMappingsUrl(integrationName), url.PathEscape(mappingName)), mapping)
+	if err != nil || !found {
+		return nil, err
+	}
+	return mapping, nil
+}
+
+func (oidcs *OidcService) CreateIdentityMapping(integrationName string, mapping OidcIdentityMapping) error {
+	if err := mapping.validate(); err != nil {
+		return err
+	}
+	mapping.ProviderName = integrationName
+	return oidcs.sendCreateOrUpdate(http.MethodPost, oidcs.getIdentityMappingsUrl(integrationName), mapping, http.StatusCreated)
+}
+
+func (oidcs *OidcService) UpdateIdentityMapping(integrationName string, mapping OidcIdentityMapping) error {
+	if err := mapping.validate(); err != nil {
+		return err
+	}
+	mapping.ProviderName = integrationName
+	return oidcs.sendCreateOrUpdate(http.MethodPut, fmt.Sprintf("%s/%s", oidcs.getIdentityMappingsUrl(integrationName), url.PathEscape(mapping.Name)), mapping, http.StatusOK)
+}
+
+func (oidcs *OidcService) DeleteIdentityMapping(integrationName, mappingName string) error {
+	return oidcs.sendDelete(fmt.Sprintf("%s/%s", oidcs.getIdentityMappingsUrl(integrationName), url.PathEscape(mappingName)))
+}
+
+func (oidcs *OidcService) sendGet(requestUrl string, result any) (bool, error) {
+	httpDetails := oidcs.ServiceDetails.CreateHttpClientDetails()
+	resp, body, _, err := oidcs.client.SendGet(requestUrl, true, &httpDetails)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return false, err
+	}
+	return true, errorutils.CheckError(json.Unmarshal(body, result))
+}
+
+func (oidcs *OidcService) sendCreateOrUpdate(method, requestUrl string, payload any, expectedStatus int) error {
+	content, err := json.Marshal(payload)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpDetails := oidcs.ServiceDetails.CreateHttpClientDetails()
+	httpDetails.SetContentTypeApplicationJson()
+	resp, body, _, err := oidcs.client.Send(method, requestUrl, content, true, true, &httpDetails, "")
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, expectedStatus, http.StatusOK)
+}
+
+func (oidcs *OidcService) sendDelete(requestUrl string) error {
+	httpDetails := oidcs.ServiceDetails.CreateHttpClientDetails()
+	resp, body, err := oidcs.client.SendDelete(requestUrl, nil, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent)
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
 	"net/http"
+	"net/url"
 )
 
 const projectsApi = "api/v1/projects"
@@ -126,9 +128,9 @@ func (ps *ProjectService) Update(params ProjectParams) error {
 	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated)
 }
 
-func (ps *ProjectService) createOrUpdateRequest(project Project) (requestContent []byte, httpDetails httputils.HttpClientDetails, err error) {
+func (ps *ProjectService) createOrUpdateRequest(payload any) (requestContent []byte, httpDetails httputils.HttpClientDetails, err error) {
 	httpDetails = ps.ServiceDetails.CreateHttpClientDetails()
-	requestContent, err = json.Marshal(project)
+	requestContent, err = json.Marshal(payload)
 	if errorutils.CheckError(err) != nil {
 		return
 	}
@@ -152,6 +154,44 @@ func (ps *ProjectService) Delete(projectKey string) error {
 	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusNoContent)
 }
 
+// The storage quota of a project. The storage used by the project is calculated by Artifactory, and can be fetched
+// using the GetProjectStorageUsage method of the Artifactory services manager.
+type ProjectStorageQuota struct {
+	ProjectKey string
+	// 0 if the project has no quota.
+	QuotaBytes int64
+	// If true, exceeding the quota only sends notifications, and uploads aren't blocked.
+	SoftLimit bool
+}
+
+func (ps *ProjectService) GetStorageQuota(projectKey string) (*ProjectStorageQuota, error) {
+	project, err := ps.Get(projectKey)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return nil, errorutils.CheckErrorf("project '%s' does not exist", projectKey)
+	}
+	quota := &ProjectStorageQuota{ProjectKey: projectKey, SoftLimit: project.SoftLimit != nil && *project.SoftLimit}
+	// A negative quota means the project is unlimited.
+	if project.StorageQuotaBytes > 0 {
+		quota.QuotaBytes = int64(project.StorageQuotaBytes)
+	}
+	return quota, nil
+}
+
+// Returns the percentage of the quota used, or 0 if the project has no quota.
+func (psq *ProjectStorageQuota) UsedPercentage(usedBytes int64) float64 {
+	if psq.QuotaBytes <= 0 {
+		return 0
+	}
+	return float64(usedBytes) * 100 / float64(psq.QuotaBytes)
+}
+
+func (psq *ProjectStorageQuota) IsExceeded(usedBytes int64) bool {
+	return psq.QuotaBytes > 0 && usedBytes >= psq.QuotaBytes
+}
+
 func (ps *ProjectService) AssignRepo(repoName, projectKey string, isForce bool) error {
 	httpDetails := ps.ServiceDetails.CreateHttpClientDetails()
 	url := fmt.Sprintf("%s/_/attach/repositories/%s/%s?force=%t", ps.getProjectsBaseUrl(), repoName, projectKey, isForce)
@@ -237,3 +277,233 @@ func (ps *ProjectService) DeleteExistingGroup(projectKey string, groupName strin
 	}
 	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusNoContent)
 }
+
+type ProjectRoleType string
+
+const (
+	// Roles created in the project.
+	CustomRole ProjectRoleType = "CUSTOM"
+	// Roles shared by all the projects, such as "Project Admin", "Developer", "Contributor", "Viewer" and "Release Manager".
+	PredefinedRole ProjectRoleType = "PREDEFINED"
+)
+
+type ProjectRole struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Type        ProjectRoleType `json:"type,omitempty"`
+	// The environments the actions are allowed in, e.g. "DEV" and "PROD".
+	Environments []string `json:"environments,omitempty"`
+	// The allowed actions, e.g. "READ_REPOSITORY", "DEPLOY_CACHE_REPOSITORY" and "MANAGE_RELEASE_BUNDLE".
+	Actions []string `json:"actions,omitempty"`
+}
+
+func (ps *ProjectService) getRolesUrl(projectKey string) string {
+	return fmt.Sprintf("%s/%s/roles", ps.getProjectsBaseUrl(), projectKey)
+}
+
+// Returns the roles of the project, including the predefined roles.
+func (ps *ProjectService) GetRoles(projectKey string) ([]ProjectRole, error) {
+	var roles []ProjectRole
+	if _, err := ps.sendGet(ps.getRolesUrl(projectKey), &roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// Returns nil if the role doesn't exist.
+func (ps *ProjectService) GetRole(projectKey, roleName string) (*ProjectRole, error) {
+	role := &ProjectRole{}
+	found, err := ps.sendGet(fmt.Sprintf("%s/%s", ps.getRolesUrl(projectKey), url.PathEscape(roleName)), role)
+	if err != nil || !found {
+		return nil, err
+	}
+	return role, nil
+}
+
+// Creates a custom role in the project.
+func (ps *ProjectService) CreateRole(projectKey string, role ProjectRole) error {
+	if err := role.validate(); err != nil {
+		return err
+	}
+	role.Type = CustomRole
+	content, httpDetails, err := ps.createOrUpdateRequest(role)
+	if err != nil {
+		return err
+	}
+	resp, body, err := ps.client.SendPost(ps.getRolesUrl(projectKey), content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated)
+}
+
+// Updates a custom role of the project. Predefined roles can't be updated.
+func (ps *ProjectService) UpdateRole(projectKey string, role ProjectRole) error {
+	if err := role.validate(); err != nil {
+		return err
+	}
+	role.Type = CustomRole
+	content, httpDetails, err := ps.createOrUpdateRequest(role)
+	if err != nil {
+		return err
+	}
+	resp, body, err := ps.client.SendPut(fmt.Sprintf("%s/%s", ps.getRolesUrl(projectKey), url.PathEscape(role.Name)), content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+}
+
+func (ps *ProjectService) DeleteRole(projectKey, roleName string) error {
+	httpDetails := ps.ServiceDetails.CreateHttpClientDetails()
+	resp, body, err := ps.client.SendDelete(fmt.Sprintf("%s/%s", ps.getRolesUrl(projectKey), url.PathEscape(roleName)), nil, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent)
+}
+
+func (role *ProjectRole) validate() error {
+	if role.Name == "" {
+		return errorutils.CheckErrorf("a role name is required")
+	}
+	if role.Type == PredefinedRole {
+		return errorutils.CheckErrorf("the predefined role '%s' can't be created or updated", role.Name)
+	}
+	if len(role.Actions) == 0 {
+		return errorutils.CheckErrorf("role '%s' must allow at least one action", role.Name)
+	}
+	return nil
+}
+
+type ProjectUser struct {
+	Name  string   `json:"name"`
+	Roles []string `json:"roles"`
+}
+
+type ProjectUsers struct {
+	Members []ProjectUser `json:"members"`
+}
+
+func (ps *ProjectService) getUsersUrl(projectKey string) string {
+	return fmt.Sprintf("%s/%s/users", ps.getProjectsBaseUrl(), projectKey)
+}
+
+// Returns the users which were assigned to the project directly, and not through a group.
+func (ps *ProjectService) GetUsers(projectKey string) ([]ProjectUser, error) {
+	var projectUsers ProjectUsers
+	if _, err := ps.sendGet(ps.getUsersUrl(projectKey), &projectUsers); err != nil {
+		return nil, err
+	}
+	return projectUsers.Members, nil
+}
+
+// Returns nil if the user isn't a member of the project.
+func (ps *ProjectService) GetUser(projectKey, username string) (*ProjectUser, error) {
+	projectUser := &ProjectUser{}
+	found, err := ps.sendGet(fmt.Sprintf("%s/%s", ps.getUsersUrl(projectKey), url.PathEscape(username)), projectUser)
+	if err != nil || !found {
+		return nil, err
+	}
+	return projectUser, nil
+}
+
+// Adds the user to the project with the given roles, or replaces its roles if it's already a member.
+func (ps *ProjectService) UpdateUser(projectKey, username string, user ProjectUser) error {
+	if len(user.Roles) == 0 {
+		return errorutils.CheckErrorf("at least one role is required to add user '%s' to project '%s'", username, projectKey)
+	}
+	content, httpDetails, err := ps.createOrUpdateRequest(user)
+	if err != nil {
+		return err
+	}
+	resp, body, err := ps.client.SendPut(fmt.Sprintf("%s/%s", ps.getUsersUrl(projectKey), url.PathEscape(username)), content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+}
+
+// Removes the user from the project. The user itself isn't deleted.
+func (ps *ProjectService) DeleteExistingUser(projectKey, username string) error {
+	httpDetails := ps.ServiceDetails.CreateHttpClientDetails()
+	resp, body, err := ps.client.SendDelete(fmt.Sprintf("%s/%s", ps.getUsersUrl(projectKey), url.PathEscape(username)), nil, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusNoContent)
+}
+
+type ProjectMemberType string
+
+const (
+	UserMember  ProjectMemberType = "user"
+	GroupMember ProjectMemberType = "group"
+)
+
+type ProjectMembership struct {
+	Name  string
+	Type  ProjectMemberType
+	Roles []string
+}
+
+type ListProjectMembershipsParams struct {
+	ProjectKey string
+	// The maximum number of members in a page. If 0, all the members are returned.
+	Limit int
+	// The cursor returned with the previous page. Empty for the first page.
+	Cursor string
+}
+
+func NewListProjectMembershipsParams(projectKey string) ListProjectMembershipsParams {
+	return ListProjectMembershipsParams{ProjectKey: projectKey}
+}
+
+type ProjectMembershipsPage struct {
+	Members []ProjectMembership
+	// The cursor of the next page, or empty if this is the last page.
+	NextCursor string
+}
+
+// Lists the users and the groups which are members of the project, with their roles. The users are listed first.
+// The members APIs don't support pagination, so the members are paginated on the client side.
+func (ps *ProjectService) ListMemberships(params ListProjectMembershipsParams) (*ProjectMembershipsPage, error) {
+	users, err := ps.GetUsers(params.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := ps.GetGroups(params.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+	var memberships []ProjectMembership
+	for _, user := range users {
+		memberships = append(memberships, ProjectMembership{Name: user.Name, Type: UserMember, Roles: user.Roles})
+	}
+	if groups != nil {
+		for _, group := range *groups {
+			memberships = append(memberships, ProjectMembership{Name: group.Name, Type: GroupMember, Roles: group.Roles})
+		}
+	}
+	page, nextCursor, err := clientutils.PaginateByOffset(memberships, params.Limit, params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &ProjectMembershipsPage{Members: page, NextCursor: nextCursor}, nil
+}
+
+// Unmarshals the response into result. Returns false if the resource wasn't found.
+func (ps *ProjectService) sendGet(requestUrl string, result any) (bool, error) {
+	httpDetails := ps.ServiceDetails.CreateHttpClientDetails()
+	resp, body, _, err := ps.client.SendGet(requestUrl, true, &httpDetails)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return false, err
+	}
+	return true, errorutils.CheckError(json.Unmarshal(body, result))
+}
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The project status of a repository: the project it's assigned to, and the projects it's shared with.
+type RepoProjectStatus struct {
+	ResourceName string `json:"resource_name,omitempty"`
+	// Empty if the repository isn't assigned to a project.
+	AssignedTo            string   `json:"assigned_to,omitempty"`
+	SharedWithProjects    []string `json:"shared_with_projects,omitempty"`
+	SharedWithAllProjects bool     `json:"shared_with_all_projects,omitempty"`
+	SharedReadOnly        bool     `json:"shared_read_only,omitempty"`
+	Environments          []string `json:"environments,omitempty"`
+}
+
+func (ps *ProjectService) GetRepoProjectStatus(repoName string) (*RepoProjectStatus, error) {
+	status := &RepoProjectStatus{}
+	found, err := ps.sendGet(fmt.Sprintf("%s/_/attach/repositories/%s", ps.getProjectsBaseUrl(), url.PathEscape(repoName)), status)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorutils.CheckErrorf("repository '%s' does not exist", repoName)
+	}
+	return status, nil
+}
+
+// Shares the repository with another project. If readOnly is true, the project can only read from the repository.
+func (ps *ProjectService) ShareRepo(repoName, targetProjectKey string, readOnly bool) error {
+	return ps.sendShareRequest(http.MethodPut, fmt.Sprintf("%s/%s", url.PathEscape(repoName), url.PathEscape(targetProjectKey)), readOnly)
+}
+
+func (ps *ProjectService) UnshareRepo(repoName, targetProjectKey string) error {
+	return ps.sendShareRequest(http.MethodDelete, fmt.Sprintf("%s/%s", url.PathEscape(repoName), url.PathEscape(targetProjectKey)), false)
+}
+
+func (ps *ProjectService) ShareRepoWithAll(repoName string, readOnly bool) error {
+	return ps.sendShareRequest(http.MethodPut, url.PathEscape(repoName), readOnly)
+}
+
+func (ps *ProjectService) UnshareRepoWithAll(repoName string) error {
+	return ps.sendShareRequest(http.MethodDelete, url.PathEscape(repoName), false)
+}
+
+func (ps *ProjectService) sendShareRequest(method, repoPath string, readOnly bool) error {
+	httpDetails := ps.ServiceDetails.CreateHttpClientDetails()
+	requestUrl := fmt.Sprintf("%s/_/share/repositories/%s", ps.getProjectsBaseUrl(), repoPath)
+	if readOnly {
+		requestUrl += "?readOnly=true"
+	}
+	resp, body, _, err := ps.client.Send(method, requestUrl, nil, true, true, &httpDetails, "")
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent)
+}
+
+// Moves the repository from the project it's assigned to, to the target project. If the repository is shared with the
+// target project, it's unshared first, as a project can't both own and share a repository.
+// Repositories can't be renamed, so a repository whose key is prefixed by the key of its current project, keeps the
+// prefix. Returns the key of the project the repository was moved from, or empty if it wasn't assigned to a project.
+func (ps *ProjectService) MoveRepo(repoName, targetProjectKey string) (string, error) {
+	status, err := ps.GetRepoProjectStatus(repoName)
+	if err != nil {
+		return "", err
+	}
+	sourceProjectKey := status.AssignedTo
+	if sourceProjectKey == targetProjectKey {
+		log.Info(fmt.Sprintf("Repository %s is already assigned to project %s.", repoName, targetProjectKey))
+		return sourceProjectKey, nil
+	}
+	for _, sharedWith := range status.SharedWithProjects {
+		if sharedWith == targetProjectKey {
+			if err = ps.UnshareRepo(repoName, targetProjectKey); err != nil {
+				return sourceProjectKey, err
+			}
+		}
+	}
+	// The forced assignment moves the repository from its current project, so it's never left unassigned.
+	if err = ps.AssignRepo(repoName, targetProjectKey, true); err != nil {
+		return sourceProjectKey, err
+	}
+	if sourceProjectKey != "" && strings.HasPrefix(repoName, sourceProjectKey+"-") {
+		log.Warn(fmt.Sprintf("Repository %s was moved to project %s, but keeps the prefix of project %s, as repositories can't be renamed.", repoName, targetProjectKey, sourceProjectKey))
+	}
+	return sourceProjectKey, nil
+}
+
+// A repository of a project, as listed by Artifactory.
+type ProjectRepository struct {
+	Key         string `json:"key,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+	Url         string `json:"url,omitempty"`
+	PackageType string `json:"packageType,omitempty"`
+}
+
+// Returns the repositories of the project, including the repositories shared with it.
+// The repositories are listed by Artifactory, so the details of the Artifactory service of the platform are required.
+func (ps *ProjectService) ListRepos(artifactoryDetails auth.ServiceDetails, projectKey string) ([]ProjectRepository, error) {
+	httpDetails := artifactoryDetails.CreateHttpClientDetails()
+	resp, body, _, err := ps.client.SendGet(artifactoryDetails.GetUrl()+"api/repositories?project="+url.QueryEscape(projectKey), true, &httpDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	var repos []ProjectRepository
+	if err = json.Unmarshal(body, &repos); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return repos, nil
+}
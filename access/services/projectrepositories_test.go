@@ -0,0 +1,37 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	accessAuth "github.com/jfrog/jfrog-client-go/access/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoveRepo(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"resource_name":"src-maven","assigned_to":"src","shared_with_projects":["dst"]}`))
+		}
+	}))
+	defer server.Close()
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	assert.NoError(t, err)
+	projectService := NewProjectService(client)
+	projectService.ServiceDetails = accessAuth.NewAccessDetails()
+	projectService.ServiceDetails.SetUrl(server.URL + "/")
+
+	sourceProjectKey, err := projectService.MoveRepo("src-maven", "dst")
+	assert.NoError(t, err)
+	assert.Equal(t, "src", sourceProjectKey)
+	// The repository is unshared from the target project, and then reassigned without being unassigned first.
+	assert.Equal(t, []string{
+		"GET /api/v1/projects/_/attach/repositories/src-maven",
+		"DELETE /api/v1/projects/_/share/repositories/src-maven/dst",
+		"PUT /api/v1/projects/_/attach/repositories/src-maven/dst?force=true",
+	}, requests)
+}
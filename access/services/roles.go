@@ -0,0 +1,192 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const rolesApi = "api/v1/roles"
+
+type GlobalRoleType string
+
+const (
+	// Roles created by the platform admins, which apply across all the projects.
+	CustomGlobalRole GlobalRoleType = "CUSTOM_GLOBAL"
+	// Roles which are built into the platform.
+	PredefinedGlobalRole GlobalRoleType = "PREDEFINED"
+)
+
+type GlobalRole struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Type        GlobalRoleType `json:"type,omitempty"`
+	// The environments the actions are allowed in, e.g. "DEV" and "PROD".
+	Environments []string `json:"environments,omitempty"`
+	// The allowed actions, e.g. "READ_REPOSITORY", "DEPLOY_CACHE_REPOSITORY" and "MANAGE_RELEASE_BUNDLE".
+	Actions []string `json:"actions,omitempty"`
+}
+
+func (role *GlobalRole) validate() error {
+	if role.Name == "" {
+		return errorutils.CheckErrorf("a role name is required")
+	}
+	if role.Type == PredefinedGlobalRole {
+		return errorutils.CheckErrorf("the predefined role '%s' can't be created or updated", role.Name)
+	}
+	if len(role.Actions) == 0 {
+		return errorutils.CheckErrorf("role '%s' must allow at least one action", role.Name)
+	}
+	if len(role.Environments) == 0 {
+		return errorutils.CheckErrorf("role '%s' must apply to at least one environment", role.Name)
+	}
+	return nil
+}
+
+// A user or a group which is assigned a global role.
+type RoleAssignment struct {
+	Name string            `json:"name"`
+	Type ProjectMemberType `json:"type"`
+}
+
+type roleAssignments struct {
+	Members []RoleAssignment `json:"members"`
+}
+
+// Manages the global roles, which apply to all the projects, and their assignment to users and groups.
+// Project roles are managed by the ProjectService.
+type RolesService struct {
+	client         *jfroghttpclient.JfrogHttpClient
+	ServiceDetails auth.ServiceDetails
+}
+
+func NewRolesService(client *jfroghttpclient.JfrogHttpClient) *RolesService {
+	return &RolesService{client: client}
+}
+
+func (rs *RolesService) getRoleUrl(roleName string) string {
+	return fmt.Sprintf("%s%s/%s", rs.ServiceDetails.GetUrl(), rolesApi, url.PathEscape(roleName))
+}
+
+func (rs *RolesService) getAssignmentUrl(roleName string, memberType ProjectMemberType, memberName string) string {
+	return fmt.Sprintf("%s/%ss/%s", rs.getRoleUrl(roleName), memberType, url.PathEscape(memberName))
+}
+
+// Returns the global roles, including the predefined roles.
+func (rs *RolesService) GetRoles() ([]GlobalRole, error) {
+	var roles []GlobalRole
+	if _, err := rs.sendGet(rs.ServiceDetails.GetUrl()+rolesApi, &roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// Returns nil if the role doesn't exist.
+func (rs *RolesService) GetRole(roleName string) (*GlobalRole, error) {
+	role := &GlobalRole{}
+	found, err := rs.sendGet(rs.getRoleUrl(roleName), role)
+	if err != nil || !found {
+		return nil, err
+	}
+	return role, nil
+}
+
+func (rs *RolesService) CreateRole(role GlobalRole) error {
+	if err := role.validate(); err != nil {
+		return err
+	}
+	role.Type = CustomGlobalRole
+	content, err := json.Marshal(role)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpDetails := rs.ServiceDetails.CreateHttpClientDetails()
+	httpDetails.SetContentTypeApplicationJson()
+	resp, body, err := rs.client.SendPost(rs.ServiceDetails.GetUrl()+rolesApi, content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated)
+}
+
+// Updates a custom global role. Predefined roles can't be updated.
+func (rs *RolesService) UpdateRole(role GlobalRole) error {
+	if err := role.validate(); err != nil {
+		return err
+	}
+	role.Type = CustomGlobalRole
+	content, err := json.Marshal(role)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpDetails := rs.ServiceDetails.CreateHttpClientDetails()
+	httpDetails.SetContentTypeApplicationJson()
+	resp, body, err := rs.client.SendPut(rs.getRoleUrl(role.Name), content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+}
+
+func (rs *RolesService) DeleteRole(roleName string) error {
+	return rs.sendDelete(rs.getRoleUrl(roleName))
+}
+
+// Returns the users and groups which are assigned the role.
+func (rs *RolesService) GetRoleAssignments(roleName string) ([]RoleAssignment, error) {
+	var assignments roleAssignments
+	found, err := rs.sendGet(rs.getRoleUrl(roleName)+"/members", &assignments)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorutils.CheckErrorf("role '%s' does not exist", roleName)
+	}
+	return assignments.Members, nil
+}
+
+// Assigns the role to a user or a group, at the global scope.
+func (rs *RolesService) AssignRole(roleName string, memberType ProjectMemberType, memberName string) error {
+	if memberType != UserMember && memberType != GroupMember {
+		return errorutils.CheckErrorf("a role can only be assigned to a user or a group, got '%s'", memberType)
+	}
+	httpDetails := rs.ServiceDetails.CreateHttpClientDetails()
+	resp, body, err := rs.client.SendPut(rs.getAssignmentUrl(roleName, memberType, memberName), nil, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent)
+}
+
+func (rs *RolesService) UnassignRole(roleName string, memberType ProjectMemberType, memberName string) error {
+	return rs.sendDelete(rs.getAssignmentUrl(roleName, memberType, memberName))
+}
+
+func (rs *RolesService) sendGet(requestUrl string, result any) (bool, error) {
+	httpDetails := rs.ServiceDetails.CreateHttpClientDetails()
+	resp, body, _, err := rs.client.SendGet(requestUrl, true, &httpDetails)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return false, err
+	}
+	return true, errorutils.CheckError(json.Unmarshal(body, result))
+}
+
+func (rs *RolesService) sendDelete(requestUrl string) error {
+	httpDetails := rs.ServiceDetails.CreateHttpClientDetails()
+	resp, body, err := rs.client.SendDelete(requestUrl, nil, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent)
+}
@@ -0,0 +1,301 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+)
+
+const (
+	scimApi             = "api/v1/scim/v2/"
+	scimContentType     = "application/scim+json"
+	ScimUserSchema      = "urn:ietf:params:scim:schemas:core:2.0:User"
+	ScimGroupSchema     = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimPatchOpSchema   = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	scimUsersResource   = "Users"
+	scimGroupsResource  = "Groups"
+	scimDefaultMaxCount = 100
+)
+
+// A user as represented by the SCIM 2.0 API. The ID of a user is its username.
+type ScimUser struct {
+	Schemas  []string    `json:"schemas"`
+	Id       string      `json:"id,omitempty"`
+	UserName string      `json:"userName"`
+	Active   *bool       `json:"active,omitempty"`
+	Emails   []ScimEmail `json:"emails,omitempty"`
+	// The groups of the user. Read-only, the members of a group are updated through the group.
+	Groups []ScimMember `json:"groups,omitempty"`
+}
+
+type ScimEmail struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// A group as represented by the SCIM 2.0 API. The ID of a group is its name.
+type ScimGroup struct {
+	Schemas     []string     `json:"schemas"`
+	Id          string       `json:"id,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []ScimMember `json:"members,omitempty"`
+}
+
+// A reference to a user in a group, or to a group of a user. Value is the ID of the referenced resource.
+type ScimMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+func NewScimUser(username, email string, active bool) ScimUser {
+	user := ScimUser{Schemas: []string{ScimUserSchema}, UserName: username, Active: &active}
+	if email != "" {
+		user.Emails = []ScimEmail{{Value: email, Primary: true}}
+	}
+	return user
+}
+
+func NewScimGroup(name string, members ...string) ScimGroup {
+	return ScimGroup{Schemas: []string{ScimGroupSchema}, DisplayName: name, Members: toScimMembers(members)}
+}
+
+type ScimListParams struct {
+	// A SCIM filter expression, for example `userName eq "john"`. See NewScimEqualsFilter.
+	Filter string
+	// The 1-based index of the first result. The first result if zero.
+	StartIndex int
+	// The maximum number of results. 100 if zero.
+	Count int
+}
+
+// Returns a filter expression which matches the resources whose attribute equals the value.
+func NewScimEqualsFilter(attribute, value string) string {
+	return fmt.Sprintf("%s eq %s", attribute, strconv.Quote(value))
+}
+
+type ScimListResponse[T any] struct {
+	TotalResults int `json:"totalResults"`
+	ItemsPerPage int `json:"itemsPerPage"`
+	StartIndex   int `json:"startIndex"`
+	Resources    []T `json:"Resources"`
+}
+
+// Returns the start index of the next page, or zero if this is the last page.
+func (lr *ScimListResponse[T]) NextStartIndex() int {
+	next := lr.StartIndex + len(lr.Resources)
+	if len(lr.Resources) == 0 || next > lr.TotalResults {
+		return 0
+	}
+	return next
+}
+
+type scimPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []scimPatchOperation `json:"Operations"`
+}
+
+type scimPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path,omitempty"`
+	Value any    `json:"value"`
+}
+
+// Provisions users and groups through the SCIM 2.0 API of the platform, so that an identity provider's middleware can
+// manage them. Requires admin permissions.
+type ScimService struct {
+	client         *jfroghttpclient.JfrogHttpClient
+	ServiceDetails auth.ServiceDetails
+}
+
+func NewScimService(client *jfroghttpclient.JfrogHttpClient) *ScimService {
+	return &ScimService{client: client}
+}
+
+func (ss *ScimService) getResourceUrl(resourceType, id string) string {
+	resourceUrl := ss.ServiceDetails.GetUrl() + scimApi + resourceType
+	if id != "" {
+		resourceUrl += "/" + url.PathEscape(id)
+	}
+	return resourceUrl
+}
+
+func (ss *ScimService) ListUsers(params ScimListParams) (*ScimListResponse[ScimUser], error) {
+	response := &ScimListResponse[ScimUser]{}
+	return response, ss.list(scimUsersResource, params, response)
+}
+
+// Returns nil if the user doesn't exist.
+func (ss *ScimService) GetUser(username string) (*ScimUser, error) {
+	user := &ScimUser{}
+	found, err := ss.sendGet(ss.getResourceUrl(scimUsersResource, username), user)
+	if err != nil || !found {
+		return nil, err
+	}
+	return user, nil
+}
+
+// Creates the user, and returns it as created by the server.
+func (ss *ScimService) CreateUser(user ScimUser) (*ScimUser, error) {
+	if user.UserName == "" {
+		return nil, errorutils.CheckErrorf("a SCIM user must have a userName")
+	}
+	created := &ScimUser{}
+	return created, ss.send(http.MethodPost, ss.getResourceUrl(scimUsersResource, ""), user, created, http.StatusCreated)
+}
+
+// Replaces all the attributes of the user.
+func (ss *ScimService) ReplaceUser(user ScimUser) (*ScimUser, error) {
+	if user.UserName == "" {
+		return nil, errorutils.CheckErrorf("a SCIM user must have a userName")
+	}
+	replaced := &ScimUser{}
+	return replaced, ss.send(http.MethodPut, ss.getResourceUrl(scimUsersResource, user.UserName), user, replaced, http.StatusOK)
+}
+
+// Deactivates the user, which prevents it from logging in, while keeping its tokens, groups and permissions.
+func (ss *ScimService) DeactivateUser(username string) error {
+	return ss.setUserActive(username, false)
+}
+
+func (ss *ScimService) ActivateUser(username string) error {
+	return ss.setUserActive(username, true)
+}
+
+func (ss *ScimService) setUserActive(username string, active bool) error {
+	patch := scimPatchRequest{
+		Schemas:    []string{scimPatchOpSchema},
+		Operations: []scimPatchOperation{{Op: "replace", Value: map[string]bool{"active": active}}},
+	}
+	return ss.send(http.MethodPatch, ss.getResourceUrl(scimUsersResource, username), patch, nil, http.StatusOK)
+}
+
+func (ss *ScimService) DeleteUser(username string) error {
+	return ss.sendDelete(ss.getResourceUrl(scimUsersResource, username))
+}
+
+func (ss *ScimService) ListGroups(params ScimListParams) (*ScimListResponse[ScimGroup], error) {
+	response := &ScimListResponse[ScimGroup]{}
+	return response, ss.list(scimGroupsResource, params, response)
+}
+
+// Returns nil if the group doesn't exist.
+func (ss *ScimService) GetGroup(groupName string) (*ScimGroup, error) {
+	group := &ScimGroup{}
+	found, err := ss.sendGet(ss.getResourceUrl(scimGroupsResource, groupName), group)
+	if err != nil || !found {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (ss *ScimService) CreateGroup(group ScimGroup) (*ScimGroup, error) {
+	if group.DisplayName == "" {
+		return nil, errorutils.CheckErrorf("a SCIM group must have a displayName")
+	}
+	created := &ScimGroup{}
+	return created, ss.send(http.MethodPost, ss.getResourceUrl(scimGroupsResource, ""), group, created, http.StatusCreated)
+}
+
+func (ss *ScimService) DeleteGroup(groupName string) error {
+	return ss.sendDelete(ss.getResourceUrl(scimGroupsResource, groupName))
+}
+
+// Sets the members of the group to exactly the given users, as synced from the identity provider.
+// Users which aren't in the list are removed from the group.
+func (ss *ScimService) SyncGroupMembers(groupName string, usernames []string) error {
+	patch := scimPatchRequest{
+		Schemas:    []string{scimPatchOpSchema},
+		Operations: []scimPatchOperation{{Op: "replace", Path: "members", Value: toScimMembers(usernames)}},
+	}
+	return ss.send(http.MethodPatch, ss.getResourceUrl(scimGroupsResource, groupName), patch, nil, http.StatusOK)
+}
+
+func toScimMembers(usernames []string) []ScimMember {
+	members := make([]ScimMember, 0, len(usernames))
+	for _, username := range usernames {
+		members = append(members, ScimMember{Value: username, Display: username})
+	}
+	return members
+}
+
+func (ss *ScimService) list(resourceType string, params ScimListParams, response any) error {
+	if params.StartIndex < 0 || params.Count < 0 {
+		return errorutils.CheckErrorf("the start index and the count can't be negative, got start index %d and count %d", params.StartIndex, params.Count)
+	}
+	queryParams := map[string]string{"startIndex": "1", "count": strconv.Itoa(scimDefaultMaxCount)}
+	if params.StartIndex > 0 {
+		queryParams["startIndex"] = strconv.Itoa(params.StartIndex)
+	}
+	if params.Count > 0 {
+		queryParams["count"] = strconv.Itoa(params.Count)
+	}
+	if strings.TrimSpace(params.Filter) != "" {
+		queryParams["filter"] = params.Filter
+	}
+	requestUrl, err := clientutils.BuildUrl(ss.ServiceDetails.GetUrl(), scimApi+resourceType, queryParams)
+	if err != nil {
+		return err
+	}
+	_, err = ss.sendGet(requestUrl, response)
+	return err
+}
+
+func (ss *ScimService) createHttpDetails() httputils.HttpClientDetails {
+	httpDetails := ss.ServiceDetails.CreateHttpClientDetails()
+	httpDetails.AddHeader("Content-Type", scimContentType)
+	httpDetails.AddHeader("Accept", scimContentType)
+	return httpDetails
+}
+
+func (ss *ScimService) sendGet(requestUrl string, result any) (bool, error) {
+	httpDetails := ss.createHttpDetails()
+	resp, body, _, err := ss.client.SendGet(requestUrl, true, &httpDetails)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return false, err
+	}
+	return true, errorutils.CheckError(json.Unmarshal(body, result))
+}
+
+// Sends the payload, and if result isn't nil, reads the response into it.
+func (ss *ScimService) send(method, requestUrl string, payload, result any, expectedStatus int) error {
+	content, err := json.Marshal(payload)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpDetails := ss.createHttpDetails()
+	resp, body, _, err := ss.client.Send(method, requestUrl, content, true, true, &httpDetails, "")
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, expectedStatus, http.StatusNoContent); err != nil {
+		return err
+	}
+	if result == nil || len(body) == 0 {
+		return nil
+	}
+	return errorutils.CheckError(json.Unmarshal(body, result))
+}
+
+func (ss *ScimService) sendDelete(requestUrl string) error {
+	httpDetails := ss.createHttpDetails()
+	resp, body, err := ss.client.SendDelete(requestUrl, nil, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent)
+}
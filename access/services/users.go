@@ -0,0 +1,312 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	usersUtils "github.com/jfrog/jfrog-client-go/utils/users"
+	"net/http"
+	"strconv"
+)
+
+const usersApi = "api/v2/users"
+
+// The statuses of an Access user.
+const (
+	UserStatusEnabled  = "enabled"
+	UserStatusDisabled = "disabled"
+)
+
+// The user as represented by the Access users API.
+type AccessUser struct {
+	Username                 string   `json:"username,omitempty"`
+	Email                    string   `json:"email,omitempty"`
+	Password                 string   `json:"password,omitempty"`
+	Admin                    *bool    `json:"admin,omitempty"`
+	ProfileUpdatable         *bool    `json:"profile_updatable,omitempty"`
+	DisableUiAccess          *bool    `json:"disable_ui_access,omitempty"`
+	InternalPasswordDisabled *bool    `json:"internal_password_disabled,omitempty"`
+	LastLoggedIn             string   `json:"last_logged_in,omitempty"`
+	Realm                    string   `json:"realm,omitempty"`
+	Status                   string   `json:"status,omitempty"`
+	Groups                   []string `json:"groups,omitempty"`
+}
+
+type accessUsersPage struct {
+	Users  []AccessUser `json:"users"`
+	Cursor string       `json:"cursor,omitempty"`
+}
+
+// The groups to add to and remove from a user.
+type UserGroupsUpdate struct {
+	Add    []string `json:"add,omitempty"`
+	Remove []string `json:"remove,omitempty"`
+}
+
+// Manages users using the Access users API. Implements the same UsersClient interface as the Artifactory UserService,
+// so that callers can switch between the two APIs.
+type UsersService struct {
+	client         *jfroghttpclient.JfrogHttpClient
+	ServiceDetails auth.ServiceDetails
+}
+
+func NewUsersService(client *jfroghttpclient.JfrogHttpClient) *UsersService {
+	return &UsersService{client: client}
+}
+
+func (us *UsersService) getUsersBaseUrl() string {
+	return fmt.Sprintf("%s%s", us.ServiceDetails.GetUrl(), usersApi)
+}
+
+// Returns a single page of users. The pagination is done by the server, and the name and realm filters are applied
+// on each page, so a filtered page may contain fewer users than the limit even if it isn't the last page.
+func (us *UsersService) ListUsers(params usersUtils.ListUsersParams) (*usersUtils.UsersPage, error) {
+	queryParams := make(map[string]string)
+	if params.Limit > 0 {
+		queryParams["limit"] = strconv.Itoa(params.Limit)
+	}
+	if params.Cursor != "" {
+		queryParams["cursor"] = params.Cursor
+	}
+	url, err := clientutils.BuildUrl(us.ServiceDetails.GetUrl(), usersApi, queryParams)
+	if err != nil {
+		return nil, err
+	}
+	httpDetails := us.ServiceDetails.CreateHttpClientDetails()
+	resp, body, _, err := us.client.SendGet(url, true, &httpDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	var usersPage accessUsersPage
+	if err = json.Unmarshal(body, &usersPage); err != nil {
+		return nil, errorutils.CheckErrorf("failed extracting users list from payload: %s", err.Error())
+	}
+	page := &usersUtils.UsersPage{NextCursor: usersPage.Cursor}
+	for _, accessUser := range usersPage.Users {
+		user := accessUser.ToUser()
+		if params.Matches(user) {
+			page.Users = append(page.Users, user)
+		}
+	}
+	return page, nil
+}
+
+// Returns nil if the user doesn't exist.
+func (us *UsersService) GetAccessUser(username string) (*AccessUser, error) {
+	httpDetails := us.ServiceDetails.CreateHttpClientDetails()
+	url := fmt.Sprintf("%s/%s", us.getUsersBaseUrl(), username)
+	resp, body, _, err := us.client.SendGet(url, true, &httpDetails)
+	if err != nil {
+		return nil, err
+	}
+	// In case the requested user is not found
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	var user AccessUser
+	err = json.Unmarshal(body, &user)
+	return &user, errorutils.CheckError(err)
+}
+
+// Returns nil if the user doesn't exist.
+func (us *UsersService) GetUserDetails(username string) (*usersUtils.User, error) {
+	accessUser, err := us.GetAccessUser(username)
+	if err != nil || accessUser == nil {
+		return nil, err
+	}
+	user := accessUser.ToUser()
+	return &user, nil
+}
+
+func (us *UsersService) CreateUser(params usersUtils.UserParams) error {
+	existing, err := us.GetAccessUser(params.UserDetails.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		if !params.ReplaceIfExists {
+			return errorutils.CheckErrorf("user '%s' already exists", existing.Username)
+		}
+		return us.update(params, existing)
+	}
+	content, httpDetails, err := us.createOrUpdateRequest(NewAccessUser(params.UserDetails))
+	if err != nil {
+		return err
+	}
+	resp, body, err := us.client.SendPost(us.getUsersBaseUrl(), content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated)
+}
+
+// Updates the user's details. Since the Access API manages the user's groups separately, the groups are updated by
+// adding and removing the difference from the user's current groups.
+func (us *UsersService) UpdateUser(params usersUtils.UserParams) error {
+	existing, err := us.GetAccessUser(params.UserDetails.Name)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return errorutils.CheckErrorf("user '%s' does not exist", params.UserDetails.Name)
+	}
+	return us.update(params, existing)
+}
+
+func (us *UsersService) update(params usersUtils.UserParams, existing *AccessUser) error {
+	accessUser := NewAccessUser(params.UserDetails)
+	// The username can't be changed, and the groups are updated separately.
+	accessUser.Username = ""
+	accessUser.Groups = nil
+	content, httpDetails, err := us.createOrUpdateRequest(accessUser)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/%s", us.getUsersBaseUrl(), existing.Username)
+	resp, body, err := us.client.SendPatch(url, content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	groups := params.UserDetails.Groups
+	if params.ClearGroups {
+		groups = &[]string{}
+	}
+	if groups == nil {
+		return nil
+	}
+	return us.updateGroups(existing.Username, DiffUserGroups(existing.Groups, *groups))
+}
+
+// Locks the user out of the platform until it's enabled. Unlike the lock which follows failed login attempts, it isn't
+// released by unlocking the user.
+func (us *UsersService) DisableUser(username string) error {
+	return us.setStatus(username, UserStatusDisabled)
+}
+
+func (us *UsersService) EnableUser(username string) error {
+	return us.setStatus(username, UserStatusEnabled)
+}
+
+func (us *UsersService) setStatus(username, status string) error {
+	content, httpDetails, err := us.createOrUpdateRequest(AccessUser{Status: status})
+	if err != nil {
+		return err
+	}
+	resp, body, err := us.client.SendPatch(fmt.Sprintf("%s/%s", us.getUsersBaseUrl(), username), content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+}
+
+func (us *UsersService) updateGroups(username string, groupsUpdate UserGroupsUpdate) error {
+	if len(groupsUpdate.Add) == 0 && len(groupsUpdate.Remove) == 0 {
+		return nil
+	}
+	content, httpDetails, err := us.createOrUpdateRequest(groupsUpdate)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/%s/groups", us.getUsersBaseUrl(), username)
+	resp, body, err := us.client.SendPatch(url, content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+}
+
+func (us *UsersService) createOrUpdateRequest(payload interface{}) (requestContent []byte, httpDetails httputils.HttpClientDetails, err error) {
+	httpDetails = us.ServiceDetails.CreateHttpClientDetails()
+	requestContent, err = json.Marshal(payload)
+	if errorutils.CheckError(err) != nil {
+		return
+	}
+	httpDetails.Headers = map[string]string{
+		"Content-Type": "application/json",
+		"Accept":       "application/json",
+	}
+	return
+}
+
+func (us *UsersService) DeleteUser(username string) error {
+	httpDetails := us.ServiceDetails.CreateHttpClientDetails()
+	url := fmt.Sprintf("%s/%s", us.getUsersBaseUrl(), username)
+	resp, body, err := us.client.SendDelete(url, nil, &httpDetails)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return errorutils.CheckErrorf("no response provided (including status code)")
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusNoContent)
+}
+
+func NewAccessUser(user usersUtils.User) AccessUser {
+	accessUser := AccessUser{
+		Username:                 user.Name,
+		Email:                    user.Email,
+		Password:                 user.Password,
+		Admin:                    user.Admin,
+		ProfileUpdatable:         user.ProfileUpdatable,
+		DisableUiAccess:          user.DisableUIAccess,
+		InternalPasswordDisabled: user.InternalPasswordDisabled,
+		Realm:                    user.Realm,
+	}
+	if user.Groups != nil {
+		accessUser.Groups = *user.Groups
+	}
+	return accessUser
+}
+
+func (au *AccessUser) ToUser() usersUtils.User {
+	user := usersUtils.User{
+		Name:                     au.Username,
+		Email:                    au.Email,
+		Admin:                    au.Admin,
+		ProfileUpdatable:         au.ProfileUpdatable,
+		DisableUIAccess:          au.DisableUiAccess,
+		InternalPasswordDisabled: au.InternalPasswordDisabled,
+		LastLoggedIn:             au.LastLoggedIn,
+		Realm:                    au.Realm,
+	}
+	if au.Groups != nil {
+		groups := au.Groups
+		user.Groups = &groups
+	}
+	return user
+}
+
+// Returns the groups to add and remove, to change the current groups of a user to the requested groups.
+func DiffUserGroups(current, requested []string) UserGroupsUpdate {
+	currentSet := make(map[string]bool, len(current))
+	for _, group := range current {
+		currentSet[group] = true
+	}
+	requestedSet := make(map[string]bool, len(requested))
+	var update UserGroupsUpdate
+	for _, group := range requested {
+		requestedSet[group] = true
+		if !currentSet[group] {
+			update.Add = append(update.Add, group)
+		}
+	}
+	for _, group := range current {
+		if !requestedSet[group] {
+			update.Remove = append(update.Remove, group)
+		}
+	}
+	return update
+}
@@ -0,0 +1,34 @@
+package services
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	accessAuth "github.com/jfrog/jfrog-client-go/access/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisableAndEnableUser(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+	}))
+	defer server.Close()
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	assert.NoError(t, err)
+	usersService := NewUsersService(client)
+	usersService.ServiceDetails = accessAuth.NewAccessDetails()
+	usersService.ServiceDetails.SetUrl(server.URL + "/")
+
+	assert.NoError(t, usersService.DisableUser("john"))
+	assert.NoError(t, usersService.EnableUser("john"))
+	assert.Equal(t, []string{
+		`PATCH /api/v2/users/john {"status":"disabled"}`,
+		`PATCH /api/v2/users/john {"status":"enabled"}`,
+	}, requests)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/jfrog/jfrog-client-go/auth"
 
+	"github.com/CycloneDX/cyclonedx-go"
 	buildinfo "github.com/jfrog/build-info-go/entities"
 
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
@@ -14,6 +15,7 @@ import (
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
 	clientutils "github.com/jfrog/jfrog-client-go/utils"
 	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	usersUtils "github.com/jfrog/jfrog-client-go/utils/users"
 )
 
 type ArtifactoryServicesManager interface {
@@ -43,10 +45,16 @@ type ArtifactoryServicesManager interface {
 	GetPermissionTarget(permissionTargetName string) (*services.PermissionTargetParams, error)
 	GetAllPermissionTargets() (*[]services.PermissionTargetParams, error)
 	PublishBuildInfo(build *buildinfo.BuildInfo, projectKey string) (*clientutils.Sha256Summary, error)
+	PublishBuildInfoWithOptions(build *buildinfo.BuildInfo, projectKey string, options services.PublishBuildInfoOptions) (*clientutils.Sha256Summary, error)
+	AppendBuild(aggregatedBuild *buildinfo.BuildInfo, buildName, buildNumber, projectKey string) error
 	DeleteBuildInfo(build *buildinfo.BuildInfo, projectKey string, buildNumberFrequency int) error
+	DeleteBuilds(buildName string, buildNumbers []string, deleteArtifacts bool, projectKey string) ([]services.BuildDeletionResult, error)
 	DistributeBuild(params services.BuildDistributionParams) error
 	PromoteBuild(params services.PromotionParams) error
+	PromoteBuildWithResult(params services.PromotionParams) (*services.BuildPromotionResult, error)
 	DiscardBuilds(params services.DiscardBuildsParams) error
+	ApplyBuildRetention(retention services.BuildRetention) error
+	GetBuildRetentionStatus(retention services.BuildRetention) (*services.BuildRetentionStatus, error)
 	XrayScanBuild(params services.XrayScanParams) ([]byte, error)
 	GetPathsToDelete(params services.DeleteParams) (*content.ContentReader, error)
 	DeleteFiles(reader *content.ContentReader) (int, error)
@@ -70,6 +78,10 @@ type ArtifactoryServicesManager interface {
 	GetConfig() config.Config
 	GetBuildInfo(params services.BuildInfoParams) (*buildinfo.PublishedBuildInfo, bool, error)
 	GetBuildRuns(params services.BuildInfoParams) (*buildinfo.BuildRuns, bool, error)
+	ListBuilds(projectKey string) ([]services.BuildSummary, error)
+	ListBuildRuns(params services.BuildRunsParams) (*services.BuildRunsPage, error)
+	GetBuildDiff(params services.BuildDiffParams) (*services.BuildDiff, error)
+	ExportBuildToCycloneDx(params services.BuildSbomParams) (*cyclonedx.BOM, error)
 	CreateAPIKey() (string, error)
 	RegenerateAPIKey() (string, error)
 	GetAPIKey() (string, error)
@@ -102,6 +114,18 @@ type ArtifactoryServicesManager interface {
 	DeleteUser(name string) error
 	GetLockedUsers() ([]string, error)
 	UnlockUser(name string) error
+	UnlockUsers(names ...string) error
+	UnlockAllUsers() error
+	ExpirePassword(name string) error
+	ExpirePasswords(names ...string) error
+	ExpireAllPasswords() error
+	UnexpirePassword(name string) error
+	GetPasswordExpirationPolicy() (*services.PasswordExpirationPolicy, error)
+	UpdatePasswordExpirationPolicy(policy services.PasswordExpirationPolicy) error
+	GetUserLockPolicy() (*services.UserLockPolicy, error)
+	UpdateUserLockPolicy(policy services.UserLockPolicy) error
+	RevokeUserAPIKey(username string) error
+	RevokeAllAPIKeys() error
 	ConvertLocalToFederatedRepository(repoKey string) error
 	TriggerFederatedRepositoryFullSyncAll(repoKey string) error
 	TriggerFederatedRepositoryFullSyncMirror(repoKey string, mirrorUrl string) error
@@ -112,8 +136,156 @@ type ArtifactoryServicesManager interface {
 	GetStorageInfo() (*utils.StorageInfo, error)
 	CalculateStorageInfo() error
 	ImportReleaseBundle(string) error
+	ImportReleaseBundleArchive(string) error
 	GetPackageLeadFile(leadFileParams services.LeadFileParams) ([]byte, error)
 	UploadTrustedKey(params services.TrustedKeyParams) (*services.TrustedKeyResponse, error)
+	CreateKeyPair(keyPair services.KeyPair) error
+	GetKeyPair(pairName string) (*services.KeyPair, error)
+	GetAllKeyPairs() ([]services.KeyPair, error)
+	DeleteKeyPair(pairName string) error
+	SearchDiff(params services.SearchDiffParams) (*services.SearchDiffResult, error)
+	GetFileStats(relativePath string) (*utils.FileStats, error)
+	SearchFilesStats(params services.SearchParams) ([]utils.ItemStats, error)
+	ListDockerRepositories(params services.DockerCatalogParams) ([]string, error)
+	ListDockerTags(params services.DockerTagsParams) ([]string, error)
+	ListDockerTagsWithDigests(params services.DockerTagsParams) ([]services.DockerTag, error)
+	GetDockerTag(repoKey, image, tag string) (*services.DockerTag, error)
+	GetFederatedRepositoryMembers(repoKey string) ([]services.FederatedRepositoryMember, error)
+	AddFederatedRepositoryMembers(repoKey string, members ...services.FederatedRepositoryMember) error
+	RemoveFederatedRepositoryMembers(repoKey string, memberUrls ...string) error
+	GetFederatedRepositoryStatus(repoKey string) (*services.FederatedRepositoryStatus, error)
+	CreateMultiPushReplication(params services.MultiPushReplicationParams) error
+	UpdateMultiPushReplication(params services.MultiPushReplicationParams) error
+	DeleteReplicationTarget(repoKey, targetUrl string) error
+	RunReplicationNow(params services.RunReplicationParams) error
+	GetReplicationStatus(repoPath string) (*services.ReplicationStatus, error)
+	WaitForReplication(params services.WaitForReplicationParams) (*services.ReplicationStatus, error)
+	RunReplicationAndWait(runParams services.RunReplicationParams, waitParams services.WaitForReplicationParams) (*services.ReplicationStatus, error)
+	ExportRepositoriesConfig(repoKeys ...string) (*services.RepositoriesConfig, error)
+	ApplyRepositoriesConfig(params services.ApplyRepositoriesConfigParams) ([]services.RepositoryConfigDiff, error)
+	CreateOrUpdateRepositoriesFromTemplate(params services.BulkRepositoriesParams) ([]services.BulkRepositoryResult, error)
+	GetStorageSummary() (*utils.StorageSummary, error)
+	RunGarbageCollection(params services.GarbageCollectionParams) error
+	PruneUnreferencedData() error
+	GetTasks() ([]services.Task, error)
+	WaitForTasks(params services.WaitForTasksParams) error
+	ListTrashcan(params services.TrashcanListParams) ([]services.TrashcanItem, error)
+	RestoreFromTrashcan(trashPath, targetPath string) error
+	DeleteFromTrashcan(trashPath string) error
+	EmptyTrashcan() error
+	CreateSupportBundle(params services.SupportBundleParams) (string, error)
+	GetSupportBundle(bundleId string) (*services.SupportBundle, error)
+	ListSupportBundles() ([]services.SupportBundle, error)
+	WaitForSupportBundle(params services.WaitForSupportBundleParams) (*services.SupportBundle, error)
+	DownloadSupportBundle(bundleId, localPath string) error
+	DeleteSupportBundle(bundleId string) error
+	GetVersionInfo() (*services.VersionInfo, error)
+	GetNodes() ([]services.ArtifactoryNode, error)
+	GetLicense() (*services.LicenseDetails, error)
+	GetHaLicenses() ([]services.LicenseDetails, error)
+	InstallLicense(licenseKey string) error
+	ListUsers(params usersUtils.ListUsersParams) (*usersUtils.UsersPage, error)
+	ImportUsers(params usersUtils.ImportUsersParams) error
+	ListGroupMembers(params services.ListGroupMembersParams) (*services.GroupMembersPage, error)
+	AddGroupMembers(groupName string, usernames ...string) error
+	RemoveGroupMembers(groupName string, usernames ...string) error
+	EnsureUser(desired services.User, options services.EnsureOptions) (*services.EnsureDiff, error)
+	EnsureGroup(desired services.Group, options services.EnsureOptions) (*services.EnsureDiff, error)
+	EnsurePermissionTarget(desired services.PermissionTargetParams, options services.EnsureOptions) (*services.EnsureDiff, error)
+	ApplyPermissionTargets(params services.ApplyPermissionTargetsParams) ([]services.PermissionTargetDiff, error)
+	GetPropertySets() ([]services.PropertySet, error)
+	GetPropertySet(name string) (*services.PropertySet, error)
+	CreatePropertySet(propertySet services.PropertySet) error
+	UpdatePropertySet(propertySet services.PropertySet) error
+	DeletePropertySet(name string) error
+	GetRepoLayouts() ([]services.RepoLayout, error)
+	GetRepoLayout(name string) (*services.RepoLayout, error)
+	CreateRepoLayout(repoLayout services.RepoLayout) error
+	UpdateRepoLayout(repoLayout services.RepoLayout) error
+	DeleteRepoLayout(name string) error
+	GetLdapSettings() ([]services.LdapSetting, error)
+	GetLdapSetting(key string) (*services.LdapSetting, error)
+	CreateLdapSetting(ldapSetting services.LdapSetting) error
+	UpdateLdapSetting(ldapSetting services.LdapSetting) error
+	DeleteLdapSetting(key string) error
+	GetLdapGroupSettings() ([]services.LdapGroupSetting, error)
+	GetLdapGroupSetting(name string) (*services.LdapGroupSetting, error)
+	CreateLdapGroupSetting(groupSetting services.LdapGroupSetting) error
+	UpdateLdapGroupSetting(groupSetting services.LdapGroupSetting) error
+	DeleteLdapGroupSetting(name string) error
+	GetCrowdSettings() (*services.CrowdSettings, error)
+	UpdateCrowdSettings(crowdSettings services.CrowdSettings) error
+	GetSamlSettings() (*services.SamlSettings, error)
+	UpdateSamlSettings(samlSettings services.SamlSettings) error
+	DisableSamlIntegration() error
+	SyncLdapGroups(params services.LdapGroupsSyncParams) (*services.LdapGroupsSyncResult, error)
+	Cleanup(params services.CleanupParams) (*services.CleanupReport, error)
+	DeepList(params services.DeepListParams) (*services.DeepListReader, error)
+	UpdatePropsBatch(params services.BatchPropsParams) (*services.BatchPropsResult, error)
+	CopyWithReport(params ...services.MoveCopyParams) (*services.MoveCopyReport, error)
+	MoveWithReport(params ...services.MoveCopyParams) (*services.MoveCopyReport, error)
+	TestRemoteRepositoryConnection(params services.RemoteRepositoryBaseParams) (*services.RemoteConnectionDiagnostics, error)
+	ZapCache(repoKey, relativePath string) error
+	DeleteCachedItems(params services.DeleteCachedItemsParams) (int, error)
+	GetRepositoryStorageUsage(repoKey string) (*utils.RepositoryUsage, error)
+	GetProjectStorageUsage(projectKey string) (*utils.ProjectStorageUsage, error)
+	GetStorageQuotaStatus() (*services.StorageQuotaStatus, error)
+	GetRunningTasks() ([]services.Task, error)
+	GetTask(taskId string) (*services.Task, error)
+	CancelTask(taskId string) error
+	WaitForTask(params services.WaitForTaskParams) (*services.Task, error)
+	CalculateSha256(params services.CalculateSha256Params) (*services.CalculateSha256Summary, error)
+	ApplyConfigPatch(params services.ConfigPatchParams) (*services.ConfigPatchResult, error)
+	PromoteDockerTag(params services.DockerPromoteParams) (*services.DockerPromoteResult, error)
+	GetDockerManifest(repoKey, image, reference string) (*services.OciManifest, error)
+	GetDockerManifestList(repoKey, image, reference string) (*services.OciManifestList, error)
+	GetDockerImageConfig(repoKey, image string, manifest *services.OciManifest) (*services.OciImageConfig, error)
+	GetDockerBlob(repoKey, image, digest string, writer io.Writer) error
+	ListDockerReferrers(repoKey, image, subjectDigest, artifactType string) ([]services.OciDescriptor, error)
+	GetDockerReferrer(repoKey, image, referrerDigest string) (*services.OciManifest, [][]byte, error)
+	AttachDockerReferrer(params services.AttachReferrerParams) (*services.OciDescriptor, error)
+	GetNpmPackage(repoKey, packageName string) (*services.NpmPackageMetadata, error)
+	GetNpmDistTags(repoKey, packageName string) (map[string]string, error)
+	SetNpmDistTag(repoKey, packageName, tag, version string) error
+	DeleteNpmDistTag(repoKey, packageName, tag string) error
+	DeprecateNpmVersions(params services.NpmDeprecateParams) ([]string, error)
+	UndeprecateNpmVersions(repoKey, packageName string, versions ...string) ([]string, error)
+	CalculateMavenMetadata(params services.MavenMetadataParams) error
+	CalculateMavenPluginsMetadata(params services.MavenMetadataParams) error
+	RecalculatePackageIndex(params services.RecalculateIndexParams) error
+	CalculateDebianMetadata(params services.DebianMetadataParams) error
+	CalculateCachedDebianCoordinates(remoteRepoKey string) error
+	CalculateRpmMetadata(params services.RpmMetadataParams) error
+	PushHelmChart(params services.HelmPushParams) ([]string, error)
+	GetHelmIndex(repoKey string) (*services.HelmIndex, error)
+	ResolveHelmChart(repoKey, chartName, chartVersion string) (*services.ResolvedHelmChart, error)
+	PublishTerraformModule(params services.TerraformModuleParams) (string, error)
+	PublishTerraformProvider(params services.TerraformProviderParams) (string, error)
+	ListTerraformModuleVersions(repoKey, namespace, name, provider string) ([]string, error)
+	GetTerraformModuleDownloadUrl(repoKey, namespace, name, provider, version string) (string, error)
+	ListTerraformProviderVersions(repoKey, namespace, providerType string) ([]services.TerraformProviderVersion, error)
+	GetTerraformProviderPackage(repoKey, namespace, providerType, version, os, arch string) (*services.TerraformProviderPackage, error)
+	SearchConanRecipes(repoKey, pattern string) ([]services.ConanReference, error)
+	ListConanRecipeRevisions(repoKey string, reference services.ConanReference) ([]services.ConanRevision, error)
+	ListConanPackageRevisions(repoKey string, reference services.ConanReference, recipeRevision, packageId string) ([]services.ConanRevision, error)
+	UploadConan(params services.ConanUploadParams) error
+	RemoveConanPackageRevision(repoKey string, reference services.ConanReference, recipeRevision, packageId, packageRevision string) error
+	PublishCargoCrate(params services.CargoPublishParams) (*services.CargoPublishWarnings, error)
+	YankCargoCrate(repoKey, crateName, version string) error
+	UnyankCargoCrate(repoKey, crateName, version string) error
+	ListVcsTags(repoKey, owner, repo string) ([]services.VcsRef, error)
+	ListVcsBranches(repoKey, owner, repo string) ([]services.VcsRef, error)
+	DownloadVcsTag(params services.VcsDownloadParams) error
+	DownloadVcsBranch(params services.VcsDownloadParams) error
+	GitLfsBatch(repoKey string, operation services.GitLfsOperation, objects ...services.GitLfsObject) (*services.GitLfsBatchResponse, error)
+	DownloadGitLfsObject(repoKey string, object services.GitLfsObject, localPath string) error
+	UploadGitLfsObject(repoKey, localPath string) (*services.GitLfsObject, error)
+	ListPackageVersions(params services.PackageVersionsParams) ([]services.PackageVersion, error)
+	DeletePackageVersion(params services.PackageVersionsParams, version string) (int, error)
+	UploadHuggingFaceModel(uploadServiceOptions UploadServiceOptions, params services.HuggingFaceModelUploadParams) (totalUploaded, totalFailed int, err error)
+	GetHuggingFaceModelInfo(repoKey, modelId, revision string) (*services.HuggingFaceModelInfo, error)
+	DownloadHuggingFaceModel(repoKey, modelId, revision, localDir string) (*services.HuggingFaceModelInfo, error)
+	DownloadHuggingFaceModelFile(repoKey, modelId, revision, fileName, localPath string) error
 }
 
 // By using this struct, you have the option of overriding only some of the ArtifactoryServicesManager
@@ -218,6 +390,14 @@ func (esm *EmptyArtifactoryServicesManager) PublishBuildInfo(*buildinfo.BuildInf
 	panic("Failed: Method is not implemented")
 }
 
+func (esm *EmptyArtifactoryServicesManager) PublishBuildInfoWithOptions(*buildinfo.BuildInfo, string, services.PublishBuildInfoOptions) (*clientutils.Sha256Summary, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) AppendBuild(*buildinfo.BuildInfo, string, string, string) error {
+	panic("Failed: Method is not implemented")
+}
+
 func (esm *EmptyArtifactoryServicesManager) DistributeBuild(services.BuildDistributionParams) error {
 	panic("Failed: Method is not implemented")
 }
@@ -226,10 +406,22 @@ func (esm *EmptyArtifactoryServicesManager) PromoteBuild(services.PromotionParam
 	panic("Failed: Method is not implemented")
 }
 
+func (esm *EmptyArtifactoryServicesManager) PromoteBuildWithResult(services.PromotionParams) (*services.BuildPromotionResult, error) {
+	panic("Failed: Method is not implemented")
+}
+
 func (esm *EmptyArtifactoryServicesManager) DiscardBuilds(services.DiscardBuildsParams) error {
 	panic("Failed: Method is not implemented")
 }
 
+func (esm *EmptyArtifactoryServicesManager) ApplyBuildRetention(services.BuildRetention) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetBuildRetentionStatus(services.BuildRetention) (*services.BuildRetentionStatus, error) {
+	panic("Failed: Method is not implemented")
+}
+
 func (esm *EmptyArtifactoryServicesManager) XrayScanBuild(services.XrayScanParams) ([]byte, error) {
 	panic("Failed: Method is not implemented")
 }
@@ -322,6 +514,22 @@ func (esm *EmptyArtifactoryServicesManager) GetBuildRuns(services.BuildInfoParam
 	panic("Failed: Method is not implemented")
 }
 
+func (esm *EmptyArtifactoryServicesManager) ListBuilds(string) ([]services.BuildSummary, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ListBuildRuns(services.BuildRunsParams) (*services.BuildRunsPage, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetBuildDiff(services.BuildDiffParams) (*services.BuildDiff, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ExportBuildToCycloneDx(services.BuildSbomParams) (*cyclonedx.BOM, error) {
+	panic("Failed: Method is not implemented")
+}
+
 func (esm *EmptyArtifactoryServicesManager) CreateAPIKey() (string, error) {
 	panic("Failed: Method is not implemented")
 }
@@ -438,6 +646,54 @@ func (esm *EmptyArtifactoryServicesManager) UnlockUser(string) error {
 	panic("Failed: Method is not implemented")
 }
 
+func (esm *EmptyArtifactoryServicesManager) UnlockUsers(...string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) UnlockAllUsers() error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ExpirePassword(string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ExpirePasswords(...string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ExpireAllPasswords() error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) UnexpirePassword(string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetPasswordExpirationPolicy() (*services.PasswordExpirationPolicy, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) UpdatePasswordExpirationPolicy(services.PasswordExpirationPolicy) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetUserLockPolicy() (*services.UserLockPolicy, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) UpdateUserLockPolicy(services.UserLockPolicy) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) RevokeUserAPIKey(string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) RevokeAllAPIKeys() error {
+	panic("Failed: Method is not implemented")
+}
+
 func (esm *EmptyArtifactoryServicesManager) GetGroup(services.GroupParams) (*services.Group, error) {
 	panic("Failed: Method is not implemented")
 }
@@ -498,6 +754,10 @@ func (esm *EmptyArtifactoryServicesManager) ImportReleaseBundle(string) error {
 	panic("Failed: Method is not implemented")
 }
 
+func (esm *EmptyArtifactoryServicesManager) ImportReleaseBundleArchive(string) error {
+	panic("Failed: Method is not implemented")
+}
+
 func (esm *EmptyArtifactoryServicesManager) GetPackageLeadFile(services.LeadFileParams) ([]byte, error) {
 	panic("Failed: Method is not implemented")
 }
@@ -506,10 +766,30 @@ func (esm *EmptyArtifactoryServicesManager) UploadTrustedKey(services.TrustedKey
 	panic("Failed: Method is not implemented")
 }
 
+func (esm *EmptyArtifactoryServicesManager) CreateKeyPair(services.KeyPair) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetKeyPair(string) (*services.KeyPair, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetAllKeyPairs() ([]services.KeyPair, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) DeleteKeyPair(string) error {
+	panic("Failed: Method is not implemented")
+}
+
 func (esm *EmptyArtifactoryServicesManager) DeleteBuildInfo(*buildinfo.BuildInfo, string, int) error {
 	panic("Failed: Method is not implemented")
 }
 
+func (esm *EmptyArtifactoryServicesManager) DeleteBuilds(string, []string, bool, string) ([]services.BuildDeletionResult, error) {
+	panic("Failed: Method is not implemented")
+}
+
 func (esm *EmptyArtifactoryServicesManager) GetRepositoriesStats(string) ([]byte, error) {
 	panic("Failed: Method is not implemented")
 }
@@ -526,6 +806,578 @@ func (esm *EmptyArtifactoryServicesManager) GetTokenDetails(string, string) ([]b
 	panic("Failed: Method is not implemented")
 }
 
+func (esm *EmptyArtifactoryServicesManager) SearchDiff(services.SearchDiffParams) (*services.SearchDiffResult, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetFileStats(string) (*utils.FileStats, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) SearchFilesStats(services.SearchParams) ([]utils.ItemStats, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ListDockerRepositories(services.DockerCatalogParams) ([]string, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ListDockerTags(services.DockerTagsParams) ([]string, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ListDockerTagsWithDigests(services.DockerTagsParams) ([]services.DockerTag, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetDockerTag(string, string, string) (*services.DockerTag, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetFederatedRepositoryMembers(string) ([]services.FederatedRepositoryMember, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) AddFederatedRepositoryMembers(string, ...services.FederatedRepositoryMember) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) RemoveFederatedRepositoryMembers(string, ...string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetFederatedRepositoryStatus(string) (*services.FederatedRepositoryStatus, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) CreateMultiPushReplication(services.MultiPushReplicationParams) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) UpdateMultiPushReplication(services.MultiPushReplicationParams) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) DeleteReplicationTarget(string, string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) RunReplicationNow(services.RunReplicationParams) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetReplicationStatus(string) (*services.ReplicationStatus, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) WaitForReplication(services.WaitForReplicationParams) (*services.ReplicationStatus, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) RunReplicationAndWait(services.RunReplicationParams, services.WaitForReplicationParams) (*services.ReplicationStatus, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ExportRepositoriesConfig(...string) (*services.RepositoriesConfig, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ApplyRepositoriesConfig(services.ApplyRepositoriesConfigParams) ([]services.RepositoryConfigDiff, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) CreateOrUpdateRepositoriesFromTemplate(services.BulkRepositoriesParams) ([]services.BulkRepositoryResult, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetStorageSummary() (*utils.StorageSummary, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) RunGarbageCollection(services.GarbageCollectionParams) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) PruneUnreferencedData() error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetTasks() ([]services.Task, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) WaitForTasks(services.WaitForTasksParams) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ListTrashcan(services.TrashcanListParams) ([]services.TrashcanItem, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) RestoreFromTrashcan(string, string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) DeleteFromTrashcan(string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) EmptyTrashcan() error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) CreateSupportBundle(services.SupportBundleParams) (string, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetSupportBundle(string) (*services.SupportBundle, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ListSupportBundles() ([]services.SupportBundle, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) WaitForSupportBundle(services.WaitForSupportBundleParams) (*services.SupportBundle, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) DownloadSupportBundle(string, string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) DeleteSupportBundle(string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetVersionInfo() (*services.VersionInfo, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetNodes() ([]services.ArtifactoryNode, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetLicense() (*services.LicenseDetails, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetHaLicenses() ([]services.LicenseDetails, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) InstallLicense(string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) ListUsers(usersUtils.ListUsersParams) (*usersUtils.UsersPage, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) ImportUsers(usersUtils.ImportUsersParams) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) ListGroupMembers(services.ListGroupMembersParams) (*services.GroupMembersPage, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) AddGroupMembers(string, ...string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) RemoveGroupMembers(string, ...string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) EnsureUser(services.User, services.EnsureOptions) (*services.EnsureDiff, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) EnsureGroup(services.Group, services.EnsureOptions) (*services.EnsureDiff, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) EnsurePermissionTarget(services.PermissionTargetParams, services.EnsureOptions) (*services.EnsureDiff, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) ApplyPermissionTargets(services.ApplyPermissionTargetsParams) ([]services.PermissionTargetDiff, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) GetPropertySets() ([]services.PropertySet, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) GetPropertySet(string) (*services.PropertySet, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) CreatePropertySet(services.PropertySet) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) UpdatePropertySet(services.PropertySet) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) DeletePropertySet(string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) GetRepoLayouts() ([]services.RepoLayout, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) GetRepoLayout(string) (*services.RepoLayout, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) CreateRepoLayout(services.RepoLayout) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) UpdateRepoLayout(services.RepoLayout) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) DeleteRepoLayout(string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) GetLdapSettings() ([]services.LdapSetting, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) GetLdapSetting(string) (*services.LdapSetting, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) CreateLdapSetting(services.LdapSetting) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) UpdateLdapSetting(services.LdapSetting) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) DeleteLdapSetting(string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) GetLdapGroupSettings() ([]services.LdapGroupSetting, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) GetLdapGroupSetting(string) (*services.LdapGroupSetting, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) CreateLdapGroupSetting(services.LdapGroupSetting) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) UpdateLdapGroupSetting(services.LdapGroupSetting) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) DeleteLdapGroupSetting(string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) GetCrowdSettings() (*services.CrowdSettings, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) UpdateCrowdSettings(services.CrowdSettings) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) GetSamlSettings() (*services.SamlSettings, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) UpdateSamlSettings(services.SamlSettings) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) DisableSamlIntegration() error {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) SyncLdapGroups(services.LdapGroupsSyncParams) (*services.LdapGroupsSyncResult, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) Cleanup(services.CleanupParams) (*services.CleanupReport, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (eas *EmptyArtifactoryServicesManager) DeepList(services.DeepListParams) (*services.DeepListReader, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) UpdatePropsBatch(services.BatchPropsParams) (*services.BatchPropsResult, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) CopyWithReport(...services.MoveCopyParams) (*services.MoveCopyReport, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) MoveWithReport(...services.MoveCopyParams) (*services.MoveCopyReport, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) TestRemoteRepositoryConnection(services.RemoteRepositoryBaseParams) (*services.RemoteConnectionDiagnostics, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ZapCache(string, string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) DeleteCachedItems(services.DeleteCachedItemsParams) (int, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetRepositoryStorageUsage(string) (*utils.RepositoryUsage, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetProjectStorageUsage(string) (*utils.ProjectStorageUsage, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetStorageQuotaStatus() (*services.StorageQuotaStatus, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetRunningTasks() ([]services.Task, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetTask(string) (*services.Task, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) CancelTask(string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) WaitForTask(services.WaitForTaskParams) (*services.Task, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) CalculateSha256(services.CalculateSha256Params) (*services.CalculateSha256Summary, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ApplyConfigPatch(services.ConfigPatchParams) (*services.ConfigPatchResult, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) PromoteDockerTag(services.DockerPromoteParams) (*services.DockerPromoteResult, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetDockerManifest(string, string, string) (*services.OciManifest, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetDockerManifestList(string, string, string) (*services.OciManifestList, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetDockerImageConfig(string, string, *services.OciManifest) (*services.OciImageConfig, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetDockerBlob(string, string, string, io.Writer) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ListDockerReferrers(string, string, string, string) ([]services.OciDescriptor, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetDockerReferrer(string, string, string) (*services.OciManifest, [][]byte, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) AttachDockerReferrer(services.AttachReferrerParams) (*services.OciDescriptor, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetNpmPackage(string, string) (*services.NpmPackageMetadata, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetNpmDistTags(string, string) (map[string]string, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) SetNpmDistTag(string, string, string, string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) DeleteNpmDistTag(string, string, string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) DeprecateNpmVersions(services.NpmDeprecateParams) ([]string, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) UndeprecateNpmVersions(string, string, ...string) ([]string, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) CalculateMavenMetadata(services.MavenMetadataParams) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) CalculateMavenPluginsMetadata(services.MavenMetadataParams) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) RecalculatePackageIndex(services.RecalculateIndexParams) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) CalculateDebianMetadata(services.DebianMetadataParams) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) CalculateCachedDebianCoordinates(string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) CalculateRpmMetadata(services.RpmMetadataParams) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) PushHelmChart(services.HelmPushParams) ([]string, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetHelmIndex(string) (*services.HelmIndex, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ResolveHelmChart(string, string, string) (*services.ResolvedHelmChart, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) PublishTerraformModule(services.TerraformModuleParams) (string, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) PublishTerraformProvider(services.TerraformProviderParams) (string, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ListTerraformModuleVersions(string, string, string, string) ([]string, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetTerraformModuleDownloadUrl(string, string, string, string, string) (string, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ListTerraformProviderVersions(string, string, string) ([]services.TerraformProviderVersion, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetTerraformProviderPackage(string, string, string, string, string, string) (*services.TerraformProviderPackage, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) SearchConanRecipes(string, string) ([]services.ConanReference, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ListConanRecipeRevisions(string, services.ConanReference) ([]services.ConanRevision, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ListConanPackageRevisions(string, services.ConanReference, string, string) ([]services.ConanRevision, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) UploadConan(services.ConanUploadParams) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) RemoveConanPackageRevision(string, services.ConanReference, string, string, string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) PublishCargoCrate(services.CargoPublishParams) (*services.CargoPublishWarnings, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) YankCargoCrate(string, string, string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) UnyankCargoCrate(string, string, string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ListVcsTags(string, string, string) ([]services.VcsRef, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ListVcsBranches(string, string, string) ([]services.VcsRef, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) DownloadVcsTag(services.VcsDownloadParams) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) DownloadVcsBranch(services.VcsDownloadParams) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GitLfsBatch(string, services.GitLfsOperation, ...services.GitLfsObject) (*services.GitLfsBatchResponse, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) DownloadGitLfsObject(string, services.GitLfsObject, string) error {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) UploadGitLfsObject(string, string) (*services.GitLfsObject, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) ListPackageVersions(services.PackageVersionsParams) ([]services.PackageVersion, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) DeletePackageVersion(services.PackageVersionsParams, string) (int, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) UploadHuggingFaceModel(UploadServiceOptions, services.HuggingFaceModelUploadParams) (int, int, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) GetHuggingFaceModelInfo(string, string, string) (*services.HuggingFaceModelInfo, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) DownloadHuggingFaceModel(string, string, string, string) (*services.HuggingFaceModelInfo, error) {
+	panic("Failed: Method is not implemented")
+}
+
+func (esm *EmptyArtifactoryServicesManager) DownloadHuggingFaceModelFile(string, string, string, string, string) error {
+	panic("Failed: Method is not implemented")
+}
+
 // Compile time check of interface implementation.
 // Since EmptyArtifactoryServicesManager can be used by tests external to this project, we want this project's tests to fail,
 // if EmptyArtifactoryServicesManager stops implementing the ArtifactoryServicesManager interface.
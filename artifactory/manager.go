@@ -5,6 +5,7 @@ import (
 
 	"github.com/jfrog/jfrog-client-go/auth"
 
+	"github.com/CycloneDX/cyclonedx-go"
 	buildinfo "github.com/jfrog/build-info-go/entities"
 
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
@@ -15,6 +16,7 @@ import (
 	clientutils "github.com/jfrog/jfrog-client-go/utils"
 	ioutils "github.com/jfrog/jfrog-client-go/utils/io"
 	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	usersUtils "github.com/jfrog/jfrog-client-go/utils/users"
 )
 
 type ArtifactoryServicesManagerImp struct {
@@ -168,6 +170,28 @@ func (sm *ArtifactoryServicesManagerImp) UploadTrustedKey(params services.Truste
 	return trustedKeysService.UploadTrustedKey(params)
 }
 
+func (sm *ArtifactoryServicesManagerImp) CreateKeyPair(keyPair services.KeyPair) error {
+	keyPairService := services.NewKeyPairService(sm.config.GetServiceDetails(), sm.client)
+	keyPairService.DryRun = sm.config.IsDryRun()
+	return keyPairService.Create(keyPair)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetKeyPair(pairName string) (*services.KeyPair, error) {
+	keyPairService := services.NewKeyPairService(sm.config.GetServiceDetails(), sm.client)
+	return keyPairService.Get(pairName)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetAllKeyPairs() ([]services.KeyPair, error) {
+	keyPairService := services.NewKeyPairService(sm.config.GetServiceDetails(), sm.client)
+	return keyPairService.GetAll()
+}
+
+func (sm *ArtifactoryServicesManagerImp) DeleteKeyPair(pairName string) error {
+	keyPairService := services.NewKeyPairService(sm.config.GetServiceDetails(), sm.client)
+	keyPairService.DryRun = sm.config.IsDryRun()
+	return keyPairService.Delete(pairName)
+}
+
 func (sm *ArtifactoryServicesManagerImp) GetAllRepositories() (*[]services.RepositoryDetails, error) {
 	repositoriesService := services.NewRepositoriesService(sm.client)
 	repositoriesService.ArtDetails = sm.config.GetServiceDetails()
@@ -222,12 +246,29 @@ func (sm *ArtifactoryServicesManagerImp) PublishBuildInfo(build *buildinfo.Build
 	return buildInfoService.PublishBuildInfo(build, projectKey)
 }
 
+func (sm *ArtifactoryServicesManagerImp) PublishBuildInfoWithOptions(build *buildinfo.BuildInfo, projectKey string, options services.PublishBuildInfoOptions) (*clientutils.Sha256Summary, error) {
+	buildInfoService := services.NewBuildInfoService(sm.config.GetServiceDetails(), sm.client)
+	buildInfoService.DryRun = sm.config.IsDryRun()
+	return buildInfoService.PublishBuildInfoWithOptions(build, projectKey, options)
+}
+
+func (sm *ArtifactoryServicesManagerImp) AppendBuild(aggregatedBuild *buildinfo.BuildInfo, buildName, buildNumber, projectKey string) error {
+	buildInfoService := services.NewBuildInfoService(sm.config.GetServiceDetails(), sm.client)
+	return buildInfoService.AppendBuild(aggregatedBuild, buildName, buildNumber, projectKey)
+}
+
 func (sm *ArtifactoryServicesManagerImp) DeleteBuildInfo(build *buildinfo.BuildInfo, projectKey string, numberOfBuildOccurrencesToBeDeleted int) error {
 	buildInfoService := services.NewBuildInfoService(sm.config.GetServiceDetails(), sm.client)
 	buildInfoService.DryRun = sm.config.IsDryRun()
 	return buildInfoService.DeleteBuildInfo(build, projectKey, numberOfBuildOccurrencesToBeDeleted)
 }
 
+func (sm *ArtifactoryServicesManagerImp) DeleteBuilds(buildName string, buildNumbers []string, deleteArtifacts bool, projectKey string) ([]services.BuildDeletionResult, error) {
+	buildInfoService := services.NewBuildInfoService(sm.config.GetServiceDetails(), sm.client)
+	buildInfoService.DryRun = sm.config.IsDryRun()
+	return buildInfoService.DeleteBuilds(buildName, buildNumbers, deleteArtifacts, projectKey)
+}
+
 func (sm *ArtifactoryServicesManagerImp) DistributeBuild(params services.BuildDistributionParams) error {
 	distributionService := services.NewDistributionService(sm.client)
 	distributionService.DryRun = sm.config.IsDryRun()
@@ -242,12 +283,31 @@ func (sm *ArtifactoryServicesManagerImp) PromoteBuild(params services.PromotionP
 	return promotionService.BuildPromote(params)
 }
 
+func (sm *ArtifactoryServicesManagerImp) PromoteBuildWithResult(params services.PromotionParams) (*services.BuildPromotionResult, error) {
+	promotionService := services.NewPromotionService(sm.client)
+	promotionService.DryRun = sm.config.IsDryRun()
+	promotionService.ArtDetails = sm.config.GetServiceDetails()
+	return promotionService.PromoteBuildWithResult(params)
+}
+
 func (sm *ArtifactoryServicesManagerImp) DiscardBuilds(params services.DiscardBuildsParams) error {
 	discardService := services.NewDiscardBuildsService(sm.client)
 	discardService.ArtDetails = sm.config.GetServiceDetails()
 	return discardService.DiscardBuilds(params)
 }
 
+func (sm *ArtifactoryServicesManagerImp) ApplyBuildRetention(retention services.BuildRetention) error {
+	discardService := services.NewDiscardBuildsService(sm.client)
+	discardService.ArtDetails = sm.config.GetServiceDetails()
+	return discardService.ApplyBuildRetention(retention)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetBuildRetentionStatus(retention services.BuildRetention) (*services.BuildRetentionStatus, error) {
+	discardService := services.NewDiscardBuildsService(sm.client)
+	discardService.ArtDetails = sm.config.GetServiceDetails()
+	return discardService.GetBuildRetentionStatus(retention)
+}
+
 func (sm *ArtifactoryServicesManagerImp) XrayScanBuild(params services.XrayScanParams) ([]byte, error) {
 	xrayScanService := services.NewXrayScanService(sm.client)
 	xrayScanService.ArtDetails = sm.config.GetServiceDetails()
@@ -422,6 +482,26 @@ func (sm *ArtifactoryServicesManagerImp) GetBuildRuns(params services.BuildInfoP
 	return buildInfoService.GetBuildRuns(params)
 }
 
+func (sm *ArtifactoryServicesManagerImp) ListBuilds(projectKey string) ([]services.BuildSummary, error) {
+	buildInfoService := services.NewBuildInfoService(sm.config.GetServiceDetails(), sm.client)
+	return buildInfoService.ListBuilds(projectKey)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListBuildRuns(params services.BuildRunsParams) (*services.BuildRunsPage, error) {
+	buildInfoService := services.NewBuildInfoService(sm.config.GetServiceDetails(), sm.client)
+	return buildInfoService.ListBuildRuns(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetBuildDiff(params services.BuildDiffParams) (*services.BuildDiff, error) {
+	buildInfoService := services.NewBuildInfoService(sm.config.GetServiceDetails(), sm.client)
+	return buildInfoService.GetBuildDiff(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ExportBuildToCycloneDx(params services.BuildSbomParams) (*cyclonedx.BOM, error) {
+	buildInfoService := services.NewBuildInfoService(sm.config.GetServiceDetails(), sm.client)
+	return buildInfoService.ExportBuildToCycloneDx(params)
+}
+
 func (sm *ArtifactoryServicesManagerImp) CreateAPIKey() (string, error) {
 	securityService := services.NewSecurityService(sm.client)
 	securityService.ArtDetails = sm.config.GetServiceDetails()
@@ -614,6 +694,78 @@ func (sm *ArtifactoryServicesManagerImp) UnlockUser(name string) error {
 	return userService.UnlockUser(name)
 }
 
+func (sm *ArtifactoryServicesManagerImp) UnlockUsers(names ...string) error {
+	userService := services.NewUserService(sm.client)
+	userService.ArtDetails = sm.config.GetServiceDetails()
+	return userService.UnlockUsers(names...)
+}
+
+func (sm *ArtifactoryServicesManagerImp) UnlockAllUsers() error {
+	userService := services.NewUserService(sm.client)
+	userService.ArtDetails = sm.config.GetServiceDetails()
+	return userService.UnlockAllUsers()
+}
+
+func (sm *ArtifactoryServicesManagerImp) ExpirePassword(name string) error {
+	userService := services.NewUserService(sm.client)
+	userService.ArtDetails = sm.config.GetServiceDetails()
+	return userService.ExpirePassword(name)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ExpirePasswords(names ...string) error {
+	userService := services.NewUserService(sm.client)
+	userService.ArtDetails = sm.config.GetServiceDetails()
+	return userService.ExpirePasswords(names...)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ExpireAllPasswords() error {
+	userService := services.NewUserService(sm.client)
+	userService.ArtDetails = sm.config.GetServiceDetails()
+	return userService.ExpireAllPasswords()
+}
+
+func (sm *ArtifactoryServicesManagerImp) UnexpirePassword(name string) error {
+	userService := services.NewUserService(sm.client)
+	userService.ArtDetails = sm.config.GetServiceDetails()
+	return userService.UnexpirePassword(name)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetPasswordExpirationPolicy() (*services.PasswordExpirationPolicy, error) {
+	securityService := services.NewSecurityService(sm.client)
+	securityService.ArtDetails = sm.config.GetServiceDetails()
+	return securityService.GetPasswordExpirationPolicy()
+}
+
+func (sm *ArtifactoryServicesManagerImp) UpdatePasswordExpirationPolicy(policy services.PasswordExpirationPolicy) error {
+	securityService := services.NewSecurityService(sm.client)
+	securityService.ArtDetails = sm.config.GetServiceDetails()
+	return securityService.UpdatePasswordExpirationPolicy(policy)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetUserLockPolicy() (*services.UserLockPolicy, error) {
+	securityService := services.NewSecurityService(sm.client)
+	securityService.ArtDetails = sm.config.GetServiceDetails()
+	return securityService.GetUserLockPolicy()
+}
+
+func (sm *ArtifactoryServicesManagerImp) UpdateUserLockPolicy(policy services.UserLockPolicy) error {
+	securityService := services.NewSecurityService(sm.client)
+	securityService.ArtDetails = sm.config.GetServiceDetails()
+	return securityService.UpdateUserLockPolicy(policy)
+}
+
+func (sm *ArtifactoryServicesManagerImp) RevokeUserAPIKey(username string) error {
+	securityService := services.NewSecurityService(sm.client)
+	securityService.ArtDetails = sm.config.GetServiceDetails()
+	return securityService.RevokeUserAPIKey(username)
+}
+
+func (sm *ArtifactoryServicesManagerImp) RevokeAllAPIKeys() error {
+	securityService := services.NewSecurityService(sm.client)
+	securityService.ArtDetails = sm.config.GetServiceDetails()
+	return securityService.RevokeAllAPIKeys()
+}
+
 func (sm *ArtifactoryServicesManagerImp) PromoteDocker(params services.DockerPromoteParams) error {
 	systemService := services.NewDockerPromoteService(sm.config.GetServiceDetails(), sm.client)
 	return systemService.PromoteDocker(params)
@@ -658,6 +810,790 @@ func (sm *ArtifactoryServicesManagerImp) ImportReleaseBundle(filePath string) er
 	return releaseService.ImportReleaseBundle(filePath)
 }
 
+func (sm *ArtifactoryServicesManagerImp) ImportReleaseBundleArchive(filePath string) error {
+	releaseService := services.NewReleaseService(sm.config.GetServiceDetails(), sm.client)
+	return releaseService.ImportReleaseBundleArchive(filePath)
+}
+
+func (sm *ArtifactoryServicesManagerImp) SearchDiff(params services.SearchDiffParams) (*services.SearchDiffResult, error) {
+	searchDiffService := services.NewSearchDiffService(sm.config.GetServiceDetails(), sm.client)
+	return searchDiffService.Diff(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetFileStats(relativePath string) (*utils.FileStats, error) {
+	storageService := services.NewStorageService(sm.config.GetServiceDetails(), sm.client)
+	return storageService.FileStats(relativePath)
+}
+
+func (sm *ArtifactoryServicesManagerImp) SearchFilesStats(params services.SearchParams) ([]utils.ItemStats, error) {
+	storageService := services.NewStorageService(sm.config.GetServiceDetails(), sm.client)
+	return storageService.SearchFilesStats(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListDockerRepositories(params services.DockerCatalogParams) ([]string, error) {
+	dockerRegistryService := services.NewDockerRegistryService(sm.config.GetServiceDetails(), sm.client)
+	return dockerRegistryService.ListAllRepositories(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListDockerTags(params services.DockerTagsParams) ([]string, error) {
+	dockerRegistryService := services.NewDockerRegistryService(sm.config.GetServiceDetails(), sm.client)
+	return dockerRegistryService.ListAllTags(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListDockerTagsWithDigests(params services.DockerTagsParams) ([]services.DockerTag, error) {
+	dockerRegistryService := services.NewDockerRegistryService(sm.config.GetServiceDetails(), sm.client)
+	return dockerRegistryService.ListTagsWithDigests(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetDockerTag(repoKey, image, tag string) (*services.DockerTag, error) {
+	dockerRegistryService := services.NewDockerRegistryService(sm.config.GetServiceDetails(), sm.client)
+	return dockerRegistryService.GetTag(repoKey, image, tag)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetFederatedRepositoryMembers(repoKey string) ([]services.FederatedRepositoryMember, error) {
+	federationService := services.NewFederationService(sm.client)
+	federationService.ArtDetails = sm.config.GetServiceDetails()
+	return federationService.GetMembers(repoKey)
+}
+
+func (sm *ArtifactoryServicesManagerImp) AddFederatedRepositoryMembers(repoKey string, members ...services.FederatedRepositoryMember) error {
+	federationService := services.NewFederationService(sm.client)
+	federationService.ArtDetails = sm.config.GetServiceDetails()
+	return federationService.AddMembers(repoKey, members...)
+}
+
+func (sm *ArtifactoryServicesManagerImp) RemoveFederatedRepositoryMembers(repoKey string, memberUrls ...string) error {
+	federationService := services.NewFederationService(sm.client)
+	federationService.ArtDetails = sm.config.GetServiceDetails()
+	return federationService.RemoveMembers(repoKey, memberUrls...)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetFederatedRepositoryStatus(repoKey string) (*services.FederatedRepositoryStatus, error) {
+	federationService := services.NewFederationService(sm.client)
+	federationService.ArtDetails = sm.config.GetServiceDetails()
+	return federationService.GetFederationStatus(repoKey)
+}
+
+func (sm *ArtifactoryServicesManagerImp) CreateMultiPushReplication(params services.MultiPushReplicationParams) error {
+	replicationService := services.NewMultiPushReplicationService(sm.client)
+	replicationService.ArtDetails = sm.config.GetServiceDetails()
+	return replicationService.CreateMultiPushReplication(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) UpdateMultiPushReplication(params services.MultiPushReplicationParams) error {
+	replicationService := services.NewMultiPushReplicationService(sm.client)
+	replicationService.ArtDetails = sm.config.GetServiceDetails()
+	return replicationService.UpdateMultiPushReplication(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DeleteReplicationTarget(repoKey, targetUrl string) error {
+	deleteReplicationService := services.NewDeleteReplicationService(sm.client)
+	deleteReplicationService.ArtDetails = sm.config.GetServiceDetails()
+	return deleteReplicationService.DeleteReplicationTarget(repoKey, targetUrl)
+}
+
+func (sm *ArtifactoryServicesManagerImp) RunReplicationNow(params services.RunReplicationParams) error {
+	replicationExecutionService := services.NewReplicationExecutionService(sm.client)
+	replicationExecutionService.ArtDetails = sm.config.GetServiceDetails()
+	return replicationExecutionService.RunReplicationNow(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetReplicationStatus(repoPath string) (*services.ReplicationStatus, error) {
+	replicationExecutionService := services.NewReplicationExecutionService(sm.client)
+	replicationExecutionService.ArtDetails = sm.config.GetServiceDetails()
+	return replicationExecutionService.GetReplicationStatus(repoPath)
+}
+
+func (sm *ArtifactoryServicesManagerImp) WaitForReplication(params services.WaitForReplicationParams) (*services.ReplicationStatus, error) {
+	replicationExecutionService := services.NewReplicationExecutionService(sm.client)
+	replicationExecutionService.ArtDetails = sm.config.GetServiceDetails()
+	return replicationExecutionService.WaitForReplication(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) RunReplicationAndWait(runParams services.RunReplicationParams, waitParams services.WaitForReplicationParams) (*services.ReplicationStatus, error) {
+	replicationExecutionService := services.NewReplicationExecutionService(sm.client)
+	replicationExecutionService.ArtDetails = sm.config.GetServiceDetails()
+	return replicationExecutionService.RunReplicationAndWait(runParams, waitParams)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ExportRepositoriesConfig(repoKeys ...string) (*services.RepositoriesConfig, error) {
+	repositoriesConfigService := services.NewRepositoriesConfigService(sm.client)
+	repositoriesConfigService.ArtDetails = sm.config.GetServiceDetails()
+	return repositoriesConfigService.Export(repoKeys...)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ApplyRepositoriesConfig(params services.ApplyRepositoriesConfigParams) ([]services.RepositoryConfigDiff, error) {
+	repositoriesConfigService := services.NewRepositoriesConfigService(sm.client)
+	repositoriesConfigService.ArtDetails = sm.config.GetServiceDetails()
+	return repositoriesConfigService.Apply(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) CreateOrUpdateRepositoriesFromTemplate(params services.BulkRepositoriesParams) ([]services.BulkRepositoryResult, error) {
+	bulkRepositoriesService := services.NewBulkRepositoriesService(sm.client)
+	bulkRepositoriesService.ArtDetails = sm.config.GetServiceDetails()
+	bulkRepositoriesService.Threads = sm.config.GetThreads()
+	return bulkRepositoriesService.CreateOrUpdate(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetStorageSummary() (*utils.StorageSummary, error) {
+	storageService := services.NewStorageService(sm.config.GetServiceDetails(), sm.client)
+	return storageService.StorageSummary()
+}
+
+func (sm *ArtifactoryServicesManagerImp) RunGarbageCollection(params services.GarbageCollectionParams) error {
+	systemService := services.NewSystemService(sm.config.GetServiceDetails(), sm.client)
+	return systemService.RunGarbageCollection(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) PruneUnreferencedData() error {
+	systemService := services.NewSystemService(sm.config.GetServiceDetails(), sm.client)
+	return systemService.PruneUnreferencedData()
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetTasks() ([]services.Task, error) {
+	tasksService := services.NewTasksService(sm.config.GetServiceDetails(), sm.client)
+	return tasksService.GetTasks()
+}
+
+func (sm *ArtifactoryServicesManagerImp) WaitForTasks(params services.WaitForTasksParams) error {
+	tasksService := services.NewTasksService(sm.config.GetServiceDetails(), sm.client)
+	return tasksService.WaitForTasks(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListTrashcan(params services.TrashcanListParams) ([]services.TrashcanItem, error) {
+	trashcanService := services.NewTrashcanService(sm.config.GetServiceDetails(), sm.client)
+	return trashcanService.List(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) RestoreFromTrashcan(trashPath, targetPath string) error {
+	trashcanService := services.NewTrashcanService(sm.config.GetServiceDetails(), sm.client)
+	return trashcanService.Restore(trashPath, targetPath)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DeleteFromTrashcan(trashPath string) error {
+	trashcanService := services.NewTrashcanService(sm.config.GetServiceDetails(), sm.client)
+	return trashcanService.Delete(trashPath)
+}
+
+func (sm *ArtifactoryServicesManagerImp) EmptyTrashcan() error {
+	trashcanService := services.NewTrashcanService(sm.config.GetServiceDetails(), sm.client)
+	return trashcanService.Empty()
+}
+
+func (sm *ArtifactoryServicesManagerImp) CreateSupportBundle(params services.SupportBundleParams) (string, error) {
+	supportBundleService := services.NewSupportBundleService(sm.config.GetServiceDetails(), sm.client)
+	return supportBundleService.Create(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetSupportBundle(bundleId string) (*services.SupportBundle, error) {
+	supportBundleService := services.NewSupportBundleService(sm.config.GetServiceDetails(), sm.client)
+	return supportBundleService.Get(bundleId)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListSupportBundles() ([]services.SupportBundle, error) {
+	supportBundleService := services.NewSupportBundleService(sm.config.GetServiceDetails(), sm.client)
+	return supportBundleService.List()
+}
+
+func (sm *ArtifactoryServicesManagerImp) WaitForSupportBundle(params services.WaitForSupportBundleParams) (*services.SupportBundle, error) {
+	supportBundleService := services.NewSupportBundleService(sm.config.GetServiceDetails(), sm.client)
+	return supportBundleService.WaitForCompletion(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DownloadSupportBundle(bundleId, localPath string) error {
+	supportBundleService := services.NewSupportBundleService(sm.config.GetServiceDetails(), sm.client)
+	return supportBundleService.Download(bundleId, localPath)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DeleteSupportBundle(bundleId string) error {
+	supportBundleService := services.NewSupportBundleService(sm.config.GetServiceDetails(), sm.client)
+	return supportBundleService.Delete(bundleId)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetVersionInfo() (*services.VersionInfo, error) {
+	systemService := services.NewSystemService(sm.config.GetServiceDetails(), sm.client)
+	return systemService.GetVersionInfo()
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetNodes() ([]services.ArtifactoryNode, error) {
+	systemService := services.NewSystemService(sm.config.GetServiceDetails(), sm.client)
+	return systemService.GetNodes()
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetLicense() (*services.LicenseDetails, error) {
+	systemService := services.NewSystemService(sm.config.GetServiceDetails(), sm.client)
+	return systemService.GetLicense()
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetHaLicenses() ([]services.LicenseDetails, error) {
+	systemService := services.NewSystemService(sm.config.GetServiceDetails(), sm.client)
+	return systemService.GetHaLicenses()
+}
+
+func (sm *ArtifactoryServicesManagerImp) InstallLicense(licenseKey string) error {
+	systemService := services.NewSystemService(sm.config.GetServiceDetails(), sm.client)
+	return systemService.InstallLicense(licenseKey)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListUsers(params usersUtils.ListUsersParams) (*usersUtils.UsersPage, error) {
+	userService := services.NewUserService(sm.client)
+	userService.ArtDetails = sm.config.GetServiceDetails()
+	return userService.ListUsers(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ImportUsers(params usersUtils.ImportUsersParams) error {
+	userService := services.NewUserService(sm.client)
+	userService.ArtDetails = sm.config.GetServiceDetails()
+	return usersUtils.ImportUsers(userService, params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListGroupMembers(params services.ListGroupMembersParams) (*services.GroupMembersPage, error) {
+	groupService := services.NewGroupService(sm.client)
+	groupService.ArtDetails = sm.config.GetServiceDetails()
+	return groupService.ListGroupMembers(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) AddGroupMembers(groupName string, usernames ...string) error {
+	groupService := services.NewGroupService(sm.client)
+	groupService.ArtDetails = sm.config.GetServiceDetails()
+	return groupService.AddGroupMembers(groupName, usernames...)
+}
+
+func (sm *ArtifactoryServicesManagerImp) RemoveGroupMembers(groupName string, usernames ...string) error {
+	groupService := services.NewGroupService(sm.client)
+	groupService.ArtDetails = sm.config.GetServiceDetails()
+	return groupService.RemoveGroupMembers(groupName, usernames...)
+}
+
+func (sm *ArtifactoryServicesManagerImp) EnsureUser(desired services.User, options services.EnsureOptions) (*services.EnsureDiff, error) {
+	userService := services.NewUserService(sm.client)
+	userService.ArtDetails = sm.config.GetServiceDetails()
+	return userService.EnsureUser(desired, options)
+}
+
+func (sm *ArtifactoryServicesManagerImp) EnsureGroup(desired services.Group, options services.EnsureOptions) (*services.EnsureDiff, error) {
+	groupService := services.NewGroupService(sm.client)
+	groupService.ArtDetails = sm.config.GetServiceDetails()
+	return groupService.EnsureGroup(desired, options)
+}
+
+func (sm *ArtifactoryServicesManagerImp) EnsurePermissionTarget(desired services.PermissionTargetParams, options services.EnsureOptions) (*services.EnsureDiff, error) {
+	permissionTargetService := services.NewPermissionTargetService(sm.client)
+	permissionTargetService.ArtDetails = sm.config.GetServiceDetails()
+	return permissionTargetService.EnsurePermissionTarget(desired, options)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ApplyPermissionTargets(params services.ApplyPermissionTargetsParams) ([]services.PermissionTargetDiff, error) {
+	permissionTargetService := services.NewPermissionTargetService(sm.client)
+	permissionTargetService.ArtDetails = sm.config.GetServiceDetails()
+	return permissionTargetService.Apply(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetPropertySets() ([]services.PropertySet, error) {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.GetPropertySets()
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetPropertySet(name string) (*services.PropertySet, error) {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.GetPropertySet(name)
+}
+
+func (sm *ArtifactoryServicesManagerImp) CreatePropertySet(propertySet services.PropertySet) error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.CreatePropertySet(propertySet)
+}
+
+func (sm *ArtifactoryServicesManagerImp) UpdatePropertySet(propertySet services.PropertySet) error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.UpdatePropertySet(propertySet)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DeletePropertySet(name string) error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.DeletePropertySet(name)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetRepoLayouts() ([]services.RepoLayout, error) {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.GetRepoLayouts()
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetRepoLayout(name string) (*services.RepoLayout, error) {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.GetRepoLayout(name)
+}
+
+func (sm *ArtifactoryServicesManagerImp) CreateRepoLayout(repoLayout services.RepoLayout) error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.CreateRepoLayout(repoLayout)
+}
+
+func (sm *ArtifactoryServicesManagerImp) UpdateRepoLayout(repoLayout services.RepoLayout) error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.UpdateRepoLayout(repoLayout)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DeleteRepoLayout(name string) error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.DeleteRepoLayout(name)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetLdapSettings() ([]services.LdapSetting, error) {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.GetLdapSettings()
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetLdapSetting(key string) (*services.LdapSetting, error) {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.GetLdapSetting(key)
+}
+
+func (sm *ArtifactoryServicesManagerImp) CreateLdapSetting(ldapSetting services.LdapSetting) error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.CreateLdapSetting(ldapSetting)
+}
+
+func (sm *ArtifactoryServicesManagerImp) UpdateLdapSetting(ldapSetting services.LdapSetting) error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.UpdateLdapSetting(ldapSetting)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DeleteLdapSetting(key string) error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.DeleteLdapSetting(key)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetLdapGroupSettings() ([]services.LdapGroupSetting, error) {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.GetLdapGroupSettings()
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetLdapGroupSetting(name string) (*services.LdapGroupSetting, error) {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.GetLdapGroupSetting(name)
+}
+
+func (sm *ArtifactoryServicesManagerImp) CreateLdapGroupSetting(groupSetting services.LdapGroupSetting) error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.CreateLdapGroupSetting(groupSetting)
+}
+
+func (sm *ArtifactoryServicesManagerImp) UpdateLdapGroupSetting(groupSetting services.LdapGroupSetting) error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.UpdateLdapGroupSetting(groupSetting)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DeleteLdapGroupSetting(name string) error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.DeleteLdapGroupSetting(name)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetCrowdSettings() (*services.CrowdSettings, error) {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.GetCrowdSettings()
+}
+
+func (sm *ArtifactoryServicesManagerImp) UpdateCrowdSettings(crowdSettings services.CrowdSettings) error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.UpdateCrowdSettings(crowdSettings)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetSamlSettings() (*services.SamlSettings, error) {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.GetSamlSettings()
+}
+
+func (sm *ArtifactoryServicesManagerImp) UpdateSamlSettings(samlSettings services.SamlSettings) error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.UpdateSamlSettings(samlSettings)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DisableSamlIntegration() error {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.DisableSamlIntegration()
+}
+
+func (sm *ArtifactoryServicesManagerImp) SyncLdapGroups(params services.LdapGroupsSyncParams) (*services.LdapGroupsSyncResult, error) {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.SyncLdapGroups(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) Cleanup(params services.CleanupParams) (*services.CleanupReport, error) {
+	cleanupService := services.NewCleanupService(sm.config.GetServiceDetails(), sm.client)
+	cleanupService.Threads = sm.config.GetThreads()
+	params.DryRun = params.DryRun || sm.config.IsDryRun()
+	return cleanupService.Cleanup(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DeepList(params services.DeepListParams) (*services.DeepListReader, error) {
+	storageService := services.NewStorageService(sm.config.GetServiceDetails(), sm.client)
+	return storageService.DeepList(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) UpdatePropsBatch(params services.BatchPropsParams) (*services.BatchPropsResult, error) {
+	propsService := services.NewPropsService(sm.client)
+	propsService.ArtDetails = sm.config.GetServiceDetails()
+	propsService.Threads = sm.config.GetThreads()
+	return propsService.UpdatePropsBatch(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) CopyWithReport(params ...services.MoveCopyParams) (*services.MoveCopyReport, error) {
+	copyService := services.NewMoveCopyService(sm.config.GetServiceDetails(), sm.client, services.COPY)
+	copyService.DryRun = sm.config.IsDryRun()
+	copyService.Threads = sm.config.GetThreads()
+	return copyService.MoveCopyFilesWithReport(params...)
+}
+
+func (sm *ArtifactoryServicesManagerImp) MoveWithReport(params ...services.MoveCopyParams) (*services.MoveCopyReport, error) {
+	moveService := services.NewMoveCopyService(sm.config.GetServiceDetails(), sm.client, services.MOVE)
+	moveService.DryRun = sm.config.IsDryRun()
+	moveService.Threads = sm.config.GetThreads()
+	return moveService.MoveCopyFilesWithReport(params...)
+}
+
+func (sm *ArtifactoryServicesManagerImp) TestRemoteRepositoryConnection(params services.RemoteRepositoryBaseParams) (*services.RemoteConnectionDiagnostics, error) {
+	repositoryService := services.NewRemoteRepositoryService(sm.client, false)
+	repositoryService.ArtDetails = sm.config.GetServiceDetails()
+	return repositoryService.TestConnection(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ZapCache(repoKey, relativePath string) error {
+	remoteCacheService := services.NewRemoteCacheService(sm.config.GetServiceDetails(), sm.client)
+	remoteCacheService.DryRun = sm.config.IsDryRun()
+	return remoteCacheService.ZapCache(repoKey, relativePath)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DeleteCachedItems(params services.DeleteCachedItemsParams) (int, error) {
+	remoteCacheService := services.NewRemoteCacheService(sm.config.GetServiceDetails(), sm.client)
+	remoteCacheService.DryRun = sm.config.IsDryRun()
+	remoteCacheService.Threads = sm.config.GetThreads()
+	return remoteCacheService.DeleteCachedItems(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetRepositoryStorageUsage(repoKey string) (*utils.RepositoryUsage, error) {
+	storageService := services.NewStorageService(sm.config.GetServiceDetails(), sm.client)
+	return storageService.RepositoryStorageUsage(repoKey)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetProjectStorageUsage(projectKey string) (*utils.ProjectStorageUsage, error) {
+	storageService := services.NewStorageService(sm.config.GetServiceDetails(), sm.client)
+	return storageService.ProjectStorageUsage(projectKey)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetStorageQuotaStatus() (*services.StorageQuotaStatus, error) {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	return configurationService.GetStorageQuotaStatus()
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetRunningTasks() ([]services.Task, error) {
+	tasksService := services.NewTasksService(sm.config.GetServiceDetails(), sm.client)
+	return tasksService.GetRunningTasks()
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetTask(taskId string) (*services.Task, error) {
+	tasksService := services.NewTasksService(sm.config.GetServiceDetails(), sm.client)
+	return tasksService.GetTask(taskId)
+}
+
+func (sm *ArtifactoryServicesManagerImp) CancelTask(taskId string) error {
+	tasksService := services.NewTasksService(sm.config.GetServiceDetails(), sm.client)
+	return tasksService.CancelTask(taskId)
+}
+
+func (sm *ArtifactoryServicesManagerImp) WaitForTask(params services.WaitForTaskParams) (*services.Task, error) {
+	tasksService := services.NewTasksService(sm.config.GetServiceDetails(), sm.client)
+	return tasksService.WaitForTask(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) CalculateSha256(params services.CalculateSha256Params) (*services.CalculateSha256Summary, error) {
+	checksumService := services.NewChecksumService(sm.config.GetServiceDetails(), sm.client)
+	checksumService.DryRun = sm.config.IsDryRun()
+	checksumService.Threads = sm.config.GetThreads()
+	checksumService.Progress = sm.progress
+	return checksumService.CalculateSha256(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ApplyConfigPatch(params services.ConfigPatchParams) (*services.ConfigPatchResult, error) {
+	configurationService := services.NewConfigurationService(sm.config.GetServiceDetails(), sm.client)
+	params.DryRun = params.DryRun || sm.config.IsDryRun()
+	return configurationService.ApplyConfigPatch(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) PromoteDockerTag(params services.DockerPromoteParams) (*services.DockerPromoteResult, error) {
+	promoteService := services.NewDockerPromoteService(sm.config.GetServiceDetails(), sm.client)
+	return promoteService.PromoteDockerTag(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetDockerManifest(repoKey, image, reference string) (*services.OciManifest, error) {
+	dockerRegistryService := services.NewDockerRegistryService(sm.config.GetServiceDetails(), sm.client)
+	return dockerRegistryService.GetManifest(repoKey, image, reference)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetDockerManifestList(repoKey, image, reference string) (*services.OciManifestList, error) {
+	dockerRegistryService := services.NewDockerRegistryService(sm.config.GetServiceDetails(), sm.client)
+	return dockerRegistryService.GetManifestList(repoKey, image, reference)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetDockerImageConfig(repoKey, image string, manifest *services.OciManifest) (*services.OciImageConfig, error) {
+	dockerRegistryService := services.NewDockerRegistryService(sm.config.GetServiceDetails(), sm.client)
+	return dockerRegistryService.GetImageConfig(repoKey, image, manifest)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetDockerBlob(repoKey, image, digest string, writer io.Writer) error {
+	dockerRegistryService := services.NewDockerRegistryService(sm.config.GetServiceDetails(), sm.client)
+	return dockerRegistryService.GetBlob(repoKey, image, digest, writer)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListDockerReferrers(repoKey, image, subjectDigest, artifactType string) ([]services.OciDescriptor, error) {
+	dockerRegistryService := services.NewDockerRegistryService(sm.config.GetServiceDetails(), sm.client)
+	return dockerRegistryService.ListReferrers(repoKey, image, subjectDigest, artifactType)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetDockerReferrer(repoKey, image, referrerDigest string) (*services.OciManifest, [][]byte, error) {
+	dockerRegistryService := services.NewDockerRegistryService(sm.config.GetServiceDetails(), sm.client)
+	return dockerRegistryService.GetReferrer(repoKey, image, referrerDigest)
+}
+
+func (sm *ArtifactoryServicesManagerImp) AttachDockerReferrer(params services.AttachReferrerParams) (*services.OciDescriptor, error) {
+	dockerRegistryService := services.NewDockerRegistryService(sm.config.GetServiceDetails(), sm.client)
+	return dockerRegistryService.AttachReferrer(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetNpmPackage(repoKey, packageName string) (*services.NpmPackageMetadata, error) {
+	npmService := services.NewNpmService(sm.config.GetServiceDetails(), sm.client)
+	return npmService.GetPackage(repoKey, packageName)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetNpmDistTags(repoKey, packageName string) (map[string]string, error) {
+	npmService := services.NewNpmService(sm.config.GetServiceDetails(), sm.client)
+	return npmService.GetDistTags(repoKey, packageName)
+}
+
+func (sm *ArtifactoryServicesManagerImp) SetNpmDistTag(repoKey, packageName, tag, version string) error {
+	npmService := services.NewNpmService(sm.config.GetServiceDetails(), sm.client)
+	npmService.DryRun = sm.config.IsDryRun()
+	return npmService.SetDistTag(repoKey, packageName, tag, version)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DeleteNpmDistTag(repoKey, packageName, tag string) error {
+	npmService := services.NewNpmService(sm.config.GetServiceDetails(), sm.client)
+	npmService.DryRun = sm.config.IsDryRun()
+	return npmService.DeleteDistTag(repoKey, packageName, tag)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DeprecateNpmVersions(params services.NpmDeprecateParams) ([]string, error) {
+	npmService := services.NewNpmService(sm.config.GetServiceDetails(), sm.client)
+	npmService.DryRun = sm.config.IsDryRun()
+	return npmService.Deprecate(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) UndeprecateNpmVersions(repoKey, packageName string, versions ...string) ([]string, error) {
+	npmService := services.NewNpmService(sm.config.GetServiceDetails(), sm.client)
+	npmService.DryRun = sm.config.IsDryRun()
+	return npmService.Undeprecate(repoKey, packageName, versions...)
+}
+
+func (sm *ArtifactoryServicesManagerImp) CalculateMavenMetadata(params services.MavenMetadataParams) error {
+	mavenService := services.NewMavenService(sm.config.GetServiceDetails(), sm.client)
+	mavenService.DryRun = sm.config.IsDryRun()
+	return mavenService.CalculateMetadata(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) CalculateMavenPluginsMetadata(params services.MavenMetadataParams) error {
+	mavenService := services.NewMavenService(sm.config.GetServiceDetails(), sm.client)
+	mavenService.DryRun = sm.config.IsDryRun()
+	return mavenService.CalculatePluginsMetadata(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) RecalculatePackageIndex(params services.RecalculateIndexParams) error {
+	packageIndexService := services.NewPackageIndexService(sm.config.GetServiceDetails(), sm.client)
+	packageIndexService.DryRun = sm.config.IsDryRun()
+	return packageIndexService.RecalculateIndex(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) CalculateDebianMetadata(params services.DebianMetadataParams) error {
+	metadataService := services.NewLinuxPackageMetadataService(sm.config.GetServiceDetails(), sm.client)
+	metadataService.DryRun = sm.config.IsDryRun()
+	return metadataService.CalculateDebianMetadata(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) CalculateCachedDebianCoordinates(remoteRepoKey string) error {
+	metadataService := services.NewLinuxPackageMetadataService(sm.config.GetServiceDetails(), sm.client)
+	metadataService.DryRun = sm.config.IsDryRun()
+	return metadataService.CalculateCachedDebianCoordinates(remoteRepoKey)
+}
+
+func (sm *ArtifactoryServicesManagerImp) CalculateRpmMetadata(params services.RpmMetadataParams) error {
+	metadataService := services.NewLinuxPackageMetadataService(sm.config.GetServiceDetails(), sm.client)
+	metadataService.DryRun = sm.config.IsDryRun()
+	return metadataService.CalculateRpmMetadata(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) PushHelmChart(params services.HelmPushParams) ([]string, error) {
+	helmService := services.NewHelmService(sm.config.GetServiceDetails(), sm.client)
+	helmService.DryRun = sm.config.IsDryRun()
+	return helmService.PushChart(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetHelmIndex(repoKey string) (*services.HelmIndex, error) {
+	helmService := services.NewHelmService(sm.config.GetServiceDetails(), sm.client)
+	return helmService.GetIndex(repoKey)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ResolveHelmChart(repoKey, chartName, chartVersion string) (*services.ResolvedHelmChart, error) {
+	helmService := services.NewHelmService(sm.config.GetServiceDetails(), sm.client)
+	return helmService.ResolveChart(repoKey, chartName, chartVersion)
+}
+
+func (sm *ArtifactoryServicesManagerImp) PublishTerraformModule(params services.TerraformModuleParams) (string, error) {
+	terraformService := services.NewTerraformService(sm.config.GetServiceDetails(), sm.client)
+	terraformService.DryRun = sm.config.IsDryRun()
+	return terraformService.PublishModule(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) PublishTerraformProvider(params services.TerraformProviderParams) (string, error) {
+	terraformService := services.NewTerraformService(sm.config.GetServiceDetails(), sm.client)
+	terraformService.DryRun = sm.config.IsDryRun()
+	return terraformService.PublishProvider(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListTerraformModuleVersions(repoKey, namespace, name, provider string) ([]string, error) {
+	terraformService := services.NewTerraformService(sm.config.GetServiceDetails(), sm.client)
+	return terraformService.ListModuleVersions(repoKey, namespace, name, provider)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetTerraformModuleDownloadUrl(repoKey, namespace, name, provider, version string) (string, error) {
+	terraformService := services.NewTerraformService(sm.config.GetServiceDetails(), sm.client)
+	return terraformService.GetModuleDownloadUrl(repoKey, namespace, name, provider, version)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListTerraformProviderVersions(repoKey, namespace, providerType string) ([]services.TerraformProviderVersion, error) {
+	terraformService := services.NewTerraformService(sm.config.GetServiceDetails(), sm.client)
+	return terraformService.ListProviderVersions(repoKey, namespace, providerType)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetTerraformProviderPackage(repoKey, namespace, providerType, version, os, arch string) (*services.TerraformProviderPackage, error) {
+	terraformService := services.NewTerraformService(sm.config.GetServiceDetails(), sm.client)
+	return terraformService.GetProviderPackage(repoKey, namespace, providerType, version, os, arch)
+}
+
+func (sm *ArtifactoryServicesManagerImp) SearchConanRecipes(repoKey, pattern string) ([]services.ConanReference, error) {
+	conanService := services.NewConanService(sm.config.GetServiceDetails(), sm.client)
+	return conanService.SearchRecipes(repoKey, pattern)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListConanRecipeRevisions(repoKey string, reference services.ConanReference) ([]services.ConanRevision, error) {
+	conanService := services.NewConanService(sm.config.GetServiceDetails(), sm.client)
+	return conanService.ListRecipeRevisions(repoKey, reference)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListConanPackageRevisions(repoKey string, reference services.ConanReference, recipeRevision, packageId string) ([]services.ConanRevision, error) {
+	conanService := services.NewConanService(sm.config.GetServiceDetails(), sm.client)
+	return conanService.ListPackageRevisions(repoKey, reference, recipeRevision, packageId)
+}
+
+func (sm *ArtifactoryServicesManagerImp) UploadConan(params services.ConanUploadParams) error {
+	conanService := services.NewConanService(sm.config.GetServiceDetails(), sm.client)
+	conanService.DryRun = sm.config.IsDryRun()
+	return conanService.Upload(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) RemoveConanPackageRevision(repoKey string, reference services.ConanReference, recipeRevision, packageId, packageRevision string) error {
+	conanService := services.NewConanService(sm.config.GetServiceDetails(), sm.client)
+	conanService.DryRun = sm.config.IsDryRun()
+	return conanService.RemovePackageRevision(repoKey, reference, recipeRevision, packageId, packageRevision)
+}
+
+func (sm *ArtifactoryServicesManagerImp) PublishCargoCrate(params services.CargoPublishParams) (*services.CargoPublishWarnings, error) {
+	cargoService := services.NewCargoService(sm.config.GetServiceDetails(), sm.client)
+	cargoService.DryRun = sm.config.IsDryRun()
+	return cargoService.Publish(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) YankCargoCrate(repoKey, crateName, version string) error {
+	cargoService := services.NewCargoService(sm.config.GetServiceDetails(), sm.client)
+	cargoService.DryRun = sm.config.IsDryRun()
+	return cargoService.Yank(repoKey, crateName, version)
+}
+
+func (sm *ArtifactoryServicesManagerImp) UnyankCargoCrate(repoKey, crateName, version string) error {
+	cargoService := services.NewCargoService(sm.config.GetServiceDetails(), sm.client)
+	cargoService.DryRun = sm.config.IsDryRun()
+	return cargoService.Unyank(repoKey, crateName, version)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListVcsTags(repoKey, owner, repo string) ([]services.VcsRef, error) {
+	vcsService := services.NewVcsService(sm.config.GetServiceDetails(), sm.client)
+	return vcsService.ListTags(repoKey, owner, repo)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListVcsBranches(repoKey, owner, repo string) ([]services.VcsRef, error) {
+	vcsService := services.NewVcsService(sm.config.GetServiceDetails(), sm.client)
+	return vcsService.ListBranches(repoKey, owner, repo)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DownloadVcsTag(params services.VcsDownloadParams) error {
+	vcsService := services.NewVcsService(sm.config.GetServiceDetails(), sm.client)
+	return vcsService.DownloadTag(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DownloadVcsBranch(params services.VcsDownloadParams) error {
+	vcsService := services.NewVcsService(sm.config.GetServiceDetails(), sm.client)
+	return vcsService.DownloadBranch(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GitLfsBatch(repoKey string, operation services.GitLfsOperation, objects ...services.GitLfsObject) (*services.GitLfsBatchResponse, error) {
+	gitLfsService := services.NewGitLfsService(sm.config.GetServiceDetails(), sm.client)
+	return gitLfsService.Batch(repoKey, operation, objects...)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DownloadGitLfsObject(repoKey string, object services.GitLfsObject, localPath string) error {
+	gitLfsService := services.NewGitLfsService(sm.config.GetServiceDetails(), sm.client)
+	return gitLfsService.DownloadObject(repoKey, object, localPath)
+}
+
+func (sm *ArtifactoryServicesManagerImp) UploadGitLfsObject(repoKey, localPath string) (*services.GitLfsObject, error) {
+	gitLfsService := services.NewGitLfsService(sm.config.GetServiceDetails(), sm.client)
+	gitLfsService.DryRun = sm.config.IsDryRun()
+	return gitLfsService.UploadObject(repoKey, localPath)
+}
+
+func (sm *ArtifactoryServicesManagerImp) ListPackageVersions(params services.PackageVersionsParams) ([]services.PackageVersion, error) {
+	packageVersionsService := services.NewPackageVersionsService(sm.config.GetServiceDetails(), sm.client)
+	return packageVersionsService.ListPackageVersions(params)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DeletePackageVersion(params services.PackageVersionsParams, version string) (int, error) {
+	packageVersionsService := services.NewPackageVersionsService(sm.config.GetServiceDetails(), sm.client)
+	packageVersionsService.DryRun = sm.config.IsDryRun()
+	packageVersionsService.Threads = sm.config.GetThreads()
+	return packageVersionsService.DeletePackageVersion(params, version)
+}
+
+func (sm *ArtifactoryServicesManagerImp) UploadHuggingFaceModel(uploadServiceOptions UploadServiceOptions, params services.HuggingFaceModelUploadParams) (totalUploaded, totalFailed int, err error) {
+	uploadParams, err := services.CreateHuggingFaceModelUploadParams(params)
+	if err != nil {
+		return 0, 0, err
+	}
+	return sm.UploadFiles(uploadServiceOptions, uploadParams)
+}
+
+func (sm *ArtifactoryServicesManagerImp) GetHuggingFaceModelInfo(repoKey, modelId, revision string) (*services.HuggingFaceModelInfo, error) {
+	huggingFaceService := services.NewHuggingFaceService(sm.config.GetServiceDetails(), sm.client)
+	return huggingFaceService.GetModelInfo(repoKey, modelId, revision)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DownloadHuggingFaceModel(repoKey, modelId, revision, localDir string) (*services.HuggingFaceModelInfo, error) {
+	huggingFaceService := services.NewHuggingFaceService(sm.config.GetServiceDetails(), sm.client)
+	return huggingFaceService.DownloadModel(repoKey, modelId, revision, localDir)
+}
+
+func (sm *ArtifactoryServicesManagerImp) DownloadHuggingFaceModelFile(repoKey, modelId, revision, fileName, localPath string) error {
+	huggingFaceService := services.NewHuggingFaceService(sm.config.GetServiceDetails(), sm.client)
+	return huggingFaceService.DownloadModelFile(repoKey, modelId, revision, fileName, localPath)
+}
+
 func buildJFrogHttpClient(config config.Config, authDetails auth.ServiceDetails) (*jfroghttpclient.JfrogHttpClient, error) {
 	return jfroghttpclient.JfrogClientBuilder().
 		SetCertificatesPath(config.GetCertificatesPath()).
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Returns the ID of a module which references a build, e.g. "buildName/buildNumber".
+func GetBuildModuleId(buildName, buildNumber string) string {
+	return buildName + "/" + buildNumber
+}
+
+// Returns the name and number of the build referenced by a module of an aggregated build.
+func ParseBuildModuleId(moduleId string) (buildName, buildNumber string, err error) {
+	return utils.ParseNameAndVersion(moduleId, false)
+}
+
+// Creates a module which references a published build, to be added to an aggregated build. The module is identified by
+// the build's name and number, and holds the checksums of the build's build info, which Artifactory uses to verify
+// the reference. Use "LATEST" as the build number to reference the latest run of the build.
+func (bis *BuildInfoService) CreateBuildAppendModule(buildName, buildNumber, projectKey string) (*buildinfo.Module, error) {
+	build, err := bis.getPublishedBuild(buildName, buildNumber, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	started, err := time.Parse(buildinfo.TimeFormat, build.Started)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the start time '%s' of build <%s>/<%s>: %s", build.Started, build.Name, build.Number, err.Error())
+	}
+	// The build info of a run is stored as "<number>-<started in milliseconds>.json" in the build info repository.
+	query := utils.CreateAqlQueryForBuildInfoJson(projectKey, build.Name, build.Number, strconv.FormatInt(started.UnixMilli(), 10))
+	checksum, err := bis.searchBuildInfoChecksum(query)
+	if err != nil {
+		return nil, err
+	}
+	if checksum == nil {
+		return nil, errorutils.CheckErrorf("the build info file of build <%s>/<%s> wasn't found", build.Name, build.Number)
+	}
+	return &buildinfo.Module{Id: GetBuildModuleId(build.Name, build.Number), Type: buildinfo.Build, Checksum: *checksum}, nil
+}
+
+// Adds a module which references a published build to the aggregated build. Publish the aggregated build after
+// appending all the builds it's made of.
+func (bis *BuildInfoService) AppendBuild(aggregatedBuild *buildinfo.BuildInfo, buildName, buildNumber, projectKey string) error {
+	module, err := bis.CreateBuildAppendModule(buildName, buildNumber, projectKey)
+	if err != nil {
+		return err
+	}
+	for i := range aggregatedBuild.Modules {
+		if aggregatedBuild.Modules[i].Type == buildinfo.Build && aggregatedBuild.Modules[i].Id == module.Id {
+			aggregatedBuild.Modules[i] = *module
+			return nil
+		}
+	}
+	log.Debug("Appending build", module.Id, "to build", aggregatedBuild.Name+"/"+aggregatedBuild.Number)
+	aggregatedBuild.Modules = append(aggregatedBuild.Modules, *module)
+	return nil
+}
+
+func (bis *BuildInfoService) searchBuildInfoChecksum(query string) (checksum *buildinfo.Checksum, err error) {
+	reader, err := utils.ExecAqlSaveToFile(query, bis)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	item := new(utils.ResultItem)
+	if reader.NextRecord(item) != nil {
+		return nil, reader.GetError()
+	}
+	return &buildinfo.Checksum{Sha1: item.Actual_Sha1, Md5: item.Actual_Md5}, nil
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBuildModuleId(t *testing.T) {
+	buildName, buildNumber, err := ParseBuildModuleId(GetBuildModuleId("build", "1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "build", buildName)
+	assert.Equal(t, "1", buildNumber)
+}
+
+func TestAppendBuild(t *testing.T) {
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/build/child/1":
+			_, _ = w.Write([]byte(`{"buildInfo":{"name":"child","number":"1","started":"2024-01-01T00:00:00.000+0000"}}`))
+		case "/api/search/aql":
+			query, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Contains(t, string(query), `"repo": "artifactory-build-info"`)
+			assert.Contains(t, string(query), `"1-1704067200000.json"`)
+			_, _ = w.Write([]byte(`{"results":[{"actual_sha1":"sha1","actual_md5":"md5"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	buildInfoService := NewBuildInfoService(serviceDetails, client)
+
+	aggregatedBuild := &buildinfo.BuildInfo{Name: "umbrella", Number: "1"}
+	assert.NoError(t, buildInfoService.AppendBuild(aggregatedBuild, "child", "1", ""))
+	// Appending the same build again replaces its module.
+	assert.NoError(t, buildInfoService.AppendBuild(aggregatedBuild, "child", "1", ""))
+	assert.Equal(t, []buildinfo.Module{{
+		Id:       "child/1",
+		Type:     buildinfo.Build,
+		Checksum: buildinfo.Checksum{Sha1: "sha1", Md5: "md5"},
+	}}, aggregatedBuild.Modules)
+
+	assert.ErrorContains(t, buildInfoService.AppendBuild(aggregatedBuild, "missing", "1", ""), "wasn't found")
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type BuildDiffParams struct {
+	BuildName string
+	// The newer build number.
+	BuildNumber string
+	// The older build number, compared against BuildNumber.
+	OtherBuildNumber string
+	ProjectKey       string
+}
+
+func NewBuildDiffParams(buildName, buildNumber, otherBuildNumber string) BuildDiffParams {
+	return BuildDiffParams{BuildName: buildName, BuildNumber: buildNumber, OtherBuildNumber: otherBuildNumber}
+}
+
+// The changes between two runs of a build.
+type BuildDiff struct {
+	Artifacts    BuildDiffItems[BuildDiffFile] `json:"artifacts"`
+	Dependencies BuildDiffItems[BuildDiffFile] `json:"dependencies"`
+	// The changes of the build properties, including the environment variables ("buildInfo.env.*").
+	Properties BuildDiffItems[BuildDiffProperty] `json:"properties"`
+}
+
+type BuildDiffItems[T any] struct {
+	New       []T `json:"new,omitempty"`
+	Updated   []T `json:"updated,omitempty"`
+	Unchanged []T `json:"unchanged,omitempty"`
+	Removed   []T `json:"removed,omitempty"`
+}
+
+// Returns true if any item was added, updated or removed.
+func (bdi *BuildDiffItems[T]) HasChanges() bool {
+	return len(bdi.New) > 0 || len(bdi.Updated) > 0 || len(bdi.Removed) > 0
+}
+
+type BuildDiffFile struct {
+	Name   string `json:"name,omitempty"`
+	Type   string `json:"type,omitempty"`
+	Sha1   string `json:"sha1,omitempty"`
+	Md5    string `json:"md5,omitempty"`
+	Module string `json:"module,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+type BuildDiffProperty struct {
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+	// The value in the other build, for updated properties.
+	DiffValue string `json:"diffValue,omitempty"`
+}
+
+// Returns true if the artifacts, dependencies or properties of the builds differ.
+func (bd *BuildDiff) HasChanges() bool {
+	return bd.Artifacts.HasChanges() || bd.Dependencies.HasChanges() || bd.Properties.HasChanges()
+}
+
+// Compares two runs of a build.
+func (bis *BuildInfoService) GetBuildDiff(params BuildDiffParams) (*BuildDiff, error) {
+	if params.BuildName == "" || params.BuildNumber == "" || params.OtherBuildNumber == "" {
+		return nil, errorutils.CheckErrorf("a build name and two build numbers are required")
+	}
+	if err := utils.ValidateBuildProjectKey(params.ProjectKey, bis.GetArtifactoryDetails()); err != nil {
+		return nil, err
+	}
+	queryParams := map[string]string{"diff": params.OtherBuildNumber}
+	if params.ProjectKey != "" {
+		queryParams["project"] = params.ProjectKey
+	}
+	requestUrl, err := utils.BuildUrlWithEscapingSlash(bis.GetArtifactoryDetails().GetUrl(), "api/build", params.BuildName, params.BuildNumber, queryParams)
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := bis.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := bis.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	buildDiff := &BuildDiff{}
+	return buildDiff, errorutils.CheckError(json.Unmarshal(body, buildDiff))
+}
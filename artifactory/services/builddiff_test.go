@@ -0,0 +1,31 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBuildDiff(t *testing.T) {
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/build/my%2Fbuild/2", r.URL.EscapedPath())
+		assert.Equal(t, "1", r.URL.Query().Get("diff"))
+		assert.Equal(t, "proj", r.URL.Query().Get("project"))
+		_, _ = w.Write([]byte(`{
+			"artifacts": {"new": [{"name": "app-2.jar", "sha1": "abc", "module": "app"}], "removed": [{"name": "app-1.jar"}]},
+			"dependencies": {"unchanged": [{"name": "lib.jar"}]},
+			"properties": {"updated": [{"key": "buildInfo.env.JAVA_HOME", "value": "/jdk17", "diffValue": "/jdk11"}]}
+		}`))
+	})
+
+	params := NewBuildDiffParams("my/build", "2", "1")
+	params.ProjectKey = "proj"
+	buildDiff, err := NewBuildInfoService(serviceDetails, client).GetBuildDiff(params)
+	assert.NoError(t, err)
+	assert.True(t, buildDiff.HasChanges())
+	assert.True(t, buildDiff.Artifacts.HasChanges())
+	assert.Equal(t, "app", buildDiff.Artifacts.New[0].Module)
+	assert.False(t, buildDiff.Dependencies.HasChanges())
+	assert.Equal(t, BuildDiffProperty{Key: "buildInfo.env.JAVA_HOME", Value: "/jdk17", DiffValue: "/jdk11"}, buildDiff.Properties.Updated[0])
+}
@@ -1,16 +1,29 @@
 package services
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/json"
+	"errors"
 	"fmt"
-	buildinfo "github.com/jfrog/build-info-go/entities"
+	"io"
 	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
 
 	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
 	clientutils "github.com/jfrog/jfrog-client-go/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
@@ -61,6 +74,21 @@ func (bis *BuildInfoService) GetBuildInfo(params BuildInfoParams) (pbi *buildinf
 	return utils.GetBuildInfo(params.BuildName, params.BuildNumber, params.ProjectKey, bis)
 }
 
+// Returns the build info of a run, or an error if the run wasn't found.
+func (bis *BuildInfoService) getPublishedBuild(buildName, buildNumber, projectKey string) (*buildinfo.BuildInfo, error) {
+	if buildName == "" || buildNumber == "" {
+		return nil, errorutils.CheckErrorf("a build name and a build number are required")
+	}
+	publishedBuildInfo, found, err := bis.GetBuildInfo(BuildInfoParams{BuildName: buildName, BuildNumber: buildNumber, ProjectKey: projectKey})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorutils.CheckErrorf("build <%s>/<%s> wasn't found", buildName, buildNumber)
+	}
+	return &publishedBuildInfo.BuildInfo, nil
+}
+
 // Returns the build runs for the requested build info name.
 // If build info was not found (404), returns found=false (with error nil).
 // For any other response that isn't 200, an error is returned.
@@ -68,24 +96,236 @@ func (bis *BuildInfoService) GetBuildRuns(params BuildInfoParams) (runs *buildin
 	return utils.GetBuildRuns(params.BuildName, params.ProjectKey, bis)
 }
 
+// A build, as listed by ListBuilds.
+type BuildSummary struct {
+	// The escaped build name, prefixed by a slash.
+	Uri         string `json:"uri,omitempty"`
+	LastStarted string `json:"lastStarted,omitempty"`
+}
+
+// Returns the name of the build.
+func (bs *BuildSummary) GetName() string {
+	name := strings.TrimPrefix(bs.Uri, "/")
+	if unescaped, err := url.PathUnescape(name); err == nil {
+		return unescaped
+	}
+	return name
+}
+
+type buildsListBody struct {
+	Builds []BuildSummary `json:"builds,omitempty"`
+}
+
+// Returns all the builds of the project, or of the default project if projectKey is empty.
+func (bis *BuildInfoService) ListBuilds(projectKey string) ([]BuildSummary, error) {
+	if err := utils.ValidateBuildProjectKey(projectKey, bis.GetArtifactoryDetails()); err != nil {
+		return nil, err
+	}
+	queryParams := make(map[string]string)
+	if projectKey != "" {
+		queryParams["project"] = projectKey
+	}
+	requestUrl, err := clientutils.BuildUrl(bis.GetArtifactoryDetails().GetUrl(), "api/build", queryParams)
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := bis.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := bis.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	// Artifactory responds with 404 when there are no builds.
+	if resp.StatusCode == http.StatusNotFound {
+		return []BuildSummary{}, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	builds := &buildsListBody{}
+	if err = json.Unmarshal(body, builds); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return builds.Builds, nil
+}
+
+type BuildRunsParams struct {
+	BuildName  string
+	ProjectKey string
+	// Returns only the runs started at or after this time, if set.
+	StartedAfter time.Time
+	// Returns only the runs started before this time, if set.
+	StartedBefore time.Time
+	// The number of runs to skip.
+	Offset int
+	// The maximum number of runs to return. 0 means no limit.
+	Limit int
+}
+
+func NewBuildRunsParams(buildName string) BuildRunsParams {
+	return BuildRunsParams{BuildName: buildName}
+}
+
+// A page of the runs of a build.
+type BuildRunsPage struct {
+	// The runs of the page, the most recently started first.
+	Runs []BuildRunSummary
+	// The number of runs which match the time filters, in all the pages.
+	TotalCount int
+	// True if there are more runs after this page.
+	HasMore bool
+}
+
+type BuildRunSummary struct {
+	BuildNumber string
+	Started     time.Time
+}
+
+// Returns a page of the runs of a build, the most recently started first. Use GetBuildInfo to fetch the build info
+// of a run.
+func (bis *BuildInfoService) ListBuildRuns(params BuildRunsParams) (*BuildRunsPage, error) {
+	if params.BuildName == "" {
+		return nil, errorutils.CheckErrorf("a build name is required")
+	}
+	if params.Offset < 0 || params.Limit < 0 {
+		return nil, errorutils.CheckErrorf("the offset and limit of the build runs can't be negative")
+	}
+	buildRuns, found, err := bis.GetBuildRuns(BuildInfoParams{BuildName: params.BuildName, ProjectKey: params.ProjectKey})
+	if err != nil {
+		return nil, err
+	}
+	page := &BuildRunsPage{Runs: []BuildRunSummary{}}
+	if !found {
+		return page, nil
+	}
+	return PaginateBuildRuns(buildRuns.BuildsNumbers, params)
+}
+
+// Filters the runs by their start time, sorts them, the most recently started first, and returns the requested page.
+func PaginateBuildRuns(runs []buildinfo.BuildRun, params BuildRunsParams) (*BuildRunsPage, error) {
+	var filtered []BuildRunSummary
+	for _, run := range runs {
+		started, err := time.Parse(buildinfo.TimeFormat, run.Started)
+		if err != nil {
+			return nil, errorutils.CheckErrorf("failed to parse the start time '%s' of build run '%s': %s", run.Started, run.Uri, err.Error())
+		}
+		if !params.StartedAfter.IsZero() && started.Before(params.StartedAfter) {
+			continue
+		}
+		if !params.StartedBefore.IsZero() && !started.Before(params.StartedBefore) {
+			continue
+		}
+		buildNumber := strings.TrimPrefix(run.Uri, "/")
+		if unescaped, err := url.PathUnescape(buildNumber); err == nil {
+			buildNumber = unescaped
+		}
+		filtered = append(filtered, BuildRunSummary{BuildNumber: buildNumber, Started: started})
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Started.After(filtered[j].Started)
+	})
+	page := &BuildRunsPage{Runs: []BuildRunSummary{}, TotalCount: len(filtered)}
+	if params.Offset >= len(filtered) {
+		return page, nil
+	}
+	end := len(filtered)
+	if params.Limit > 0 && params.Offset+params.Limit < end {
+		end = params.Offset + params.Limit
+	}
+	page.Runs = filtered[params.Offset:end]
+	page.HasMore = end < len(filtered)
+	return page, nil
+}
+
+// What to do when the serialized build info exceeds the maximum size.
+type BuildInfoSizePolicy string
+
+const (
+	// Fails the publishing with a BuildInfoTooLargeError.
+	FailOversizedBuildInfo BuildInfoSizePolicy = "fail"
+	// Drops the dependencies of the modules, the modules with the most dependencies first, until the build info fits.
+	// The artifacts of the modules are always kept.
+	TruncateBuildInfoDependencies BuildInfoSizePolicy = "truncate-dependencies"
+)
+
+type PublishBuildInfoOptions struct {
+	// Serializes the build info into a temporary file rather than in memory, one module at a time, and streams the file
+	// to Artifactory. Recommended for build info with tens of thousands of dependencies.
+	Stream bool
+	// Compresses the build info with gzip before sending it.
+	Gzip bool
+	// The maximum size, in bytes, of the serialized (uncompressed) build info. 0 means no limit.
+	MaxSize int64
+	// What to do when the build info exceeds MaxSize. Defaults to FailOversizedBuildInfo.
+	SizePolicy BuildInfoSizePolicy
+}
+
+// Returned when the build info exceeds the maximum size, or is rejected by Artifactory for its size.
+type BuildInfoTooLargeError struct {
+	BuildName   string
+	BuildNumber string
+	// The size of the serialized build info, in bytes. If the build info was rejected by Artifactory, this is the size of
+	// the request body, which is compressed if gzip was requested.
+	Size int64
+	// The maximum size, or 0 if the build info was rejected by Artifactory.
+	MaxSize int64
+}
+
+func (e *BuildInfoTooLargeError) Error() string {
+	if e.MaxSize == 0 {
+		return fmt.Sprintf("build info <%s>/<%s> of %d bytes was rejected by Artifactory as too large", e.BuildName, e.BuildNumber, e.Size)
+	}
+	return fmt.Sprintf("build info <%s>/<%s> of %d bytes exceeds the maximum size of %d bytes", e.BuildName, e.BuildNumber, e.Size, e.MaxSize)
+}
+
 func (bis *BuildInfoService) PublishBuildInfo(build *buildinfo.BuildInfo, projectKey string) (*clientutils.Sha256Summary, error) {
+	return bis.PublishBuildInfoWithOptions(build, projectKey, PublishBuildInfoOptions{})
+}
+
+func (bis *BuildInfoService) PublishBuildInfoWithOptions(build *buildinfo.BuildInfo, projectKey string, options PublishBuildInfoOptions) (*clientutils.Sha256Summary, error) {
 	summary := clientutils.NewSha256Summary()
-	content, err := json.Marshal(build)
-	if errorutils.CheckError(err) != nil {
+	if err := utils.ValidateBuildProjectKey(projectKey, bis.GetArtifactoryDetails()); err != nil {
+		return summary, err
+	}
+	build, _, err := ApplyBuildInfoSizeLimit(build, options.MaxSize, options.SizePolicy)
+	if err != nil {
 		return summary, err
 	}
 	if bis.IsDryRun() {
+		content, err := json.Marshal(build)
+		if errorutils.CheckError(err) != nil {
+			return summary, err
+		}
 		log.Info("[Dry run] Logging Build info preview...")
 		log.Output(clientutils.IndentJson(content))
 		return summary, nil
 	}
 	httpClientsDetails := bis.GetArtifactoryDetails().CreateHttpClientDetails()
 	utils.SetContentType("application/vnd.org.jfrog.artifactory+json", &httpClientsDetails.Headers)
+	if options.Gzip {
+		utils.AddHeader("Content-Encoding", "gzip", &httpClientsDetails.Headers)
+	}
 	log.Info(fmt.Sprintf("Publishing build info for <%s>/<%s>...", build.Name, build.Number))
-	resp, body, err := bis.client.SendPut(bis.GetArtifactoryDetails().GetUrl()+"api/build"+utils.GetProjectQueryParam(projectKey), content, &httpClientsDetails)
+	requestUrl := bis.GetArtifactoryDetails().GetUrl() + "api/build" + utils.GetProjectQueryParam(projectKey)
+	var resp *http.Response
+	var body []byte
+	var size int64
+	if options.Stream {
+		resp, body, size, err = bis.streamBuildInfo(build, options.Gzip, requestUrl, &httpClientsDetails)
+	} else {
+		content := new(bytes.Buffer)
+		if err = EncodeBuildInfo(content, build, options.Gzip); err != nil {
+			return summary, err
+		}
+		size = int64(content.Len())
+		resp, body, err = bis.client.SendPut(requestUrl, content.Bytes(), &httpClientsDetails)
+	}
 	if err != nil {
 		return summary, fmt.Errorf("error occurred while publishing build info: %s", err.Error())
 	}
+	if resp.StatusCode == http.StatusRequestEntityTooLarge {
+		return summary, errorutils.CheckError(&BuildInfoTooLargeError{BuildName: build.Name, BuildNumber: build.Number, Size: size})
+	}
 	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated, http.StatusNoContent); err != nil {
 		return summary, err
 	}
@@ -96,7 +336,169 @@ func (bis *BuildInfoService) PublishBuildInfo(build *buildinfo.BuildInfo, projec
 	return summary, nil
 }
 
+// Serializes the build info into a temporary file, and sends the file without holding it in memory.
+// Unlike SendPut, SendPutFromReader doesn't retry, so the file is resent here on network errors and server errors.
+func (bis *BuildInfoService) streamBuildInfo(build *buildinfo.BuildInfo, gzipContent bool, requestUrl string,
+	httpClientsDetails *httputils.HttpClientDetails) (resp *http.Response, body []byte, size int64, err error) {
+	tempFile, err := fileutils.CreateTempFile()
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(tempFile.Close()), errorutils.CheckError(os.Remove(tempFile.Name())))
+	}()
+	if err = EncodeBuildInfo(tempFile, build, gzipContent); err != nil {
+		return
+	}
+	size, err = tempFile.Seek(0, io.SeekCurrent)
+	if errorutils.CheckError(err) != nil {
+		return
+	}
+	retryExecutor := clientutils.RetryExecutor{
+		MaxRetries:               bis.client.GetHttpClient().GetRetries(),
+		RetriesIntervalMilliSecs: bis.client.GetHttpClient().GetRetryWaitTime(),
+		ErrorMessage:             fmt.Sprintf("Failure occurred while publishing build info to %s", requestUrl),
+		ExecutionHandler: func() (bool, error) {
+			if _, e := tempFile.Seek(0, io.SeekStart); e != nil {
+				return false, errorutils.CheckError(e)
+			}
+			var e error
+			// The HTTP client closes the request body, while the file is closed and removed here.
+			resp, body, e = bis.client.SendPutFromReader(io.NopCloser(tempFile), requestUrl, httpClientsDetails, size)
+			if e != nil {
+				return true, e
+			}
+			// If response-code < 500, should not retry
+			if resp.StatusCode < 500 {
+				return false, nil
+			}
+			return true, errorutils.CheckResponseStatusWithBody(resp, body)
+		},
+	}
+	err = retryExecutor.Execute()
+	return
+}
+
+// Writes the build info as JSON, compressed with gzip if requested.
+// The modules, which hold most of the build info, are serialized one at a time, so only a single module is held in
+// memory rather than the whole build info.
+func EncodeBuildInfo(writer io.Writer, build *buildinfo.BuildInfo, gzipContent bool) error {
+	if !gzipContent {
+		return encodeBuildInfo(writer, build)
+	}
+	gzipWriter := gzip.NewWriter(writer)
+	if err := encodeBuildInfo(gzipWriter, build); err != nil {
+		return errors.Join(err, errorutils.CheckError(gzipWriter.Close()))
+	}
+	return errorutils.CheckError(gzipWriter.Close())
+}
+
+func encodeBuildInfo(writer io.Writer, build *buildinfo.BuildInfo) error {
+	// The build info without its modules, which are omitted from the JSON when empty.
+	withoutModules := *build
+	withoutModules.Modules = nil
+	content, err := json.Marshal(&withoutModules)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if len(build.Modules) == 0 {
+		_, err = writer.Write(append(content, '\n'))
+		return errorutils.CheckError(err)
+	}
+	// Reopen the JSON object, to append the modules to it.
+	content = bytes.TrimSuffix(content, []byte("}"))
+	if len(content) > 1 {
+		content = append(content, ',')
+	}
+	content = append(content, `"modules":[`...)
+	if _, err = writer.Write(content); err != nil {
+		return errorutils.CheckError(err)
+	}
+	for i := range build.Modules {
+		if content, err = json.Marshal(&build.Modules[i]); err != nil {
+			return errorutils.CheckError(err)
+		}
+		if i > 0 {
+			content = append([]byte{','}, content...)
+		}
+		if _, err = writer.Write(content); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	_, err = writer.Write([]byte("]}\n"))
+	return errorutils.CheckError(err)
+}
+
+// Returns the size in bytes of the build info serialized as JSON.
+func GetBuildInfoSize(build *buildinfo.BuildInfo) (int64, error) {
+	counter := &byteCounter{}
+	err := EncodeBuildInfo(counter, build, false)
+	return counter.count, err
+}
+
+// Checks the size of the serialized build info against the maximum size, and applies the policy if it's exceeded.
+// Returns the build info to publish and its size. If there is no maximum size, the build info isn't serialized and
+// the returned size is 0. The given build info is never modified - when dependencies are truncated, a copy is returned.
+func ApplyBuildInfoSizeLimit(build *buildinfo.BuildInfo, maxSize int64, policy BuildInfoSizePolicy) (*buildinfo.BuildInfo, int64, error) {
+	if maxSize <= 0 {
+		return build, 0, nil
+	}
+	size, err := GetBuildInfoSize(build)
+	if err != nil || size <= maxSize {
+		return build, size, err
+	}
+	tooLargeErr := &BuildInfoTooLargeError{BuildName: build.Name, BuildNumber: build.Number, Size: size, MaxSize: maxSize}
+	switch policy {
+	case "", FailOversizedBuildInfo:
+		return nil, size, errorutils.CheckError(tooLargeErr)
+	case TruncateBuildInfoDependencies:
+	default:
+		return nil, size, errorutils.CheckErrorf("unknown build info size policy '%s'", policy)
+	}
+	truncated := *build
+	truncated.Modules = slices.Clone(build.Modules)
+	// The indexes of the modules, the modules with the most dependencies first.
+	indexes := make([]int, len(truncated.Modules))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return len(truncated.Modules[indexes[i]].Dependencies) > len(truncated.Modules[indexes[j]].Dependencies)
+	})
+	var truncatedModules []string
+	for _, i := range indexes {
+		if len(truncated.Modules[i].Dependencies) == 0 {
+			break
+		}
+		truncated.Modules[i].Dependencies = nil
+		truncatedModules = append(truncatedModules, truncated.Modules[i].Id)
+		if size, err = GetBuildInfoSize(&truncated); err != nil {
+			return nil, size, err
+		}
+		if size <= maxSize {
+			log.Warn(fmt.Sprintf("Build info <%s>/<%s> exceeds the maximum size of %d bytes. The dependencies of the following modules were removed: %s",
+				build.Name, build.Number, maxSize, strings.Join(truncatedModules, ", ")))
+			return &truncated, size, nil
+		}
+	}
+	tooLargeErr.Size = size
+	return nil, size, errorutils.CheckError(tooLargeErr)
+}
+
+// An io.Writer which only counts the bytes written to it.
+type byteCounter struct {
+	count int64
+}
+
+func (bc *byteCounter) Write(p []byte) (int, error) {
+	bc.count += int64(len(p))
+	return len(p), nil
+}
+
 func (bis *BuildInfoService) DeleteBuildInfo(build *buildinfo.BuildInfo, projectKey string, numberOfBuildOccurrencesToBeDeleted int) error {
+	if err := utils.ValidateBuildProjectKey(projectKey, bis.GetArtifactoryDetails()); err != nil {
+		return err
+	}
 	params := createDeleteBuildInfoBody(build, projectKey, numberOfBuildOccurrencesToBeDeleted)
 	content, err := json.Marshal(params)
 	if err != nil {
@@ -120,6 +522,70 @@ func (bis *BuildInfoService) DeleteBuildInfo(build *buildinfo.BuildInfo, project
 	return nil
 }
 
+// The result of deleting a single run of a build.
+type BuildDeletionResult struct {
+	BuildNumber string
+	Deleted     bool
+	// True if the run doesn't exist. Not considered a failure.
+	NotFound bool
+	Err      error
+}
+
+// Deletes the given runs of a build, and their artifacts if requested. Each run is deleted separately, so that a
+// failure to delete one run doesn't prevent deleting the others. Returns the result of each run, and an error if any
+// of them failed.
+func (bis *BuildInfoService) DeleteBuilds(buildName string, buildNumbers []string, deleteArtifacts bool, projectKey string) ([]BuildDeletionResult, error) {
+	if buildName == "" || len(buildNumbers) == 0 {
+		return nil, errorutils.CheckErrorf("a build name and at least one build number are required")
+	}
+	if err := utils.ValidateBuildProjectKey(projectKey, bis.GetArtifactoryDetails()); err != nil {
+		return nil, err
+	}
+	results := make([]BuildDeletionResult, 0, len(buildNumbers))
+	var errs []error
+	for _, buildNumber := range buildNumbers {
+		result := BuildDeletionResult{BuildNumber: buildNumber}
+		result.Deleted, result.NotFound, result.Err = bis.deleteBuildRun(buildName, buildNumber, deleteArtifacts, projectKey)
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete build <%s>/<%s>: %w", buildName, buildNumber, result.Err))
+		}
+		results = append(results, result)
+	}
+	return results, errors.Join(errs...)
+}
+
+func (bis *BuildInfoService) deleteBuildRun(buildName, buildNumber string, deleteArtifacts bool, projectKey string) (deleted, notFound bool, err error) {
+	content, err := json.Marshal(DeleteBuildInfoBody{
+		BuildName:       buildName,
+		BuildNumber:     []string{buildNumber},
+		Project:         projectKey,
+		DeleteArtifacts: deleteArtifacts,
+	})
+	if err != nil {
+		return false, false, errorutils.CheckError(err)
+	}
+	if bis.IsDryRun() {
+		log.Info(fmt.Sprintf("[Dry run] Deleting build <%s>/<%s>", buildName, buildNumber))
+		return false, false, nil
+	}
+	log.Info(fmt.Sprintf("Deleting build <%s>/<%s>...", buildName, buildNumber))
+	httpClientsDetails := bis.GetArtifactoryDetails().CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	resp, body, err := bis.client.SendPost(bis.GetArtifactoryDetails().GetUrl()+"api/build/delete", content, &httpClientsDetails)
+	if err != nil {
+		return false, false, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		log.Info(fmt.Sprintf("Build <%s>/<%s> wasn't found.", buildName, buildNumber))
+		return false, true, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent); err != nil {
+		return false, false, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return true, false, nil
+}
+
 func createDeleteBuildInfoBody(build *buildinfo.BuildInfo, projectKey string, numberOfBuildOccurrencesToBeDeleted int) DeleteBuildInfoBody {
 	buildNumbers := make([]string, 0, numberOfBuildOccurrencesToBeDeleted)
 	for i := 0; i < numberOfBuildOccurrencesToBeDeleted; i++ {
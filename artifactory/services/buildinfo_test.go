@@ -0,0 +1,228 @@
+package services
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestBuildInfo() *buildinfo.BuildInfo {
+	dependencies := func(count int) (result []buildinfo.Dependency) {
+		for range count {
+			result = append(result, buildinfo.Dependency{Id: "org.acme:dependency-with-a-long-name:1.0.0"})
+		}
+		return
+	}
+	return &buildinfo.BuildInfo{
+		Name:   "build",
+		Number: "1",
+		Modules: []buildinfo.Module{
+			{Id: "small", Dependencies: dependencies(1)},
+			{Id: "large", Dependencies: dependencies(100)},
+			{Id: "medium", Dependencies: dependencies(10)},
+		},
+	}
+}
+
+func TestApplyBuildInfoSizeLimit(t *testing.T) {
+	build := createTestBuildInfo()
+	size, err := GetBuildInfoSize(build)
+	assert.NoError(t, err)
+
+	// Without a maximum size, the build info isn't serialized.
+	result, resultSize, err := ApplyBuildInfoSizeLimit(build, 0, FailOversizedBuildInfo)
+	assert.NoError(t, err)
+	assert.Same(t, build, result)
+	assert.Zero(t, resultSize)
+
+	result, resultSize, err = ApplyBuildInfoSizeLimit(build, size, FailOversizedBuildInfo)
+	assert.NoError(t, err)
+	assert.Same(t, build, result)
+	assert.Equal(t, size, resultSize)
+
+	_, _, err = ApplyBuildInfoSizeLimit(build, size/2, FailOversizedBuildInfo)
+	var tooLargeErr *BuildInfoTooLargeError
+	assert.True(t, errors.As(err, &tooLargeErr))
+	assert.Equal(t, size, tooLargeErr.Size)
+	assert.Equal(t, size/2, tooLargeErr.MaxSize)
+
+	// Dropping the dependencies of the largest module is enough.
+	result, resultSize, err = ApplyBuildInfoSizeLimit(build, size/2, TruncateBuildInfoDependencies)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, resultSize, size/2)
+	assert.Len(t, result.Modules[0].Dependencies, 1)
+	assert.Empty(t, result.Modules[1].Dependencies)
+	assert.Len(t, result.Modules[2].Dependencies, 10)
+	// The given build info is kept as is.
+	assert.Len(t, build.Modules[1].Dependencies, 100)
+
+	// Not even dropping all the dependencies is enough.
+	_, _, err = ApplyBuildInfoSizeLimit(build, 10, TruncateBuildInfoDependencies)
+	assert.True(t, errors.As(err, &tooLargeErr))
+	assert.Less(t, tooLargeErr.Size, size)
+}
+
+func TestEncodeBuildInfo(t *testing.T) {
+	for _, build := range []*buildinfo.BuildInfo{createTestBuildInfo(), {Name: "build", Number: "1"}, {}, {Modules: []buildinfo.Module{{Id: "module"}}}} {
+		expected, err := json.Marshal(build)
+		assert.NoError(t, err)
+		content := new(bytes.Buffer)
+		assert.NoError(t, EncodeBuildInfo(content, build, false))
+		assert.Equal(t, string(expected)+"\n", content.String())
+	}
+}
+
+func TestPublishBuildInfoWithOptions(t *testing.T) {
+	build := createTestBuildInfo()
+	for _, options := range []PublishBuildInfoOptions{{}, {Gzip: true}, {Stream: true}, {Stream: true, Gzip: true}} {
+		serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "/api/build", r.URL.Path)
+			var reader io.Reader = r.Body
+			if options.Gzip {
+				assert.Equal(t, "gzip", r.Header.Get("Content-Encoding"))
+				gzipReader, err := gzip.NewReader(r.Body)
+				assert.NoError(t, err)
+				reader = gzipReader
+			}
+			published := &buildinfo.BuildInfo{}
+			assert.NoError(t, json.NewDecoder(reader).Decode(published))
+			assert.Equal(t, build, published)
+			w.WriteHeader(http.StatusNoContent)
+		})
+		summary, err := NewBuildInfoService(serviceDetails, client).PublishBuildInfoWithOptions(build, "", options)
+		assert.NoError(t, err)
+		assert.True(t, summary.IsSucceeded())
+	}
+}
+
+func TestPublishBuildInfoStreamRetries(t *testing.T) {
+	build := createTestBuildInfo()
+	requests := 0
+	serviceDetails, _ := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		published := &buildinfo.BuildInfo{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(published))
+		assert.Equal(t, build, published)
+		if requests == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	client, err := jfroghttpclient.JfrogClientBuilder().SetRetries(1).Build()
+	assert.NoError(t, err)
+	summary, err := NewBuildInfoService(serviceDetails, client).PublishBuildInfoWithOptions(build, "", PublishBuildInfoOptions{Stream: true})
+	assert.NoError(t, err)
+	assert.True(t, summary.IsSucceeded())
+	assert.Equal(t, 2, requests)
+}
+
+func TestPublishBuildInfoRejectedAsTooLarge(t *testing.T) {
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+	})
+	_, err := NewBuildInfoService(serviceDetails, client).PublishBuildInfoWithOptions(createTestBuildInfo(), "", PublishBuildInfoOptions{Stream: true})
+	var tooLargeErr *BuildInfoTooLargeError
+	assert.True(t, errors.As(err, &tooLargeErr))
+	assert.Zero(t, tooLargeErr.MaxSize)
+	assert.Positive(t, tooLargeErr.Size)
+}
+
+func TestListBuilds(t *testing.T) {
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/build", r.URL.Path)
+		if r.URL.Query().Get("project") == "empty" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "proj", r.URL.Query().Get("project"))
+		_, _ = w.Write([]byte(`{"builds":[{"uri":"/my%20build","lastStarted":"2018-05-07T17:34:49.729+0300"}]}`))
+	})
+	buildInfoService := NewBuildInfoService(serviceDetails, client)
+
+	builds, err := buildInfoService.ListBuilds("proj")
+	assert.NoError(t, err)
+	if assert.Len(t, builds, 1) {
+		assert.Equal(t, "my build", builds[0].GetName())
+	}
+	builds, err = buildInfoService.ListBuilds("empty")
+	assert.NoError(t, err)
+	assert.Empty(t, builds)
+}
+
+func TestPaginateBuildRuns(t *testing.T) {
+	runs := []buildinfo.BuildRun{
+		{Uri: "/1", Started: "2018-05-01T17:34:49.729+0300"},
+		{Uri: "/3", Started: "2018-05-03T17:34:49.729+0300"},
+		{Uri: "/2", Started: "2018-05-02T17:34:49.729+0300"},
+		{Uri: "/4", Started: "2018-05-04T17:34:49.729+0300"},
+	}
+	getBuildNumbers := func(page *BuildRunsPage) (buildNumbers []string) {
+		for _, run := range page.Runs {
+			buildNumbers = append(buildNumbers, run.BuildNumber)
+		}
+		return
+	}
+
+	page, err := PaginateBuildRuns(runs, BuildRunsParams{Limit: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"4", "3", "2"}, getBuildNumbers(page))
+	assert.Equal(t, 4, page.TotalCount)
+	assert.True(t, page.HasMore)
+
+	page, err = PaginateBuildRuns(runs, BuildRunsParams{Offset: 3, Limit: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, getBuildNumbers(page))
+	assert.False(t, page.HasMore)
+
+	startedAfter, _ := time.Parse(buildinfo.TimeFormat, "2018-05-02T17:34:49.729+0300")
+	startedBefore, _ := time.Parse(buildinfo.TimeFormat, "2018-05-04T17:34:49.729+0300")
+	page, err = PaginateBuildRuns(runs, BuildRunsParams{StartedAfter: startedAfter, StartedBefore: startedBefore})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3", "2"}, getBuildNumbers(page))
+	assert.Equal(t, 2, page.TotalCount)
+
+	page, err = PaginateBuildRuns(runs, BuildRunsParams{Offset: 10})
+	assert.NoError(t, err)
+	assert.Empty(t, page.Runs)
+}
+
+func TestDeleteBuilds(t *testing.T) {
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/build/delete", r.URL.Path)
+		body := DeleteBuildInfoBody{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "build", body.BuildName)
+		assert.Equal(t, "proj", body.Project)
+		assert.True(t, body.DeleteArtifacts)
+		switch body.BuildNumber[0] {
+		case "1":
+			w.WriteHeader(http.StatusNoContent)
+		case "2":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	})
+
+	results, err := NewBuildInfoService(serviceDetails, client).DeleteBuilds("build", []string{"1", "2", "3"}, true, "proj")
+	assert.ErrorContains(t, err, "<build>/<3>")
+	if assert.Len(t, results, 3) {
+		assert.True(t, results[0].Deleted)
+		assert.NoError(t, results[0].Err)
+		assert.True(t, results[1].NotFound)
+		assert.NoError(t, results[1].Err)
+		assert.False(t, results[2].Deleted)
+		assert.Error(t, results[2].Err)
+	}
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+type BuildSbomParams struct {
+	BuildName string
+	// Use "LATEST" for the latest run of the build.
+	BuildNumber string
+	ProjectKey  string
+	// Includes the modules of the builds referenced by an aggregated build, recursively.
+	IncludeAggregatedBuilds bool
+}
+
+func NewBuildSbomParams(buildName, buildNumber string) BuildSbomParams {
+	return BuildSbomParams{BuildName: buildName, BuildNumber: buildNumber}
+}
+
+// Fetches a published build and converts it to a CycloneDX SBOM.
+func (bis *BuildInfoService) ExportBuildToCycloneDx(params BuildSbomParams) (*cyclonedx.BOM, error) {
+	build, err := bis.getPublishedBuild(params.BuildName, params.BuildNumber, params.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+	if params.IncludeAggregatedBuilds {
+		if build, err = bis.flattenAggregatedBuild(build, params.ProjectKey, map[string]bool{}); err != nil {
+			return nil, err
+		}
+	}
+	return BuildInfoToCycloneDx(build)
+}
+
+// Converts a build info to a CycloneDX SBOM. Each module is an application component, which holds its artifacts as
+// file components, and depends on its dependencies, which are library components. The checksums of the artifacts and
+// dependencies are kept as hashes. The build itself is the SBOM's subject, and its VCS details are kept as external
+// references. Modules which reference other builds are skipped.
+func BuildInfoToCycloneDx(build *buildinfo.BuildInfo) (*cyclonedx.BOM, error) {
+	bom, err := build.ToCycloneDxBom()
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	bom.Metadata = &cyclonedx.Metadata{
+		Component: &cyclonedx.Component{
+			BOMRef:  GetBuildModuleId(build.Name, build.Number),
+			Type:    cyclonedx.ComponentTypeApplication,
+			Name:    build.Name,
+			Version: build.Number,
+		},
+	}
+	if started, err := time.Parse(buildinfo.TimeFormat, build.Started); err == nil {
+		bom.Metadata.Timestamp = started.UTC().Format(time.RFC3339)
+	}
+	var externalReferences []cyclonedx.ExternalReference
+	if build.BuildUrl != "" {
+		externalReferences = append(externalReferences, cyclonedx.ExternalReference{Type: cyclonedx.ERTypeBuildSystem, URL: build.BuildUrl})
+	}
+	for _, vcs := range build.VcsList {
+		if vcs.Url != "" {
+			externalReferences = append(externalReferences, cyclonedx.ExternalReference{Type: cyclonedx.ERTypeVCS, URL: vcs.Url, Comment: vcs.Revision})
+		}
+	}
+	if len(externalReferences) > 0 {
+		bom.Metadata.Component.ExternalReferences = &externalReferences
+	}
+	if bom.Components == nil {
+		return bom, nil
+	}
+	modules := make(map[string]*buildinfo.Module)
+	for i := range build.Modules {
+		modules[build.Modules[i].Id] = &build.Modules[i]
+	}
+	for i, component := range *bom.Components {
+		module, isModule := modules[component.BOMRef]
+		if !isModule || len(module.Artifacts) == 0 {
+			continue
+		}
+		artifacts := make([]cyclonedx.Component, 0, len(module.Artifacts))
+		for _, artifact := range module.Artifacts {
+			artifacts = append(artifacts, cyclonedx.Component{
+				BOMRef: module.Id + ":" + artifact.Name,
+				Type:   cyclonedx.ComponentTypeFile,
+				Name:   artifact.Name,
+				Hashes: toCycloneDxHashes(artifact.Checksum),
+			})
+		}
+		(*bom.Components)[i].Components = &artifacts
+	}
+	addDirectModuleDependencies(bom, build.Modules)
+	return bom, nil
+}
+
+// Dependencies which aren't requested by other dependencies are the direct dependencies of their module, so the
+// module is made to depend on them.
+func addDirectModuleDependencies(bom *cyclonedx.BOM, modules []buildinfo.Module) {
+	var bomDependencies []cyclonedx.Dependency
+	if bom.Dependencies != nil {
+		bomDependencies = *bom.Dependencies
+	}
+	for _, module := range modules {
+		if module.Type == buildinfo.Build {
+			continue
+		}
+		var direct []string
+		for _, dependency := range module.Dependencies {
+			if len(dependency.RequestedBy) == 0 {
+				direct = append(direct, dependency.Id)
+			}
+		}
+		if len(direct) == 0 {
+			continue
+		}
+		index := slices.IndexFunc(bomDependencies, func(bomDependency cyclonedx.Dependency) bool {
+			return bomDependency.Ref == module.Id
+		})
+		if index < 0 {
+			bomDependencies = append(bomDependencies, cyclonedx.Dependency{Ref: module.Id, Dependencies: &[]string{}})
+			index = len(bomDependencies) - 1
+		}
+		dependsOn := bomDependencies[index].Dependencies
+		for _, dependencyId := range direct {
+			if !slices.Contains(*dependsOn, dependencyId) {
+				*dependsOn = append(*dependsOn, dependencyId)
+			}
+		}
+		slices.Sort(*dependsOn)
+	}
+	slices.SortFunc(bomDependencies, func(a, b cyclonedx.Dependency) int {
+		return strings.Compare(a.Ref, b.Ref)
+	})
+	bom.Dependencies = &bomDependencies
+}
+
+func toCycloneDxHashes(checksum buildinfo.Checksum) *[]cyclonedx.Hash {
+	var hashes []cyclonedx.Hash
+	for _, hash := range []cyclonedx.Hash{
+		{Algorithm: cyclonedx.HashAlgoSHA256, Value: checksum.Sha256},
+		{Algorithm: cyclonedx.HashAlgoSHA1, Value: checksum.Sha1},
+		{Algorithm: cyclonedx.HashAlgoMD5, Value: checksum.Md5},
+	} {
+		if hash.Value != "" {
+			hashes = append(hashes, hash)
+		}
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+	return &hashes
+}
+
+// Returns a copy of the build, in which the modules which reference other builds are replaced by the modules of
+// those builds.
+func (bis *BuildInfoService) flattenAggregatedBuild(build *buildinfo.BuildInfo, projectKey string, visited map[string]bool) (*buildinfo.BuildInfo, error) {
+	visited[GetBuildModuleId(build.Name, build.Number)] = true
+	flattened := *build
+	flattened.Modules = nil
+	for _, module := range build.Modules {
+		if module.Type != buildinfo.Build {
+			flattened.Modules = append(flattened.Modules, module)
+			continue
+		}
+		if visited[module.Id] {
+			continue
+		}
+		buildName, buildNumber, err := ParseBuildModuleId(module.Id)
+		if err != nil {
+			return nil, err
+		}
+		referencedBuild, err := bis.getPublishedBuild(buildName, buildNumber, projectKey)
+		if err != nil {
+			return nil, err
+		}
+		if referencedBuild, err = bis.flattenAggregatedBuild(referencedBuild, projectKey, visited); err != nil {
+			return nil, err
+		}
+		flattened.Modules = append(flattened.Modules, referencedBuild.Modules...)
+	}
+	return &flattened, nil
+}
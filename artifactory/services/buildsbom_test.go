@@ -0,0 +1,84 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildInfoToCycloneDx(t *testing.T) {
+	build := &buildinfo.BuildInfo{
+		Name:     "build",
+		Number:   "1",
+		Started:  "2024-01-01T02:00:00.000+0200",
+		BuildUrl: "https://ci.acme.com/build/1",
+		VcsList:  []buildinfo.Vcs{{Url: "https://github.com/acme/app.git", Revision: "abc"}},
+		Modules: []buildinfo.Module{
+			{
+				Id:        "org.acme:app:1.0",
+				Artifacts: []buildinfo.Artifact{{Name: "app-1.0.jar", Checksum: buildinfo.Checksum{Sha1: "sha1"}}},
+				Dependencies: []buildinfo.Dependency{
+					{Id: "org.acme:lib:2.0", Checksum: buildinfo.Checksum{Sha1: "lib-sha1", Md5: "lib-md5"}},
+					{Id: "org.acme:transitive:3.0", RequestedBy: [][]string{{"org.acme:lib:2.0"}}},
+				},
+			},
+			{Id: "other/2", Type: buildinfo.Build},
+		},
+	}
+	bom, err := BuildInfoToCycloneDx(build)
+	assert.NoError(t, err)
+	assert.Equal(t, "build", bom.Metadata.Component.Name)
+	assert.Equal(t, "1", bom.Metadata.Component.Version)
+	assert.Equal(t, "2024-01-01T00:00:00Z", bom.Metadata.Timestamp)
+	assert.Equal(t, []cyclonedx.ExternalReference{
+		{Type: cyclonedx.ERTypeBuildSystem, URL: "https://ci.acme.com/build/1"},
+		{Type: cyclonedx.ERTypeVCS, URL: "https://github.com/acme/app.git", Comment: "abc"},
+	}, *bom.Metadata.Component.ExternalReferences)
+
+	components := make(map[string]cyclonedx.Component)
+	for _, component := range *bom.Components {
+		components[component.BOMRef] = component
+	}
+	assert.Len(t, components, 3)
+	app := components["org.acme:app:1.0"]
+	assert.Equal(t, cyclonedx.ComponentTypeApplication, app.Type)
+	assert.Equal(t, []cyclonedx.Component{{
+		BOMRef: "org.acme:app:1.0:app-1.0.jar",
+		Type:   cyclonedx.ComponentTypeFile,
+		Name:   "app-1.0.jar",
+		Hashes: &[]cyclonedx.Hash{{Algorithm: cyclonedx.HashAlgoSHA1, Value: "sha1"}},
+	}}, *app.Components)
+	lib := components["org.acme:lib:2.0"]
+	assert.Equal(t, cyclonedx.ComponentTypeLibrary, lib.Type)
+	assert.Equal(t, "org.acme", lib.Group)
+
+	assert.Equal(t, []cyclonedx.Dependency{
+		{Ref: "org.acme:app:1.0", Dependencies: &[]string{"org.acme:lib:2.0"}},
+		{Ref: "org.acme:lib:2.0", Dependencies: &[]string{"org.acme:transitive:3.0"}},
+	}, *bom.Dependencies)
+}
+
+func TestExportBuildToCycloneDxWithAggregatedBuilds(t *testing.T) {
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/build/umbrella/1":
+			_, _ = w.Write([]byte(`{"buildInfo":{"name":"umbrella","number":"1","modules":[{"id":"child/2","type":"build"},{"id":"umbrella/1","type":"build"}]}}`))
+		case "/api/build/child/2":
+			_, _ = w.Write([]byte(`{"buildInfo":{"name":"child","number":"2","modules":[{"id":"org.acme:child:2.0"}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	params := NewBuildSbomParams("umbrella", "1")
+	params.IncludeAggregatedBuilds = true
+	bom, err := NewBuildInfoService(serviceDetails, client).ExportBuildToCycloneDx(params)
+	assert.NoError(t, err)
+	assert.Equal(t, "umbrella", bom.Metadata.Component.Name)
+	if assert.Len(t, *bom.Components, 1) {
+		assert.Equal(t, "org.acme:child:2.0", (*bom.Components)[0].BOMRef)
+	}
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jfrog/gofrog/parallel"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Creates or updates many repositories which share the same settings, concurrently.
+type BulkRepositoriesService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	ArtDetails auth.ServiceDetails
+	Threads    int
+}
+
+func NewBulkRepositoriesService(client *jfroghttpclient.JfrogHttpClient) *BulkRepositoriesService {
+	return &BulkRepositoriesService{client: client}
+}
+
+func (brs *BulkRepositoriesService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return brs.client
+}
+
+func (brs *BulkRepositoriesService) GetThreads() int {
+	return brs.Threads
+}
+
+type BulkRepositoriesParams struct {
+	// The settings shared by all the repositories. Either a map of the fields accepted by the repositories REST API,
+	// or one of the repository params structs, such as LocalRepositoryBaseParams or MavenRemoteRepositoryParams.
+	Template     interface{}
+	Repositories []BulkRepositoryParams
+}
+
+func NewBulkRepositoriesParams(template interface{}) BulkRepositoriesParams {
+	return BulkRepositoriesParams{Template: template}
+}
+
+// Adds repositories which use the template as is.
+func (brp *BulkRepositoriesParams) AddKeys(repoKeys ...string) {
+	for _, repoKey := range repoKeys {
+		brp.Repositories = append(brp.Repositories, BulkRepositoryParams{Key: repoKey})
+	}
+}
+
+type BulkRepositoryParams struct {
+	Key string
+	// Fields which override the template for this repository, e.g. {"description": "Releases of project A"}.
+	Overrides map[string]interface{}
+}
+
+type BulkRepositoryResult struct {
+	Key string
+	// Either RepositoryConfigCreate or RepositoryConfigUpdate. Empty if the existence of the repository couldn't be checked.
+	Action RepositoryConfigAction
+	Err    error
+}
+
+// Creates the repositories that don't exist, and updates those that do.
+// All the repositories are processed even if some fail. The returned error aggregates the errors of all the failed repositories.
+func (brs *BulkRepositoriesService) CreateOrUpdate(params BulkRepositoriesParams) ([]BulkRepositoryResult, error) {
+	configs, err := params.buildConfigs()
+	if err != nil {
+		return nil, err
+	}
+	threads := brs.Threads
+	if threads <= 0 {
+		threads = 1
+	}
+	repositoriesService := NewRepositoriesService(brs.client)
+	repositoriesService.ArtDetails = brs.ArtDetails
+	results := make([]BulkRepositoryResult, len(configs))
+	producerConsumer := parallel.NewBounedRunner(threads, false)
+	go func() {
+		defer producerConsumer.Done()
+		for i := range configs {
+			task := func(int) error {
+				results[i] = createOrUpdateRepository(repositoriesService, params.Repositories[i].Key, configs[i])
+				return nil
+			}
+			_, _ = producerConsumer.AddTask(task)
+		}
+	}()
+	producerConsumer.Run()
+
+	var errs []error
+	for _, result := range results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("repository '%s': %w", result.Key, result.Err))
+		}
+	}
+	log.Info(fmt.Sprintf("Done processing %d repositories, %d failed.", len(results), len(errs)))
+	return results, errors.Join(errs...)
+}
+
+func createOrUpdateRepository(repositoriesService *RepositoriesService, repoKey string, config map[string]interface{}) BulkRepositoryResult {
+	result := BulkRepositoryResult{Key: repoKey}
+	exists, err := repositoriesService.IsExists(repoKey)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	if exists {
+		result.Action = RepositoryConfigUpdate
+		result.Err = repositoriesService.Update(config, repoKey)
+	} else {
+		result.Action = RepositoryConfigCreate
+		result.Err = repositoriesService.Create(config, repoKey)
+	}
+	return result
+}
+
+// Returns the configuration of each repository: the template, with the repository's key and overrides applied.
+func (brp *BulkRepositoriesParams) buildConfigs() ([]map[string]interface{}, error) {
+	if brp.Template == nil {
+		return nil, errorutils.CheckErrorf("a repository template is required")
+	}
+	template, err := toRepositoryConfig(brp.Template)
+	if err != nil {
+		return nil, err
+	}
+	if rclass, _ := template["rclass"].(string); rclass == "" {
+		return nil, errorutils.CheckErrorf("the repository template must set 'rclass'")
+	}
+	keys := make(map[string]bool)
+	configs := make([]map[string]interface{}, 0, len(brp.Repositories))
+	for _, repo := range brp.Repositories {
+		if repo.Key == "" {
+			return nil, errorutils.CheckErrorf("a key is required for each repository")
+		}
+		if keys[repo.Key] {
+			return nil, errorutils.CheckErrorf("repository '%s' appears more than once", repo.Key)
+		}
+		keys[repo.Key] = true
+		config := make(map[string]interface{}, len(template)+len(repo.Overrides)+1)
+		for field, value := range template {
+			config[field] = value
+		}
+		for field, value := range repo.Overrides {
+			config[field] = value
+		}
+		config["key"] = repo.Key
+		configs = append(configs, config)
+	}
+	return configs, nil
+}
+
+func toRepositoryConfig(template interface{}) (map[string]interface{}, error) {
+	if config, ok := template.(map[string]interface{}); ok {
+		return config, nil
+	}
+	content, err := json.Marshal(template)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	config := make(map[string]interface{})
+	return config, errorutils.CheckError(json.Unmarshal(content, &config))
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkRepositoriesBuildConfigs(t *testing.T) {
+	template := NewMavenLocalRepositoryParams()
+	template.Description = "Shared description"
+	maxUniqueSnapshots := 10
+	template.MaxUniqueSnapshots = &maxUniqueSnapshots
+	params := NewBulkRepositoriesParams(template)
+	params.AddKeys("project-a-release")
+	params.Repositories = append(params.Repositories, BulkRepositoryParams{Key: "project-b-release", Overrides: map[string]interface{}{"description": "Project B"}})
+
+	configs, err := params.buildConfigs()
+	assert.NoError(t, err)
+	if assert.Len(t, configs, 2) {
+		assert.Equal(t, "project-a-release", configs[0]["key"])
+		assert.Equal(t, "Shared description", configs[0]["description"])
+		assert.Equal(t, "local", configs[0]["rclass"])
+		assert.Equal(t, "maven", configs[0]["packageType"])
+		assert.Equal(t, "project-b-release", configs[1]["key"])
+		assert.Equal(t, "Project B", configs[1]["description"])
+		assert.Equal(t, float64(10), configs[1]["maxUniqueSnapshots"])
+	}
+}
+
+func TestBulkRepositoriesBuildConfigsValidation(t *testing.T) {
+	params := NewBulkRepositoriesParams(nil)
+	_, err := params.buildConfigs()
+	assert.ErrorContains(t, err, "template is required")
+
+	params = NewBulkRepositoriesParams(map[string]interface{}{"packageType": "npm"})
+	params.AddKeys("npm-local")
+	_, err = params.buildConfigs()
+	assert.ErrorContains(t, err, "rclass")
+
+	params = NewBulkRepositoriesParams(map[string]interface{}{"rclass": "local", "packageType": "npm"})
+	params.AddKeys("npm-local", "npm-local")
+	_, err = params.buildConfigs()
+	assert.ErrorContains(t, err, "more than once")
+}
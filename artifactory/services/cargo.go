@@ -0,0 +1,222 @@
+package services
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The Cargo registry web API, relative to the repository.
+const cargoCratesApi = "api/v1/crates/"
+
+// Publishes and yanks crates in Cargo repositories, using the Cargo registry web API exposed by Artifactory.
+type CargoService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+	DryRun     bool
+}
+
+func NewCargoService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *CargoService {
+	return &CargoService{artDetails: &artDetails, client: client}
+}
+
+func (cs *CargoService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *cs.artDetails
+}
+
+func (cs *CargoService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return cs.client
+}
+
+func (cs *CargoService) IsDryRun() bool {
+	return cs.DryRun
+}
+
+// The metadata of a published crate version, as sent by 'cargo publish'. Taken from the crate's Cargo.toml.
+type CargoCrateMetadata struct {
+	Name          string              `json:"name"`
+	Version       string              `json:"vers"`
+	Deps          []CargoDependency   `json:"deps"`
+	Features      map[string][]string `json:"features"`
+	Authors       []string            `json:"authors"`
+	Description   string              `json:"description,omitempty"`
+	Documentation string              `json:"documentation,omitempty"`
+	Homepage      string              `json:"homepage,omitempty"`
+	Readme        string              `json:"readme,omitempty"`
+	ReadmeFile    string              `json:"readme_file,omitempty"`
+	Keywords      []string            `json:"keywords"`
+	Categories    []string            `json:"categories"`
+	License       string              `json:"license,omitempty"`
+	LicenseFile   string              `json:"license_file,omitempty"`
+	Repository    string              `json:"repository,omitempty"`
+	Links         string              `json:"links,omitempty"`
+	RustVersion   string              `json:"rust_version,omitempty"`
+}
+
+type CargoDependency struct {
+	Name            string   `json:"name"`
+	VersionReq      string   `json:"version_req"`
+	Features        []string `json:"features"`
+	Optional        bool     `json:"optional"`
+	DefaultFeatures bool     `json:"default_features"`
+	Target          string   `json:"target,omitempty"`
+	// One of: "normal", "dev" or "build".
+	Kind     string `json:"kind"`
+	Registry string `json:"registry,omitempty"`
+	// The name of the crate if the dependency is renamed.
+	ExplicitNameInToml string `json:"explicit_name_in_toml,omitempty"`
+}
+
+type CargoPublishParams struct {
+	RepoKey string
+	// The path of the .crate file, as created by 'cargo package'.
+	CratePath string
+	Metadata  CargoCrateMetadata
+}
+
+func NewCargoPublishParams(repoKey, cratePath string, metadata CargoCrateMetadata) CargoPublishParams {
+	return CargoPublishParams{RepoKey: repoKey, CratePath: cratePath, Metadata: metadata}
+}
+
+// The warnings returned by the registry for a published crate.
+type CargoPublishWarnings struct {
+	InvalidCategories []string `json:"invalid_categories,omitempty"`
+	InvalidBadges     []string `json:"invalid_badges,omitempty"`
+	Other             []string `json:"other,omitempty"`
+}
+
+type cargoPublishResponse struct {
+	Warnings CargoPublishWarnings `json:"warnings"`
+}
+
+// Publishes a crate version. Returns the warnings of the registry, if any.
+func (cs *CargoService) Publish(params CargoPublishParams) (*CargoPublishWarnings, error) {
+	if params.RepoKey == "" || params.CratePath == "" || params.Metadata.Name == "" || params.Metadata.Version == "" {
+		return nil, errorutils.CheckErrorf("a repository, a crate file and the crate's name and version are required")
+	}
+	crate, err := os.ReadFile(params.CratePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	content, err := CreateCargoPublishBody(params.Metadata, crate)
+	if err != nil {
+		return nil, err
+	}
+	requestUrl, err := cs.buildUrl(params.RepoKey, cargoCratesApi+"new")
+	if err != nil {
+		return nil, err
+	}
+	description := params.Metadata.Name + " " + params.Metadata.Version
+	if cs.DryRun {
+		log.Info("[Dry run] Publishing the crate " + description + " to " + params.RepoKey)
+		return &CargoPublishWarnings{}, nil
+	}
+	log.Info("Publishing the crate " + description + " to " + params.RepoKey + "...")
+	httpClientsDetails := cs.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, err := cs.client.SendPut(requestUrl, content, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	response := &cargoPublishResponse{}
+	if len(body) > 0 {
+		if err = errorutils.CheckError(json.Unmarshal(body, response)); err != nil {
+			return nil, err
+		}
+	}
+	return &response.Warnings, nil
+}
+
+// Yanks a crate version, so that new dependents can't resolve it. Existing lock files can still download it.
+func (cs *CargoService) Yank(repoKey, crateName, version string) error {
+	return cs.setYanked(repoKey, crateName, version, true)
+}
+
+// Reverts yanking a crate version.
+func (cs *CargoService) Unyank(repoKey, crateName, version string) error {
+	return cs.setYanked(repoKey, crateName, version, false)
+}
+
+func (cs *CargoService) setYanked(repoKey, crateName, version string, yank bool) error {
+	if repoKey == "" || crateName == "" || version == "" {
+		return errorutils.CheckErrorf("a repository, a crate name and a version are required")
+	}
+	action := "unyank"
+	if yank {
+		action = "yank"
+	}
+	requestUrl, err := cs.buildUrl(repoKey, fmt.Sprintf("%s%s/%s/%s", cargoCratesApi, crateName, version, action))
+	if err != nil {
+		return err
+	}
+	if cs.DryRun {
+		log.Info(fmt.Sprintf("[Dry run] Running %s on the crate %s %s", action, crateName, version))
+		return nil
+	}
+	log.Info(fmt.Sprintf("Running %s on the crate %s %s...", action, crateName, version))
+	httpClientsDetails := cs.GetArtifactoryDetails().CreateHttpClientDetails()
+	var resp *http.Response
+	var body []byte
+	if yank {
+		resp, body, err = cs.client.SendDelete(requestUrl, nil, &httpClientsDetails)
+	} else {
+		resp, body, err = cs.client.SendPut(requestUrl, nil, &httpClientsDetails)
+	}
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+func (cs *CargoService) buildUrl(repoKey, restApi string) (string, error) {
+	return clientutils.BuildUrl(cs.GetArtifactoryDetails().GetUrl(), "api/cargo/"+repoKey+"/"+restApi, nil)
+}
+
+// Creates the body of a publish request: the length of the JSON metadata and the metadata, followed by the length of
+// the crate and the crate. The lengths are 32-bit little-endian integers.
+func CreateCargoPublishBody(metadata CargoCrateMetadata, crate []byte) ([]byte, error) {
+	// The registry expects arrays and objects rather than nulls.
+	if metadata.Deps == nil {
+		metadata.Deps = []CargoDependency{}
+	}
+	if metadata.Features == nil {
+		metadata.Features = map[string][]string{}
+	}
+	if metadata.Authors == nil {
+		metadata.Authors = []string{}
+	}
+	if metadata.Keywords == nil {
+		metadata.Keywords = []string{}
+	}
+	if metadata.Categories == nil {
+		metadata.Categories = []string{}
+	}
+	metadataJson, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var body bytes.Buffer
+	for _, part := range [][]byte{metadataJson, crate} {
+		if err = binary.Write(&body, binary.LittleEndian, uint32(len(part))); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		body.Write(part)
+	}
+	return body.Bytes(), nil
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCargoPublishBody(t *testing.T) {
+	body, err := CreateCargoPublishBody(CargoCrateMetadata{Name: "acme", Version: "1.0.0"}, []byte("crate"))
+	assert.NoError(t, err)
+	metadataLength := binary.LittleEndian.Uint32(body[:4])
+	var metadata map[string]interface{}
+	assert.NoError(t, json.Unmarshal(body[4:4+metadataLength], &metadata))
+	assert.Equal(t, "1.0.0", metadata["vers"])
+	assert.Equal(t, []interface{}{}, metadata["deps"])
+	crate := body[4+metadataLength:]
+	assert.Equal(t, uint32(5), binary.LittleEndian.Uint32(crate[:4]))
+	assert.Equal(t, "crate", string(crate[4:]))
+}
+
+func TestCargoPublishAndYank(t *testing.T) {
+	var requests []string
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.URL.Path == "/api/cargo/cargo-local/api/v1/crates/new" {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, "crate", string(body[len(body)-5:]))
+			_, _ = w.Write([]byte(`{"warnings":{"invalid_categories":["bad"],"invalid_badges":[],"other":[]}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+	cargoService := NewCargoService(serviceDetails, client)
+
+	cratePath := filepath.Join(t.TempDir(), "acme-1.0.0.crate")
+	assert.NoError(t, os.WriteFile(cratePath, []byte("crate"), 0600))
+	warnings, err := cargoService.Publish(NewCargoPublishParams("cargo-local", cratePath, CargoCrateMetadata{Name: "acme", Version: "1.0.0"}))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bad"}, warnings.InvalidCategories)
+	assert.NoError(t, cargoService.Yank("cargo-local", "acme", "1.0.0"))
+	assert.NoError(t, cargoService.Unyank("cargo-local", "acme", "1.0.0"))
+	assert.Equal(t, []string{
+		"PUT /api/cargo/cargo-local/api/v1/crates/new",
+		"DELETE /api/cargo/cargo-local/api/v1/crates/acme/1.0.0/yank",
+		"PUT /api/cargo/cargo-local/api/v1/crates/acme/1.0.0/unyank",
+	}, requests)
+
+	cargoService.DryRun = true
+	assert.NoError(t, cargoService.Yank("cargo-local", "acme", "1.0.0"))
+	assert.Len(t, requests, 3)
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sync"
+
+	"github.com/jfrog/gofrog/parallel"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	clientio "github.com/jfrog/jfrog-client-go/utils/io"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const setSha256Api = "api/checksum/sha256"
+
+// Calculates the SHA-256 checksums of artifacts which were deployed before Artifactory started calculating them.
+// SHA-256 checksums are required, for example, for checksum deploy by SHA-256 and for release bundles v2.
+type ChecksumService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+	DryRun     bool
+	Threads    int
+	// Optional. The general progress is incremented for each artifact handled.
+	Progress clientio.ProgressMgr
+}
+
+func NewChecksumService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *ChecksumService {
+	return &ChecksumService{artDetails: &artDetails, client: client}
+}
+
+func (cs *ChecksumService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *cs.artDetails
+}
+
+func (cs *ChecksumService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return cs.client
+}
+
+func (cs *ChecksumService) IsDryRun() bool {
+	return cs.DryRun
+}
+
+type CalculateSha256Params struct {
+	*utils.CommonParams
+}
+
+func (csp *CalculateSha256Params) GetFile() *utils.CommonParams {
+	return csp.CommonParams
+}
+
+func NewCalculateSha256Params() CalculateSha256Params {
+	return CalculateSha256Params{CommonParams: &utils.CommonParams{}}
+}
+
+type CalculateSha256Summary struct {
+	// The number of artifacts matching the pattern.
+	Total int
+	// The number of artifacts which already had a SHA-256 checksum.
+	Skipped int
+	// The number of artifacts whose SHA-256 checksum was calculated. In dry-run, the number of artifacts which would
+	// have been calculated.
+	Calculated int
+	Failed     int
+}
+
+type setSha256Request struct {
+	RepoKey string `json:"repoKey"`
+	Path    string `json:"path"`
+}
+
+// Calculates the SHA-256 checksums of the artifacts matching the pattern, which don't have one yet.
+// A failure doesn't stop the calculation of the other artifacts, and the returned error aggregates all the failures.
+func (cs *ChecksumService) CalculateSha256(params CalculateSha256Params) (summary *CalculateSha256Summary, err error) {
+	log.Info("Searching artifacts...")
+	params.IncludeDirs = false
+	reader, err := utils.SearchBySpecWithPattern(params.GetFile(), cs, utils.NONE)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	var candidates []utils.ResultItem
+	summary = &CalculateSha256Summary{}
+	for item := new(utils.ResultItem); reader.NextRecord(item) == nil; item = new(utils.ResultItem) {
+		summary.Total++
+		if item.Sha256 != "" {
+			summary.Skipped++
+			continue
+		}
+		candidates = append(candidates, *item)
+	}
+	if err = reader.GetError(); err != nil {
+		return nil, err
+	}
+	log.Info(fmt.Sprintf("Found %d artifacts without a SHA-256 checksum, out of %d.", len(candidates), summary.Total))
+	if cs.Progress != nil {
+		cs.Progress.IncGeneralProgressTotalBy(int64(len(candidates)))
+	}
+
+	var mutex sync.Mutex
+	var errs []error
+	producerConsumer := parallel.NewBounedRunner(max(cs.Threads, 1), false)
+	go func() {
+		defer producerConsumer.Done()
+		for _, candidate := range candidates {
+			_, _ = producerConsumer.AddTask(func(threadId int) error {
+				calcErr := cs.setSha256(threadId, candidate)
+				if cs.Progress != nil {
+					cs.Progress.IncrementGeneralProgress()
+				}
+				mutex.Lock()
+				defer mutex.Unlock()
+				if calcErr != nil {
+					summary.Failed++
+					errs = append(errs, calcErr)
+					return nil
+				}
+				summary.Calculated++
+				return nil
+			})
+		}
+	}()
+	producerConsumer.Run()
+	log.Info(fmt.Sprintf("Calculated the SHA-256 checksums of %d artifacts, %d failed.", summary.Calculated, summary.Failed))
+	return summary, errors.Join(errs...)
+}
+
+func (cs *ChecksumService) setSha256(threadId int, item utils.ResultItem) error {
+	logMsgPrefix := clientutils.GetLogMsgPrefix(threadId, cs.DryRun)
+	relativePath := item.GetItemRelativePath()
+	if cs.DryRun {
+		log.Info(logMsgPrefix+"[Dry run] Calculating the SHA-256 checksum of", relativePath)
+		return nil
+	}
+	log.Info(logMsgPrefix+"Calculating the SHA-256 checksum of", relativePath)
+	content, err := json.Marshal(setSha256Request{RepoKey: item.Repo, Path: path.Join(item.Path, item.Name)})
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpClientsDetails := cs.GetArtifactoryDetails().CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	resp, body, err := cs.client.SendPost(cs.GetArtifactoryDetails().GetUrl()+setSha256Api, content, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return fmt.Errorf("%s: %w", relativePath, err)
+	}
+	log.Debug(logMsgPrefix+"Artifactory response:", resp.Status)
+	return nil
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetSha256(t *testing.T) {
+	var requests []setSha256Request
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+setSha256Api, r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		var request setSha256Request
+		assert.NoError(t, json.Unmarshal(body, &request))
+		requests = append(requests, request)
+		if request.Path == "missing.jar" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	checksumService := NewChecksumService(serviceDetails, client)
+
+	assert.NoError(t, checksumService.setSha256(0, utils.ResultItem{Repo: "libs-release", Path: "org/acme", Name: "acme.jar"}))
+	assert.NoError(t, checksumService.setSha256(0, utils.ResultItem{Repo: "libs-release", Path: ".", Name: "root.jar"}))
+	assert.ErrorContains(t, checksumService.setSha256(0, utils.ResultItem{Repo: "libs-release", Path: ".", Name: "missing.jar"}), "libs-release/missing.jar")
+	checksumService.DryRun = true
+	assert.NoError(t, checksumService.setSha256(0, utils.ResultItem{Repo: "libs-release", Path: ".", Name: "dry.jar"}))
+	assert.Equal(t, []setSha256Request{
+		{RepoKey: "libs-release", Path: "org/acme/acme.jar"},
+		{RepoKey: "libs-release", Path: "root.jar"},
+		{RepoKey: "libs-release", Path: "missing.jar"},
+	}, requests)
+}
@@ -0,0 +1,360 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The date format used when comparing dates in AQL.
+const aqlDateFormat = "2006-01-02T15:04:05.000Z"
+
+// Deletes artifacts according to retention rules. Each rule is resolved to the matching artifacts using AQL.
+type CleanupService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+	Threads    int
+}
+
+func NewCleanupService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *CleanupService {
+	return &CleanupService{artDetails: &artDetails, client: client}
+}
+
+func (cs *CleanupService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *cs.artDetails
+}
+
+func (cs *CleanupService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return cs.client
+}
+
+func (cs *CleanupService) IsDryRun() bool {
+	return false
+}
+
+// A retention rule. An artifact is deleted only if it matches all the rule's conditions.
+type CleanupRule struct {
+	Repo string
+	// A wildcard pattern of the folders inside the repository, e.g. "org/acme/*". Defaults to all the folders.
+	PathPattern string
+	// A wildcard pattern of the file names, e.g. "*.jar". Defaults to all the files.
+	NamePattern string
+	// Only artifacts created more than this duration ago.
+	OlderThan time.Duration
+	// Only artifacts which weren't downloaded for this duration. Artifacts which were never downloaded match if they
+	// were created more than this duration ago.
+	NotDownloadedFor time.Duration
+	// The number of the most recently created artifacts to keep in each folder, regardless of the other conditions.
+	KeepLatest int
+	// Only artifacts with these properties, e.g. "key1=value1;key2=value2".
+	Props string
+	// Artifacts with any of these properties are kept.
+	ExcludeProps string
+}
+
+func (cr *CleanupRule) Validate() error {
+	if cr.Repo == "" {
+		return errorutils.CheckErrorf("a cleanup rule must specify a repository")
+	}
+	if cr.OlderThan < 0 || cr.NotDownloadedFor < 0 || cr.KeepLatest < 0 {
+		return errorutils.CheckErrorf("cleanup rule for repository '%s': durations and the number of artifacts to keep must not be negative", cr.Repo)
+	}
+	if cr.OlderThan == 0 && cr.NotDownloadedFor == 0 && cr.KeepLatest == 0 && cr.Props == "" {
+		return errorutils.CheckErrorf("cleanup rule for repository '%s' must have at least one condition, otherwise it would delete the whole repository", cr.Repo)
+	}
+	return nil
+}
+
+type CleanupParams struct {
+	Rules []CleanupRule
+	// If true, the artifacts are only reported and not deleted.
+	DryRun bool
+}
+
+func NewCleanupParams(rules ...CleanupRule) CleanupParams {
+	return CleanupParams{Rules: rules}
+}
+
+type CleanupReport struct {
+	Rules []CleanupRuleReport
+	// The total number and size of the artifacts matching the rules. An artifact matched by several rules is counted once.
+	TotalCandidates int
+	TotalSize       int64
+	// The number of artifacts which were deleted. Always 0 in dry-run.
+	Deleted int
+	DryRun  bool
+}
+
+type CleanupRuleReport struct {
+	Rule       CleanupRule
+	Candidates []utils.ResultItem
+	Size       int64
+	// The artifacts which matched the rule's query but were kept, since the rule depends on their dates and they are
+	// missing or invalid.
+	Skipped []utils.ResultItem
+}
+
+// Resolves the artifacts matching each rule, and deletes them unless running in dry-run.
+// The report is returned also on failure, with the artifacts deleted until the failure.
+func (cs *CleanupService) Cleanup(params CleanupParams) (*CleanupReport, error) {
+	for i := range params.Rules {
+		if err := params.Rules[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+	report := &CleanupReport{DryRun: params.DryRun}
+	now := time.Now()
+	var allCandidates []utils.ResultItem
+	seen := make(map[string]bool)
+	for i, rule := range params.Rules {
+		log.Info(fmt.Sprintf("Resolving cleanup rule %d/%d for repository '%s'...", i+1, len(params.Rules), rule.Repo))
+		candidates, skipped, err := cs.resolveRule(rule, now)
+		if err != nil {
+			return report, err
+		}
+		for _, item := range skipped {
+			log.Warn(fmt.Sprintf("Skipping '%s' since its creation or download date is missing or invalid.", item.GetItemRelativePath()))
+		}
+		ruleReport := CleanupRuleReport{Rule: rule, Candidates: candidates, Skipped: skipped}
+		for _, candidate := range candidates {
+			ruleReport.Size += candidate.Size
+			if key := candidate.GetItemRelativePath(); !seen[key] {
+				seen[key] = true
+				allCandidates = append(allCandidates, candidate)
+				report.TotalSize += candidate.Size
+			}
+		}
+		report.Rules = append(report.Rules, ruleReport)
+		log.Info(fmt.Sprintf("Cleanup rule %d/%d matched %d artifacts (%d bytes).", i+1, len(params.Rules), len(candidates), ruleReport.Size))
+	}
+	report.TotalCandidates = len(allCandidates)
+	if params.DryRun {
+		log.Info(fmt.Sprintf("[Dry run] %d artifacts (%d bytes) would be deleted.", report.TotalCandidates, report.TotalSize))
+		return report, nil
+	}
+	if len(allCandidates) == 0 {
+		log.Info("No artifacts to delete.")
+		return report, nil
+	}
+	deleted, err := cs.deleteItems(allCandidates)
+	report.Deleted = deleted
+	log.Info(fmt.Sprintf("Deleted %d out of %d artifacts.", deleted, report.TotalCandidates))
+	return report, err
+}
+
+func (cs *CleanupService) resolveRule(rule CleanupRule, now time.Time) (candidates, skipped []utils.ResultItem, err error) {
+	query, err := CreateCleanupAqlQuery(rule, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	reader, err := utils.ExecAqlSaveToFile(query, cs)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	var items []utils.ResultItem
+	for item := new(utils.ResultItem); reader.NextRecord(item) == nil; item = new(utils.ResultItem) {
+		items = append(items, *item)
+	}
+	if err = reader.GetError(); err != nil {
+		return nil, nil, err
+	}
+	candidates, skipped = SelectCleanupCandidates(items, rule, now)
+	return candidates, skipped, nil
+}
+
+func (cs *CleanupService) deleteItems(items []utils.ResultItem) (deleted int, err error) {
+	writer, err := content.NewContentWriter(content.DefaultKey, true, false)
+	if err != nil {
+		return 0, err
+	}
+	for _, item := range items {
+		writer.Write(item)
+	}
+	if err = writer.Close(); err != nil {
+		return 0, err
+	}
+	reader := content.NewContentReader(writer.GetFilePath(), content.DefaultKey)
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	deleteService := NewDeleteService(*cs.artDetails, cs.client)
+	deleteService.Threads = cs.Threads
+	return deleteService.DeleteFiles(reader)
+}
+
+// Creates the AQL query of the rule. When artifacts should be kept per folder, the age conditions are applied
+// after the query, since all the artifacts in the folder are needed to determine the latest ones.
+func CreateCleanupAqlQuery(rule CleanupRule, now time.Time) (string, error) {
+	conditions := []map[string]interface{}{
+		{"repo": rule.Repo},
+		{"type": "file"},
+		{"path": map[string]string{"$match": defaultPattern(rule.PathPattern)}},
+		{"name": map[string]string{"$match": defaultPattern(rule.NamePattern)}},
+	}
+	if rule.KeepLatest == 0 {
+		if rule.OlderThan > 0 {
+			conditions = append(conditions, map[string]interface{}{"created": map[string]string{"$lt": formatAqlDate(now.Add(-rule.OlderThan))}})
+		}
+		if rule.NotDownloadedFor > 0 {
+			threshold := formatAqlDate(now.Add(-rule.NotDownloadedFor))
+			conditions = append(conditions,
+				map[string]interface{}{"created": map[string]string{"$lt": threshold}},
+				map[string]interface{}{"$or": []map[string]interface{}{
+					{"stat.downloaded": map[string]string{"$lt": threshold}},
+					{"stat.downloaded": map[string]interface{}{"$eq": nil}},
+				}})
+		}
+	}
+	if rule.Props != "" {
+		props, err := utils.ParseProperties(rule.Props)
+		if err != nil {
+			return "", err
+		}
+		for _, prop := range sortedProperties(props) {
+			conditions = append(conditions, map[string]interface{}{"@" + prop.Key: prop.Value})
+		}
+	}
+	if rule.ExcludeProps != "" {
+		excludeProps, err := utils.ParseProperties(rule.ExcludeProps)
+		if err != nil {
+			return "", err
+		}
+		for _, prop := range sortedProperties(excludeProps) {
+			conditions = append(conditions, map[string]interface{}{"@" + prop.Key: map[string]string{"$ne": prop.Value}})
+		}
+	}
+	criteria, err := json.Marshal(map[string]interface{}{"$and": conditions})
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return fmt.Sprintf(`items.find(%s).include("repo","path","name","type","size","created","modified","stat.downloaded")`, criteria), nil
+}
+
+// Applies the keep-latest and age conditions of the rule on the items returned by the rule's query.
+// Returns the items to delete, and the items which were skipped because the rule depends on their dates and they are
+// missing or can't be parsed. Both are sorted by path. Items with unknown dates are never deleted.
+func SelectCleanupCandidates(items []utils.ResultItem, rule CleanupRule, now time.Time) (candidates, skipped []utils.ResultItem) {
+	datesRequired := rule.KeepLatest > 0 || rule.OlderThan > 0 || rule.NotDownloadedFor > 0
+	created := make(map[string]time.Time)
+	var datedItems []utils.ResultItem
+	for _, item := range items {
+		if datesRequired {
+			itemCreated, err := parseArtifactoryDate(item.Created)
+			if err != nil || !hasValidDownloadDates(item) {
+				skipped = append(skipped, item)
+				continue
+			}
+			created[item.GetItemRelativePath()] = itemCreated
+		}
+		datedItems = append(datedItems, item)
+	}
+	kept := make(map[string]bool)
+	if rule.KeepLatest > 0 {
+		byFolder := make(map[string][]utils.ResultItem)
+		for _, item := range datedItems {
+			folder := path.Join(item.Repo, item.Path)
+			byFolder[folder] = append(byFolder[folder], item)
+		}
+		for _, folderItems := range byFolder {
+			sort.SliceStable(folderItems, func(i, j int) bool {
+				return created[folderItems[i].GetItemRelativePath()].After(created[folderItems[j].GetItemRelativePath()])
+			})
+			for i := 0; i < rule.KeepLatest && i < len(folderItems); i++ {
+				kept[folderItems[i].GetItemRelativePath()] = true
+			}
+		}
+	}
+	for _, item := range datedItems {
+		if kept[item.GetItemRelativePath()] || !matchesCleanupAge(item, created[item.GetItemRelativePath()], rule, now) {
+			continue
+		}
+		candidates = append(candidates, item)
+	}
+	sortByRelativePath(candidates)
+	sortByRelativePath(skipped)
+	return candidates, skipped
+}
+
+func matchesCleanupAge(item utils.ResultItem, created time.Time, rule CleanupRule, now time.Time) bool {
+	if rule.OlderThan > 0 && !created.Before(now.Add(-rule.OlderThan)) {
+		return false
+	}
+	if rule.NotDownloadedFor > 0 {
+		threshold := now.Add(-rule.NotDownloadedFor)
+		if !created.Before(threshold) {
+			return false
+		}
+		for _, stat := range item.Stats {
+			if downloaded, err := parseArtifactoryDate(stat.Downloaded); err == nil && !downloaded.Before(threshold) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// An empty download date means that the item was never downloaded.
+func hasValidDownloadDates(item utils.ResultItem) bool {
+	for _, stat := range item.Stats {
+		if stat.Downloaded == "" {
+			continue
+		}
+		if _, err := parseArtifactoryDate(stat.Downloaded); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func parseArtifactoryDate(date string) (time.Time, error) {
+	if date == "" {
+		return time.Time{}, errors.New("missing date")
+	}
+	return time.Parse(time.RFC3339, date)
+}
+
+func sortByRelativePath(items []utils.ResultItem) {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].GetItemRelativePath() < items[j].GetItemRelativePath()
+	})
+}
+
+func formatAqlDate(date time.Time) string {
+	return date.UTC().Format(aqlDateFormat)
+}
+
+func sortedProperties(props *utils.Properties) []utils.Property {
+	var result []utils.Property
+	for key, values := range props.ToMap() {
+		for _, value := range values {
+			result = append(result, utils.Property{Key: key, Value: value})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Key != result[j].Key {
+			return result[i].Key < result[j].Key
+		}
+		return result[i].Value < result[j].Value
+	})
+	return result
+}
+
+func defaultPattern(pattern string) string {
+	if pattern == "" {
+		return "*"
+	}
+	return pattern
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var cleanupTestNow = time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+
+func TestCleanupRuleValidate(t *testing.T) {
+	rule := CleanupRule{}
+	assert.ErrorContains(t, rule.Validate(), "must specify a repository")
+	rule.Repo = "libs-snapshot-local"
+	assert.ErrorContains(t, rule.Validate(), "at least one condition")
+	rule.KeepLatest = -1
+	assert.ErrorContains(t, rule.Validate(), "must not be negative")
+	rule.KeepLatest = 3
+	assert.NoError(t, rule.Validate())
+}
+
+func TestCreateCleanupAqlQuery(t *testing.T) {
+	rule := CleanupRule{Repo: "libs-snapshot-local", NamePattern: "*.jar", OlderThan: 30 * 24 * time.Hour, NotDownloadedFor: 24 * time.Hour, Props: "b=2;a=1", ExcludeProps: "keep=true"}
+	query, err := CreateCleanupAqlQuery(rule, cleanupTestNow)
+	assert.NoError(t, err)
+	expected := `items.find({"$and":[{"repo":"libs-snapshot-local"},{"type":"file"},{"path":{"$match":"*"}},{"name":{"$match":"*.jar"}},` +
+		`{"created":{"$lt":"2024-05-31T12:00:00.000Z"}},{"created":{"$lt":"2024-06-29T12:00:00.000Z"}},` +
+		`{"$or":[{"stat.downloaded":{"$lt":"2024-06-29T12:00:00.000Z"}},{"stat.downloaded":{"$eq":null}}]},` +
+		`{"@a":"1"},{"@b":"2"},{"@keep":{"$ne":"true"}}]})` +
+		`.include("repo","path","name","type","size","created","modified","stat.downloaded")`
+	assert.Equal(t, expected, query)
+
+	// When keeping the latest artifacts, the age conditions are applied after the query.
+	rule.KeepLatest = 2
+	query, err = CreateCleanupAqlQuery(rule, cleanupTestNow)
+	assert.NoError(t, err)
+	assert.NotContains(t, query, "$lt")
+}
+
+func TestSelectCleanupCandidates(t *testing.T) {
+	items := []utils.ResultItem{
+		{Repo: "repo", Path: "a", Name: "1.jar", Created: "2024-01-01T00:00:00.000Z"},
+		{Repo: "repo", Path: "a", Name: "2.jar", Created: "2024-02-01T00:00:00.000Z"},
+		{Repo: "repo", Path: "a", Name: "3.jar", Created: "2024-06-29T00:00:00.000+02:00"},
+		{Repo: "repo", Path: "b", Name: "1.jar", Created: "2024-01-01T00:00:00.000Z", Stats: []utils.Stat{{Downloaded: "2024-06-20T00:00:00.000Z"}}},
+		{Repo: "repo", Path: "b", Name: "2.jar", Created: "2024-01-02T00:00:00.000Z"},
+	}
+	// Keeps the latest artifact in each folder, and deletes the others if they are older than 30 days.
+	rule := CleanupRule{Repo: "repo", KeepLatest: 1, OlderThan: 30 * 24 * time.Hour}
+	candidates, skipped := SelectCleanupCandidates(items, rule, cleanupTestNow)
+	assert.Equal(t, []utils.ResultItem{items[0], items[1], items[3]}, candidates)
+	assert.Empty(t, skipped)
+
+	// Items downloaded recently are kept.
+	rule = CleanupRule{Repo: "repo", NotDownloadedFor: 30 * 24 * time.Hour}
+	candidates, skipped = SelectCleanupCandidates(items, rule, cleanupTestNow)
+	assert.Equal(t, []utils.ResultItem{items[0], items[1], items[4]}, candidates)
+	assert.Empty(t, skipped)
+}
+
+func TestSelectCleanupCandidatesUnknownDates(t *testing.T) {
+	items := []utils.ResultItem{
+		{Repo: "repo", Path: "a", Name: "1.jar", Created: "2024-01-01T00:00:00.000Z"},
+		{Repo: "repo", Path: "a", Name: "2.jar"},
+		{Repo: "repo", Path: "a", Name: "3.jar", Created: "not-a-date"},
+		{Repo: "repo", Path: "a", Name: "4.jar", Created: "2024-01-01T00:00:00.000Z", Stats: []utils.Stat{{Downloaded: "not-a-date"}}},
+	}
+	for _, rule := range []CleanupRule{
+		{Repo: "repo", OlderThan: 30 * 24 * time.Hour},
+		{Repo: "repo", NotDownloadedFor: 30 * 24 * time.Hour},
+		{Repo: "repo", KeepLatest: 1, Props: "a=1"},
+	} {
+		candidates, skipped := SelectCleanupCandidates(items, rule, cleanupTestNow)
+		// With a single dated item left in the folder, keeping the latest one means nothing is deleted.
+		if rule.KeepLatest > 0 {
+			assert.Empty(t, candidates)
+		} else {
+			assert.Equal(t, []utils.ResultItem{items[0]}, candidates)
+		}
+		assert.Equal(t, []utils.ResultItem{items[1], items[2], items[3]}, skipped)
+	}
+
+	// Dates are ignored when the rule doesn't depend on them.
+	candidates, skipped := SelectCleanupCandidates(items, CleanupRule{Repo: "repo", Props: "a=1"}, cleanupTestNow)
+	assert.Equal(t, items, candidates)
+	assert.Empty(t, skipped)
+}
@@ -0,0 +1,282 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	conanApi = "api/conan/"
+	// References without a user and channel are stored with this placeholder.
+	conanNoValue = "_"
+	// The manifest is uploaded last, since Conan considers a revision complete once its manifest exists.
+	conanManifestFile = "conanmanifest.txt"
+)
+
+// Searches, uploads and removes Conan recipes and packages, using the Conan v2 API of Artifactory, in which every
+// recipe and package is stored by revision.
+type ConanService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+	DryRun     bool
+}
+
+func NewConanService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *ConanService {
+	return &ConanService{artDetails: &artDetails, client: client}
+}
+
+func (cs *ConanService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *cs.artDetails
+}
+
+func (cs *ConanService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return cs.client
+}
+
+func (cs *ConanService) IsDryRun() bool {
+	return cs.DryRun
+}
+
+// A recipe reference, e.g. "zlib/1.3.1" or "zlib/1.3.1@acme/stable".
+type ConanReference struct {
+	Name    string
+	Version string
+	User    string
+	Channel string
+}
+
+func ParseConanReference(reference string) (ConanReference, error) {
+	nameVersion, userChannel, hasUserChannel := strings.Cut(reference, "@")
+	name, version, ok := strings.Cut(nameVersion, "/")
+	if !ok || name == "" || version == "" {
+		return ConanReference{}, errorutils.CheckErrorf("invalid Conan reference '%s', expected name/version[@user/channel]", reference)
+	}
+	conanReference := ConanReference{Name: name, Version: version}
+	if hasUserChannel {
+		user, channel, ok := strings.Cut(userChannel, "/")
+		if !ok || user == "" || channel == "" {
+			return ConanReference{}, errorutils.CheckErrorf("invalid Conan reference '%s', expected name/version[@user/channel]", reference)
+		}
+		if user != conanNoValue {
+			conanReference.User, conanReference.Channel = user, channel
+		}
+	}
+	return conanReference, nil
+}
+
+func (cr ConanReference) String() string {
+	if cr.User == "" {
+		return cr.Name + "/" + cr.Version
+	}
+	return cr.Name + "/" + cr.Version + "@" + cr.User + "/" + cr.Channel
+}
+
+// The path of the reference in the Conan API, e.g. "zlib/1.3.1/_/_".
+func (cr ConanReference) apiPath() string {
+	user, channel := cr.User, cr.Channel
+	if user == "" {
+		user, channel = conanNoValue, conanNoValue
+	}
+	return path.Join(cr.Name, cr.Version, user, channel)
+}
+
+type ConanRevision struct {
+	Revision string `json:"revision,omitempty"`
+	// The creation time of the revision.
+	Time string `json:"time,omitempty"`
+}
+
+type ConanPackageUpload struct {
+	PackageId       string
+	PackageRevision string
+	// The paths of the package files, e.g. conaninfo.txt, conan_package.tgz and conanmanifest.txt.
+	Files []string
+}
+
+type ConanUploadParams struct {
+	RepoKey        string
+	Reference      ConanReference
+	RecipeRevision string
+	// The paths of the recipe files, e.g. conanfile.py, conan_export.tgz, conan_sources.tgz and conanmanifest.txt.
+	RecipeFiles []string
+	// The binary packages of the recipe revision.
+	Packages []ConanPackageUpload
+}
+
+func NewConanUploadParams(repoKey string, reference ConanReference, recipeRevision string, recipeFiles ...string) ConanUploadParams {
+	return ConanUploadParams{RepoKey: repoKey, Reference: reference, RecipeRevision: recipeRevision, RecipeFiles: recipeFiles}
+}
+
+type conanSearchResponse struct {
+	Results []string `json:"results"`
+}
+
+type conanRevisionsResponse struct {
+	Revisions []ConanRevision `json:"revisions"`
+}
+
+// Returns the recipe references matching the pattern, e.g. "zlib/*".
+func (cs *ConanService) SearchRecipes(repoKey, pattern string) ([]ConanReference, error) {
+	body, err := cs.get(repoKey, "v2/conans/search", map[string]string{"q": pattern})
+	if err != nil {
+		return nil, err
+	}
+	response := &conanSearchResponse{}
+	if err = errorutils.CheckError(json.Unmarshal(body, response)); err != nil {
+		return nil, err
+	}
+	references := make([]ConanReference, 0, len(response.Results))
+	for _, result := range response.Results {
+		reference, err := ParseConanReference(result)
+		if err != nil {
+			return nil, err
+		}
+		references = append(references, reference)
+	}
+	return references, nil
+}
+
+// Returns the revisions of a recipe, the latest first.
+func (cs *ConanService) ListRecipeRevisions(repoKey string, reference ConanReference) ([]ConanRevision, error) {
+	return cs.getRevisions(repoKey, path.Join("v2/conans", reference.apiPath(), "revisions"))
+}
+
+// Returns the revisions of a binary package of a recipe revision, the latest first.
+func (cs *ConanService) ListPackageRevisions(repoKey string, reference ConanReference, recipeRevision, packageId string) ([]ConanRevision, error) {
+	return cs.getRevisions(repoKey, path.Join("v2/conans", reference.apiPath(), "revisions", recipeRevision, "packages", packageId, "revisions"))
+}
+
+// Uploads the files of a recipe revision and its packages. The recipe is uploaded before its packages, and the
+// manifest of each is uploaded last.
+func (cs *ConanService) Upload(params ConanUploadParams) error {
+	if params.RepoKey == "" || params.Reference.Name == "" || params.RecipeRevision == "" || len(params.RecipeFiles) == 0 {
+		return errorutils.CheckErrorf("a repository, a reference, a recipe revision and recipe files are required")
+	}
+	recipePath := path.Join("v2/conans", params.Reference.apiPath(), "revisions", params.RecipeRevision)
+	if err := cs.uploadFiles(params.RepoKey, path.Join(recipePath, "files"), params.RecipeFiles); err != nil {
+		return err
+	}
+	for _, conanPackage := range params.Packages {
+		if conanPackage.PackageId == "" || conanPackage.PackageRevision == "" {
+			return errorutils.CheckErrorf("a package ID and a package revision are required for the packages of %s", params.Reference)
+		}
+		packagePath := path.Join(recipePath, "packages", conanPackage.PackageId, "revisions", conanPackage.PackageRevision, "files")
+		if err := cs.uploadFiles(params.RepoKey, packagePath, conanPackage.Files); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Removes a revision of a binary package.
+func (cs *ConanService) RemovePackageRevision(repoKey string, reference ConanReference, recipeRevision, packageId, packageRevision string) error {
+	if recipeRevision == "" || packageId == "" || packageRevision == "" {
+		return errorutils.CheckErrorf("a recipe revision, a package ID and a package revision are required")
+	}
+	restApi := path.Join("v2/conans", reference.apiPath(), "revisions", recipeRevision, "packages", packageId, "revisions", packageRevision)
+	description := fmt.Sprintf("%s#%s:%s#%s", reference, recipeRevision, packageId, packageRevision)
+	requestUrl, err := clientutils.BuildUrl(cs.GetArtifactoryDetails().GetUrl(), conanApi+path.Join(repoKey, restApi), nil)
+	if err != nil {
+		return err
+	}
+	if cs.DryRun {
+		log.Info("[Dry run] Removing the Conan package " + description)
+		return nil
+	}
+	log.Info("Removing the Conan package " + description + "...")
+	httpClientsDetails := cs.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, err := cs.client.SendDelete(requestUrl, nil, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+func (cs *ConanService) uploadFiles(repoKey, filesPath string, localPaths []string) error {
+	for _, localPath := range SortConanFilesForUpload(localPaths) {
+		requestUrl, err := clientutils.BuildUrl(cs.GetArtifactoryDetails().GetUrl(), conanApi+path.Join(repoKey, filesPath, filepath.Base(localPath)), nil)
+		if err != nil {
+			return err
+		}
+		if cs.DryRun {
+			log.Info("[Dry run] Uploading " + localPath + " to " + path.Join(repoKey, filesPath))
+			continue
+		}
+		log.Info("Uploading " + localPath + " to " + path.Join(repoKey, filesPath) + "...")
+		httpClientsDetails := cs.GetArtifactoryDetails().CreateHttpClientDetails()
+		resp, body, err := utils.UploadFile(localPath, requestUrl, "", cs.artDetails, nil, httpClientsDetails, cs.client, true, nil)
+		if err != nil {
+			return err
+		}
+		if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusCreated, http.StatusOK); err != nil {
+			return err
+		}
+		log.Debug("Artifactory response:", resp.Status)
+	}
+	return nil
+}
+
+// Returns the files in their upload order, with the manifest last.
+func SortConanFilesForUpload(localPaths []string) []string {
+	sorted := slices.Clone(localPaths)
+	slices.SortStableFunc(sorted, func(a, b string) int {
+		aIsManifest, bIsManifest := filepath.Base(a) == conanManifestFile, filepath.Base(b) == conanManifestFile
+		switch {
+		case aIsManifest == bIsManifest:
+			return 0
+		case aIsManifest:
+			return 1
+		default:
+			return -1
+		}
+	})
+	return sorted
+}
+
+func (cs *ConanService) getRevisions(repoKey, restApi string) ([]ConanRevision, error) {
+	body, err := cs.get(repoKey, restApi, nil)
+	if err != nil {
+		return nil, err
+	}
+	response := &conanRevisionsResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return response.Revisions, nil
+}
+
+func (cs *ConanService) get(repoKey, restApi string, queryParams map[string]string) ([]byte, error) {
+	if repoKey == "" {
+		return nil, errorutils.CheckErrorf("a repository key is required")
+	}
+	requestUrl, err := clientutils.BuildUrl(cs.GetArtifactoryDetails().GetUrl(), conanApi+path.Join(repoKey, restApi), queryParams)
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := cs.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := cs.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return body, nil
+}
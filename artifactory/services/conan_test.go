@@ -0,0 +1,90 @@
+package services
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConanReference(t *testing.T) {
+	tests := []struct {
+		reference string
+		expected  ConanReference
+		str       string
+	}{
+		{"zlib/1.3.1", ConanReference{Name: "zlib", Version: "1.3.1"}, "zlib/1.3.1"},
+		{"zlib/1.3.1@_/_", ConanReference{Name: "zlib", Version: "1.3.1"}, "zlib/1.3.1"},
+		{"zlib/1.3.1@acme/stable", ConanReference{Name: "zlib", Version: "1.3.1", User: "acme", Channel: "stable"}, "zlib/1.3.1@acme/stable"},
+	}
+	for _, test := range tests {
+		t.Run(test.reference, func(t *testing.T) {
+			reference, err := ParseConanReference(test.reference)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, reference)
+			assert.Equal(t, test.str, reference.String())
+		})
+	}
+	for _, invalid := range []string{"zlib", "zlib/", "zlib/1.0@acme"} {
+		_, err := ParseConanReference(invalid)
+		assert.ErrorContains(t, err, "invalid Conan reference")
+	}
+}
+
+func TestSortConanFilesForUpload(t *testing.T) {
+	assert.Equal(t, []string{"a/conanfile.py", "a/conan_export.tgz", "a/conanmanifest.txt"},
+		SortConanFilesForUpload([]string{"a/conanmanifest.txt", "a/conanfile.py", "a/conan_export.tgz"}))
+}
+
+func TestConanService(t *testing.T) {
+	var requests []string
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/api/conan/conan-local/v2/conans/search":
+			assert.Equal(t, "zlib/*", r.URL.Query().Get("q"))
+			_, _ = w.Write([]byte(`{"results":["zlib/1.3.1@_/_","zlib/1.2.13@acme/stable"]}`))
+		case "/api/conan/conan-local/v2/conans/zlib/1.3.1/_/_/revisions":
+			_, _ = w.Write([]byte(`{"reference":"zlib/1.3.1","revisions":[{"revision":"rrev2","time":"2024-01-02T00:00:00Z"},{"revision":"rrev1"}]}`))
+		case "/api/conan/conan-local/v2/conans/zlib/1.3.1/_/_/revisions/rrev2/packages/pkg1/revisions":
+			_, _ = w.Write([]byte(`{"revisions":[{"revision":"prev1"}]}`))
+		default:
+			if r.Method == http.MethodPut {
+				w.WriteHeader(http.StatusCreated)
+			}
+		}
+	})
+	conanService := NewConanService(serviceDetails, client)
+	reference := ConanReference{Name: "zlib", Version: "1.3.1"}
+
+	references, err := conanService.SearchRecipes("conan-local", "zlib/*")
+	assert.NoError(t, err)
+	assert.Equal(t, []ConanReference{reference, {Name: "zlib", Version: "1.2.13", User: "acme", Channel: "stable"}}, references)
+	revisions, err := conanService.ListRecipeRevisions("conan-local", reference)
+	assert.NoError(t, err)
+	assert.Equal(t, []ConanRevision{{Revision: "rrev2", Time: "2024-01-02T00:00:00Z"}, {Revision: "rrev1"}}, revisions)
+	revisions, err = conanService.ListPackageRevisions("conan-local", reference, "rrev2", "pkg1")
+	assert.NoError(t, err)
+	assert.Equal(t, []ConanRevision{{Revision: "prev1"}}, revisions)
+
+	tempDir := t.TempDir()
+	var files []string
+	for _, name := range []string{"conanmanifest.txt", "conanfile.py", "conan_package.tgz"} {
+		files = append(files, filepath.Join(tempDir, name))
+		assert.NoError(t, os.WriteFile(files[len(files)-1], []byte(name), 0600))
+	}
+	requests = nil
+	params := NewConanUploadParams("conan-local", reference, "rrev2", files[0], files[1])
+	params.Packages = []ConanPackageUpload{{PackageId: "pkg1", PackageRevision: "prev1", Files: []string{files[0], files[2]}}}
+	assert.NoError(t, conanService.Upload(params))
+	assert.NoError(t, conanService.RemovePackageRevision("conan-local", reference, "rrev2", "pkg1", "prev0"))
+	assert.Equal(t, []string{
+		"PUT /api/conan/conan-local/v2/conans/zlib/1.3.1/_/_/revisions/rrev2/files/conanfile.py",
+		"PUT /api/conan/conan-local/v2/conans/zlib/1.3.1/_/_/revisions/rrev2/files/conanmanifest.txt",
+		"PUT /api/conan/conan-local/v2/conans/zlib/1.3.1/_/_/revisions/rrev2/packages/pkg1/revisions/prev1/files/conan_package.tgz",
+		"PUT /api/conan/conan-local/v2/conans/zlib/1.3.1/_/_/revisions/rrev2/packages/pkg1/revisions/prev1/files/conanmanifest.txt",
+		"DELETE /api/conan/conan-local/v2/conans/zlib/1.3.1/_/_/revisions/rrev2/packages/pkg1/revisions/prev0",
+	}, requests)
+}
@@ -0,0 +1,361 @@
+package services
+
+import (
+	"encoding/xml"
+	"net/http"
+	"slices"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
+)
+
+const configurationApi = "api/system/configuration"
+
+// Manages parts of the global configuration descriptor, which are not exposed by a dedicated REST API.
+// The configuration is read from the XML descriptor and changed using YAML patches, which are merged into the descriptor
+// by Artifactory. A null value in a patch removes the matching element.
+type ConfigurationService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+}
+
+func NewConfigurationService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *ConfigurationService {
+	return &ConfigurationService{artDetails: &artDetails, client: client}
+}
+
+func (cs *ConfigurationService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *cs.artDetails
+}
+
+func (cs *ConfigurationService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return cs.client
+}
+
+func (cs *ConfigurationService) IsDryRun() bool {
+	return false
+}
+
+// The parts of the XML configuration descriptor which are managed by this service.
+type configDescriptor struct {
+	PropertySets []PropertySet  `xml:"propertySets>propertySet"`
+	RepoLayouts  []RepoLayout   `xml:"repoLayouts>repoLayout"`
+	QuotaConfig  *StorageQuota  `xml:"quotaConfig"`
+	Security     securityConfig `xml:"security"`
+}
+
+type PropertySet struct {
+	Name       string                `xml:"name" json:"name"`
+	Visible    bool                  `xml:"visible" json:"visible"`
+	Properties []PropertySetProperty `xml:"properties>property" json:"properties,omitempty"`
+}
+
+type PropertySetProperty struct {
+	Name                   string            `xml:"name" json:"name"`
+	ClosedPredefinedValues bool              `xml:"closedPredefinedValues" json:"closedPredefinedValues"`
+	MultipleChoice         bool              `xml:"multipleChoice" json:"multipleChoice"`
+	PredefinedValues       []PredefinedValue `xml:"predefinedValues>predefinedValue" json:"predefinedValues,omitempty"`
+}
+
+type PredefinedValue struct {
+	Value        string `xml:"value" json:"value"`
+	DefaultValue bool   `xml:"defaultValue" json:"defaultValue"`
+}
+
+type RepoLayout struct {
+	Name                             string `xml:"name" json:"name"`
+	ArtifactPathPattern              string `xml:"artifactPathPattern" json:"artifactPathPattern"`
+	DistinctiveDescriptorPathPattern bool   `xml:"distinctiveDescriptorPathPattern" json:"distinctiveDescriptorPathPattern"`
+	DescriptorPathPattern            string `xml:"descriptorPathPattern,omitempty" json:"descriptorPathPattern,omitempty"`
+	FolderIntegrationRevisionRegExp  string `xml:"folderIntegrationRevisionRegExp,omitempty" json:"folderIntegrationRevisionRegExp,omitempty"`
+	FileIntegrationRevisionRegExp    string `xml:"fileIntegrationRevisionRegExp,omitempty" json:"fileIntegrationRevisionRegExp,omitempty"`
+}
+
+// The global storage quota, which blocks uploads when the file store's used space reaches the limit.
+type StorageQuota struct {
+	Enabled                    bool `xml:"enabled"`
+	DiskSpaceLimitPercentage   int  `xml:"diskSpaceLimitPercentage"`
+	DiskSpaceWarningPercentage int  `xml:"diskSpaceWarningPercentage"`
+}
+
+type StorageQuotaStatus struct {
+	Quota StorageQuota
+	// The percentage of the file store's total space which is used.
+	UsedPercentage float64
+	// True if the used space reached the warning percentage.
+	Warning bool
+	// True if the used space reached the limit percentage, in which case uploads fail.
+	Exceeded bool
+}
+
+func NewStorageQuotaStatus(quota StorageQuota, fileStore utils.FileStoreUsage) StorageQuotaStatus {
+	status := StorageQuotaStatus{Quota: quota, UsedPercentage: fileStore.UsedPercentage()}
+	if quota.Enabled {
+		status.Warning = status.UsedPercentage >= float64(quota.DiskSpaceWarningPercentage)
+		status.Exceeded = status.UsedPercentage >= float64(quota.DiskSpaceLimitPercentage)
+	}
+	return status
+}
+
+func (ps *PropertySet) Validate() error {
+	if ps.Name == "" {
+		return errorutils.CheckErrorf("a property set name is required")
+	}
+	var names []string
+	for _, property := range ps.Properties {
+		if property.Name == "" {
+			return errorutils.CheckErrorf("property set '%s': a property name is required", ps.Name)
+		}
+		if slices.Contains(names, property.Name) {
+			return errorutils.CheckErrorf("property set '%s': property '%s' appears more than once", ps.Name, property.Name)
+		}
+		names = append(names, property.Name)
+	}
+	return nil
+}
+
+func (rl *RepoLayout) Validate() error {
+	if rl.Name == "" {
+		return errorutils.CheckErrorf("a repository layout name is required")
+	}
+	if rl.ArtifactPathPattern == "" {
+		return errorutils.CheckErrorf("repository layout '%s': an artifact path pattern is required", rl.Name)
+	}
+	if rl.DistinctiveDescriptorPathPattern && rl.DescriptorPathPattern == "" {
+		return errorutils.CheckErrorf("repository layout '%s': a descriptor path pattern is required when the descriptor path pattern is distinctive", rl.Name)
+	}
+	return nil
+}
+
+func (cs *ConfigurationService) GetPropertySets() ([]PropertySet, error) {
+	descriptor, err := cs.getConfigDescriptor()
+	if err != nil {
+		return nil, err
+	}
+	return descriptor.PropertySets, nil
+}
+
+// Returns nil if the property set doesn't exist.
+func (cs *ConfigurationService) GetPropertySet(name string) (*PropertySet, error) {
+	propertySets, err := cs.GetPropertySets()
+	if err != nil {
+		return nil, err
+	}
+	for i := range propertySets {
+		if propertySets[i].Name == name {
+			return &propertySets[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (cs *ConfigurationService) CreatePropertySet(propertySet PropertySet) error {
+	current, err := cs.GetPropertySet(propertySet.Name)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		return errorutils.CheckErrorf("property set '%s' already exists", propertySet.Name)
+	}
+	return cs.patchPropertySet(nil, propertySet)
+}
+
+// Replaces the property set. Properties and predefined values which aren't in the given property set are removed.
+func (cs *ConfigurationService) UpdatePropertySet(propertySet PropertySet) error {
+	current, err := cs.GetPropertySet(propertySet.Name)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return errorutils.CheckErrorf("property set '%s' does not exist", propertySet.Name)
+	}
+	return cs.patchPropertySet(current, propertySet)
+}
+
+func (cs *ConfigurationService) patchPropertySet(current *PropertySet, propertySet PropertySet) error {
+	if err := propertySet.Validate(); err != nil {
+		return err
+	}
+	patch := map[string]interface{}{"propertySets": map[string]interface{}{propertySet.Name: propertySetPatch(current, propertySet)}}
+	log.Info("Applying property set '" + propertySet.Name + "'...")
+	return cs.patch(patch)
+}
+
+func (cs *ConfigurationService) DeletePropertySet(name string) error {
+	log.Info("Deleting property set '" + name + "'...")
+	return cs.patch(map[string]interface{}{"propertySets": map[string]interface{}{name: nil}})
+}
+
+func (cs *ConfigurationService) GetRepoLayouts() ([]RepoLayout, error) {
+	descriptor, err := cs.getConfigDescriptor()
+	if err != nil {
+		return nil, err
+	}
+	return descriptor.RepoLayouts, nil
+}
+
+// Returns nil if the repository layout doesn't exist.
+func (cs *ConfigurationService) GetRepoLayout(name string) (*RepoLayout, error) {
+	repoLayouts, err := cs.GetRepoLayouts()
+	if err != nil {
+		return nil, err
+	}
+	for i := range repoLayouts {
+		if repoLayouts[i].Name == name {
+			return &repoLayouts[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (cs *ConfigurationService) CreateRepoLayout(repoLayout RepoLayout) error {
+	current, err := cs.GetRepoLayout(repoLayout.Name)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		return errorutils.CheckErrorf("repository layout '%s' already exists", repoLayout.Name)
+	}
+	return cs.patchRepoLayout(repoLayout)
+}
+
+func (cs *ConfigurationService) UpdateRepoLayout(repoLayout RepoLayout) error {
+	current, err := cs.GetRepoLayout(repoLayout.Name)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return errorutils.CheckErrorf("repository layout '%s' does not exist", repoLayout.Name)
+	}
+	return cs.patchRepoLayout(repoLayout)
+}
+
+func (cs *ConfigurationService) patchRepoLayout(repoLayout RepoLayout) error {
+	if err := repoLayout.Validate(); err != nil {
+		return err
+	}
+	log.Info("Applying repository layout '" + repoLayout.Name + "'...")
+	return cs.patch(map[string]interface{}{"repoLayouts": map[string]interface{}{repoLayout.Name: repoLayoutPatch(repoLayout)}})
+}
+
+func (cs *ConfigurationService) DeleteRepoLayout(name string) error {
+	log.Info("Deleting repository layout '" + name + "'...")
+	return cs.patch(map[string]interface{}{"repoLayouts": map[string]interface{}{name: nil}})
+}
+
+// Returns the global storage quota. A disabled quota is returned if it isn't configured.
+func (cs *ConfigurationService) GetStorageQuota() (*StorageQuota, error) {
+	descriptor, err := cs.getConfigDescriptor()
+	if err != nil {
+		return nil, err
+	}
+	if descriptor.QuotaConfig == nil {
+		return &StorageQuota{}, nil
+	}
+	return descriptor.QuotaConfig, nil
+}
+
+// Returns the global storage quota along with the current usage of the file store.
+func (cs *ConfigurationService) GetStorageQuotaStatus() (*StorageQuotaStatus, error) {
+	quota, err := cs.GetStorageQuota()
+	if err != nil {
+		return nil, err
+	}
+	summary, err := NewStorageService(*cs.artDetails, cs.client).StorageSummary()
+	if err != nil {
+		return nil, err
+	}
+	status := NewStorageQuotaStatus(*quota, summary.FileStore)
+	return &status, nil
+}
+
+func (cs *ConfigurationService) getConfigDescriptor() (*configDescriptor, error) {
+	content, err := NewSystemService(*cs.artDetails, cs.client).GetConfigDescriptor()
+	if err != nil {
+		return nil, err
+	}
+	return parseConfigDescriptor([]byte(content))
+}
+
+func parseConfigDescriptor(content []byte) (*configDescriptor, error) {
+	descriptor := &configDescriptor{}
+	if err := xml.Unmarshal(content, descriptor); err != nil {
+		return nil, errorutils.CheckErrorf("failed parsing the configuration descriptor: %s", err.Error())
+	}
+	return descriptor, nil
+}
+
+func (cs *ConfigurationService) patch(patch map[string]interface{}) error {
+	content, err := yaml.Marshal(patch)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	return cs.sendPatch(content)
+}
+
+func (cs *ConfigurationService) sendPatch(content []byte) error {
+	httpDetails := cs.GetArtifactoryDetails().CreateHttpClientDetails()
+	utils.SetContentType("application/yaml", &httpDetails.Headers)
+	resp, body, err := cs.client.SendPatch(cs.GetArtifactoryDetails().GetUrl()+configurationApi, content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", string(body), resp.Status)
+	return nil
+}
+
+// Returns the YAML patch of a property set. Since patches are merged into the existing configuration, properties and
+// predefined values which exist only in the current property set are explicitly removed.
+func propertySetPatch(current *PropertySet, propertySet PropertySet) map[string]interface{} {
+	properties := map[string]interface{}{}
+	if current != nil {
+		for _, property := range current.Properties {
+			properties[property.Name] = nil
+		}
+	}
+	for _, property := range propertySet.Properties {
+		predefinedValues := map[string]interface{}{}
+		if current != nil {
+			for _, currentProperty := range current.Properties {
+				if currentProperty.Name != property.Name {
+					continue
+				}
+				for _, value := range currentProperty.PredefinedValues {
+					predefinedValues[value.Value] = nil
+				}
+			}
+		}
+		for _, value := range property.PredefinedValues {
+			predefinedValues[value.Value] = map[string]interface{}{"defaultValue": value.DefaultValue}
+		}
+		propertyPatch := map[string]interface{}{
+			"closedPredefinedValues": property.ClosedPredefinedValues,
+			"multipleChoice":         property.MultipleChoice,
+		}
+		if len(predefinedValues) > 0 {
+			propertyPatch["predefinedValues"] = predefinedValues
+		}
+		properties[property.Name] = propertyPatch
+	}
+	patch := map[string]interface{}{"visible": propertySet.Visible}
+	if len(properties) > 0 {
+		patch["properties"] = properties
+	}
+	return patch
+}
+
+func repoLayoutPatch(repoLayout RepoLayout) map[string]interface{} {
+	return map[string]interface{}{
+		"artifactPathPattern":              repoLayout.ArtifactPathPattern,
+		"distinctiveDescriptorPathPattern": repoLayout.DistinctiveDescriptorPathPattern,
+		"descriptorPathPattern":            repoLayout.DescriptorPathPattern,
+		"folderIntegrationRevisionRegExp":  repoLayout.FolderIntegrationRevisionRegExp,
+		"fileIntegrationRevisionRegExp":    repoLayout.FileIntegrationRevisionRegExp,
+	}
+}
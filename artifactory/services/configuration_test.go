@@ -0,0 +1,192 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const testConfigDescriptor = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<config xmlns="http://artifactory.jfrog.org/xsd/3.1.21">
+    <propertySets>
+        <propertySet>
+            <name>artifactory</name>
+            <visible>true</visible>
+            <properties>
+                <property>
+                    <name>licenses</name>
+                    <predefinedValues>
+                        <predefinedValue>
+                            <value>Apache-2.0</value>
+                            <defaultValue>true</defaultValue>
+                        </predefinedValue>
+                        <predefinedValue>
+                            <value>MIT</value>
+                            <defaultValue>false</defaultValue>
+                        </predefinedValue>
+                    </predefinedValues>
+                    <closedPredefinedValues>true</closedPredefinedValues>
+                    <multipleChoice>true</multipleChoice>
+                </property>
+            </properties>
+        </propertySet>
+    </propertySets>
+    <repoLayouts>
+        <repoLayout>
+            <name>maven-2-default</name>
+            <artifactPathPattern>[orgPath]/[module]/[baseRev](-[folderItegRev])/[module]-[baseRev](-[fileItegRev])(-[classifier]).[ext]</artifactPathPattern>
+            <distinctiveDescriptorPathPattern>true</distinctiveDescriptorPathPattern>
+            <descriptorPathPattern>[orgPath]/[module]/[baseRev](-[folderItegRev])/[module]-[baseRev](-[fileItegRev])(-[classifier]).pom</descriptorPathPattern>
+            <folderIntegrationRevisionRegExp>SNAPSHOT</folderIntegrationRevisionRegExp>
+            <fileIntegrationRevisionRegExp>SNAPSHOT|(?:(?:[0-9]{8}.[0-9]{6})-(?:[0-9]+))</fileIntegrationRevisionRegExp>
+        </repoLayout>
+    </repoLayouts>
+    <quotaConfig>
+        <enabled>true</enabled>
+        <diskSpaceLimitPercentage>95</diskSpaceLimitPercentage>
+        <diskSpaceWarningPercentage>85</diskSpaceWarningPercentage>
+    </quotaConfig>
+    <security>
+        <ldapSettings>
+            <ldapSetting>
+                <key>corp-ldap</key>
+                <enabled>true</enabled>
+                <ldapUrl>ldaps://ldap.example.com:636/dc=example,dc=com</ldapUrl>
+                <search>
+                    <searchFilter>(uid={0})</searchFilter>
+                    <searchBase>ou=People</searchBase>
+                    <searchSubTree>true</searchSubTree>
+                    <managerDn>cn=admin,dc=example,dc=com</managerDn>
+                    <managerPassword>encrypted</managerPassword>
+                </search>
+                <autoCreateUser>true</autoCreateUser>
+                <emailAttribute>mail</emailAttribute>
+                <ldapPoisoningProtection>true</ldapPoisoningProtection>
+                <allowUserToAccessProfile>false</allowUserToAccessProfile>
+                <pagingSupportEnabled>true</pagingSupportEnabled>
+            </ldapSetting>
+        </ldapSettings>
+        <ldapGroupSettings>
+            <ldapGroupSetting>
+                <name>corp-groups</name>
+                <groupBaseDn>ou=Groups</groupBaseDn>
+                <groupNameAttribute>cn</groupNameAttribute>
+                <groupMemberAttribute>uniqueMember</groupMemberAttribute>
+                <subTree>true</subTree>
+                <filter>(objectClass=groupOfUniqueNames)</filter>
+                <descriptionAttribute>description</descriptionAttribute>
+                <strategy>STATIC</strategy>
+                <enabledLdap>corp-ldap</enabledLdap>
+            </ldapGroupSetting>
+        </ldapGroupSettings>
+        <crowdSettings>
+            <applicationName>artifactory</applicationName>
+            <password>encrypted</password>
+            <serverUrl>https://crowd.example.com/crowd</serverUrl>
+            <sessionValidationInterval>5</sessionValidationInterval>
+            <enableIntegration>true</enableIntegration>
+            <noAutoUserCreation>false</noAutoUserCreation>
+            <useDefaultProxy>false</useDefaultProxy>
+            <directAuthentication>true</directAuthentication>
+            <overrideAllGroupsUponLogin>false</overrideAllGroupsUponLogin>
+        </crowdSettings>
+    </security>
+</config>`
+
+func TestParseConfigDescriptor(t *testing.T) {
+	descriptor, err := parseConfigDescriptor([]byte(testConfigDescriptor))
+	assert.NoError(t, err)
+	assert.Equal(t, []PropertySet{{
+		Name:    "artifactory",
+		Visible: true,
+		Properties: []PropertySetProperty{{
+			Name:                   "licenses",
+			ClosedPredefinedValues: true,
+			MultipleChoice:         true,
+			PredefinedValues:       []PredefinedValue{{Value: "Apache-2.0", DefaultValue: true}, {Value: "MIT"}},
+		}},
+	}}, descriptor.PropertySets)
+	if assert.Len(t, descriptor.RepoLayouts, 1) {
+		assert.Equal(t, "maven-2-default", descriptor.RepoLayouts[0].Name)
+		assert.True(t, descriptor.RepoLayouts[0].DistinctiveDescriptorPathPattern)
+		assert.Equal(t, "SNAPSHOT", descriptor.RepoLayouts[0].FolderIntegrationRevisionRegExp)
+	}
+	assert.Equal(t, &StorageQuota{Enabled: true, DiskSpaceLimitPercentage: 95, DiskSpaceWarningPercentage: 85}, descriptor.QuotaConfig)
+	if assert.Len(t, descriptor.Security.LdapSettings, 1) {
+		ldapSetting := descriptor.Security.LdapSettings[0]
+		assert.Equal(t, "corp-ldap", ldapSetting.Key)
+		assert.Equal(t, "(uid={0})", ldapSetting.Search.SearchFilter)
+		assert.True(t, ldapSetting.Search.SearchSubTree)
+		assert.True(t, ldapSetting.PagingSupportEnabled)
+	}
+	assert.Equal(t, []LdapGroupSetting{{
+		Name:                 "corp-groups",
+		EnabledLdap:          "corp-ldap",
+		GroupBaseDn:          "ou=Groups",
+		GroupNameAttribute:   "cn",
+		GroupMemberAttribute: "uniqueMember",
+		SubTree:              true,
+		Filter:               "(objectClass=groupOfUniqueNames)",
+		DescriptionAttribute: "description",
+		Strategy:             LdapGroupStrategyStatic,
+	}}, descriptor.Security.LdapGroupSettings)
+	if assert.NotNil(t, descriptor.Security.CrowdSettings) {
+		assert.True(t, descriptor.Security.CrowdSettings.EnableIntegration)
+		assert.Equal(t, 5, descriptor.Security.CrowdSettings.SessionValidationInterval)
+	}
+}
+
+func TestNewStorageQuotaStatus(t *testing.T) {
+	quota := StorageQuota{Enabled: true, DiskSpaceLimitPercentage: 95, DiskSpaceWarningPercentage: 85}
+	status := NewStorageQuotaStatus(quota, utils.FileStoreUsage{TotalSpaceInBytes: 100, UsedSpaceInBytes: 90})
+	assert.Equal(t, 90.0, status.UsedPercentage)
+	assert.True(t, status.Warning)
+	assert.False(t, status.Exceeded)
+
+	status = NewStorageQuotaStatus(quota, utils.FileStoreUsage{TotalSpaceInBytes: 100, UsedSpaceInBytes: 95})
+	assert.True(t, status.Exceeded)
+
+	// A disabled quota is never reached.
+	status = NewStorageQuotaStatus(StorageQuota{DiskSpaceLimitPercentage: 95}, utils.FileStoreUsage{TotalSpaceInBytes: 100, UsedSpaceInBytes: 99})
+	assert.False(t, status.Warning)
+	assert.False(t, status.Exceeded)
+}
+
+func TestPropertySetPatch(t *testing.T) {
+	current := &PropertySet{Name: "ps", Properties: []PropertySetProperty{
+		{Name: "removed"},
+		{Name: "kept", PredefinedValues: []PredefinedValue{{Value: "a"}, {Value: "b"}}},
+	}}
+	desired := PropertySet{Name: "ps", Visible: true, Properties: []PropertySetProperty{
+		{Name: "kept", MultipleChoice: true, PredefinedValues: []PredefinedValue{{Value: "b", DefaultValue: true}}},
+	}}
+	assert.Equal(t, map[string]interface{}{
+		"visible": true,
+		"properties": map[string]interface{}{
+			"removed": nil,
+			"kept": map[string]interface{}{
+				"closedPredefinedValues": false,
+				"multipleChoice":         true,
+				"predefinedValues": map[string]interface{}{
+					"a": nil,
+					"b": map[string]interface{}{"defaultValue": true},
+				},
+			},
+		},
+	}, propertySetPatch(current, desired))
+}
+
+func TestPropertySetValidate(t *testing.T) {
+	propertySet := PropertySet{Name: "ps", Properties: []PropertySetProperty{{Name: "p"}, {Name: "p"}}}
+	assert.ErrorContains(t, propertySet.Validate(), "more than once")
+	propertySet.Properties = propertySet.Properties[:1]
+	assert.NoError(t, propertySet.Validate())
+}
+
+func TestRepoLayoutValidate(t *testing.T) {
+	repoLayout := RepoLayout{Name: "my-layout", ArtifactPathPattern: "[org]/[module]/[baseRev]/[module]-[baseRev].[ext]", DistinctiveDescriptorPathPattern: true}
+	assert.ErrorContains(t, repoLayout.Validate(), "descriptor path pattern is required")
+	repoLayout.DistinctiveDescriptorPathPattern = false
+	assert.NoError(t, repoLayout.Validate())
+}
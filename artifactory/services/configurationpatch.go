@@ -0,0 +1,393 @@
+package services
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
+)
+
+// The top level sections of the configuration descriptor which can be patched.
+var ConfigPatchSections = []string{
+	"urlBase", "offlineMode", "helpLinksEnabled", "fileUploadMaxSizeMb", "dateFormat", "addons", "mailServer", "xrayConfig",
+	"security", "backups", "indexer", "localRepositories", "remoteRepositories", "virtualRepositories",
+	"federatedRepositories", "distributionRepositories", "releaseBundlesRepositories", "proxies", "reverseProxies",
+	"propertySets", "repoLayouts", "localReplications", "remoteReplications", "gcConfig", "cleanupConfig",
+	"virtualCacheCleanupConfig", "quotaConfig", "systemMessageConfig", "folderDownloadConfig", "trashcanConfig",
+	"replicationsConfig", "sumoLogicConfig", "downloadRedirectConfig", "releaseBundlesConfig", "signedUrlConfig",
+}
+
+type ConfigPatchParams struct {
+	// The YAML patch, which is merged into the configuration descriptor by Artifactory.
+	Patch []byte
+	// Null values in a patch remove the matching elements, e.g. a null repository deletes the repository along with its
+	// content. Patches with null values are rejected unless this is true.
+	AllowRemovals bool
+	// If true, the patch is only validated, and its changes are returned without applying it.
+	DryRun bool
+}
+
+func NewConfigPatchParams(patch []byte) ConfigPatchParams {
+	return ConfigPatchParams{Patch: patch}
+}
+
+// A single value set or removed by a patch.
+type ConfigPatchChange struct {
+	// The dot separated path of the value, e.g. "localRepositories.libs-release.description".
+	Path string
+	// The new value. Nil for removals.
+	Value   interface{}
+	Removal bool
+}
+
+type ConfigPatchResult struct {
+	// The changes of the patch, sorted by path.
+	Changes []ConfigPatchChange
+	// The lines of the configuration descriptor which were changed, prefixed with "-" for removed lines and "+" for
+	// added lines. In dry-run, the patch is applied locally on the current configuration descriptor, so the diff is only
+	// a preview, and the order of added elements may differ from the order Artifactory would give them.
+	Diff    []string
+	Applied bool
+}
+
+// Validates the patch and returns its changes. The patch must be a YAML mapping of known configuration sections.
+func ValidateConfigPatch(patch []byte, allowRemovals bool) ([]ConfigPatchChange, error) {
+	var root interface{}
+	if err := yaml.Unmarshal(patch, &root); err != nil {
+		return nil, errorutils.CheckErrorf("invalid configuration patch: %s", err.Error())
+	}
+	sections, ok := root.(map[string]interface{})
+	if !ok || len(sections) == 0 {
+		return nil, errorutils.CheckErrorf("invalid configuration patch: expected a mapping of configuration sections")
+	}
+	var changes []ConfigPatchChange
+	for _, section := range slices.Sorted(maps.Keys(sections)) {
+		if !slices.Contains(ConfigPatchSections, section) {
+			return nil, errorutils.CheckErrorf("invalid configuration patch: unknown section '%s'", section)
+		}
+		changes = flattenConfigPatch(section, sections[section], changes)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	if !allowRemovals {
+		for _, change := range changes {
+			if change.Removal {
+				return nil, errorutils.CheckErrorf("the configuration patch removes '%s', which is allowed only if removals are explicitly allowed", change.Path)
+			}
+		}
+	}
+	return changes, nil
+}
+
+func flattenConfigPatch(path string, value interface{}, changes []ConfigPatchChange) []ConfigPatchChange {
+	mapping, ok := value.(map[string]interface{})
+	if !ok || len(mapping) == 0 {
+		return append(changes, ConfigPatchChange{Path: path, Value: value, Removal: value == nil})
+	}
+	for key, child := range mapping {
+		changes = flattenConfigPatch(path+"."+key, child, changes)
+	}
+	return changes
+}
+
+// Validates the patch and applies it, unless running in dry-run. Since Artifactory doesn't report what a patch
+// changed, the configuration descriptor is compared before and after applying the patch.
+func (cs *ConfigurationService) ApplyConfigPatch(params ConfigPatchParams) (*ConfigPatchResult, error) {
+	changes, err := ValidateConfigPatch(params.Patch, params.AllowRemovals)
+	if err != nil {
+		return nil, err
+	}
+	result := &ConfigPatchResult{Changes: changes}
+	systemService := NewSystemService(*cs.artDetails, cs.client)
+	before, err := systemService.GetConfigDescriptor()
+	if err != nil {
+		return nil, err
+	}
+	if params.DryRun {
+		formattedBefore, patched, err := patchConfigDescriptor(before, params.Patch)
+		if err != nil {
+			return nil, err
+		}
+		result.Diff = DiffLines(formattedBefore, patched)
+		log.Info(fmt.Sprintf("[Dry run] The configuration patch includes %d changes.", len(changes)))
+		return result, nil
+	}
+	log.Info(fmt.Sprintf("Applying a configuration patch with %d changes...", len(changes)))
+	if err = cs.sendPatch(params.Patch); err != nil {
+		return nil, err
+	}
+	result.Applied = true
+	after, err := systemService.GetConfigDescriptor()
+	if err != nil {
+		return result, err
+	}
+	result.Diff = DiffLines(before, after)
+	return result, nil
+}
+
+// The collections of the configuration descriptor, whose entries are referenced in patches by their keys rather than
+// by their element names.
+var configPatchCollections = map[string]configPatchCollection{
+	"localRepositories":          {entry: "localRepository", keyField: "key"},
+	"remoteRepositories":         {entry: "remoteRepository", keyField: "key"},
+	"virtualRepositories":        {entry: "virtualRepository", keyField: "key"},
+	"federatedRepositories":      {entry: "federatedRepository", keyField: "key"},
+	"distributionRepositories":   {entry: "distributionRepository", keyField: "key"},
+	"releaseBundlesRepositories": {entry: "releaseBundlesRepository", keyField: "key"},
+	"localReplications":          {entry: "localReplication", keyField: "repoKey"},
+	"remoteReplications":         {entry: "remoteReplication", keyField: "repoKey"},
+	"backups":                    {entry: "backup", keyField: "key"},
+	"proxies":                    {entry: "proxy", keyField: "key"},
+	"reverseProxies":             {entry: "reverseProxy", keyField: "key"},
+	"propertySets":               {entry: "propertySet", keyField: "name"},
+	"repoLayouts":                {entry: "repoLayout", keyField: "name"},
+	"ldapSettings":               {entry: "ldapSetting", keyField: "key"},
+	"ldapGroupSettings":          {entry: "ldapGroupSetting", keyField: "name"},
+}
+
+type configPatchCollection struct {
+	entry    string
+	keyField string
+}
+
+// An element of the configuration descriptor, used to apply patches locally.
+type configXmlElement struct {
+	name     string
+	attrs    []xml.Attr
+	text     string
+	children []*configXmlElement
+}
+
+// Applies the patch on the configuration descriptor locally, the way Artifactory merges it.
+// Returns the descriptor before and after the patch, formatted the same way so that they can be compared.
+func patchConfigDescriptor(descriptor string, patch []byte) (before, after string, err error) {
+	root, err := parseConfigXml(descriptor)
+	if err != nil {
+		return "", "", err
+	}
+	before = root.String()
+	var sections map[string]interface{}
+	if err = yaml.Unmarshal(patch, &sections); err != nil {
+		return "", "", errorutils.CheckErrorf("invalid configuration patch: %s", err.Error())
+	}
+	root.patch(sections)
+	return before, root.String(), nil
+}
+
+func parseConfigXml(descriptor string) (*configXmlElement, error) {
+	decoder := xml.NewDecoder(strings.NewReader(descriptor))
+	var root *configXmlElement
+	var stack []*configXmlElement
+	for {
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errorutils.CheckErrorf("failed parsing the configuration descriptor: %s", err.Error())
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			element := &configXmlElement{name: xmlElementName(t.Name), attrs: slices.Clone(t.Attr)}
+			if len(stack) == 0 {
+				if root != nil {
+					return nil, errorutils.CheckErrorf("failed parsing the configuration descriptor: multiple root elements")
+				}
+				root = element
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, element)
+			}
+			stack = append(stack, element)
+		case xml.EndElement:
+			if len(stack) == 0 {
+				return nil, errorutils.CheckErrorf("failed parsing the configuration descriptor: unexpected end element '%s'", xmlElementName(t.Name))
+			}
+			stack[len(stack)-1].text = strings.TrimSpace(stack[len(stack)-1].text)
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(t)
+			}
+		}
+	}
+	if root == nil || len(stack) > 0 {
+		return nil, errorutils.CheckErrorf("failed parsing the configuration descriptor: incomplete XML")
+	}
+	return root, nil
+}
+
+// Merges a patch mapping into the element. Null values remove elements, mappings are merged recursively, and any
+// other value replaces the content of the element.
+func (e *configXmlElement) patch(values map[string]interface{}) {
+	collection, isCollection := configPatchCollections[e.name]
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		value := values[key]
+		index := e.findChild(key, collection, isCollection)
+		if value == nil {
+			if index >= 0 {
+				e.children = slices.Delete(e.children, index, index+1)
+			}
+			continue
+		}
+		var child *configXmlElement
+		switch {
+		case index >= 0:
+			child = e.children[index]
+		case isCollection:
+			child = &configXmlElement{name: collection.entry, children: []*configXmlElement{{name: collection.keyField, text: key}}}
+			e.children = append(e.children, child)
+		default:
+			child = &configXmlElement{name: key}
+			e.children = append(e.children, child)
+		}
+		child.set(value)
+	}
+}
+
+func (e *configXmlElement) set(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		e.text = ""
+		e.patch(v)
+	case []interface{}:
+		// The items of a list replace the existing items, which share the same element name.
+		itemName := strings.TrimSuffix(e.name, "s")
+		if len(e.children) > 0 {
+			itemName = e.children[0].name
+		}
+		e.text, e.children = "", nil
+		for _, item := range v {
+			itemElement := &configXmlElement{name: itemName}
+			itemElement.set(item)
+			e.children = append(e.children, itemElement)
+		}
+	default:
+		e.text, e.children = fmt.Sprint(v), nil
+	}
+}
+
+// Returns the index of the child element referenced by the key, or -1 if it doesn't exist.
+func (e *configXmlElement) findChild(key string, collection configPatchCollection, isCollection bool) int {
+	return slices.IndexFunc(e.children, func(child *configXmlElement) bool {
+		if !isCollection {
+			return child.name == key
+		}
+		return slices.ContainsFunc(child.children, func(field *configXmlElement) bool {
+			return field.name == collection.keyField && field.text == key
+		})
+	})
+}
+
+func (e *configXmlElement) String() string {
+	var builder strings.Builder
+	e.write(&builder, 0)
+	return builder.String()
+}
+
+func (e *configXmlElement) write(builder *strings.Builder, depth int) {
+	indent := strings.Repeat("    ", depth)
+	builder.WriteString(indent + "<" + e.name)
+	for _, attr := range e.attrs {
+		builder.WriteString(" " + xmlElementName(attr.Name) + `="`)
+		_ = xml.EscapeText(builder, []byte(attr.Value))
+		builder.WriteString(`"`)
+	}
+	switch {
+	case len(e.children) > 0:
+		builder.WriteString(">\n")
+		for _, child := range e.children {
+			child.write(builder, depth+1)
+		}
+		builder.WriteString(indent + "</" + e.name + ">\n")
+	case e.text != "":
+		builder.WriteString(">")
+		_ = xml.EscapeText(builder, []byte(e.text))
+		builder.WriteString("</" + e.name + ">\n")
+	default:
+		builder.WriteString("/>\n")
+	}
+}
+
+// Raw XML tokens keep the namespace prefix of the name as its space.
+func xmlElementName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+// Returns the lines removed from before, prefixed with "-", and the lines added in after, prefixed with "+", in order.
+// Unchanged lines are omitted.
+func DiffLines(before, after string) []string {
+	beforeLines, afterLines := strings.Split(before, "\n"), strings.Split(after, "\n")
+	// Patches usually change a small part of the descriptor, so the common prefix and suffix are skipped before
+	// comparing the rest.
+	prefix := 0
+	for prefix < len(beforeLines) && prefix < len(afterLines) && beforeLines[prefix] == afterLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(beforeLines)-prefix && suffix < len(afterLines)-prefix &&
+		beforeLines[len(beforeLines)-1-suffix] == afterLines[len(afterLines)-1-suffix] {
+		suffix++
+	}
+	return myersDiff(beforeLines[prefix:len(beforeLines)-suffix], afterLines[prefix:len(afterLines)-suffix])
+}
+
+// Myers' diff algorithm, which takes O((N+M)D) time and O(D^2) memory, where D is the number of changed lines.
+func myersDiff(a, b []string) []string {
+	n, m := len(a), len(b)
+	offset := n + m + 1
+	// The furthest index in a reached on each diagonal k = x - y, indexed by k + offset.
+	furthest := make([]int, 2*offset+1)
+	// The furthest indexes after each number of edits d, on the diagonals -d to d, to backtrack the edits.
+	var trace [][]int
+	for d, done := 0, false; !done; d++ {
+		for k := -d; k <= d && !done; k += 2 {
+			var x int
+			if k == -d || (k != d && furthest[offset+k-1] < furthest[offset+k+1]) {
+				x = furthest[offset+k+1]
+			} else {
+				x = furthest[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			furthest[offset+k] = x
+			done = x >= n && y >= m
+		}
+		trace = append(trace, slices.Clone(furthest[offset-d:offset+d+1]))
+	}
+	var diff []string
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		// The diagonals of the previous step start from -(d-1).
+		previous := func(k int) int { return trace[d-1][k+d-1] }
+		k := x - y
+		if k == -d || (k != d && previous(k-1) < previous(k+1)) {
+			// Reached from the diagonal above by adding a line of b.
+			x = previous(k + 1)
+			y = x - k - 1
+			diff = append(diff, "+"+b[y])
+		} else {
+			// Reached from the diagonal below by removing a line of a.
+			x = previous(k - 1)
+			y = x - k + 1
+			diff = append(diff, "-"+a[x])
+		}
+	}
+	slices.Reverse(diff)
+	return diff
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateConfigPatch(t *testing.T) {
+	patch := []byte(`
+localRepositories:
+  libs-release:
+    description: "Releases"
+    xrayIndex: true
+urlBase: https://acme.jfrog.io
+`)
+	changes, err := ValidateConfigPatch(patch, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []ConfigPatchChange{
+		{Path: "localRepositories.libs-release.description", Value: "Releases"},
+		{Path: "localRepositories.libs-release.xrayIndex", Value: true},
+		{Path: "urlBase", Value: "https://acme.jfrog.io"},
+	}, changes)
+
+	removal := []byte("remoteRepositories:\n  npm-remote: ~\n")
+	_, err = ValidateConfigPatch(removal, false)
+	assert.ErrorContains(t, err, "removes 'remoteRepositories.npm-remote'")
+	changes, err = ValidateConfigPatch(removal, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []ConfigPatchChange{{Path: "remoteRepositories.npm-remote", Removal: true}}, changes)
+
+	_, err = ValidateConfigPatch([]byte("localRepos:\n  a: b\n"), false)
+	assert.ErrorContains(t, err, "unknown section 'localRepos'")
+	for i := 0; i < 10; i++ {
+		_, err = ValidateConfigPatch([]byte("zRepos: {}\nbRepos: {}\naRepos: {}\n"), false)
+		assert.ErrorContains(t, err, "unknown section 'aRepos'")
+	}
+	_, err = ValidateConfigPatch([]byte("- a\n- b\n"), false)
+	assert.ErrorContains(t, err, "expected a mapping")
+	_, err = ValidateConfigPatch([]byte(""), false)
+	assert.Error(t, err)
+	_, err = ValidateConfigPatch([]byte("urlBase: [unclosed"), false)
+	assert.ErrorContains(t, err, "invalid configuration patch")
+}
+
+func TestDiffLines(t *testing.T) {
+	before := "<config>\n<urlBase>a</urlBase>\n<offlineMode>false</offlineMode>\n<repos>\n<repo>x</repo>\n</repos>\n</config>"
+	after := "<config>\n<urlBase>b</urlBase>\n<offlineMode>false</offlineMode>\n<repos>\n<repo>x</repo>\n<repo>y</repo>\n</repos>\n</config>"
+	assert.Equal(t, []string{"-<urlBase>a</urlBase>", "+<urlBase>b</urlBase>", "+<repo>y</repo>"}, DiffLines(before, after))
+	assert.Empty(t, DiffLines(before, before))
+	assert.Equal(t, []string{"-a", "-b"}, DiffLines("a\nb\nc", "c"))
+}
+
+func TestDiffLinesEdits(t *testing.T) {
+	assert.Equal(t, []string{"-b", "+x", "+y", "-d"}, DiffLines("a\nb\nc\nd\ne", "a\nx\ny\nc\ne"))
+	assert.Equal(t, []string{"+a", "+b"}, DiffLines("", "a\nb\n"))
+
+	// The diff of a large descriptor with a few changes is computed in time proportional to its size.
+	var lines []string
+	for i := 0; i < 100000; i++ {
+		lines = append(lines, fmt.Sprintf("<line>%d</line>", i))
+	}
+	before := strings.Join(lines, "\n")
+	lines[10], lines[90000] = "<line>changed</line>", "<line>also changed</line>"
+	assert.Equal(t, []string{"-<line>10</line>", "+<line>changed</line>", "-<line>90000</line>", "+<line>also changed</line>"},
+		DiffLines(before, strings.Join(lines, "\n")))
+}
+
+const configPatchTestDescriptor = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<config xmlns="http://artifactory.jfrog.org/xsd/3.1.21">
+    <urlBase>https://old.jfrog.io</urlBase>
+    <localRepositories>
+        <localRepository>
+            <key>libs-release</key>
+            <description>Old &amp; busted</description>
+        </localRepository>
+        <localRepository>
+            <key>libs-snapshot</key>
+        </localRepository>
+    </localRepositories>
+</config>`
+
+func TestPatchConfigDescriptor(t *testing.T) {
+	patch := []byte(`
+urlBase: https://acme.jfrog.io
+localRepositories:
+  libs-release:
+    description: "Releases"
+    xrayIndex: true
+  libs-snapshot: ~
+  libs-new:
+    description: New
+`)
+	before, after, err := patchConfigDescriptor(configPatchTestDescriptor, patch)
+	assert.NoError(t, err)
+	assert.Contains(t, before, "<description>Old &amp; busted</description>")
+	assert.Equal(t, []string{
+		"-    <urlBase>https://old.jfrog.io</urlBase>",
+		"+    <urlBase>https://acme.jfrog.io</urlBase>",
+		"-            <description>Old &amp; busted</description>",
+		"+            <description>Releases</description>",
+		"+            <xrayIndex>true</xrayIndex>",
+		"-            <key>libs-snapshot</key>",
+		"+            <key>libs-new</key>",
+		"+            <description>New</description>",
+	}, DiffLines(before, after))
+
+	_, _, err = patchConfigDescriptor("<config>", patch)
+	assert.ErrorContains(t, err, "failed parsing the configuration descriptor")
+}
+
+func TestApplyConfigPatchDryRun(t *testing.T) {
+	configurationService := NewConfigurationService(newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "a dry-run must not send the patch")
+		_, _ = w.Write([]byte(configPatchTestDescriptor))
+	}))
+	params := NewConfigPatchParams([]byte("urlBase: https://acme.jfrog.io\n"))
+	params.DryRun = true
+	result, err := configurationService.ApplyConfigPatch(params)
+	assert.NoError(t, err)
+	assert.False(t, result.Applied)
+	assert.Equal(t, []string{"-    <urlBase>https://old.jfrog.io</urlBase>", "+    <urlBase>https://acme.jfrog.io</urlBase>"}, result.Diff)
+}
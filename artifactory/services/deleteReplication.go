@@ -2,6 +2,7 @@ package services
 
 import (
 	"net/http"
+	"net/url"
 
 	"github.com/jfrog/jfrog-client-go/auth"
 
@@ -37,3 +38,19 @@ func (drs *DeleteReplicationService) DeleteReplication(repoKey string) error {
 	log.Info("Done Deleting replication job.")
 	return nil
 }
+
+// Removes a single target from the replications of a repository, such as one target of a multi-push replication.
+func (drs *DeleteReplicationService) DeleteReplicationTarget(repoKey, targetUrl string) error {
+	httpClientsDetails := drs.ArtDetails.CreateHttpClientDetails()
+	log.Info("Deleting replication target...")
+	resp, body, err := drs.client.SendDelete(drs.ArtDetails.GetUrl()+"api/replications/"+repoKey+"?url="+url.QueryEscape(targetUrl), nil, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	log.Info("Done deleting replication target.")
+	return nil
+}
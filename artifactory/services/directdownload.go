@@ -922,9 +922,10 @@ func (dds *DirectDownloadService) getBuildInfo(buildName, buildNumber, project s
 		buildName,
 		buildNumber)
 
-	if project != "" {
-		buildUrl += "?project=" + project
+	if err := utils.ValidateBuildProjectKey(project, *dds.artDetails); err != nil {
+		return nil, err
 	}
+	buildUrl += utils.GetProjectQueryParam(project)
 
 	log.Debug("Fetching build info from:", buildUrl)
 
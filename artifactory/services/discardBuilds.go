@@ -3,13 +3,17 @@ package services
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
 	"path"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	buildinfo "github.com/jfrog/build-info-go/entities"
 
+	artifactoryutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
 	"github.com/jfrog/jfrog-client-go/utils"
@@ -29,17 +33,6 @@ func NewDiscardBuildsService(client *jfroghttpclient.JfrogHttpClient) *DiscardBu
 func (ds *DiscardBuildsService) DiscardBuilds(params DiscardBuildsParams) error {
 	log.Info("Discarding builds...")
 
-	discardUrl := ds.ArtDetails.GetUrl()
-	restApi := path.Join("api/build/retention/", params.GetBuildName())
-	requestFullUrl, err := utils.BuildUrl(discardUrl, restApi, make(map[string]string))
-	if err != nil {
-		return err
-	}
-	requestFullUrl += "?async=" + strconv.FormatBool(params.IsAsync())
-	if params.ProjectKey != "" {
-		requestFullUrl += "&project=" + params.ProjectKey
-	}
-
 	var excludeBuilds []string
 	if params.GetExcludeBuilds() != "" {
 		excludeBuilds = strings.Split(params.GetExcludeBuilds(), ",")
@@ -47,6 +40,7 @@ func (ds *DiscardBuildsService) DiscardBuilds(params DiscardBuildsParams) error
 
 	minimumBuildDateString := ""
 	if params.GetMaxDays() != "" {
+		var err error
 		minimumBuildDateString, err = calculateMinimumBuildDate(time.Now(), params.GetMaxDays())
 		if err != nil {
 			return err
@@ -58,6 +52,64 @@ func (ds *DiscardBuildsService) DiscardBuilds(params DiscardBuildsParams) error
 		MinimumBuildDate: minimumBuildDateString,
 		MaxBuilds:        params.GetMaxBuilds(),
 		DeleteArtifacts:  params.IsDeleteArtifacts()}
+	return ds.sendDiscardBuilds(params.GetBuildName(), params.GetProjectKey(), params.IsAsync(), data)
+}
+
+// Enforces a retention policy on the runs of a build. When running asynchronously, use GetBuildRetentionStatus to
+// check whether the policy was enforced.
+func (ds *DiscardBuildsService) ApplyBuildRetention(retention BuildRetention) error {
+	if retention.BuildName == "" {
+		return errorutils.CheckErrorf("a build name is required")
+	}
+	if retention.MaxDays < 0 || retention.MaxBuilds < 0 {
+		return errorutils.CheckErrorf("the maximum days and maximum builds of a build retention can't be negative")
+	}
+	log.Info("Applying build retention to " + retention.BuildName + "...")
+	data := DiscardBuildsBody{
+		ExcludeBuilds:   retention.ExcludeBuilds,
+		DeleteArtifacts: retention.DeleteArtifacts,
+	}
+	if retention.MaxDays > 0 {
+		data.MinimumBuildDate = retention.getMinimumBuildDate(time.Now()).Format(buildinfo.TimeFormat)
+	}
+	if retention.MaxBuilds > 0 {
+		data.MaxBuilds = strconv.Itoa(retention.MaxBuilds)
+	}
+	return ds.sendDiscardBuilds(retention.BuildName, retention.ProjectKey, retention.Async, data)
+}
+
+// Returns the runs of the build which the retention policy has yet to remove.
+func (ds *DiscardBuildsService) GetBuildRetentionStatus(retention BuildRetention) (*BuildRetentionStatus, error) {
+	if retention.BuildName == "" {
+		return nil, errorutils.CheckErrorf("a build name is required")
+	}
+	buildRuns, found, err := NewBuildInfoService(ds.ArtDetails, ds.client).GetBuildRuns(BuildInfoParams{BuildName: retention.BuildName, ProjectKey: retention.ProjectKey})
+	if err != nil {
+		return nil, err
+	}
+	status := &BuildRetentionStatus{}
+	if found {
+		status.PendingBuilds, status.RemainingBuilds = retention.getPendingBuilds(buildRuns.BuildsNumbers, time.Now())
+	}
+	status.Completed = len(status.PendingBuilds) == 0
+	return status, nil
+}
+
+func (ds *DiscardBuildsService) sendDiscardBuilds(buildName, projectKey string, async bool, data DiscardBuildsBody) error {
+	if err := artifactoryutils.ValidateBuildProjectKey(projectKey, ds.ArtDetails); err != nil {
+		return err
+	}
+	discardUrl := ds.ArtDetails.GetUrl()
+	restApi := path.Join("api/build/retention/", buildName)
+	requestFullUrl, err := utils.BuildUrl(discardUrl, restApi, make(map[string]string))
+	if err != nil {
+		return err
+	}
+	requestFullUrl += "?async=" + strconv.FormatBool(async)
+	if projectKey != "" {
+		requestFullUrl += "&project=" + url.QueryEscape(projectKey)
+	}
+
 	requestContent, err := json.Marshal(data)
 	if err != nil {
 		return errorutils.CheckError(err)
@@ -73,7 +125,7 @@ func (ds *DiscardBuildsService) DiscardBuilds(params DiscardBuildsParams) error
 	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusNoContent); err != nil {
 		return err
 	}
-	if params.IsAsync() {
+	if async {
 		log.Info("Builds are being discarded asynchronously.")
 		return nil
 	}
@@ -146,3 +198,69 @@ func (bd *DiscardBuildsParams) IsAsync() bool {
 func NewDiscardBuildsParams() DiscardBuildsParams {
 	return DiscardBuildsParams{}
 }
+
+// A retention policy of the runs of a build. A run is removed if it's older than MaxDays, or if it isn't among the
+// newest MaxBuilds runs.
+type BuildRetention struct {
+	BuildName  string
+	ProjectKey string
+	// The maximum age of a run in days. 0 means no limit.
+	MaxDays int
+	// The number of runs to keep. 0 means no limit.
+	MaxBuilds int
+	// The numbers of the runs which are never removed.
+	ExcludeBuilds []string
+	// Removes the artifacts of the removed runs as well.
+	DeleteArtifacts bool
+	// Returns as soon as Artifactory accepts the request, rather than when the runs are removed.
+	Async bool
+}
+
+func NewBuildRetention(buildName string) BuildRetention {
+	return BuildRetention{BuildName: buildName}
+}
+
+type BuildRetentionStatus struct {
+	// True when no run of the build violates the retention policy.
+	Completed bool
+	// The numbers of the runs which violate the retention policy, and are yet to be removed.
+	PendingBuilds []string
+	// The number of runs which are kept by the retention policy, not including the excluded runs.
+	RemainingBuilds int
+}
+
+func (br *BuildRetention) getMinimumBuildDate(now time.Time) time.Time {
+	return now.Add(-24 * time.Hour * time.Duration(br.MaxDays))
+}
+
+// Splits the runs of the build into those which violate the policy, and the number of those which are kept.
+func (br *BuildRetention) getPendingBuilds(runs []buildinfo.BuildRun, now time.Time) (pending []string, remaining int) {
+	runs = slices.Clone(runs)
+	// The newest runs first, as they're the ones kept by MaxBuilds.
+	sort.SliceStable(runs, func(i, j int) bool {
+		return parseBuildRunStarted(runs[i].Started).After(parseBuildRunStarted(runs[j].Started))
+	})
+	minimumBuildDate := br.getMinimumBuildDate(now)
+	for _, run := range runs {
+		buildNumber := strings.TrimPrefix(run.Uri, "/")
+		if slices.Contains(br.ExcludeBuilds, buildNumber) {
+			continue
+		}
+		tooOld := br.MaxDays > 0 && parseBuildRunStarted(run.Started).Before(minimumBuildDate)
+		tooMany := br.MaxBuilds > 0 && remaining >= br.MaxBuilds
+		if tooOld || tooMany {
+			pending = append(pending, buildNumber)
+			continue
+		}
+		remaining++
+	}
+	return
+}
+
+func parseBuildRunStarted(started string) time.Time {
+	parsed, err := time.Parse(buildinfo.TimeFormat, started)
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
+}
@@ -1,9 +1,14 @@
 package services
 
 import (
-	buildinfo "github.com/jfrog/build-info-go/entities"
+	"encoding/json"
+	"io"
+	"net/http"
 	"testing"
 	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCalculateMinimumBuildDate(t *testing.T) {
@@ -32,3 +37,70 @@ func TestCalculateMinimumBuildDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPendingBuilds(t *testing.T) {
+	now, _ := time.Parse(buildinfo.TimeFormat, "2018-05-07T17:34:49.729+0300")
+	runs := []buildinfo.BuildRun{
+		{Uri: "/1", Started: "2018-05-01T17:34:49.729+0300"},
+		{Uri: "/3", Started: "2018-05-06T17:34:49.729+0300"},
+		{Uri: "/2", Started: "2018-05-05T17:34:49.729+0300"},
+		{Uri: "/4", Started: "2018-05-07T17:34:49.729+0300"},
+	}
+	tests := []struct {
+		testName          string
+		retention         BuildRetention
+		expectedPending   []string
+		expectedRemaining int
+	}{
+		{"no_limits", BuildRetention{}, nil, 4},
+		{"max_days", BuildRetention{MaxDays: 3}, []string{"1"}, 3},
+		{"max_builds", BuildRetention{MaxBuilds: 2}, []string{"2", "1"}, 2},
+		{"exclude_builds", BuildRetention{MaxBuilds: 1, ExcludeBuilds: []string{"1", "3"}}, []string{"2"}, 1},
+	}
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			pending, remaining := test.retention.getPendingBuilds(runs, now)
+			assert.Equal(t, test.expectedPending, pending)
+			assert.Equal(t, test.expectedRemaining, remaining)
+		})
+	}
+}
+
+func TestApplyBuildRetention(t *testing.T) {
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/build/retention/build":
+			assert.Equal(t, "async=true&project=proj", r.URL.RawQuery)
+			content, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			body := DiscardBuildsBody{}
+			assert.NoError(t, json.Unmarshal(content, &body))
+			assert.Equal(t, "2", body.MaxBuilds)
+			assert.NotEmpty(t, body.MinimumBuildDate)
+			assert.Equal(t, []string{"1"}, body.ExcludeBuilds)
+			assert.True(t, body.DeleteArtifacts)
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/build/build":
+			_, _ = w.Write([]byte(`{"buildsNumbers":[{"uri":"/1","started":"2018-05-01T17:34:49.729+0300"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	discardService := NewDiscardBuildsService(client)
+	discardService.ArtDetails = serviceDetails
+
+	retention := BuildRetention{BuildName: "build", ProjectKey: "proj", MaxDays: 30, MaxBuilds: 2, ExcludeBuilds: []string{"1"}, DeleteArtifacts: true, Async: true}
+	assert.NoError(t, discardService.ApplyBuildRetention(retention))
+	status, err := discardService.GetBuildRetentionStatus(retention)
+	assert.NoError(t, err)
+	assert.True(t, status.Completed)
+	assert.Equal(t, 0, status.RemainingBuilds)
+
+	retention.ExcludeBuilds = nil
+	status, err = discardService.GetBuildRetentionStatus(retention)
+	assert.NoError(t, err)
+	assert.False(t, status.Completed)
+	assert.Equal(t, []string{"1"}, status.PendingBuilds)
+
+	assert.Error(t, discardService.ApplyBuildRetention(BuildRetention{BuildName: "build", MaxDays: -1}))
+}
@@ -6,6 +6,7 @@ import (
 	"path"
 	"strings"
 
+	artifactoryutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
 	"github.com/jfrog/jfrog-client-go/utils"
@@ -38,10 +39,17 @@ func (ds *DistributeService) BuildDistribute(params BuildDistributionParams) err
 	}
 	message := "Distributing build..."
 	log.Info(dryRun + message)
+	if err := artifactoryutils.ValidateBuildProjectKey(params.ProjectKey, ds.ArtDetails); err != nil {
+		return err
+	}
 
 	distributeUrl := ds.ArtDetails.GetUrl()
 	restApi := path.Join("api/build/distribute/", params.GetBuildName(), params.GetBuildNumber())
-	requestFullUrl, err := utils.BuildUrl(distributeUrl, restApi, make(map[string]string))
+	queryParams := make(map[string]string)
+	if params.ProjectKey != "" {
+		queryParams["project"] = params.ProjectKey
+	}
+	requestFullUrl, err := utils.BuildUrl(distributeUrl, restApi, queryParams)
 	if err != nil {
 		return err
 	}
@@ -99,6 +107,7 @@ type BuildDistributionParams struct {
 	Async                 bool
 	BuildName             string
 	BuildNumber           string
+	ProjectKey            string
 }
 
 func (bd *BuildDistributionParams) GetSourceRepos() string {
@@ -0,0 +1,291 @@
+package services
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	OciManifestMediaType        = "application/vnd.oci.image.manifest.v1+json"
+	OciIndexMediaType           = "application/vnd.oci.image.index.v1+json"
+	DockerManifestMediaType     = "application/vnd.docker.distribution.manifest.v2+json"
+	DockerManifestListMediaType = "application/vnd.docker.distribution.manifest.list.v2+json"
+	dockerContentDigestHeader   = "Docker-Content-Digest"
+	sha256DigestPrefix          = "sha256:"
+)
+
+// The media types accepted when fetching a manifest, so that the registry doesn't convert it to an older schema.
+var acceptedManifestMediaTypes = []string{OciManifestMediaType, OciIndexMediaType, DockerManifestMediaType, DockerManifestListMediaType}
+
+// References content, such as a layer, a config blob or a manifest of a manifest list.
+type OciDescriptor struct {
+	MediaType    string            `json:"mediaType,omitempty"`
+	Digest       string            `json:"digest,omitempty"`
+	Size         int64             `json:"size,omitempty"`
+	ArtifactType string            `json:"artifactType,omitempty"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+	// Set only for the manifests of a manifest list.
+	Platform *OciPlatform `json:"platform,omitempty"`
+}
+
+type OciPlatform struct {
+	Architecture string `json:"architecture,omitempty"`
+	Os           string `json:"os,omitempty"`
+	OsVersion    string `json:"os.version,omitempty"`
+	Variant      string `json:"variant,omitempty"`
+}
+
+// An OCI image manifest or a Docker image manifest (schema 2).
+type OciManifest struct {
+	SchemaVersion int               `json:"schemaVersion,omitempty"`
+	MediaType     string            `json:"mediaType,omitempty"`
+	ArtifactType  string            `json:"artifactType,omitempty"`
+	Config        OciDescriptor     `json:"config,omitempty"`
+	Layers        []OciDescriptor   `json:"layers,omitempty"`
+	Subject       *OciDescriptor    `json:"subject,omitempty"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+	// The verified digest of the manifest.
+	Digest string `json:"-"`
+}
+
+// An OCI image index or a Docker manifest list, which references the manifests of a multi-arch image.
+type OciManifestList struct {
+	SchemaVersion int               `json:"schemaVersion,omitempty"`
+	MediaType     string            `json:"mediaType,omitempty"`
+	Manifests     []OciDescriptor   `json:"manifests,omitempty"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+	// The verified digest of the manifest list.
+	Digest string `json:"-"`
+}
+
+// Returns the manifest of the platform, or nil if the list has none. An empty variant matches any variant.
+func (oml *OciManifestList) FindPlatform(os, architecture, variant string) *OciDescriptor {
+	for i, manifest := range oml.Manifests {
+		if manifest.Platform == nil || manifest.Platform.Os != os || manifest.Platform.Architecture != architecture {
+			continue
+		}
+		if variant == "" || manifest.Platform.Variant == variant {
+			return &oml.Manifests[i]
+		}
+	}
+	return nil
+}
+
+// The config blob of an image.
+type OciImageConfig struct {
+	Architecture string            `json:"architecture,omitempty"`
+	Os           string            `json:"os,omitempty"`
+	Variant      string            `json:"variant,omitempty"`
+	Created      string            `json:"created,omitempty"`
+	Author       string            `json:"author,omitempty"`
+	Config       OciImageRuntime   `json:"config,omitempty"`
+	RootFS       OciRootFS         `json:"rootfs,omitempty"`
+	History      []OciHistoryEntry `json:"history,omitempty"`
+}
+
+// The execution parameters of a container created from the image.
+type OciImageRuntime struct {
+	User       string            `json:"User,omitempty"`
+	Env        []string          `json:"Env,omitempty"`
+	Entrypoint []string          `json:"Entrypoint,omitempty"`
+	Cmd        []string          `json:"Cmd,omitempty"`
+	WorkingDir string            `json:"WorkingDir,omitempty"`
+	Labels     map[string]string `json:"Labels,omitempty"`
+}
+
+type OciRootFS struct {
+	Type    string   `json:"type,omitempty"`
+	DiffIds []string `json:"diff_ids,omitempty"`
+}
+
+type OciHistoryEntry struct {
+	Created    string `json:"created,omitempty"`
+	CreatedBy  string `json:"created_by,omitempty"`
+	Comment    string `json:"comment,omitempty"`
+	EmptyLayer bool   `json:"empty_layer,omitempty"`
+}
+
+// Returns true if the media type is of an OCI image index or a Docker manifest list.
+func IsManifestListMediaType(mediaType string) bool {
+	return mediaType == OciIndexMediaType || mediaType == DockerManifestListMediaType
+}
+
+// Returns the image manifest of a tag or a digest. Fails if the reference points to a manifest list, which can be
+// fetched using GetManifestList.
+func (drs *DockerRegistryService) GetManifest(repoKey, image, reference string) (*OciManifest, error) {
+	body, mediaType, digest, err := drs.getManifest(repoKey, image, reference)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &OciManifest{}
+	if err = errorutils.CheckError(json.Unmarshal(body, manifest)); err != nil {
+		return nil, err
+	}
+	if manifest.MediaType == "" {
+		manifest.MediaType = mediaType
+	}
+	if IsManifestListMediaType(manifest.MediaType) {
+		return nil, errorutils.CheckErrorf("'%s:%s' in repository '%s' is a manifest list, not an image manifest", image, reference, repoKey)
+	}
+	manifest.Digest = digest
+	return manifest, nil
+}
+
+// Returns the manifest list of a tag or a digest. Fails if the reference points to a single image manifest.
+func (drs *DockerRegistryService) GetManifestList(repoKey, image, reference string) (*OciManifestList, error) {
+	body, mediaType, digest, err := drs.getManifest(repoKey, image, reference)
+	if err != nil {
+		return nil, err
+	}
+	manifestList := &OciManifestList{}
+	if err = errorutils.CheckError(json.Unmarshal(body, manifestList)); err != nil {
+		return nil, err
+	}
+	if manifestList.MediaType == "" {
+		manifestList.MediaType = mediaType
+	}
+	if !IsManifestListMediaType(manifestList.MediaType) {
+		return nil, errorutils.CheckErrorf("'%s:%s' in repository '%s' is not a manifest list", image, reference, repoKey)
+	}
+	manifestList.Digest = digest
+	return manifestList, nil
+}
+
+// Streams a blob, such as a layer or a config blob, to the writer, and verifies that it matches the requested digest.
+// Since the content is verified once it's fully written, the content written so far must be discarded on error.
+func (drs *DockerRegistryService) GetBlob(repoKey, image, digest string, writer io.Writer) (err error) {
+	if err = validateDigestAlgorithm(digest); err != nil {
+		return err
+	}
+	restApi := path.Join("api/docker", repoKey, "v2", image, "blobs", digest)
+	requestUrl, err := clientutils.BuildUrl(drs.GetArtifactoryDetails().GetUrl(), restApi, nil)
+	if err != nil {
+		return err
+	}
+	httpClientsDetails := drs.GetArtifactoryDetails().CreateHttpClientDetails()
+	body, resp, err := drs.client.ReadRemoteFile(requestUrl, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatus(resp, http.StatusOK); err != nil {
+		return errors.Join(err, errorutils.CheckError(resp.Body.Close()))
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(body.Close()))
+	}()
+	log.Debug("Artifactory response:", resp.Status)
+	checksum := sha256.New()
+	if _, err = io.Copy(io.MultiWriter(writer, checksum), body); err != nil {
+		return errorutils.CheckError(err)
+	}
+	if actual := sha256DigestPrefix + hex.EncodeToString(checksum.Sum(nil)); actual != digest {
+		return errorutils.CheckErrorf("digest mismatch: expected '%s' but the content's digest is '%s'", digest, actual)
+	}
+	return nil
+}
+
+// Returns the content of a small blob, such as a config blob, after verifying that it matches the digest.
+func (drs *DockerRegistryService) getBlobContent(repoKey, image, digest string) ([]byte, error) {
+	var content bytes.Buffer
+	if err := drs.GetBlob(repoKey, image, digest, &content); err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// Returns the config blob referenced by the image manifest.
+func (drs *DockerRegistryService) GetImageConfig(repoKey, image string, manifest *OciManifest) (*OciImageConfig, error) {
+	if manifest.Config.Digest == "" {
+		return nil, errorutils.CheckErrorf("the manifest of '%s' doesn't reference a config blob", image)
+	}
+	body, err := drs.getBlobContent(repoKey, image, manifest.Config.Digest)
+	if err != nil {
+		return nil, err
+	}
+	imageConfig := &OciImageConfig{}
+	return imageConfig, errorutils.CheckError(json.Unmarshal(body, imageConfig))
+}
+
+// Returns the manifest content, media type and digest. The manifest is verified against the reference if it's a
+// digest, and against the digest returned by the registry otherwise. If a tag is requested and the registry doesn't
+// return the digest, the manifest isn't verified and the digest is computed from its content.
+func (drs *DockerRegistryService) getManifest(repoKey, image, reference string) (body []byte, mediaType, digest string, err error) {
+	resp, body, err := drs.getRegistryContent(repoKey, image, "manifests", reference, strings.Join(acceptedManifestMediaTypes, ", "))
+	if err != nil {
+		return nil, "", "", err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, "", "", err
+	}
+	digest = reference
+	if !strings.Contains(reference, ":") {
+		digest = resp.Header.Get(dockerContentDigestHeader)
+	}
+	if digest == "" {
+		// A tag was requested and the registry didn't return the digest, so there's nothing to verify against.
+		log.Debug(fmt.Sprintf("The registry didn't return the digest of '%s:%s', skipping its verification", image, reference))
+		digest = sha256Digest(body)
+	} else if err = VerifyDigest(body, digest); err != nil {
+		return nil, "", "", err
+	}
+	mediaType = resp.Header.Get("Content-Type")
+	if !slices.Contains(acceptedManifestMediaTypes, mediaType) {
+		mediaType = ""
+	}
+	return body, mediaType, digest, nil
+}
+
+func (drs *DockerRegistryService) getRegistryContent(repoKey, image, contentType, reference, accept string) (*http.Response, []byte, error) {
+	restApi := path.Join("api/docker", repoKey, "v2", image, contentType, reference)
+	requestUrl, err := clientutils.BuildUrl(drs.GetArtifactoryDetails().GetUrl(), restApi, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	httpClientsDetails := drs.GetArtifactoryDetails().CreateHttpClientDetails()
+	if accept != "" {
+		utils.AddHeader("Accept", accept, &httpClientsDetails.Headers)
+	}
+	resp, body, _, err := drs.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return resp, body, nil
+}
+
+// Verifies that the content matches the digest. Only SHA-256 digests are supported.
+func VerifyDigest(content []byte, digest string) error {
+	if err := validateDigestAlgorithm(digest); err != nil {
+		return err
+	}
+	if actual := sha256Digest(content); actual != digest {
+		return errorutils.CheckErrorf("digest mismatch: expected '%s' but the content's digest is '%s'", digest, actual)
+	}
+	return nil
+}
+
+func validateDigestAlgorithm(digest string) error {
+	if !strings.HasPrefix(digest, sha256DigestPrefix) {
+		return errorutils.CheckErrorf("unsupported digest '%s': only sha256 digests are supported", digest)
+	}
+	return nil
+}
+
+func sha256Digest(content []byte) string {
+	checksum := sha256.Sum256(content)
+	return sha256DigestPrefix + hex.EncodeToString(checksum[:])
+}
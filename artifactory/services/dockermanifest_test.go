@@ -0,0 +1,104 @@
+package services
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testImageConfig  = `{"architecture":"amd64","os":"linux","config":{"Env":["PATH=/usr/bin"],"Cmd":["/hello"]},"rootfs":{"type":"layers","diff_ids":["sha256:aaa"]}}`
+	testManifestList = `{"schemaVersion":2,"mediaType":"application/vnd.oci.image.index.v1+json","manifests":[` +
+		`{"mediaType":"application/vnd.oci.image.manifest.v1+json","digest":"sha256:111","size":10,"platform":{"architecture":"arm64","os":"linux","variant":"v8"}},` +
+		`{"mediaType":"application/vnd.oci.image.manifest.v1+json","digest":"sha256:222","size":10,"platform":{"architecture":"amd64","os":"linux"}}]}`
+)
+
+func createDockerManifestTestService(t *testing.T, manifest string) *DockerRegistryService {
+	configDigest := sha256Digest([]byte(testImageConfig))
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/docker/docker-local/v2/hello-world/manifests/1.0":
+			assert.Contains(t, r.Header.Get("Accept"), OciIndexMediaType)
+			w.Header().Set("Content-Type", OciManifestMediaType)
+			w.Header().Set(dockerContentDigestHeader, sha256Digest([]byte(manifest)))
+			_, _ = w.Write([]byte(manifest))
+		case "/api/docker/docker-local/v2/hello-world/manifests/tampered":
+			w.Header().Set(dockerContentDigestHeader, "sha256:000")
+			_, _ = w.Write([]byte(manifest))
+		case "/api/docker/docker-local/v2/hello-world/manifests/no-digest", "/api/docker/docker-local/v2/hello-world/manifests/sha256:000":
+			// The registry doesn't return the digest.
+			_, _ = w.Write([]byte(manifest))
+		case "/api/docker/docker-local/v2/hello-world/blobs/" + configDigest:
+			_, _ = w.Write([]byte(testImageConfig))
+		case "/api/docker/docker-local/v2/hello-world/blobs/sha256:000":
+			// Content which doesn't match the requested digest.
+			_, _ = w.Write([]byte(testImageConfig))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return NewDockerRegistryService(serviceDetails, client)
+}
+
+func TestGetManifestAndImageConfig(t *testing.T) {
+	configDigest := sha256Digest([]byte(testImageConfig))
+	// The media type is omitted, so it's taken from the Content-Type header.
+	manifest := `{"schemaVersion":2,"config":{"mediaType":"application/vnd.oci.image.config.v1+json","digest":"` + configDigest + `","size":100},` +
+		`"layers":[{"mediaType":"application/vnd.oci.image.layer.v1.tar+gzip","digest":"sha256:bbb","size":200}]}`
+	registryService := createDockerManifestTestService(t, manifest)
+
+	ociManifest, err := registryService.GetManifest("docker-local", "hello-world", "1.0")
+	assert.NoError(t, err)
+	assert.Equal(t, OciManifestMediaType, ociManifest.MediaType)
+	assert.Equal(t, sha256Digest([]byte(manifest)), ociManifest.Digest)
+	assert.Equal(t, configDigest, ociManifest.Config.Digest)
+	assert.Len(t, ociManifest.Layers, 1)
+
+	imageConfig, err := registryService.GetImageConfig("docker-local", "hello-world", ociManifest)
+	assert.NoError(t, err)
+	assert.Equal(t, "amd64", imageConfig.Architecture)
+	assert.Equal(t, []string{"/hello"}, imageConfig.Config.Cmd)
+	assert.Equal(t, []string{"sha256:aaa"}, imageConfig.RootFS.DiffIds)
+
+	_, err = registryService.GetManifestList("docker-local", "hello-world", "1.0")
+	assert.ErrorContains(t, err, "is not a manifest list")
+	_, err = registryService.GetManifest("docker-local", "hello-world", "tampered")
+	assert.ErrorContains(t, err, "digest mismatch")
+	// Without a digest from the registry, only a manifest requested by its digest can be verified.
+	ociManifest, err = registryService.GetManifest("docker-local", "hello-world", "no-digest")
+	assert.NoError(t, err)
+	assert.Equal(t, sha256Digest([]byte(manifest)), ociManifest.Digest)
+	_, err = registryService.GetManifest("docker-local", "hello-world", "sha256:000")
+	assert.ErrorContains(t, err, "digest mismatch: expected 'sha256:000'")
+	var blob bytes.Buffer
+	assert.NoError(t, registryService.GetBlob("docker-local", "hello-world", configDigest, &blob))
+	assert.Equal(t, testImageConfig, blob.String())
+	assert.ErrorContains(t, registryService.GetBlob("docker-local", "hello-world", "sha256:000", io.Discard), "digest mismatch: expected 'sha256:000'")
+	assert.ErrorContains(t, registryService.GetBlob("docker-local", "hello-world", "sha256:404", io.Discard), "404")
+	assert.ErrorContains(t, registryService.GetBlob("docker-local", "hello-world", "md5:abc", io.Discard), "only sha256 digests are supported")
+}
+
+func TestGetManifestList(t *testing.T) {
+	registryService := createDockerManifestTestService(t, testManifestList)
+
+	manifestList, err := registryService.GetManifestList("docker-local", "hello-world", "1.0")
+	assert.NoError(t, err)
+	assert.Equal(t, OciIndexMediaType, manifestList.MediaType)
+	assert.Len(t, manifestList.Manifests, 2)
+	assert.Equal(t, "sha256:222", manifestList.FindPlatform("linux", "amd64", "").Digest)
+	assert.Equal(t, "sha256:111", manifestList.FindPlatform("linux", "arm64", "v8").Digest)
+	assert.Nil(t, manifestList.FindPlatform("linux", "arm64", "v7"))
+
+	_, err = registryService.GetManifest("docker-local", "hello-world", "1.0")
+	assert.ErrorContains(t, err, "is a manifest list")
+}
+
+func TestVerifyDigest(t *testing.T) {
+	content := []byte("content")
+	assert.NoError(t, VerifyDigest(content, sha256Digest(content)))
+	assert.ErrorContains(t, VerifyDigest(content, sha256Digest([]byte("other"))), "digest mismatch")
+	assert.ErrorContains(t, VerifyDigest(content, "sha512:abc"), "only sha256 digests are supported")
+}
@@ -73,6 +73,63 @@ func (ps *DockerPromoteService) PromoteDocker(params DockerPromoteParams) error
 	return nil
 }
 
+// The outcome of promoting a single tag.
+type DockerPromoteResult struct {
+	TargetRepo  string
+	TargetImage string
+	TargetTag   string
+	// The manifest digest, which is the same in the source and the target.
+	Digest string
+	// True if the tag points to a manifest list (multi-arch image). The manifest list is promoted along with the
+	// manifests of all its platforms.
+	IsManifestList bool
+}
+
+// Promotes a single tag, and verifies that the target tag points to the same manifest as the source tag.
+// Multi-arch images are supported, in which case the manifest list is compared.
+func (ps *DockerPromoteService) PromoteDockerTag(params DockerPromoteParams) (*DockerPromoteResult, error) {
+	if params.SourceTag == "" {
+		return nil, errorutils.CheckErrorf("a source tag is required to promote a single tag")
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	registryService := NewDockerRegistryService(*ps.artDetails, ps.client)
+	sourceTag, err := registryService.GetTag(params.SourceRepo, params.SourceDockerImage, params.SourceTag)
+	if err != nil {
+		return nil, err
+	}
+	if sourceTag.IsManifestList {
+		log.Info("Image", params.SourceDockerImage+":"+params.SourceTag, "is a multi-arch image, promoting its manifest list.")
+	}
+	if err = ps.PromoteDocker(params); err != nil {
+		return nil, err
+	}
+	result := &DockerPromoteResult{
+		TargetRepo:     params.TargetRepo,
+		TargetImage:    defaultIfEmpty(params.TargetDockerImage, params.SourceDockerImage),
+		TargetTag:      defaultIfEmpty(params.TargetTag, params.SourceTag),
+		Digest:         sourceTag.Digest,
+		IsManifestList: sourceTag.IsManifestList,
+	}
+	targetTag, err := registryService.GetTag(result.TargetRepo, result.TargetImage, result.TargetTag)
+	if err != nil {
+		return nil, err
+	}
+	if targetTag.Digest != sourceTag.Digest || targetTag.IsManifestList != sourceTag.IsManifestList {
+		return nil, errorutils.CheckErrorf("the promoted tag '%s:%s' in repository '%s' points to '%s' instead of '%s'",
+			result.TargetImage, result.TargetTag, result.TargetRepo, targetTag.Digest, sourceTag.Digest)
+	}
+	return result, nil
+}
+
+func defaultIfEmpty(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
 type DockerPromoteParams struct {
 	// Mandatory:
 	// The name of the source repository in Artifactory, e.g. "docker-local-1". Supported by local repositories only.
@@ -125,6 +182,33 @@ func NewDockerPromoteParams(sourceDockerImage, sourceRepo, targetRepo string) Do
 	}
 }
 
+// Returns the params to promote a single tag. To retag an image within the same repository, pass the same source and
+// target repositories, along with a target tag.
+func NewDockerTagPromoteParams(sourceRepo, targetRepo, image, tag, targetTag string, copyImage bool) DockerPromoteParams {
+	return DockerPromoteParams{
+		SourceRepo:        sourceRepo,
+		TargetRepo:        targetRepo,
+		SourceDockerImage: image,
+		SourceTag:         tag,
+		TargetTag:         targetTag,
+		Copy:              copyImage,
+	}
+}
+
+func (dp DockerPromoteParams) Validate() error {
+	if dp.SourceRepo == "" || dp.TargetRepo == "" || dp.SourceDockerImage == "" {
+		return errorutils.CheckErrorf("the source repository, target repository and source Docker image are required to promote a Docker image")
+	}
+	if dp.TargetTag != "" && dp.SourceTag == "" {
+		return errorutils.CheckErrorf("a target tag requires a source tag")
+	}
+	if dp.SourceRepo == dp.TargetRepo && defaultIfEmpty(dp.TargetDockerImage, dp.SourceDockerImage) == dp.SourceDockerImage &&
+		defaultIfEmpty(dp.TargetTag, dp.SourceTag) == dp.SourceTag {
+		return errorutils.CheckErrorf("promoting '%s' to the same repository requires a different target image or tag", dp.SourceDockerImage)
+	}
+	return nil
+}
+
 type DockerPromoteBody struct {
 	TargetRepo             string `json:"targetRepo"`
 	DockerRepository       string `json:"dockerRepository"`
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDockerPromoteParamsValidate(t *testing.T) {
+	assert.NoError(t, NewDockerPromoteParams("hello-world", "docker-dev", "docker-prod").Validate())
+	assert.NoError(t, NewDockerTagPromoteParams("docker-dev", "docker-prod", "hello-world", "1.0", "", true).Validate())
+	// Retagging in the same repository.
+	assert.NoError(t, NewDockerTagPromoteParams("docker-dev", "docker-dev", "hello-world", "1.0", "latest", true).Validate())
+	assert.ErrorContains(t, NewDockerTagPromoteParams("docker-dev", "docker-dev", "hello-world", "1.0", "", true).Validate(), "different target image or tag")
+	assert.ErrorContains(t, NewDockerTagPromoteParams("docker-dev", "docker-prod", "hello-world", "", "latest", true).Validate(), "requires a source tag")
+	assert.ErrorContains(t, NewDockerPromoteParams("", "docker-dev", "docker-prod").Validate(), "are required")
+}
+
+func TestPromoteDockerTagManifestList(t *testing.T) {
+	var promoted bool
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/docker/docker-dev/v2/promote":
+			promoted = true
+		case "/api/storage/docker-dev/hello-world/1.0/list.manifest.json", "/api/storage/docker-prod/hello-world/1.0/list.manifest.json":
+			_, _ = w.Write([]byte(`{"checksums":{"sha256":"abc"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	promoteService := NewDockerPromoteService(serviceDetails, client)
+
+	result, err := promoteService.PromoteDockerTag(NewDockerTagPromoteParams("docker-dev", "docker-prod", "hello-world", "1.0", "", true))
+	assert.NoError(t, err)
+	assert.True(t, promoted)
+	assert.Equal(t, &DockerPromoteResult{TargetRepo: "docker-prod", TargetImage: "hello-world", TargetTag: "1.0", Digest: "sha256:abc", IsManifestList: true}, result)
+
+	_, err = promoteService.PromoteDockerTag(NewDockerTagPromoteParams("docker-dev", "docker-prod", "hello-world", "2.0", "", true))
+	assert.ErrorContains(t, err, "no manifest was found")
+
+	promoted = false
+	_, err = promoteService.PromoteDockerTag(NewDockerTagPromoteParams("docker-dev", "docker-dev", "hello-world", "1.0", "", true))
+	assert.ErrorContains(t, err, "different target image or tag")
+	assert.False(t, promoted)
+}
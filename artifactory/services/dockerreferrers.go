@@ -0,0 +1,300 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The media type of the empty config blob of artifacts which aren't images, such as signatures and SBOMs.
+	OciEmptyMediaType = "application/vnd.oci.empty.v1+json"
+	// Returned by registries that support the referrers API, when a manifest with a subject is pushed.
+	ociSubjectHeader        = "OCI-Subject"
+	ociFiltersAppliedHeader = "OCI-Filters-Applied"
+)
+
+var ociEmptyContent = []byte("{}")
+
+// A layer of a referrer, such as a signature payload or an SBOM document.
+type OciReferrerLayer struct {
+	MediaType   string
+	Content     []byte
+	Annotations map[string]string
+}
+
+type AttachReferrerParams struct {
+	// The key of the Docker or OCI repository.
+	RepoKey string
+	Image   string
+	// The digest of the manifest the referrer is attached to, e.g. the digest of a signed image.
+	SubjectDigest string
+	// The type of the referrer, e.g. "application/vnd.dev.cosign.artifact.sig.v1+json" or "application/spdx+json".
+	ArtifactType string
+	Layers       []OciReferrerLayer
+	Annotations  map[string]string
+}
+
+func NewAttachReferrerParams(repoKey, image, subjectDigest, artifactType string) AttachReferrerParams {
+	return AttachReferrerParams{RepoKey: repoKey, Image: image, SubjectDigest: subjectDigest, ArtifactType: artifactType}
+}
+
+func (arp AttachReferrerParams) Validate() error {
+	if arp.RepoKey == "" || arp.Image == "" || arp.SubjectDigest == "" {
+		return errorutils.CheckErrorf("a repository, an image and a subject digest are required")
+	}
+	if arp.ArtifactType == "" {
+		return errorutils.CheckErrorf("an artifact type is required")
+	}
+	if len(arp.Layers) == 0 {
+		return errorutils.CheckErrorf("a referrer must have at least one layer")
+	}
+	return validateDigestAlgorithm(arp.SubjectDigest)
+}
+
+// Returns the tag used to list the referrers of a manifest in registries that don't support the referrers API,
+// e.g. "sha256-6c3c624b58db..." for "sha256:6c3c624b58db...".
+func ReferrersTag(digest string) string {
+	return strings.Replace(digest, ":", "-", 1)
+}
+
+// Returns the referrers of a manifest, such as signatures, attestations and SBOMs, optionally filtered by artifact type.
+// If the referrers API isn't available, the referrers are read from the index tagged by the tag schema.
+func (drs *DockerRegistryService) ListReferrers(repoKey, image, subjectDigest, artifactType string) ([]OciDescriptor, error) {
+	if err := validateDigestAlgorithm(subjectDigest); err != nil {
+		return nil, err
+	}
+	queryParams := make(map[string]string)
+	if artifactType != "" {
+		queryParams["artifactType"] = artifactType
+	}
+	restApi := path.Join("api/docker", repoKey, "v2", image, "referrers", subjectDigest)
+	requestUrl, err := clientutils.BuildUrl(drs.GetArtifactoryDetails().GetUrl(), restApi, queryParams)
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := drs.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := drs.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	var index *OciManifestList
+	filtered := false
+	if resp.StatusCode == http.StatusNotFound {
+		log.Debug("The referrers API isn't available, falling back to the tag schema.")
+		if index, err = drs.getReferrersTagIndex(repoKey, image, subjectDigest); err != nil {
+			return nil, err
+		}
+	} else {
+		if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+			return nil, err
+		}
+		index = &OciManifestList{}
+		if err = errorutils.CheckError(json.Unmarshal(body, index)); err != nil {
+			return nil, err
+		}
+		filtered = strings.Contains(resp.Header.Get(ociFiltersAppliedHeader), "artifactType")
+	}
+	if artifactType == "" || filtered {
+		return index.Manifests, nil
+	}
+	var referrers []OciDescriptor
+	for _, referrer := range index.Manifests {
+		if referrer.ArtifactType == artifactType {
+			referrers = append(referrers, referrer)
+		}
+	}
+	return referrers, nil
+}
+
+// Returns the manifest of a referrer and the content of its layers.
+func (drs *DockerRegistryService) GetReferrer(repoKey, image, referrerDigest string) (*OciManifest, [][]byte, error) {
+	manifest, err := drs.GetManifest(repoKey, image, referrerDigest)
+	if err != nil {
+		return nil, nil, err
+	}
+	layers := make([][]byte, 0, len(manifest.Layers))
+	for _, layer := range manifest.Layers {
+		content, err := drs.getBlobContent(repoKey, image, layer.Digest)
+		if err != nil {
+			return nil, nil, err
+		}
+		layers = append(layers, content)
+	}
+	return manifest, layers, nil
+}
+
+// Pushes a referrer with the given layers and the subject manifest, and returns its descriptor.
+// If the registry doesn't support the referrers API, the referrer is also added to the index tagged by the tag schema.
+func (drs *DockerRegistryService) AttachReferrer(params AttachReferrerParams) (*OciDescriptor, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	subjectContent, subjectMediaType, subjectDigest, err := drs.getManifest(params.RepoKey, params.Image, params.SubjectDigest)
+	if err != nil {
+		return nil, err
+	}
+	if subjectMediaType == "" {
+		subjectManifest := &OciManifest{}
+		if err = errorutils.CheckError(json.Unmarshal(subjectContent, subjectManifest)); err != nil {
+			return nil, err
+		}
+		subjectMediaType = subjectManifest.MediaType
+	}
+	manifest := OciManifest{
+		SchemaVersion: 2,
+		MediaType:     OciManifestMediaType,
+		ArtifactType:  params.ArtifactType,
+		Subject:       &OciDescriptor{MediaType: subjectMediaType, Digest: subjectDigest, Size: int64(len(subjectContent))},
+		Annotations:   params.Annotations,
+	}
+	if manifest.Config, err = drs.uploadBlob(params.RepoKey, params.Image, OciEmptyMediaType, ociEmptyContent); err != nil {
+		return nil, err
+	}
+	for _, layer := range params.Layers {
+		layerDescriptor, err := drs.uploadBlob(params.RepoKey, params.Image, layer.MediaType, layer.Content)
+		if err != nil {
+			return nil, err
+		}
+		layerDescriptor.Annotations = layer.Annotations
+		manifest.Layers = append(manifest.Layers, layerDescriptor)
+	}
+	content, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	referrer := &OciDescriptor{
+		MediaType:    OciManifestMediaType,
+		Digest:       sha256Digest(content),
+		Size:         int64(len(content)),
+		ArtifactType: params.ArtifactType,
+		Annotations:  params.Annotations,
+	}
+	log.Info("Attaching a referrer of type '" + params.ArtifactType + "' to " + params.Image + "@" + subjectDigest + "...")
+	resp, err := drs.putManifest(params.RepoKey, params.Image, referrer.Digest, OciManifestMediaType, content)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Header.Get(ociSubjectHeader) == "" {
+		if err = drs.addToReferrersTagIndex(params.RepoKey, params.Image, subjectDigest, *referrer); err != nil {
+			return nil, err
+		}
+	}
+	log.Info("Attached referrer " + referrer.Digest + ".")
+	return referrer, nil
+}
+
+// Returns an empty index if the tag doesn't exist.
+func (drs *DockerRegistryService) getReferrersTagIndex(repoKey, image, subjectDigest string) (*OciManifestList, error) {
+	resp, body, err := drs.getRegistryContent(repoKey, image, "manifests", ReferrersTag(subjectDigest), OciIndexMediaType)
+	if err != nil {
+		return nil, err
+	}
+	index := &OciManifestList{SchemaVersion: 2, MediaType: OciIndexMediaType}
+	if resp.StatusCode == http.StatusNotFound {
+		return index, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return index, errorutils.CheckError(json.Unmarshal(body, index))
+}
+
+func (drs *DockerRegistryService) addToReferrersTagIndex(repoKey, image, subjectDigest string, referrer OciDescriptor) error {
+	index, err := drs.getReferrersTagIndex(repoKey, image, subjectDigest)
+	if err != nil {
+		return err
+	}
+	if slices.ContainsFunc(index.Manifests, func(descriptor OciDescriptor) bool { return descriptor.Digest == referrer.Digest }) {
+		return nil
+	}
+	log.Debug("The registry doesn't support the referrers API, updating the referrers tag.")
+	index.Manifests = append(index.Manifests, referrer)
+	content, err := json.Marshal(index)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	_, err = drs.putManifest(repoKey, image, ReferrersTag(subjectDigest), OciIndexMediaType, content)
+	return err
+}
+
+func (drs *DockerRegistryService) putManifest(repoKey, image, reference, mediaType string, content []byte) (*http.Response, error) {
+	restApi := path.Join("api/docker", repoKey, "v2", image, "manifests", reference)
+	requestUrl, err := clientutils.BuildUrl(drs.GetArtifactoryDetails().GetUrl(), restApi, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := drs.GetArtifactoryDetails().CreateHttpClientDetails()
+	utils.SetContentType(mediaType, &httpClientsDetails.Headers)
+	resp, body, err := drs.client.SendPut(requestUrl, content, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusCreated, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return resp, nil
+}
+
+// Uploads a blob in a single request, after starting an upload session.
+func (drs *DockerRegistryService) uploadBlob(repoKey, image, mediaType string, content []byte) (OciDescriptor, error) {
+	descriptor := OciDescriptor{MediaType: mediaType, Digest: sha256Digest(content), Size: int64(len(content))}
+	restApi := path.Join("api/docker", repoKey, "v2", image, "blobs", "uploads") + "/"
+	requestUrl, err := clientutils.BuildUrl(drs.GetArtifactoryDetails().GetUrl(), restApi, nil)
+	if err != nil {
+		return descriptor, err
+	}
+	httpClientsDetails := drs.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, err := drs.client.SendPost(requestUrl, nil, &httpClientsDetails)
+	if err != nil {
+		return descriptor, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusAccepted); err != nil {
+		return descriptor, err
+	}
+	uploadUrl, err := resolveUploadLocation(requestUrl, resp.Header.Get("Location"), descriptor.Digest)
+	if err != nil {
+		return descriptor, err
+	}
+	httpClientsDetails = drs.GetArtifactoryDetails().CreateHttpClientDetails()
+	utils.SetContentType("application/octet-stream", &httpClientsDetails.Headers)
+	resp, body, err = drs.client.SendPut(uploadUrl, content, &httpClientsDetails)
+	if err != nil {
+		return descriptor, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusCreated); err != nil {
+		return descriptor, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return descriptor, nil
+}
+
+// The location of an upload session may be relative to the request URL. The digest of the blob completes the upload.
+func resolveUploadLocation(requestUrl, location, digest string) (string, error) {
+	if location == "" {
+		return "", errorutils.CheckErrorf("the registry didn't return the location of the upload session")
+	}
+	base, err := url.Parse(requestUrl)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	locationUrl, err := url.Parse(location)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	uploadUrl := base.ResolveReference(locationUrl)
+	query := uploadUrl.Query()
+	query.Set("digest", digest)
+	uploadUrl.RawQuery = query.Encode()
+	return uploadUrl.String(), nil
+}
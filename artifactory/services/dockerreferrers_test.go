@@ -0,0 +1,98 @@
+package services
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSignatureType = "application/vnd.dev.cosign.artifact.sig.v1+json"
+
+// A registry without the referrers API, which stores blobs and manifests in memory.
+func createReferrersTestService(t *testing.T, subject string) (*DockerRegistryService, map[string][]byte) {
+	const registryPath = "/api/docker/docker-local/v2/hello-world/"
+	store := map[string][]byte{"manifests/" + sha256Digest([]byte(subject)): []byte(subject)}
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		resource := strings.TrimPrefix(r.URL.Path, registryPath)
+		switch {
+		case r.Method == http.MethodPost && resource == "blobs/uploads/":
+			w.Header().Set("Location", "/api/docker/docker-local/v2/hello-world/blobs/uploads/session?state=1")
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodPut && resource == "blobs/uploads/session":
+			assert.Equal(t, "1", r.URL.Query().Get("state"))
+			content, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			store["blobs/"+r.URL.Query().Get("digest")] = content
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPut:
+			content, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			store[resource] = content
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && store[resource] != nil:
+			_, _ = w.Write(store[resource])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return NewDockerRegistryService(serviceDetails, client), store
+}
+
+func TestAttachAndListReferrersWithTagSchema(t *testing.T) {
+	subject := `{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","layers":[]}`
+	subjectDigest := sha256Digest([]byte(subject))
+	registryService, store := createReferrersTestService(t, subject)
+
+	params := NewAttachReferrerParams("docker-local", "hello-world", subjectDigest, testSignatureType)
+	params.Layers = []OciReferrerLayer{{MediaType: "application/vnd.dev.cosign.simplesigning.v1+json", Content: []byte("payload")}}
+	referrer, err := registryService.AttachReferrer(params)
+	assert.NoError(t, err)
+	assert.Equal(t, testSignatureType, referrer.ArtifactType)
+	assert.Equal(t, []byte("payload"), store["blobs/"+sha256Digest([]byte("payload"))])
+	assert.Equal(t, ociEmptyContent, store["blobs/"+sha256Digest(ociEmptyContent)])
+	assert.Contains(t, store, "manifests/"+ReferrersTag(subjectDigest))
+
+	// Attaching the same referrer again doesn't duplicate it.
+	_, err = registryService.AttachReferrer(params)
+	assert.NoError(t, err)
+
+	referrers, err := registryService.ListReferrers("docker-local", "hello-world", subjectDigest, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []OciDescriptor{*referrer}, referrers)
+	referrers, err = registryService.ListReferrers("docker-local", "hello-world", subjectDigest, "application/spdx+json")
+	assert.NoError(t, err)
+	assert.Empty(t, referrers)
+
+	manifest, layers, err := registryService.GetReferrer("docker-local", "hello-world", referrer.Digest)
+	assert.NoError(t, err)
+	assert.Equal(t, subjectDigest, manifest.Subject.Digest)
+	assert.Equal(t, OciManifestMediaType, manifest.Subject.MediaType)
+	assert.Equal(t, [][]byte{[]byte("payload")}, layers)
+}
+
+func TestListReferrersWithReferrersApi(t *testing.T) {
+	subjectDigest := sha256Digest([]byte("subject"))
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/docker/docker-local/v2/hello-world/referrers/"+subjectDigest, r.URL.Path)
+		// The filter isn't applied by the registry, so the client applies it.
+		_, _ = w.Write([]byte(`{"schemaVersion":2,"manifests":[{"digest":"sha256:111","artifactType":"` + testSignatureType + `"},{"digest":"sha256:222","artifactType":"application/spdx+json"}]}`))
+	})
+	registryService := NewDockerRegistryService(serviceDetails, client)
+
+	referrers, err := registryService.ListReferrers("docker-local", "hello-world", subjectDigest, testSignatureType)
+	assert.NoError(t, err)
+	assert.Len(t, referrers, 1)
+	assert.Equal(t, "sha256:111", referrers[0].Digest)
+}
+
+func TestAttachReferrerParamsValidate(t *testing.T) {
+	params := NewAttachReferrerParams("docker-local", "hello-world", "sha256:abc", testSignatureType)
+	assert.ErrorContains(t, params.Validate(), "at least one layer")
+	params.Layers = []OciReferrerLayer{{MediaType: "text/plain", Content: []byte("a")}}
+	assert.NoError(t, params.Validate())
+	params.SubjectDigest = "1.0"
+	assert.ErrorContains(t, params.Validate(), "only sha256 digests are supported")
+}
@@ -0,0 +1,254 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	dockerManifestFile     = "manifest.json"
+	dockerListManifestFile = "list.manifest.json"
+)
+
+// Lists images and tags of Docker and OCI repositories, using the Docker Registry API exposed by Artifactory.
+type DockerRegistryService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+}
+
+func NewDockerRegistryService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *DockerRegistryService {
+	return &DockerRegistryService{artDetails: &artDetails, client: client}
+}
+
+func (drs *DockerRegistryService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *drs.artDetails
+}
+
+func (drs *DockerRegistryService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return drs.client
+}
+
+func (drs *DockerRegistryService) IsDryRun() bool {
+	return false
+}
+
+type DockerPageParams struct {
+	// The maximum number of results to return. If 0, the server's default is used.
+	PageSize int
+	// Return only the results that come after this value. Taken from the Next field of the previous page.
+	Last string
+}
+
+type DockerCatalogParams struct {
+	DockerPageParams
+	// The key of the Docker or OCI repository.
+	RepoKey string
+}
+
+func NewDockerCatalogParams(repoKey string) DockerCatalogParams {
+	return DockerCatalogParams{RepoKey: repoKey}
+}
+
+type DockerTagsParams struct {
+	DockerPageParams
+	// The key of the Docker or OCI repository.
+	RepoKey string
+	// The image name, e.g. "hello-world" or "org/hello-world".
+	Image string
+}
+
+func NewDockerTagsParams(repoKey, image string) DockerTagsParams {
+	return DockerTagsParams{RepoKey: repoKey, Image: image}
+}
+
+type DockerCatalog struct {
+	Repositories []string `json:"repositories,omitempty"`
+	// The value to pass as 'Last' to fetch the next page, or empty if this is the last page.
+	Next string `json:"-"`
+}
+
+type DockerTagsList struct {
+	Name string   `json:"name,omitempty"`
+	Tags []string `json:"tags,omitempty"`
+	// The value to pass as 'Last' to fetch the next page, or empty if this is the last page.
+	Next string `json:"-"`
+}
+
+type DockerTag struct {
+	Image string
+	Tag   string
+	// The manifest digest, e.g. "sha256:6c3c624b58db...".
+	Digest string
+	// True if the tag points to a manifest list (multi-arch image) rather than a single image manifest.
+	IsManifestList bool
+	LastModified   string
+}
+
+// Returns a single page of the images in the repository.
+func (drs *DockerRegistryService) ListRepositories(params DockerCatalogParams) (*DockerCatalog, error) {
+	restApi := path.Join("api/docker", params.RepoKey, "v2", "_catalog")
+	catalog := &DockerCatalog{}
+	next, err := drs.getPage(restApi, params.DockerPageParams, catalog)
+	if err != nil {
+		return nil, err
+	}
+	catalog.Next = next
+	return catalog, nil
+}
+
+// Returns all the images in the repository, fetching as many pages as needed.
+func (drs *DockerRegistryService) ListAllRepositories(params DockerCatalogParams) ([]string, error) {
+	var repositories []string
+	for {
+		catalog, err := drs.ListRepositories(params)
+		if err != nil {
+			return nil, err
+		}
+		repositories = append(repositories, catalog.Repositories...)
+		if catalog.Next == "" {
+			return repositories, nil
+		}
+		params.Last = catalog.Next
+	}
+}
+
+// Returns a single page of the tags of an image.
+func (drs *DockerRegistryService) ListTags(params DockerTagsParams) (*DockerTagsList, error) {
+	restApi := path.Join("api/docker", params.RepoKey, "v2", params.Image, "tags", "list")
+	tagsList := &DockerTagsList{}
+	next, err := drs.getPage(restApi, params.DockerPageParams, tagsList)
+	if err != nil {
+		return nil, err
+	}
+	tagsList.Next = next
+	return tagsList, nil
+}
+
+// Returns all the tags of an image, fetching as many pages as needed.
+func (drs *DockerRegistryService) ListAllTags(params DockerTagsParams) ([]string, error) {
+	var tags []string
+	for {
+		tagsList, err := drs.ListTags(params)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tagsList.Tags...)
+		if tagsList.Next == "" {
+			return tags, nil
+		}
+		params.Last = tagsList.Next
+	}
+}
+
+// Returns the manifest digest of a tag, using the storage API.
+// Multi-arch images are stored with a manifest list instead of a manifest, so both are looked up.
+func (drs *DockerRegistryService) GetTag(repoKey, image, tag string) (*DockerTag, error) {
+	tagPath := path.Join(repoKey, image, tag)
+	for _, manifestFile := range []string{dockerManifestFile, dockerListManifestFile} {
+		fileInfo, err := drs.getManifestInfo(path.Join(tagPath, manifestFile))
+		if err != nil {
+			return nil, err
+		}
+		if fileInfo == nil {
+			continue
+		}
+		return &DockerTag{
+			Image:          image,
+			Tag:            tag,
+			Digest:         "sha256:" + fileInfo.Checksums.Sha256,
+			IsManifestList: manifestFile == dockerListManifestFile,
+			LastModified:   fileInfo.LastModified,
+		}, nil
+	}
+	return nil, errorutils.CheckErrorf("no manifest was found for '%s:%s' in repository '%s'", image, tag, repoKey)
+}
+
+// Returns nil if the manifest doesn't exist.
+func (drs *DockerRegistryService) getManifestInfo(manifestPath string) (*utils.FileInfo, error) {
+	requestUrl, err := clientutils.BuildUrl(drs.GetArtifactoryDetails().GetUrl(), path.Join(StorageRestApi, manifestPath), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := drs.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := drs.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	fileInfo := &utils.FileInfo{}
+	return fileInfo, errorutils.CheckError(json.Unmarshal(body, fileInfo))
+}
+
+// Returns all the tags of an image, including their manifest digests.
+func (drs *DockerRegistryService) ListTagsWithDigests(params DockerTagsParams) ([]DockerTag, error) {
+	tags, err := drs.ListAllTags(params)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]DockerTag, 0, len(tags))
+	for _, tag := range tags {
+		dockerTag, err := drs.GetTag(params.RepoKey, params.Image, tag)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *dockerTag)
+	}
+	return result, nil
+}
+
+func (drs *DockerRegistryService) getPage(restApi string, pageParams DockerPageParams, result interface{}) (next string, err error) {
+	queryParams := make(map[string]string)
+	if pageParams.PageSize > 0 {
+		queryParams["n"] = strconv.Itoa(pageParams.PageSize)
+	}
+	if pageParams.Last != "" {
+		queryParams["last"] = pageParams.Last
+	}
+	requestUrl, err := clientutils.BuildUrl(drs.GetArtifactoryDetails().GetUrl(), restApi, queryParams)
+	if err != nil {
+		return "", err
+	}
+	httpClientsDetails := drs.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := drs.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return "", err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return "", err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	if err = errorutils.CheckError(json.Unmarshal(body, result)); err != nil {
+		return "", err
+	}
+	return getNextPageFromLinkHeader(resp.Header.Get("Link")), nil
+}
+
+// Extracts the 'last' query param from a Link header, such as: </v2/_catalog?last=image&n=100>; rel="next"
+func getNextPageFromLinkHeader(link string) string {
+	start := strings.Index(link, "<")
+	end := strings.Index(link, ">")
+	if start < 0 || end <= start {
+		return ""
+	}
+	nextUrl, err := url.Parse(link[start+1 : end])
+	if err != nil {
+		return ""
+	}
+	return nextUrl.Query().Get("last")
+}
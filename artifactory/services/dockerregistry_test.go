@@ -0,0 +1,22 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNextPageFromLinkHeader(t *testing.T) {
+	tests := []struct {
+		link     string
+		expected string
+	}{
+		{`</v2/_catalog?last=org%2Fimage&n=100>; rel="next"`, "org/image"},
+		{`</v2/hello-world/tags/list?n=2&last=1.0>; rel="next"`, "1.0"},
+		{"", ""},
+		{"invalid", ""},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, getNextPageFromLinkHeader(test.link))
+	}
+}
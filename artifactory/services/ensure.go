@@ -0,0 +1,282 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The Ensure operations bring a single user, group or permission target to a desired state. They read the current
+// state, compare it with the desired one, and apply only the differences, so they can be called repeatedly, for
+// example from a reconciliation loop. Only the fields which are set in the desired state are compared and applied.
+
+type EnsureAction string
+
+const (
+	EnsureCreate    EnsureAction = "create"
+	EnsureUpdate    EnsureAction = "update"
+	EnsureUnchanged EnsureAction = "unchanged"
+)
+
+type EnsureOptions struct {
+	// If true, only the differences are returned and nothing is changed.
+	DryRun bool
+}
+
+// A field whose current value differs from its desired value.
+type FieldChange struct {
+	Field   string
+	Current any
+	Desired any
+}
+
+type EnsureDiff struct {
+	Name   string
+	Action EnsureAction
+	// The fields which differ, for updated entities.
+	Changes []FieldChange
+}
+
+func (diff *EnsureDiff) IsChanged() bool {
+	return diff.Action != EnsureUnchanged
+}
+
+func (diff *EnsureDiff) String() string {
+	result := fmt.Sprintf("%s: %s", diff.Name, diff.Action)
+	for _, change := range diff.Changes {
+		result += fmt.Sprintf("\n  %s: %v -> %v", change.Field, change.Current, change.Desired)
+	}
+	return result
+}
+
+func (diff *EnsureDiff) hasChange(field string) bool {
+	return slices.ContainsFunc(diff.Changes, func(change FieldChange) bool { return change.Field == field })
+}
+
+func (diff *EnsureDiff) compareString(field, current, desired string) {
+	if desired != "" && desired != current {
+		diff.Changes = append(diff.Changes, FieldChange{Field: field, Current: current, Desired: desired})
+	}
+}
+
+// A missing current value is treated as false.
+func (diff *EnsureDiff) compareBool(field string, current, desired *bool) {
+	currentValue := current != nil && *current
+	if desired != nil && *desired != currentValue {
+		diff.Changes = append(diff.Changes, FieldChange{Field: field, Current: currentValue, Desired: *desired})
+	}
+}
+
+// The order of the values is ignored.
+func (diff *EnsureDiff) compareSet(field string, current, desired []string) {
+	current, desired = sortedOrNil(current), sortedOrNil(desired)
+	if !slices.Equal(current, desired) {
+		diff.Changes = append(diff.Changes, FieldChange{Field: field, Current: current, Desired: desired})
+	}
+}
+
+func (diff *EnsureDiff) setUpdateActionIfChanged() {
+	if len(diff.Changes) > 0 {
+		diff.Action = EnsureUpdate
+	}
+}
+
+// Compares the current user, or nil if it doesn't exist, with the desired one.
+// The password is never compared, as it can't be read.
+func DiffUser(current *User, desired User) EnsureDiff {
+	diff := EnsureDiff{Name: desired.Name, Action: EnsureUnchanged}
+	if current == nil {
+		diff.Action = EnsureCreate
+		return diff
+	}
+	diff.compareString("email", current.Email, desired.Email)
+	for _, field := range []struct {
+		name             string
+		current, desired *bool
+	}{
+		{"admin", current.Admin, desired.Admin},
+		{"profileUpdatable", current.ProfileUpdatable, desired.ProfileUpdatable},
+		{"disableUIAccess", current.DisableUIAccess, desired.DisableUIAccess},
+		{"internalPasswordDisabled", current.InternalPasswordDisabled, desired.InternalPasswordDisabled},
+		{"watchManager", current.WatchManager, desired.WatchManager},
+		{"reportsManager", current.ReportsManager, desired.ReportsManager},
+		{"policyManager", current.PolicyManager, desired.PolicyManager},
+		{"projectAdmin", current.ProjectAdmin, desired.ProjectAdmin},
+	} {
+		diff.compareBool(field.name, field.current, field.desired)
+	}
+	if desired.Groups != nil {
+		var currentGroups []string
+		if current.Groups != nil {
+			currentGroups = *current.Groups
+		}
+		diff.compareSet("groups", currentGroups, *desired.Groups)
+	}
+	diff.setUpdateActionIfChanged()
+	return diff
+}
+
+// Creates the user if it doesn't exist, or updates the fields which differ from the desired user.
+// The password is set only when the user is created. If Groups is nil, the groups of the user are left as is.
+func (us *UserService) EnsureUser(desired User, options EnsureOptions) (*EnsureDiff, error) {
+	if desired.Name == "" {
+		return nil, errorutils.CheckErrorf("a user name is required")
+	}
+	params := NewUserParams()
+	params.UserDetails = desired
+	current, err := us.GetUser(params)
+	if err != nil {
+		return nil, err
+	}
+	diff := DiffUser(current, desired)
+	if options.DryRun {
+		return &diff, nil
+	}
+	switch diff.Action {
+	case EnsureCreate:
+		params.ReplaceIfExists = true
+		err = us.CreateUser(params)
+	case EnsureUpdate:
+		params.UserDetails.Password = ""
+		err = us.UpdateUser(params)
+	}
+	if err != nil {
+		return &diff, err
+	}
+	logEnsureDiff("User", diff)
+	return &diff, nil
+}
+
+// Compares the current group, or nil if it doesn't exist, with the desired one.
+// The members are compared only if the desired UsersNames isn't nil.
+func DiffGroup(current *Group, currentMembers []string, desired Group) EnsureDiff {
+	diff := EnsureDiff{Name: desired.Name, Action: EnsureUnchanged}
+	if current == nil {
+		diff.Action = EnsureCreate
+		return diff
+	}
+	diff.compareString("description", current.Description, desired.Description)
+	diff.compareBool("autoJoin", current.AutoJoin, desired.AutoJoin)
+	diff.compareBool("adminPrivileges", current.AdminPrivileges, desired.AdminPrivileges)
+	diff.compareString("realm", current.Realm, desired.Realm)
+	diff.compareString("realmAttributes", current.RealmAttributes, desired.RealmAttributes)
+	if desired.UsersNames != nil {
+		diff.compareSet("members", currentMembers, desired.UsersNames)
+	}
+	diff.setUpdateActionIfChanged()
+	return diff
+}
+
+// Creates the group if it doesn't exist, or updates the fields and members which differ from the desired group.
+// Members are added and removed individually. If UsersNames is nil, the members of the group are left as is.
+func (gs *GroupService) EnsureGroup(desired Group, options EnsureOptions) (*EnsureDiff, error) {
+	if desired.Name == "" {
+		return nil, errorutils.CheckErrorf("a group name is required")
+	}
+	params := NewGroupParams()
+	params.GroupDetails = desired
+	current, err := gs.GetGroup(params)
+	if err != nil {
+		return nil, err
+	}
+	var currentMembers []string
+	if current != nil && desired.UsersNames != nil {
+		if currentMembers, err = gs.getGroupMembers(desired.Name); err != nil {
+			return nil, err
+		}
+	}
+	diff := DiffGroup(current, currentMembers, desired)
+	if options.DryRun || !diff.IsChanged() {
+		return &diff, nil
+	}
+	if diff.Action == EnsureCreate {
+		params.ReplaceIfExists = true
+		if err = gs.CreateGroup(params); err != nil {
+			return &diff, err
+		}
+		logEnsureDiff("Group", diff)
+		return &diff, nil
+	}
+	if len(diff.Changes) > 1 || !diff.hasChange("members") {
+		params.GroupDetails.UsersNames = nil
+		if err = gs.UpdateGroup(params); err != nil {
+			return &diff, err
+		}
+	}
+	if diff.hasChange("members") {
+		if err = gs.reconcileGroupMembers(desired.Name, currentMembers, desired.UsersNames); err != nil {
+			return &diff, err
+		}
+	}
+	logEnsureDiff("Group", diff)
+	return &diff, nil
+}
+
+func (gs *GroupService) reconcileGroupMembers(groupName string, currentMembers, desiredMembers []string) error {
+	var toAdd, toRemove []string
+	for _, member := range desiredMembers {
+		if !slices.Contains(currentMembers, member) {
+			toAdd = append(toAdd, member)
+		}
+	}
+	for _, member := range currentMembers {
+		if !slices.Contains(desiredMembers, member) {
+			toRemove = append(toRemove, member)
+		}
+	}
+	if len(toAdd) > 0 {
+		if err := gs.AddGroupMembers(groupName, toAdd...); err != nil {
+			return err
+		}
+	}
+	if len(toRemove) > 0 {
+		return gs.RemoveGroupMembers(groupName, toRemove...)
+	}
+	return nil
+}
+
+// Creates the permission target if it doesn't exist, or replaces it if any of its sections differ from the desired
+// permission target. Unlike users and groups, all the sections are compared, as a permission target is replaced as a
+// whole. To reconcile several permission targets at once, and delete the ones which aren't desired, use Apply.
+func (pts *PermissionTargetService) EnsurePermissionTarget(desired PermissionTargetParams, options EnsureOptions) (*EnsureDiff, error) {
+	if err := desired.Validate(); err != nil {
+		return nil, err
+	}
+	current, err := pts.Get(desired.Name)
+	if err != nil {
+		return nil, err
+	}
+	targetDiff := DiffPermissionTarget(current, desired)
+	// The create, update and unchanged actions of permission targets have the same values as the Ensure actions.
+	diff := EnsureDiff{Name: desired.Name, Action: EnsureAction(targetDiff.Action)}
+	desiredSections := desired.sections()
+	for _, section := range targetDiff.Changes {
+		diff.Changes = append(diff.Changes, FieldChange{
+			Field:   section,
+			Current: normalizePermissionTargetSection(current.sections()[section]),
+			Desired: normalizePermissionTargetSection(desiredSections[section]),
+		})
+	}
+	if options.DryRun {
+		return &diff, nil
+	}
+	switch diff.Action {
+	case EnsureCreate:
+		err = pts.Create(desired)
+	case EnsureUpdate:
+		err = pts.Update(desired)
+	}
+	if err != nil {
+		return &diff, err
+	}
+	logEnsureDiff("Permission target", diff)
+	return &diff, nil
+}
+
+func logEnsureDiff(entityType string, diff EnsureDiff) {
+	if diff.IsChanged() {
+		log.Info(fmt.Sprintf("%s %s", entityType, diff.String()))
+	}
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffUser(t *testing.T) {
+	desired := User{Name: "my-user", Email: "my-user@jfrog.com", Admin: utils.Pointer(false), Groups: &[]string{"readers", "deployers"}}
+	assert.Equal(t, EnsureCreate, DiffUser(nil, desired).Action)
+
+	// Missing booleans are false, and the order of the groups is ignored
+	current := &User{Name: "my-user", Email: "my-user@jfrog.com", Groups: &[]string{"deployers", "readers"}, Realm: "internal"}
+	diff := DiffUser(current, desired)
+	assert.Equal(t, EnsureUnchanged, diff.Action)
+	assert.Empty(t, diff.Changes)
+
+	// Fields which aren't set in the desired user are ignored
+	current.ProfileUpdatable = utils.Pointer(true)
+	desired.Email = ""
+	assert.Equal(t, EnsureUnchanged, DiffUser(current, desired).Action)
+
+	desired.Admin = utils.Pointer(true)
+	desired.Groups = &[]string{"readers"}
+	diff = DiffUser(current, desired)
+	assert.Equal(t, EnsureUpdate, diff.Action)
+	assert.Equal(t, []FieldChange{
+		{Field: "admin", Current: false, Desired: true},
+		{Field: "groups", Current: []string{"deployers", "readers"}, Desired: []string{"readers"}},
+	}, diff.Changes)
+}
+
+func TestDiffGroup(t *testing.T) {
+	desired := Group{Name: "my-group", Description: "My group", UsersNames: []string{"b", "a"}}
+	assert.Equal(t, EnsureCreate, DiffGroup(nil, nil, desired).Action)
+
+	current := &Group{Name: "my-group", Description: "My group", AutoJoin: utils.Pointer(false)}
+	assert.Equal(t, EnsureUnchanged, DiffGroup(current, []string{"a", "b"}, desired).Action)
+
+	diff := DiffGroup(current, []string{"a", "c"}, desired)
+	assert.Equal(t, EnsureUpdate, diff.Action)
+	assert.Equal(t, []FieldChange{{Field: "members", Current: []string{"a", "c"}, Desired: []string{"a", "b"}}}, diff.Changes)
+
+	// The members are ignored if the desired members are nil, and an empty list removes all the members
+	desired.UsersNames = nil
+	assert.Equal(t, EnsureUnchanged, DiffGroup(current, []string{"a", "c"}, desired).Action)
+	desired.UsersNames = []string{}
+	assert.Equal(t, EnsureUpdate, DiffGroup(current, []string{"a", "c"}, desired).Action)
+}
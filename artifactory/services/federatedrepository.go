@@ -95,6 +95,10 @@ func (frs *FederatedRepositoryService) Helm(params HelmFederatedRepositoryParams
 	return frs.performRequest(params, params.Key)
 }
 
+func (frs *FederatedRepositoryService) HuggingFaceMl(params HuggingFaceMlFederatedRepositoryParams) error {
+	return frs.performRequest(params, params.Key)
+}
+
 func (frs *FederatedRepositoryService) Ivy(params IvyFederatedRepositoryParams) error {
 	return frs.performRequest(params, params.Key)
 }
@@ -308,6 +312,14 @@ func NewHelmFederatedRepositoryParams() HelmFederatedRepositoryParams {
 	return HelmFederatedRepositoryParams{FederatedRepositoryBaseParams: NewFederatedRepositoryPackageParams("helm")}
 }
 
+type HuggingFaceMlFederatedRepositoryParams struct {
+	FederatedRepositoryBaseParams
+}
+
+func NewHuggingFaceMlFederatedRepositoryParams() HuggingFaceMlFederatedRepositoryParams {
+	return HuggingFaceMlFederatedRepositoryParams{FederatedRepositoryBaseParams: NewFederatedRepositoryPackageParams("huggingfaceml")}
+}
+
 type IvyFederatedRepositoryParams struct {
 	FederatedRepositoryBaseParams
 	JavaPackageManagersRepositoryParams
@@ -1,8 +1,10 @@
 package services
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
@@ -73,3 +75,162 @@ func (fs *FederationService) TriggerFederatedFullSyncMirror(repoKey string, mirr
 	log.Info("Done triggering federated repository synchronisation.")
 	return nil
 }
+
+// Returns the members of a federated repository.
+func (fs *FederationService) GetMembers(repoKey string) ([]FederatedRepositoryMember, error) {
+	repoDetails := FederatedRepositoryBaseParams{}
+	if err := fs.getRepositoriesService().Get(repoKey, &repoDetails); err != nil {
+		return nil, err
+	}
+	if repoDetails.Rclass != FederatedRepositoryRepoType {
+		return nil, errorutils.CheckErrorf("repository '%s' is not a federated repository", repoKey)
+	}
+	return repoDetails.Members, nil
+}
+
+// Adds members to a federated repository. Members that already exist are replaced by the provided ones.
+func (fs *FederationService) AddMembers(repoKey string, members ...FederatedRepositoryMember) error {
+	currentMembers, err := fs.GetMembers(repoKey)
+	if err != nil {
+		return err
+	}
+	log.Info("Adding members to federated repository '" + repoKey + "'...")
+	if err = fs.updateMembers(repoKey, AddFederatedMembers(currentMembers, members...)); err != nil {
+		return err
+	}
+	log.Info("Done adding members.")
+	return nil
+}
+
+// Removes members from a federated repository by their URLs.
+func (fs *FederationService) RemoveMembers(repoKey string, memberUrls ...string) error {
+	currentMembers, err := fs.GetMembers(repoKey)
+	if err != nil {
+		return err
+	}
+	remainingMembers := RemoveFederatedMembers(currentMembers, memberUrls...)
+	if len(remainingMembers) == len(currentMembers) {
+		log.Info("None of the provided members belong to federated repository '" + repoKey + "'.")
+		return nil
+	}
+	log.Info("Removing members from federated repository '" + repoKey + "'...")
+	if err = fs.updateMembers(repoKey, remainingMembers); err != nil {
+		return err
+	}
+	log.Info("Done removing members.")
+	return nil
+}
+
+func (fs *FederationService) updateMembers(repoKey string, members []FederatedRepositoryMember) error {
+	// The members list is sent even when it is empty, so that the last member can be removed.
+	if members == nil {
+		members = []FederatedRepositoryMember{}
+	}
+	return fs.getRepositoriesService().Update(federatedMembersUpdate{Rclass: FederatedRepositoryRepoType, Members: members}, repoKey)
+}
+
+func (fs *FederationService) getRepositoriesService() *RepositoriesService {
+	repositoriesService := NewRepositoriesService(fs.client)
+	repositoriesService.ArtDetails = fs.ArtDetails
+	return repositoriesService
+}
+
+// Returns the synchronization status of a federated repository, including the status of each of its mirrors.
+func (fs *FederationService) GetFederationStatus(repoKey string) (*FederatedRepositoryStatus, error) {
+	httpClientsDetails := fs.ArtDetails.CreateHttpClientDetails()
+	var url = fs.ArtDetails.GetUrl() + "api/federation/status/repo/" + url.PathEscape(repoKey)
+	resp, body, _, err := fs.client.SendGet(url, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	status := &FederatedRepositoryStatus{}
+	return status, errorutils.CheckError(json.Unmarshal(body, status))
+}
+
+// Returns the given members with the new members added. A new member replaces an existing member with the same URL.
+func AddFederatedMembers(members []FederatedRepositoryMember, newMembers ...FederatedRepositoryMember) []FederatedRepositoryMember {
+	result := append([]FederatedRepositoryMember{}, members...)
+	for _, newMember := range newMembers {
+		if index := findFederatedMember(result, newMember.Url); index >= 0 {
+			result[index] = newMember
+		} else {
+			result = append(result, newMember)
+		}
+	}
+	return result
+}
+
+// Returns the given members without the members with the provided URLs.
+func RemoveFederatedMembers(members []FederatedRepositoryMember, memberUrls ...string) []FederatedRepositoryMember {
+	var result []FederatedRepositoryMember
+	for _, member := range members {
+		removed := false
+		for _, memberUrl := range memberUrls {
+			if isSameFederatedMemberUrl(member.Url, memberUrl) {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			result = append(result, member)
+		}
+	}
+	return result
+}
+
+func findFederatedMember(members []FederatedRepositoryMember, memberUrl string) int {
+	for i, member := range members {
+		if isSameFederatedMemberUrl(member.Url, memberUrl) {
+			return i
+		}
+	}
+	return -1
+}
+
+func isSameFederatedMemberUrl(first, second string) bool {
+	return strings.TrimSuffix(first, "/") == strings.TrimSuffix(second, "/")
+}
+
+type federatedMembersUpdate struct {
+	Rclass  string                      `json:"rclass"`
+	Members []FederatedRepositoryMember `json:"members"`
+}
+
+type FederatedRepositoryStatus struct {
+	LocalKey          string                                `json:"localKey,omitempty"`
+	BinariesTasksInfo FederatedBinariesTasksInfo            `json:"binariesTasksInfo,omitempty"`
+	MirrorsStatus     []FederatedMirrorStatus               `json:"mirrorEventsStatusInfo,omitempty"`
+	FileListStatus    []FederatedFileListSubscriptionStatus `json:"fileListSubscriptionsInfo,omitempty"`
+}
+
+type FederatedBinariesTasksInfo struct {
+	InProgressTasks int64 `json:"inProgressTasks,omitempty"`
+	FailingTasks    int64 `json:"failingTasks,omitempty"`
+}
+
+// The synchronization status of a single member (mirror) of a federated repository.
+type FederatedMirrorStatus struct {
+	RemoteUrl     string `json:"remoteUrl,omitempty"`
+	RemoteRepoKey string `json:"remoteRepoKey,omitempty"`
+	// For example: HEALTHY, DISCONNECTED or FAILING.
+	Status       string `json:"status,omitempty"`
+	CreateEvents int64  `json:"createEvents,omitempty"`
+	UpdateEvents int64  `json:"updateEvents,omitempty"`
+	DeleteEvents int64  `json:"deleteEvents,omitempty"`
+	PropsEvents  int64  `json:"propsEvents,omitempty"`
+	ErrorEvents  int64  `json:"errorEvents,omitempty"`
+	// How far behind the mirror is, in milliseconds.
+	LagInMS int64 `json:"lagInMS,omitempty"`
+}
+
+type FederatedFileListSubscriptionStatus struct {
+	RemoteUrl          string `json:"remoteUrl,omitempty"`
+	RemoteRepoKey      string `json:"remoteRepoKey,omitempty"`
+	Status             string `json:"status,omitempty"`
+	LastFullSyncStatus string `json:"lastFullSyncStatus,omitempty"`
+	LastFullSyncTime   int64  `json:"lastFullSyncTime,omitempty"`
+}
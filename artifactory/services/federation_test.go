@@ -0,0 +1,29 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddFederatedMembers(t *testing.T) {
+	disabled := false
+	members := []FederatedRepositoryMember{{Url: "https://a.jfrog.io/artifactory/repo"}, {Url: "https://b.jfrog.io/artifactory/repo"}}
+	result := AddFederatedMembers(members,
+		FederatedRepositoryMember{Url: "https://b.jfrog.io/artifactory/repo/", Enabled: &disabled},
+		FederatedRepositoryMember{Url: "https://c.jfrog.io/artifactory/repo"})
+	assert.Equal(t, []FederatedRepositoryMember{
+		{Url: "https://a.jfrog.io/artifactory/repo"},
+		{Url: "https://b.jfrog.io/artifactory/repo/", Enabled: &disabled},
+		{Url: "https://c.jfrog.io/artifactory/repo"},
+	}, result)
+	// The original members must not be modified.
+	assert.Nil(t, members[1].Enabled)
+}
+
+func TestRemoveFederatedMembers(t *testing.T) {
+	members := []FederatedRepositoryMember{{Url: "https://a.jfrog.io/artifactory/repo"}, {Url: "https://b.jfrog.io/artifactory/repo"}}
+	assert.Equal(t, []FederatedRepositoryMember{{Url: "https://a.jfrog.io/artifactory/repo"}},
+		RemoveFederatedMembers(members, "https://b.jfrog.io/artifactory/repo/", "https://unknown.jfrog.io/artifactory/repo"))
+	assert.Empty(t, RemoveFederatedMembers(members, "https://a.jfrog.io/artifactory/repo", "https://b.jfrog.io/artifactory/repo"))
+}
@@ -0,0 +1,256 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/httpclient"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	gitLfsApi       = "api/lfs/"
+	gitLfsMediaType = "application/vnd.git-lfs+json"
+	// The only transfer adapter used, in which objects are downloaded and uploaded by a single request.
+	gitLfsBasicTransfer = "basic"
+)
+
+type GitLfsOperation string
+
+const (
+	GitLfsDownload GitLfsOperation = "download"
+	GitLfsUpload   GitLfsOperation = "upload"
+)
+
+// The names of the actions returned for an object by the batch API.
+const (
+	GitLfsDownloadAction = "download"
+	GitLfsUploadAction   = "upload"
+	GitLfsVerifyAction   = "verify"
+)
+
+// Downloads and uploads Git LFS objects of Git LFS repositories, using the Git LFS batch API.
+type GitLfsService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+	DryRun     bool
+}
+
+func NewGitLfsService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *GitLfsService {
+	return &GitLfsService{artDetails: &artDetails, client: client}
+}
+
+func (gls *GitLfsService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *gls.artDetails
+}
+
+func (gls *GitLfsService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return gls.client
+}
+
+func (gls *GitLfsService) IsDryRun() bool {
+	return gls.DryRun
+}
+
+// An LFS object, identified by the SHA-256 of its content.
+type GitLfsObject struct {
+	Oid  string `json:"oid"`
+	Size int64  `json:"size"`
+}
+
+type GitLfsBatchRequest struct {
+	Operation GitLfsOperation `json:"operation"`
+	Transfers []string        `json:"transfers,omitempty"`
+	Objects   []GitLfsObject  `json:"objects"`
+}
+
+type GitLfsBatchResponse struct {
+	Transfer string              `json:"transfer,omitempty"`
+	Objects  []GitLfsBatchObject `json:"objects"`
+}
+
+type GitLfsBatchObject struct {
+	GitLfsObject
+	Authenticated bool `json:"authenticated,omitempty"`
+	// The actions to run on the object, by name. Missing when there's nothing to do, e.g. when uploading an object
+	// that already exists.
+	Actions map[string]GitLfsAction `json:"actions,omitempty"`
+	Error   *GitLfsObjectError      `json:"error,omitempty"`
+}
+
+type GitLfsAction struct {
+	Href      string            `json:"href"`
+	Header    map[string]string `json:"header,omitempty"`
+	ExpiresIn int               `json:"expires_in,omitempty"`
+	ExpiresAt string            `json:"expires_at,omitempty"`
+}
+
+type GitLfsObjectError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Requests the actions needed to download or upload the objects.
+func (gls *GitLfsService) Batch(repoKey string, operation GitLfsOperation, objects ...GitLfsObject) (*GitLfsBatchResponse, error) {
+	if repoKey == "" || len(objects) == 0 {
+		return nil, errorutils.CheckErrorf("a repository key and at least one object are required")
+	}
+	content, err := json.Marshal(GitLfsBatchRequest{Operation: operation, Transfers: []string{gitLfsBasicTransfer}, Objects: objects})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	requestUrl, err := clientutils.BuildUrl(gls.GetArtifactoryDetails().GetUrl(), gitLfsApi+repoKey+"/objects/batch", nil)
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := gls.GetArtifactoryDetails().CreateHttpClientDetails()
+	utils.SetContentType(gitLfsMediaType, &httpClientsDetails.Headers)
+	utils.AddHeader("Accept", gitLfsMediaType, &httpClientsDetails.Headers)
+	resp, body, err := gls.client.SendPost(requestUrl, content, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	batchResponse := &GitLfsBatchResponse{}
+	return batchResponse, errorutils.CheckError(json.Unmarshal(body, batchResponse))
+}
+
+// Downloads an object to the local path. The content is verified against the object's ID.
+func (gls *GitLfsService) DownloadObject(repoKey string, object GitLfsObject, localPath string) error {
+	batchObject, err := gls.batchObject(repoKey, GitLfsDownload, object)
+	if err != nil {
+		return err
+	}
+	action, exists := batchObject.Actions[GitLfsDownloadAction]
+	if !exists {
+		return errorutils.CheckErrorf("Git LFS object %s wasn't returned with a download action", object.Oid)
+	}
+	downloadFileDetails := &httpclient.DownloadFileDetails{
+		DownloadPath:   action.Href,
+		LocalPath:      filepath.Dir(localPath),
+		LocalFileName:  filepath.Base(localPath),
+		ExpectedSha256: object.Oid,
+		Size:           object.Size,
+	}
+	log.Info("Downloading Git LFS object " + object.Oid + " to '" + localPath + "'...")
+	httpClientsDetails := gls.createActionHttpClientDetails(&action)
+	resp, err := gls.client.DownloadFile(downloadFileDetails, "", &httpClientsDetails, false, false)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatus(resp, http.StatusOK); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+// Uploads the local file as an object, unless it already exists. Returns the uploaded object.
+func (gls *GitLfsService) UploadObject(repoKey, localPath string) (*GitLfsObject, error) {
+	fileDetails, err := fileutils.GetFileDetails(localPath, true)
+	if err != nil {
+		return nil, err
+	}
+	object := GitLfsObject{Oid: fileDetails.Checksum.Sha256, Size: fileDetails.Size}
+	if gls.DryRun {
+		log.Info("[Dry run] Uploading " + localPath + " as Git LFS object " + object.Oid)
+		return &object, nil
+	}
+	batchObject, err := gls.batchObject(repoKey, GitLfsUpload, object)
+	if err != nil {
+		return nil, err
+	}
+	uploadAction, exists := batchObject.Actions[GitLfsUploadAction]
+	if !exists {
+		log.Info("Git LFS object " + object.Oid + " already exists.")
+		return &object, nil
+	}
+	log.Info("Uploading " + localPath + " as Git LFS object " + object.Oid + "...")
+	httpClientsDetails := gls.createActionHttpClientDetails(&uploadAction)
+	resp, body, err := gls.client.UploadFile(localPath, uploadAction.Href, "", &httpClientsDetails, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	if verifyAction, exists := batchObject.Actions[GitLfsVerifyAction]; exists {
+		if err = gls.verify(&verifyAction, object); err != nil {
+			return nil, err
+		}
+	}
+	return &object, nil
+}
+
+func (gls *GitLfsService) verify(action *GitLfsAction, object GitLfsObject) error {
+	content, err := json.Marshal(object)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpClientsDetails := gls.createActionHttpClientDetails(action)
+	utils.SetContentType(gitLfsMediaType, &httpClientsDetails.Headers)
+	resp, body, err := gls.client.SendPost(action.Href, content, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+}
+
+// Runs a batch request of a single object, and returns the object's response.
+func (gls *GitLfsService) batchObject(repoKey string, operation GitLfsOperation, object GitLfsObject) (*GitLfsBatchObject, error) {
+	batchResponse, err := gls.Batch(repoKey, operation, object)
+	if err != nil {
+		return nil, err
+	}
+	for _, batchObject := range batchResponse.Objects {
+		if batchObject.Oid != object.Oid {
+			continue
+		}
+		if batchObject.Error != nil {
+			return nil, errorutils.CheckErrorf("Git LFS object %s: %s (%d)", object.Oid, batchObject.Error.Message, batchObject.Error.Code)
+		}
+		return &batchObject, nil
+	}
+	return nil, errorutils.CheckErrorf("Git LFS object %s is missing from the batch response", object.Oid)
+}
+
+// The actions are sent with their own headers. Artifactory's credentials are added only if the action points at
+// Artifactory, since the actions may point at other hosts, such as a cloud storage, and the credentials must not leak
+// to them. An action which provides its own authorization is sent with it instead.
+func (gls *GitLfsService) createActionHttpClientDetails(action *GitLfsAction) httputils.HttpClientDetails {
+	httpClientsDetails := httputils.HttpClientDetails{Headers: make(map[string]string)}
+	if _, hasAuthorization := action.Header["Authorization"]; !hasAuthorization && isSameOrigin(action.Href, gls.GetArtifactoryDetails().GetUrl()) {
+		httpClientsDetails = gls.GetArtifactoryDetails().CreateHttpClientDetails()
+	}
+	for name, value := range action.Header {
+		utils.AddHeader(name, value, &httpClientsDetails.Headers)
+	}
+	return httpClientsDetails
+}
+
+// Returns true if both URLs have the same scheme and host, including the port.
+func isSameOrigin(firstUrl, secondUrl string) bool {
+	first, err := url.Parse(firstUrl)
+	if err != nil {
+		return false
+	}
+	second, err := url.Parse(secondUrl)
+	if err != nil {
+		return false
+	}
+	return first.Host != "" && strings.EqualFold(first.Scheme, second.Scheme) && strings.EqualFold(first.Host, second.Host)
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitLfsService(t *testing.T) {
+	content := []byte("large file")
+	sum := sha256.Sum256(content)
+	object := GitLfsObject{Oid: hex.EncodeToString(sum[:]), Size: int64(len(content))}
+	var serverUrl string
+	stored := make(map[string][]byte)
+	verified := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/lfs/lfs-local/objects/batch":
+			assert.Equal(t, gitLfsMediaType, r.Header.Get("Content-Type"))
+			request := &GitLfsBatchRequest{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(request))
+			href := serverUrl + "objects/" + request.Objects[0].Oid
+			actions := map[string]GitLfsAction{}
+			switch {
+			case request.Operation == GitLfsDownload:
+				actions[GitLfsDownloadAction] = GitLfsAction{Href: href, Header: map[string]string{"X-Test": "download"}}
+			case stored[request.Objects[0].Oid] == nil:
+				actions[GitLfsUploadAction] = GitLfsAction{Href: href}
+				actions[GitLfsVerifyAction] = GitLfsAction{Href: serverUrl + "verify"}
+			}
+			response := GitLfsBatchResponse{Transfer: "basic", Objects: []GitLfsBatchObject{{GitLfsObject: request.Objects[0], Actions: actions}}}
+			assert.NoError(t, json.NewEncoder(w).Encode(response))
+		case "/objects/" + object.Oid:
+			if r.Method == http.MethodPut {
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				stored[object.Oid] = body
+				return
+			}
+			assert.Equal(t, "download", r.Header.Get("X-Test"))
+			_, _ = w.Write(stored[object.Oid])
+		case "/verify":
+			verified = true
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	serverUrl = server.URL + "/"
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	assert.NoError(t, err)
+	var serviceDetails auth.ServiceDetails = &testServiceDetails{}
+	serviceDetails.SetUrl(serverUrl)
+	gitLfsService := NewGitLfsService(serviceDetails, client)
+
+	tempDir := t.TempDir()
+	localPath := filepath.Join(tempDir, "large.bin")
+	assert.NoError(t, os.WriteFile(localPath, content, 0600))
+	uploaded, err := gitLfsService.UploadObject("lfs-local", localPath)
+	assert.NoError(t, err)
+	assert.Equal(t, object, *uploaded)
+	assert.Equal(t, content, stored[object.Oid])
+	assert.True(t, verified)
+
+	// An existing object isn't uploaded again.
+	verified = false
+	_, err = gitLfsService.UploadObject("lfs-local", localPath)
+	assert.NoError(t, err)
+	assert.False(t, verified)
+
+	downloadPath := filepath.Join(tempDir, "downloaded.bin")
+	assert.NoError(t, gitLfsService.DownloadObject("lfs-local", object, downloadPath))
+	downloaded, err := os.ReadFile(downloadPath)
+	assert.NoError(t, err)
+	assert.Equal(t, content, downloaded)
+
+	// The downloaded content must match the object ID.
+	stored[object.Oid] = []byte("corrupted")
+	assert.Error(t, gitLfsService.DownloadObject("lfs-local", object, downloadPath))
+}
+
+func TestGitLfsActionCredentials(t *testing.T) {
+	var serviceDetails auth.ServiceDetails = &testServiceDetails{}
+	serviceDetails.SetUrl("https://acme.jfrog.io/artifactory/")
+	serviceDetails.SetAccessToken("token")
+	gitLfsService := NewGitLfsService(serviceDetails, nil)
+
+	details := gitLfsService.createActionHttpClientDetails(&GitLfsAction{Href: "https://acme.jfrog.io/artifactory/api/lfs/lfs-local/objects/1", Header: map[string]string{"X-Test": "1"}})
+	assert.Equal(t, "token", details.AccessToken)
+	assert.Equal(t, "1", details.Headers["X-Test"])
+
+	// Actions pointing at other hosts, or at Artifactory over another scheme, aren't sent with Artifactory's credentials.
+	for _, href := range []string{"https://storage.example.com/objects/1", "http://acme.jfrog.io/artifactory/objects/1", "https://acme.jfrog.io:8443/objects/1", "/objects/1"} {
+		details = gitLfsService.createActionHttpClientDetails(&GitLfsAction{Href: href, Header: map[string]string{"X-Test": "1"}})
+		assert.Empty(t, details.AccessToken, href)
+		assert.Equal(t, map[string]string{"X-Test": "1"}, details.Headers, href)
+	}
+
+	// An action with its own authorization is sent with it.
+	details = gitLfsService.createActionHttpClientDetails(&GitLfsAction{Href: "https://acme.jfrog.io/artifactory/objects/1", Header: map[string]string{"Authorization": "Basic abc"}})
+	assert.Empty(t, details.AccessToken)
+	assert.Equal(t, "Basic abc", details.Headers["Authorization"])
+}
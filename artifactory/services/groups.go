@@ -5,11 +5,22 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
+	"github.com/jfrog/gofrog/version"
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The minimal Artifactory version in which group members are managed by the Access groups API.
+	AccessGroupsApiMinArtifactoryVersion = "7.49.3"
+	accessGroupsApi                      = "api/v2/groups"
 )
 
 type GroupParams struct {
@@ -165,3 +176,192 @@ func (gs *GroupService) DeleteGroup(name string) error {
 	}
 	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
 }
+
+type ListGroupMembersParams struct {
+	GroupName string
+	// The maximum number of members in a page. If 0, all the members are returned.
+	Limit int
+	// The cursor returned with the previous page. Empty for the first page.
+	Cursor string
+}
+
+func NewListGroupMembersParams(groupName string) ListGroupMembersParams {
+	return ListGroupMembersParams{GroupName: groupName}
+}
+
+type GroupMembersPage struct {
+	Members []string
+	// The cursor of the next page, or empty if this is the last page.
+	NextCursor string
+}
+
+// The group as represented by the Access groups API.
+type accessGroup struct {
+	Name    string   `json:"name,omitempty"`
+	Members []string `json:"members,omitempty"`
+}
+
+type accessGroupMembersUpdate struct {
+	Add    []string `json:"add,omitempty"`
+	Remove []string `json:"remove,omitempty"`
+}
+
+// Lists the members of a group. Neither of the groups APIs supports pagination, so the members are paginated on the
+// client side, and the cursor is the offset of the page.
+func (gs *GroupService) ListGroupMembers(params ListGroupMembersParams) (*GroupMembersPage, error) {
+	members, err := gs.getGroupMembers(params.GroupName)
+	if err != nil {
+		return nil, err
+	}
+	page, nextCursor, err := clientutils.PaginateByOffset(members, params.Limit, params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &GroupMembersPage{Members: page, NextCursor: nextCursor}, nil
+}
+
+func (gs *GroupService) AddGroupMembers(groupName string, usernames ...string) error {
+	return gs.updateGroupMembers(groupName, accessGroupMembersUpdate{Add: usernames})
+}
+
+func (gs *GroupService) RemoveGroupMembers(groupName string, usernames ...string) error {
+	return gs.updateGroupMembers(groupName, accessGroupMembersUpdate{Remove: usernames})
+}
+
+func (gs *GroupService) getGroupMembers(groupName string) ([]string, error) {
+	accessUrl, err := gs.getAccessUrl()
+	if err != nil {
+		return nil, err
+	}
+	if accessUrl == "" {
+		params := NewGroupParams()
+		params.GroupDetails.Name = groupName
+		params.IncludeUsers = true
+		group, err := gs.GetGroup(params)
+		if err != nil {
+			return nil, err
+		}
+		if group == nil {
+			return nil, errorutils.CheckErrorf("group '%s' does not exist", groupName)
+		}
+		return group.UsersNames, nil
+	}
+	httpDetails := gs.ArtDetails.CreateHttpClientDetails()
+	url := fmt.Sprintf("%s%s/%s", accessUrl, accessGroupsApi, groupName)
+	resp, body, _, err := gs.client.SendGet(url, true, &httpDetails)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errorutils.CheckErrorf("group '%s' does not exist", groupName)
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	var group accessGroup
+	if err = json.Unmarshal(body, &group); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return group.Members, nil
+}
+
+func (gs *GroupService) updateGroupMembers(groupName string, update accessGroupMembersUpdate) error {
+	accessUrl, err := gs.getAccessUrl()
+	if err != nil {
+		return err
+	}
+	if accessUrl == "" {
+		return gs.updateGroupMembersUsingSecurityApi(groupName, update)
+	}
+	content, err := json.Marshal(update)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpDetails := gs.ArtDetails.CreateHttpClientDetails()
+	httpDetails.SetContentTypeApplicationJson()
+	url := fmt.Sprintf("%s%s/%s/members", accessUrl, accessGroupsApi, groupName)
+	resp, body, err := gs.client.SendPatch(url, content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent)
+}
+
+// Returns the URL of the Access service if its groups API can be used, or an empty string if the security API should
+// be used instead. The Access URL is derived from the Artifactory URL, so the Access groups API is used only when
+// Artifactory is served under the JFrog Platform URL.
+func (gs *GroupService) getAccessUrl() (string, error) {
+	accessUrl := GetAccessUrlFromArtifactoryUrl(gs.ArtDetails.GetUrl())
+	if accessUrl == "" {
+		return "", nil
+	}
+	artifactoryVersion, err := gs.ArtDetails.GetVersion()
+	if err != nil {
+		return "", err
+	}
+	if !version.NewVersion(artifactoryVersion).AtLeast(AccessGroupsApiMinArtifactoryVersion) {
+		log.Debug(fmt.Sprintf("Artifactory version %s is older than %s. Using the security API to manage group members.", artifactoryVersion, AccessGroupsApiMinArtifactoryVersion))
+		return "", nil
+	}
+	return accessUrl, nil
+}
+
+// Returns the Access URL of a JFrog Platform, given its Artifactory URL, e.g. https://acme.jfrog.io/artifactory/ -> https://acme.jfrog.io/access/.
+// Returns an empty string if the URL doesn't end with "/artifactory/".
+func GetAccessUrlFromArtifactoryUrl(artifactoryUrl string) string {
+	artifactoryUrl = strings.TrimSuffix(artifactoryUrl, "/")
+	if !strings.HasSuffix(artifactoryUrl, "/artifactory") {
+		return ""
+	}
+	return strings.TrimSuffix(artifactoryUrl, "artifactory") + "access/"
+}
+
+// The security API doesn't allow removing a group's last member through the group, so the groups of each user are
+// updated instead.
+func (gs *GroupService) updateGroupMembersUsingSecurityApi(groupName string, update accessGroupMembersUpdate) error {
+	userService := NewUserService(gs.client)
+	userService.ArtDetails = gs.ArtDetails
+	for _, username := range append(append([]string{}, update.Add...), update.Remove...) {
+		user, err := userService.GetUserDetails(username)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return errorutils.CheckErrorf("user '%s' does not exist", username)
+		}
+		var groups []string
+		if user.Groups != nil {
+			groups = *user.Groups
+		}
+		isAdded := slices.Contains(update.Add, username)
+		updatedGroups := UpdateUserGroups(groups, groupName, isAdded)
+		if slices.Equal(groups, updatedGroups) {
+			continue
+		}
+		// Only the groups are sent, so that the other details of the user are kept.
+		params := NewUserParams()
+		params.UserDetails = User{Name: username, Groups: &updatedGroups}
+		params.ClearGroups = len(updatedGroups) == 0
+		if err = userService.UpdateUser(params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the user's groups after adding it to, or removing it from, the given group.
+func UpdateUserGroups(groups []string, groupName string, add bool) []string {
+	if add {
+		if slices.Contains(groups, groupName) {
+			return groups
+		}
+		return append(slices.Clone(groups), groupName)
+	}
+	result := []string{}
+	for _, group := range groups {
+		if group != groupName {
+			result = append(result, group)
+		}
+	}
+	return result
+}
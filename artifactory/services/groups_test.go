@@ -0,0 +1,22 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAccessUrlFromArtifactoryUrl(t *testing.T) {
+	assert.Equal(t, "https://acme.jfrog.io/access/", GetAccessUrlFromArtifactoryUrl("https://acme.jfrog.io/artifactory/"))
+	assert.Equal(t, "http://localhost:8082/access/", GetAccessUrlFromArtifactoryUrl("http://localhost:8082/artifactory"))
+	assert.Empty(t, GetAccessUrlFromArtifactoryUrl("http://localhost:8081/"))
+	assert.Empty(t, GetAccessUrlFromArtifactoryUrl("http://localhost:8081/my-artifactory/"))
+}
+
+func TestUpdateUserGroups(t *testing.T) {
+	assert.Equal(t, []string{"readers", "deployers"}, UpdateUserGroups([]string{"readers"}, "deployers", true))
+	assert.Equal(t, []string{"deployers", "readers"}, UpdateUserGroups([]string{"deployers", "readers"}, "deployers", true))
+	assert.Equal(t, []string{"readers"}, UpdateUserGroups([]string{"deployers", "readers"}, "deployers", false))
+	assert.Equal(t, []string{}, UpdateUserGroups([]string{"deployers"}, "deployers", false))
+	assert.Equal(t, []string{"deployers"}, UpdateUserGroups(nil, "deployers", true))
+}
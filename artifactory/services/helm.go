@@ -0,0 +1,211 @@
+package services
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/gofrog/version"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	helmIndexFile          = "index.yaml"
+	helmProvenanceSuffix   = ".prov"
+	helmChartArchiveSuffix = ".tgz"
+)
+
+// Pushes and resolves Helm charts. The index of Helm repositories can be recalculated using the PackageIndexService.
+type HelmService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+	DryRun     bool
+}
+
+func NewHelmService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *HelmService {
+	return &HelmService{artDetails: &artDetails, client: client}
+}
+
+func (hs *HelmService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *hs.artDetails
+}
+
+func (hs *HelmService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return hs.client
+}
+
+func (hs *HelmService) IsDryRun() bool {
+	return hs.DryRun
+}
+
+type HelmPushParams struct {
+	// The key of the local Helm repository.
+	RepoKey string
+	// The path of the chart archive, e.g. "charts/my-chart-1.0.0.tgz".
+	ChartPath string
+	// The path of the provenance file. Defaults to the chart path with a ".prov" suffix, if it exists.
+	ProvenancePath string
+	// The folder in the repository to push the chart to. Defaults to the repository root.
+	TargetPath string
+}
+
+func NewHelmPushParams(repoKey, chartPath string) HelmPushParams {
+	return HelmPushParams{RepoKey: repoKey, ChartPath: chartPath}
+}
+
+// The index of a Helm repository. The index of a virtual repository merges the indexes of its repositories.
+type HelmIndex struct {
+	ApiVersion string                        `yaml:"apiVersion,omitempty"`
+	Entries    map[string][]HelmChartVersion `yaml:"entries,omitempty"`
+	Generated  string                        `yaml:"generated,omitempty"`
+}
+
+type HelmChartVersion struct {
+	Name        string   `yaml:"name,omitempty"`
+	Version     string   `yaml:"version,omitempty"`
+	AppVersion  string   `yaml:"appVersion,omitempty"`
+	Description string   `yaml:"description,omitempty"`
+	Created     string   `yaml:"created,omitempty"`
+	Digest      string   `yaml:"digest,omitempty"`
+	Deprecated  bool     `yaml:"deprecated,omitempty"`
+	Urls        []string `yaml:"urls,omitempty"`
+}
+
+// A chart version resolved from the index of a repository.
+type ResolvedHelmChart struct {
+	HelmChartVersion
+	// The absolute URL to download the chart from.
+	DownloadUrl string
+}
+
+// Pushes a chart archive and its provenance file. Returns the paths of the pushed files in the repository.
+func (hs *HelmService) PushChart(params HelmPushParams) ([]string, error) {
+	if params.RepoKey == "" || params.ChartPath == "" {
+		return nil, errorutils.CheckErrorf("a repository and a chart path are required")
+	}
+	if !strings.HasSuffix(params.ChartPath, helmChartArchiveSuffix) {
+		return nil, errorutils.CheckErrorf("'%s' is not a chart archive", params.ChartPath)
+	}
+	files := []string{params.ChartPath}
+	provenancePath := params.ProvenancePath
+	if provenancePath == "" {
+		exists, err := fileutils.IsFileExists(params.ChartPath+helmProvenanceSuffix, false)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			provenancePath = params.ChartPath + helmProvenanceSuffix
+		}
+	}
+	if provenancePath != "" {
+		files = append(files, provenancePath)
+	}
+	var pushed []string
+	for _, localPath := range files {
+		targetPath := path.Join(params.RepoKey, params.TargetPath, filepath.Base(localPath))
+		if err := hs.uploadFile(localPath, targetPath); err != nil {
+			return pushed, err
+		}
+		pushed = append(pushed, targetPath)
+	}
+	return pushed, nil
+}
+
+func (hs *HelmService) uploadFile(localPath, targetPath string) error {
+	if hs.DryRun {
+		log.Info("[Dry run] Pushing " + localPath + " to " + targetPath)
+		return nil
+	}
+	log.Info("Pushing " + localPath + " to " + targetPath + "...")
+	targetUrl, err := clientutils.BuildUrl(hs.GetArtifactoryDetails().GetUrl(), targetPath, nil)
+	if err != nil {
+		return err
+	}
+	httpClientsDetails := hs.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, err := utils.UploadFile(localPath, targetUrl, "", hs.artDetails, nil, httpClientsDetails, hs.client, true, nil)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusCreated, http.StatusOK); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+// Returns the index of a Helm repository. For virtual repositories, the index merges the indexes of the repositories
+// they include.
+func (hs *HelmService) GetIndex(repoKey string) (*HelmIndex, error) {
+	requestUrl, err := clientutils.BuildUrl(hs.GetArtifactoryDetails().GetUrl(), path.Join(repoKey, helmIndexFile), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := hs.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := hs.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	index := &HelmIndex{}
+	return index, errorutils.CheckError(yaml.Unmarshal(body, index))
+}
+
+// Resolves a chart version from the index of a repository, typically a virtual repository.
+// If the version is empty, the latest version which isn't a pre-release is resolved, as Helm does.
+// Returns nil if the chart or the version doesn't exist.
+func (hs *HelmService) ResolveChart(repoKey, chartName, chartVersion string) (*ResolvedHelmChart, error) {
+	index, err := hs.GetIndex(repoKey)
+	if err != nil {
+		return nil, err
+	}
+	chart := FindHelmChartVersion(index, chartName, chartVersion)
+	if chart == nil {
+		return nil, nil
+	}
+	if len(chart.Urls) == 0 {
+		return nil, errorutils.CheckErrorf("the index of '%s' doesn't include the URL of %s-%s", repoKey, chartName, chart.Version)
+	}
+	repoUrl, err := url.Parse(hs.GetArtifactoryDetails().GetUrl() + repoKey + "/")
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	// The URLs in the index are usually relative to the repository.
+	chartUrl, err := url.Parse(chart.Urls[0])
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return &ResolvedHelmChart{HelmChartVersion: *chart, DownloadUrl: repoUrl.ResolveReference(chartUrl).String()}, nil
+}
+
+// Returns the chart version from the index, or the latest version which isn't a pre-release if the version is empty.
+// Returns nil if there's no such version.
+func FindHelmChartVersion(index *HelmIndex, chartName, chartVersion string) *HelmChartVersion {
+	var latest *HelmChartVersion
+	for i, chart := range index.Entries[chartName] {
+		if chartVersion != "" {
+			if chart.Version == chartVersion {
+				return &index.Entries[chartName][i]
+			}
+			continue
+		}
+		if strings.Contains(chart.Version, "-") {
+			continue
+		}
+		if latest == nil || version.NewVersion(chart.Version).Compare(latest.Version) < 0 {
+			latest = &index.Entries[chartName][i]
+		}
+	}
+	return latest
+}
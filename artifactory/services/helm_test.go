@@ -0,0 +1,80 @@
+package services
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testHelmIndex = `apiVersion: v1
+entries:
+  my-chart:
+  - name: my-chart
+    version: 1.10.0
+    urls:
+    - my-chart-1.10.0.tgz
+  - name: my-chart
+    version: 2.0.0-rc.1
+    urls:
+    - my-chart-2.0.0-rc.1.tgz
+  - name: my-chart
+    version: 1.9.0
+    urls:
+    - https://charts.example.com/my-chart-1.9.0.tgz
+`
+
+func createHelmTestService(t *testing.T, handler http.HandlerFunc) *HelmService {
+	return NewHelmService(newTestServer(t, handler))
+}
+
+func TestHelmResolveChart(t *testing.T) {
+	helmService := createHelmTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/helm-virtual/index.yaml", r.URL.Path)
+		_, _ = w.Write([]byte(testHelmIndex))
+	})
+
+	chart, err := helmService.ResolveChart("helm-virtual", "my-chart", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.10.0", chart.Version)
+	assert.Equal(t, helmService.GetArtifactoryDetails().GetUrl()+"helm-virtual/my-chart-1.10.0.tgz", chart.DownloadUrl)
+
+	chart, err = helmService.ResolveChart("helm-virtual", "my-chart", "1.9.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://charts.example.com/my-chart-1.9.0.tgz", chart.DownloadUrl)
+
+	chart, err = helmService.ResolveChart("helm-virtual", "other-chart", "")
+	assert.NoError(t, err)
+	assert.Nil(t, chart)
+}
+
+func TestHelmPushChart(t *testing.T) {
+	uploaded := make(map[string]string)
+	helmService := createHelmTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		content, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		uploaded[r.URL.Path] = string(content)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	chartPath := filepath.Join(t.TempDir(), "my-chart-1.0.0.tgz")
+	assert.NoError(t, os.WriteFile(chartPath, []byte("chart"), 0600))
+	assert.NoError(t, os.WriteFile(chartPath+".prov", []byte("provenance"), 0600))
+
+	params := NewHelmPushParams("helm-local", chartPath)
+	params.TargetPath = "stable"
+	pushed, err := helmService.PushChart(params)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"helm-local/stable/my-chart-1.0.0.tgz", "helm-local/stable/my-chart-1.0.0.tgz.prov"}, pushed)
+	assert.Equal(t, map[string]string{
+		"/helm-local/stable/my-chart-1.0.0.tgz":      "chart",
+		"/helm-local/stable/my-chart-1.0.0.tgz.prov": "provenance",
+	}, uploaded)
+
+	_, err = helmService.PushChart(NewHelmPushParams("helm-local", "Chart.yaml"))
+	assert.ErrorContains(t, err, "is not a chart archive")
+}
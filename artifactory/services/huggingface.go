@@ -0,0 +1,254 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/httpclient"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	huggingFaceApi = "api/huggingfaceml/"
+	// The revision used when none is given, as in the Hugging Face Hub.
+	HuggingFaceDefaultRevision = "main"
+	// The model card, whose YAML front matter holds the model's metadata.
+	huggingFaceModelCardFile = "README.md"
+	// The prefix of the properties set on uploaded model files.
+	huggingFacePropertyPrefix = "huggingfaceml."
+)
+
+// Resolves and downloads models from Hugging Face repositories, using the Hugging Face Hub API exposed by Artifactory.
+type HuggingFaceService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+}
+
+func NewHuggingFaceService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *HuggingFaceService {
+	return &HuggingFaceService{artDetails: &artDetails, client: client}
+}
+
+func (hfs *HuggingFaceService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *hfs.artDetails
+}
+
+func (hfs *HuggingFaceService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return hfs.client
+}
+
+type HuggingFaceModelInfo struct {
+	// The model ID, e.g. "acme/sentiment".
+	Id string `json:"id,omitempty"`
+	// The commit of the resolved revision.
+	Sha          string                 `json:"sha,omitempty"`
+	PipelineTag  string                 `json:"pipeline_tag,omitempty"`
+	LibraryName  string                 `json:"library_name,omitempty"`
+	Tags         []string               `json:"tags,omitempty"`
+	Siblings     []HuggingFaceModelFile `json:"siblings,omitempty"`
+	LastModified string                 `json:"lastModified,omitempty"`
+}
+
+type HuggingFaceModelFile struct {
+	// The path of the file in the model.
+	Rfilename string `json:"rfilename"`
+}
+
+// The metadata of a model, as written in the front matter of its model card.
+type HuggingFaceModelCard struct {
+	License     string   `yaml:"license,omitempty"`
+	PipelineTag string   `yaml:"pipeline_tag,omitempty"`
+	LibraryName string   `yaml:"library_name,omitempty"`
+	BaseModel   string   `yaml:"base_model,omitempty"`
+	Language    []string `yaml:"language,omitempty"`
+	Tags        []string `yaml:"tags,omitempty"`
+	Datasets    []string `yaml:"datasets,omitempty"`
+}
+
+// Parses the YAML front matter of a model card. Returns an empty card if the model card has no front matter.
+func ParseHuggingFaceModelCard(content []byte) (*HuggingFaceModelCard, error) {
+	card := &HuggingFaceModelCard{}
+	content = bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+	frontMatter, found := bytes.CutPrefix(content, []byte("---\n"))
+	if !found {
+		return card, nil
+	}
+	end := bytes.Index(frontMatter, []byte("\n---"))
+	if end < 0 {
+		return nil, errorutils.CheckErrorf("the front matter of the model card isn't terminated")
+	}
+	return card, errorutils.CheckError(yaml.Unmarshal(frontMatter[:end], card))
+}
+
+// Returns the metadata of the card as properties. Multi-valued metadata, such as tags, is set as multiple values.
+func (card *HuggingFaceModelCard) ToProperties() *utils.Properties {
+	props := utils.NewProperties()
+	for key, value := range map[string]string{
+		"license":      card.License,
+		"pipeline_tag": card.PipelineTag,
+		"library_name": card.LibraryName,
+		"base_model":   card.BaseModel,
+	} {
+		if value != "" {
+			props.AddProperty(huggingFacePropertyPrefix+key, value)
+		}
+	}
+	for key, values := range map[string][]string{"language": card.Language, "tags": card.Tags, "datasets": card.Datasets} {
+		for _, value := range values {
+			props.AddProperty(huggingFacePropertyPrefix+key, value)
+		}
+	}
+	return props
+}
+
+type HuggingFaceModelUploadParams struct {
+	RepoKey string
+	// The model ID, e.g. "acme/sentiment".
+	ModelId string
+	// Defaults to "main".
+	Revision string
+	// The local directory of the model. Its model card, if exists, is parsed for the model's metadata.
+	LocalDir string
+	// Additional properties to set on the model files, e.g. "key1=value1;key2=value2".
+	Props string
+}
+
+func NewHuggingFaceModelUploadParams(repoKey, modelId, localDir string) HuggingFaceModelUploadParams {
+	return HuggingFaceModelUploadParams{RepoKey: repoKey, ModelId: modelId, Revision: HuggingFaceDefaultRevision, LocalDir: localDir}
+}
+
+// Returns the path of the model revision, e.g. "hf-local/models/acme/sentiment/main".
+func (params HuggingFaceModelUploadParams) GetTargetPath() string {
+	return path.Join(params.RepoKey, "models", params.ModelId, defaultRevision(params.Revision))
+}
+
+// Creates the params to upload all the files of the model directory with the upload service. Large files, such as
+// weights, are uploaded in parts when multipart upload is supported. The files are set with the model ID and
+// revision, and with the metadata of the model card, as properties.
+func CreateHuggingFaceModelUploadParams(params HuggingFaceModelUploadParams) (UploadParams, error) {
+	if params.RepoKey == "" || params.ModelId == "" || params.LocalDir == "" {
+		return UploadParams{}, errorutils.CheckErrorf("a repository key, a model ID and a local directory are required")
+	}
+	props := utils.NewProperties()
+	cardContent, err := os.ReadFile(filepath.Join(params.LocalDir, huggingFaceModelCardFile))
+	switch {
+	case err == nil:
+		card, err := ParseHuggingFaceModelCard(cardContent)
+		if err != nil {
+			return UploadParams{}, err
+		}
+		props = card.ToProperties()
+	case !os.IsNotExist(err):
+		return UploadParams{}, errorutils.CheckError(err)
+	}
+	targetPath := params.GetTargetPath()
+	props.AddProperty(huggingFacePropertyPrefix+"model_id", params.ModelId)
+	props.AddProperty(huggingFacePropertyPrefix+"revision", path.Base(targetPath))
+	if params.Props != "" {
+		if err = props.ParseAndAddProperties(params.Props); err != nil {
+			return UploadParams{}, err
+		}
+	}
+	uploadParams := NewUploadParams()
+	uploadParams.Pattern = strings.TrimSuffix(filepath.ToSlash(params.LocalDir), "/") + "/(*)"
+	uploadParams.Target = targetPath + "/{1}"
+	uploadParams.Recursive = true
+	uploadParams.TargetProps = props
+	return uploadParams, nil
+}
+
+// Returns the information of a model revision, including its files.
+func (hfs *HuggingFaceService) GetModelInfo(repoKey, modelId, revision string) (*HuggingFaceModelInfo, error) {
+	if modelId == "" {
+		return nil, errorutils.CheckErrorf("a model ID is required")
+	}
+	requestUrl, err := hfs.buildUrl(repoKey, path.Join("api/models", modelId, "revision", defaultRevision(revision)))
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := hfs.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := hfs.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	modelInfo := &HuggingFaceModelInfo{}
+	return modelInfo, errorutils.CheckError(json.Unmarshal(body, modelInfo))
+}
+
+// Downloads a single file of a model revision to the local path.
+func (hfs *HuggingFaceService) DownloadModelFile(repoKey, modelId, revision, fileName, localPath string) error {
+	if modelId == "" || fileName == "" || localPath == "" {
+		return errorutils.CheckErrorf("a model ID, a file name and a local path are required")
+	}
+	requestUrl, err := hfs.buildUrl(repoKey, path.Join(modelId, "resolve", defaultRevision(revision), fileName))
+	if err != nil {
+		return err
+	}
+	httpClientsDetails := hfs.GetArtifactoryDetails().CreateHttpClientDetails()
+	downloadFileDetails := &httpclient.DownloadFileDetails{
+		DownloadPath:  requestUrl,
+		LocalPath:     filepath.Dir(localPath),
+		LocalFileName: filepath.Base(localPath),
+		SkipChecksum:  true,
+	}
+	log.Info("Downloading " + modelId + "/" + fileName + " to '" + localPath + "'...")
+	resp, err := hfs.client.DownloadFile(downloadFileDetails, "", &httpClientsDetails, false, false)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatus(resp, http.StatusOK); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+// Downloads all the files of a model revision into the local directory, keeping their paths in the model.
+// Returns the information of the downloaded revision.
+func (hfs *HuggingFaceService) DownloadModel(repoKey, modelId, revision, localDir string) (*HuggingFaceModelInfo, error) {
+	modelInfo, err := hfs.GetModelInfo(repoKey, modelId, revision)
+	if err != nil {
+		return nil, err
+	}
+	// Download the exact commit, in case the revision is a branch which moves during the download.
+	if modelInfo.Sha != "" {
+		revision = modelInfo.Sha
+	}
+	for _, file := range modelInfo.Siblings {
+		if !filepath.IsLocal(filepath.FromSlash(file.Rfilename)) {
+			return nil, errorutils.CheckErrorf("model file '%s' is outside the model directory", file.Rfilename)
+		}
+		if err = hfs.DownloadModelFile(repoKey, modelId, revision, file.Rfilename, filepath.Join(localDir, filepath.FromSlash(file.Rfilename))); err != nil {
+			return nil, err
+		}
+	}
+	return modelInfo, nil
+}
+
+func (hfs *HuggingFaceService) buildUrl(repoKey, restApi string) (string, error) {
+	if repoKey == "" {
+		return "", errorutils.CheckErrorf("a repository key is required")
+	}
+	return clientutils.BuildUrl(hfs.GetArtifactoryDetails().GetUrl(), huggingFaceApi+repoKey+"/"+restApi, nil)
+}
+
+func defaultRevision(revision string) string {
+	if revision == "" {
+		return HuggingFaceDefaultRevision
+	}
+	return revision
+}
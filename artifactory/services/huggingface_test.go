@@ -0,0 +1,82 @@
+package services
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testModelCard = "---\nlicense: apache-2.0\npipeline_tag: text-classification\ntags:\n- sentiment\n- english\n---\n# Sentiment\n"
+
+func TestParseHuggingFaceModelCard(t *testing.T) {
+	card, err := ParseHuggingFaceModelCard([]byte(testModelCard))
+	assert.NoError(t, err)
+	assert.Equal(t, &HuggingFaceModelCard{License: "apache-2.0", PipelineTag: "text-classification", Tags: []string{"sentiment", "english"}}, card)
+	assert.Equal(t, map[string][]string{
+		"huggingfaceml.license":      {"apache-2.0"},
+		"huggingfaceml.pipeline_tag": {"text-classification"},
+		"huggingfaceml.tags":         {"sentiment", "english"},
+	}, card.ToProperties().ToMap())
+
+	card, err = ParseHuggingFaceModelCard([]byte("# No front matter\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, &HuggingFaceModelCard{}, card)
+	_, err = ParseHuggingFaceModelCard([]byte("---\nlicense: mit\n"))
+	assert.ErrorContains(t, err, "isn't terminated")
+}
+
+func TestCreateHuggingFaceModelUploadParams(t *testing.T) {
+	localDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(localDir, "README.md"), []byte(testModelCard), 0600))
+	params := NewHuggingFaceModelUploadParams("hf-local", "acme/sentiment", localDir)
+	params.Props = "team=ml"
+	uploadParams, err := CreateHuggingFaceModelUploadParams(params)
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.ToSlash(localDir)+"/(*)", uploadParams.Pattern)
+	assert.Equal(t, "hf-local/models/acme/sentiment/main/{1}", uploadParams.Target)
+	assert.True(t, uploadParams.Recursive)
+	assert.Positive(t, uploadParams.SplitCount)
+	props := uploadParams.TargetProps.ToMap()
+	assert.Equal(t, []string{"acme/sentiment"}, props["huggingfaceml.model_id"])
+	assert.Equal(t, []string{"main"}, props["huggingfaceml.revision"])
+	assert.Equal(t, []string{"apache-2.0"}, props["huggingfaceml.license"])
+	assert.Equal(t, []string{"ml"}, props["team"])
+
+	_, err = CreateHuggingFaceModelUploadParams(NewHuggingFaceModelUploadParams("hf-local", "", localDir))
+	assert.ErrorContains(t, err, "are required")
+}
+
+func TestHuggingFaceDownloadModel(t *testing.T) {
+	siblings := `[{"rfilename":"config.json"},{"rfilename":"weights/model.safetensors"}]`
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/huggingfaceml/hf-remote/api/models/acme/sentiment/revision/main":
+			_, _ = w.Write([]byte(`{"id":"acme/sentiment","sha":"abc123","siblings":` + siblings + `}`))
+		case "/api/huggingfaceml/hf-remote/api/models/acme/evil/revision/main":
+			_, _ = w.Write([]byte(`{"id":"acme/evil","siblings":[{"rfilename":"../escape"}]}`))
+		case "/api/huggingfaceml/hf-remote/acme/sentiment/resolve/abc123/config.json":
+			_, _ = w.Write([]byte("{}"))
+		case "/api/huggingfaceml/hf-remote/acme/sentiment/resolve/abc123/weights/model.safetensors":
+			_, _ = w.Write([]byte("weights"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	huggingFaceService := NewHuggingFaceService(serviceDetails, client)
+
+	localDir := t.TempDir()
+	modelInfo, err := huggingFaceService.DownloadModel("hf-remote", "acme/sentiment", "", localDir)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", modelInfo.Sha)
+	weights, err := os.ReadFile(filepath.Join(localDir, "weights", "model.safetensors"))
+	assert.NoError(t, err)
+	assert.Equal(t, "weights", string(weights))
+
+	_, err = huggingFaceService.DownloadModel("hf-remote", "acme/evil", "", localDir)
+	assert.ErrorContains(t, err, "outside the model directory")
+	_, err = huggingFaceService.GetModelInfo("hf-remote", "acme/missing", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const keyPairApi = "api/security/keypair"
+
+type KeyPairType string
+
+const (
+	GpgKeyPair KeyPairType = "GPG"
+	RsaKeyPair KeyPairType = "RSA"
+)
+
+// Manages the key pairs of Artifactory, which sign Release Bundles v2, Debian and RPM metadata and more.
+// The signing key of Release Bundles is the name of its key pair.
+type KeyPairService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+	DryRun     bool
+}
+
+func NewKeyPairService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *KeyPairService {
+	return &KeyPairService{artDetails: &artDetails, client: client}
+}
+
+func (kps *KeyPairService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *kps.artDetails
+}
+
+func (kps *KeyPairService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return kps.client
+}
+
+func (kps *KeyPairService) IsDryRun() bool {
+	return kps.DryRun
+}
+
+type KeyPair struct {
+	PairName string      `json:"pairName"`
+	PairType KeyPairType `json:"pairType"`
+	// The alias the public key is published with, e.g. for Debian repositories.
+	Alias     string `json:"alias,omitempty"`
+	PublicKey string `json:"publicKey,omitempty"`
+	// Never returned by Artifactory.
+	PrivateKey string `json:"privateKey,omitempty"`
+	// The passphrase of the private key, if it's encrypted.
+	Passphrase string `json:"passphrase,omitempty"`
+}
+
+func NewKeyPair(pairName string, pairType KeyPairType, publicKey, privateKey string) KeyPair {
+	return KeyPair{PairName: pairName, PairType: pairType, Alias: pairName, PublicKey: publicKey, PrivateKey: privateKey}
+}
+
+// Uploads a key pair. Key pairs can't be updated, so to rotate a signing key, create a key pair with a new name,
+// switch to signing with it, and then delete the old key pair.
+func (kps *KeyPairService) Create(keyPair KeyPair) error {
+	if keyPair.PairName == "" || keyPair.PublicKey == "" || keyPair.PrivateKey == "" {
+		return errorutils.CheckErrorf("a name, a public key and a private key are required to create a key pair")
+	}
+	if keyPair.PairType != GpgKeyPair && keyPair.PairType != RsaKeyPair {
+		return errorutils.CheckErrorf("unsupported key pair type '%s', expected %s or %s", keyPair.PairType, GpgKeyPair, RsaKeyPair)
+	}
+	if keyPair.Alias == "" {
+		keyPair.Alias = keyPair.PairName
+	}
+	if kps.DryRun {
+		log.Info(fmt.Sprintf("[Dry run] Creating key pair %s...", keyPair.PairName))
+		return nil
+	}
+	content, err := json.Marshal(keyPair)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpClientsDetails := kps.GetArtifactoryDetails().CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	log.Info(fmt.Sprintf("Creating key pair %s...", keyPair.PairName))
+	resp, body, err := kps.client.SendPost(kps.GetArtifactoryDetails().GetUrl()+keyPairApi, content, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+// Returns a key pair, without its private key.
+func (kps *KeyPairService) Get(pairName string) (*KeyPair, error) {
+	keyPair := &KeyPair{}
+	if err := kps.sendGet(keyPairApi+"/"+url.PathEscape(pairName), keyPair); err != nil {
+		return nil, err
+	}
+	return keyPair, nil
+}
+
+// Returns all the key pairs, without their private keys.
+func (kps *KeyPairService) GetAll() ([]KeyPair, error) {
+	var keyPairs []KeyPair
+	if err := kps.sendGet(keyPairApi, &keyPairs); err != nil {
+		return nil, err
+	}
+	return keyPairs, nil
+}
+
+func (kps *KeyPairService) Delete(pairName string) error {
+	if kps.DryRun {
+		log.Info(fmt.Sprintf("[Dry run] Deleting key pair %s...", pairName))
+		return nil
+	}
+	httpClientsDetails := kps.GetArtifactoryDetails().CreateHttpClientDetails()
+	log.Info(fmt.Sprintf("Deleting key pair %s...", pairName))
+	resp, body, err := kps.client.SendDelete(kps.GetArtifactoryDetails().GetUrl()+keyPairApi+"/"+url.PathEscape(pairName), nil, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+func (kps *KeyPairService) sendGet(api string, result any) error {
+	httpClientsDetails := kps.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := kps.client.SendGet(kps.GetArtifactoryDetails().GetUrl()+api, true, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return errorutils.CheckError(json.Unmarshal(body, result))
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createKeyPairTestService(t *testing.T, handler http.HandlerFunc) *KeyPairService {
+	return NewKeyPairService(newTestServer(t, handler))
+}
+
+func TestKeyPairCrud(t *testing.T) {
+	var created KeyPair
+	var deleted string
+	keyPairService := createKeyPairTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /" + keyPairApi:
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+		case "GET /" + keyPairApi + "/rb-key":
+			_, _ = w.Write([]byte(`{"pairName":"rb-key","pairType":"RSA","alias":"rb-key","publicKey":"public"}`))
+		case "GET /" + keyPairApi:
+			_, _ = w.Write([]byte(`[{"pairName":"rb-key","pairType":"RSA"},{"pairName":"deb-key","pairType":"GPG"}]`))
+		case "DELETE /" + keyPairApi + "/old-key":
+			deleted = "old-key"
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	assert.NoError(t, keyPairService.Create(NewKeyPair("rb-key", RsaKeyPair, "public", "private")))
+	assert.Equal(t, KeyPair{PairName: "rb-key", PairType: RsaKeyPair, Alias: "rb-key", PublicKey: "public", PrivateKey: "private"}, created)
+	assert.ErrorContains(t, keyPairService.Create(NewKeyPair("rb-key", "DSA", "public", "private")), "unsupported key pair type")
+	assert.ErrorContains(t, keyPairService.Create(KeyPair{PairName: "rb-key", PairType: GpgKeyPair}), "are required")
+
+	keyPair, err := keyPairService.Get("rb-key")
+	assert.NoError(t, err)
+	assert.Equal(t, "public", keyPair.PublicKey)
+	keyPairs, err := keyPairService.GetAll()
+	assert.NoError(t, err)
+	assert.Len(t, keyPairs, 2)
+	_, err = keyPairService.Get("missing")
+	assert.Error(t, err)
+
+	assert.NoError(t, keyPairService.Delete("old-key"))
+	assert.Equal(t, "old-key", deleted)
+}
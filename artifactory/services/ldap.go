@@ -0,0 +1,373 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const ldapGroupsImportApi = "api/ldap/groups/%s/import"
+
+// The strategies of LDAP group settings, which determine how the groups of a user are found.
+const (
+	LdapGroupStrategyStatic       = "STATIC"
+	LdapGroupStrategyDynamic      = "DYNAMIC"
+	LdapGroupStrategyHierarchical = "HIERARCHICAL"
+)
+
+// The directory and SSO integrations in the security section of the configuration descriptor.
+type securityConfig struct {
+	LdapSettings      []LdapSetting      `xml:"ldapSettings>ldapSetting"`
+	LdapGroupSettings []LdapGroupSetting `xml:"ldapGroupSettings>ldapGroupSetting"`
+	CrowdSettings     *CrowdSettings     `xml:"crowdSettings"`
+	SamlSettings      *SamlSettings      `xml:"samlSettings"`
+}
+
+type LdapSetting struct {
+	Key     string `xml:"key" json:"key"`
+	Enabled bool   `xml:"enabled" json:"enabled"`
+	// For example "ldap://ldap.example.com:389/dc=example,dc=com".
+	LdapUrl string `xml:"ldapUrl" json:"ldapUrl"`
+	// For example "uid={0},ou=People". Either a pattern or a search filter is required.
+	UserDnPattern            string            `xml:"userDnPattern,omitempty" json:"userDnPattern,omitempty"`
+	Search                   LdapSettingSearch `xml:"search" json:"search"`
+	AutoCreateUser           bool              `xml:"autoCreateUser" json:"autoCreateUser"`
+	EmailAttribute           string            `xml:"emailAttribute,omitempty" json:"emailAttribute,omitempty"`
+	LdapPoisoningProtection  bool              `xml:"ldapPoisoningProtection" json:"ldapPoisoningProtection"`
+	AllowUserToAccessProfile bool              `xml:"allowUserToAccessProfile" json:"allowUserToAccessProfile"`
+	PagingSupportEnabled     bool              `xml:"pagingSupportEnabled" json:"pagingSupportEnabled"`
+}
+
+type LdapSettingSearch struct {
+	// For example "(uid={0})".
+	SearchFilter  string `xml:"searchFilter,omitempty" json:"searchFilter,omitempty"`
+	SearchBase    string `xml:"searchBase,omitempty" json:"searchBase,omitempty"`
+	SearchSubTree bool   `xml:"searchSubTree" json:"searchSubTree"`
+	ManagerDn     string `xml:"managerDn,omitempty" json:"managerDn,omitempty"`
+	// Returned encrypted. Kept as is on updates if empty.
+	ManagerPassword string `xml:"managerPassword,omitempty" json:"managerPassword,omitempty"`
+}
+
+// Maps the groups of an LDAP server to Artifactory groups.
+type LdapGroupSetting struct {
+	Name string `xml:"name" json:"name"`
+	// The key of the LDAP setting the groups are read with.
+	EnabledLdap          string `xml:"enabledLdap" json:"enabledLdap"`
+	GroupBaseDn          string `xml:"groupBaseDn,omitempty" json:"groupBaseDn,omitempty"`
+	GroupNameAttribute   string `xml:"groupNameAttribute" json:"groupNameAttribute"`
+	GroupMemberAttribute string `xml:"groupMemberAttribute" json:"groupMemberAttribute"`
+	SubTree              bool   `xml:"subTree" json:"subTree"`
+	// For example "(objectClass=groupOfNames)".
+	Filter               string `xml:"filter" json:"filter"`
+	DescriptionAttribute string `xml:"descriptionAttribute,omitempty" json:"descriptionAttribute,omitempty"`
+	// One of the LdapGroupStrategy values.
+	Strategy string `xml:"strategy" json:"strategy"`
+}
+
+type CrowdSettings struct {
+	EnableIntegration bool   `xml:"enableIntegration" json:"enableIntegration"`
+	ServerUrl         string `xml:"serverUrl,omitempty" json:"serverUrl,omitempty"`
+	ApplicationName   string `xml:"applicationName,omitempty" json:"applicationName,omitempty"`
+	// Returned encrypted. Kept as is on updates if empty.
+	Password string `xml:"password,omitempty" json:"password,omitempty"`
+	// In minutes.
+	SessionValidationInterval  int  `xml:"sessionValidationInterval" json:"sessionValidationInterval"`
+	NoAutoUserCreation         bool `xml:"noAutoUserCreation" json:"noAutoUserCreation"`
+	UseDefaultProxy            bool `xml:"useDefaultProxy" json:"useDefaultProxy"`
+	DirectAuthentication       bool `xml:"directAuthentication" json:"directAuthentication"`
+	OverrideAllGroupsUponLogin bool `xml:"overrideAllGroupsUponLogin" json:"overrideAllGroupsUponLogin"`
+}
+
+func (ls *LdapSetting) Validate() error {
+	if ls.Key == "" {
+		return errorutils.CheckErrorf("an LDAP setting key is required")
+	}
+	if !strings.HasPrefix(ls.LdapUrl, "ldap://") && !strings.HasPrefix(ls.LdapUrl, "ldaps://") {
+		return errorutils.CheckErrorf("LDAP setting '%s': the LDAP URL must start with ldap:// or ldaps://, got '%s'", ls.Key, ls.LdapUrl)
+	}
+	if ls.UserDnPattern == "" && ls.Search.SearchFilter == "" {
+		return errorutils.CheckErrorf("LDAP setting '%s': either a user DN pattern or a search filter is required", ls.Key)
+	}
+	return nil
+}
+
+func (lgs *LdapGroupSetting) Validate() error {
+	if lgs.Name == "" || lgs.EnabledLdap == "" {
+		return errorutils.CheckErrorf("an LDAP group setting name and the key of its LDAP setting are required")
+	}
+	if lgs.GroupNameAttribute == "" || lgs.GroupMemberAttribute == "" || lgs.Filter == "" {
+		return errorutils.CheckErrorf("LDAP group setting '%s': the group name attribute, the group member attribute and the filter are required", lgs.Name)
+	}
+	strategies := []string{LdapGroupStrategyStatic, LdapGroupStrategyDynamic, LdapGroupStrategyHierarchical}
+	if !slices.Contains(strategies, lgs.Strategy) {
+		return errorutils.CheckErrorf("LDAP group setting '%s': unknown strategy '%s'. Valid strategies are: %v", lgs.Name, lgs.Strategy, strategies)
+	}
+	return nil
+}
+
+func (cs *ConfigurationService) GetLdapSettings() ([]LdapSetting, error) {
+	descriptor, err := cs.getConfigDescriptor()
+	if err != nil {
+		return nil, err
+	}
+	return descriptor.Security.LdapSettings, nil
+}
+
+// Returns nil if the LDAP setting doesn't exist.
+func (cs *ConfigurationService) GetLdapSetting(key string) (*LdapSetting, error) {
+	ldapSettings, err := cs.GetLdapSettings()
+	if err != nil {
+		return nil, err
+	}
+	for i := range ldapSettings {
+		if ldapSettings[i].Key == key {
+			return &ldapSettings[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (cs *ConfigurationService) CreateLdapSetting(ldapSetting LdapSetting) error {
+	current, err := cs.GetLdapSetting(ldapSetting.Key)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		return errorutils.CheckErrorf("LDAP setting '%s' already exists", ldapSetting.Key)
+	}
+	return cs.patchLdapSetting(ldapSetting)
+}
+
+func (cs *ConfigurationService) UpdateLdapSetting(ldapSetting LdapSetting) error {
+	current, err := cs.GetLdapSetting(ldapSetting.Key)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return errorutils.CheckErrorf("LDAP setting '%s' does not exist", ldapSetting.Key)
+	}
+	return cs.patchLdapSetting(ldapSetting)
+}
+
+func (cs *ConfigurationService) patchLdapSetting(ldapSetting LdapSetting) error {
+	if err := ldapSetting.Validate(); err != nil {
+		return err
+	}
+	log.Info("Applying LDAP setting '" + ldapSetting.Key + "'...")
+	return cs.patch(securityPatch("ldapSettings", ldapSetting.Key, ldapSettingPatch(ldapSetting)))
+}
+
+// LDAP group settings which use the LDAP setting should be deleted first.
+func (cs *ConfigurationService) DeleteLdapSetting(key string) error {
+	log.Info("Deleting LDAP setting '" + key + "'...")
+	return cs.patch(securityPatch("ldapSettings", key, nil))
+}
+
+func (cs *ConfigurationService) GetLdapGroupSettings() ([]LdapGroupSetting, error) {
+	descriptor, err := cs.getConfigDescriptor()
+	if err != nil {
+		return nil, err
+	}
+	return descriptor.Security.LdapGroupSettings, nil
+}
+
+// Returns nil if the LDAP group setting doesn't exist.
+func (cs *ConfigurationService) GetLdapGroupSetting(name string) (*LdapGroupSetting, error) {
+	groupSettings, err := cs.GetLdapGroupSettings()
+	if err != nil {
+		return nil, err
+	}
+	for i := range groupSettings {
+		if groupSettings[i].Name == name {
+			return &groupSettings[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (cs *ConfigurationService) CreateLdapGroupSetting(groupSetting LdapGroupSetting) error {
+	return cs.patchLdapGroupSetting(groupSetting, false)
+}
+
+func (cs *ConfigurationService) UpdateLdapGroupSetting(groupSetting LdapGroupSetting) error {
+	return cs.patchLdapGroupSetting(groupSetting, true)
+}
+
+// Validates that the group setting exists only if it's updated, and that its LDAP setting exists.
+func (cs *ConfigurationService) patchLdapGroupSetting(groupSetting LdapGroupSetting, update bool) error {
+	if err := groupSetting.Validate(); err != nil {
+		return err
+	}
+	descriptor, err := cs.getConfigDescriptor()
+	if err != nil {
+		return err
+	}
+	exists := slices.ContainsFunc(descriptor.Security.LdapGroupSettings, func(current LdapGroupSetting) bool { return current.Name == groupSetting.Name })
+	if exists && !update {
+		return errorutils.CheckErrorf("LDAP group setting '%s' already exists", groupSetting.Name)
+	}
+	if !exists && update {
+		return errorutils.CheckErrorf("LDAP group setting '%s' does not exist", groupSetting.Name)
+	}
+	if !slices.ContainsFunc(descriptor.Security.LdapSettings, func(ldapSetting LdapSetting) bool { return ldapSetting.Key == groupSetting.EnabledLdap }) {
+		return errorutils.CheckErrorf("LDAP group setting '%s': LDAP setting '%s' does not exist", groupSetting.Name, groupSetting.EnabledLdap)
+	}
+	log.Info("Applying LDAP group setting '" + groupSetting.Name + "'...")
+	return cs.patch(securityPatch("ldapGroupSettings", groupSetting.Name, ldapGroupSettingPatch(groupSetting)))
+}
+
+func (cs *ConfigurationService) DeleteLdapGroupSetting(name string) error {
+	log.Info("Deleting LDAP group setting '" + name + "'...")
+	return cs.patch(securityPatch("ldapGroupSettings", name, nil))
+}
+
+// Returns disabled settings if Crowd isn't configured.
+func (cs *ConfigurationService) GetCrowdSettings() (*CrowdSettings, error) {
+	descriptor, err := cs.getConfigDescriptor()
+	if err != nil {
+		return nil, err
+	}
+	if descriptor.Security.CrowdSettings == nil {
+		return &CrowdSettings{}, nil
+	}
+	return descriptor.Security.CrowdSettings, nil
+}
+
+func (cs *ConfigurationService) UpdateCrowdSettings(crowdSettings CrowdSettings) error {
+	if crowdSettings.EnableIntegration && (crowdSettings.ServerUrl == "" || crowdSettings.ApplicationName == "") {
+		return errorutils.CheckErrorf("a server URL and an application name are required to enable the Crowd integration")
+	}
+	log.Info("Applying the Crowd settings...")
+	return cs.patch(map[string]interface{}{"security": map[string]interface{}{"crowdSettings": crowdSettingsPatch(crowdSettings)}})
+}
+
+type LdapGroupsSyncParams struct {
+	// The name of the LDAP group setting the groups are imported with.
+	GroupSettingName string
+	// The names of the LDAP groups to import. All the groups found by the group setting if empty.
+	GroupNames []string
+}
+
+type LdapGroupsSyncResult struct {
+	// The groups which didn't exist in Artifactory before the import.
+	Created []string
+	// The groups which existed in Artifactory, and whose members were synced.
+	Synced []string
+}
+
+// Imports the groups of an LDAP group setting to Artifactory, or syncs the members of the groups which were already
+// imported. Since Artifactory doesn't report the imported groups, they are found by comparing the groups before and
+// after the import.
+func (cs *ConfigurationService) SyncLdapGroups(params LdapGroupsSyncParams) (*LdapGroupsSyncResult, error) {
+	groupSetting, err := cs.GetLdapGroupSetting(params.GroupSettingName)
+	if err != nil {
+		return nil, err
+	}
+	if groupSetting == nil {
+		return nil, errorutils.CheckErrorf("LDAP group setting '%s' does not exist", params.GroupSettingName)
+	}
+	groupService := NewGroupService(cs.client)
+	groupService.ArtDetails = *cs.artDetails
+	groupsBefore, err := groupService.GetAllGroups()
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.Marshal(map[string][]string{"groups": params.GroupNames})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	httpDetails := cs.GetArtifactoryDetails().CreateHttpClientDetails()
+	httpDetails.SetContentTypeApplicationJson()
+	log.Info(fmt.Sprintf("Importing the groups of LDAP group setting '%s'...", params.GroupSettingName))
+	requestUrl := cs.GetArtifactoryDetails().GetUrl() + fmt.Sprintf(ldapGroupsImportApi, url.PathEscape(params.GroupSettingName))
+	resp, body, err := cs.client.SendPost(requestUrl, content, &httpDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent); err != nil {
+		return nil, err
+	}
+	groupsAfter, err := groupService.GetAllGroups()
+	if err != nil {
+		return nil, err
+	}
+	return newLdapGroupsSyncResult(*groupsBefore, *groupsAfter, params.GroupNames), nil
+}
+
+// The synced groups are known only if the names of the groups were requested.
+func newLdapGroupsSyncResult(groupsBefore, groupsAfter, requestedGroups []string) *LdapGroupsSyncResult {
+	result := &LdapGroupsSyncResult{}
+	for _, group := range groupsAfter {
+		if !slices.Contains(groupsBefore, group) {
+			result.Created = append(result.Created, group)
+		} else if slices.Contains(requestedGroups, group) {
+			result.Synced = append(result.Synced, group)
+		}
+	}
+	slices.Sort(result.Created)
+	slices.Sort(result.Synced)
+	return result
+}
+
+func securityPatch(section, name string, value interface{}) map[string]interface{} {
+	return map[string]interface{}{"security": map[string]interface{}{section: map[string]interface{}{name: value}}}
+}
+
+func ldapSettingPatch(ldapSetting LdapSetting) map[string]interface{} {
+	search := map[string]interface{}{
+		"searchFilter":  ldapSetting.Search.SearchFilter,
+		"searchBase":    ldapSetting.Search.SearchBase,
+		"searchSubTree": ldapSetting.Search.SearchSubTree,
+		"managerDn":     ldapSetting.Search.ManagerDn,
+	}
+	if ldapSetting.Search.ManagerPassword != "" {
+		search["managerPassword"] = ldapSetting.Search.ManagerPassword
+	}
+	return map[string]interface{}{
+		"enabled":                  ldapSetting.Enabled,
+		"ldapUrl":                  ldapSetting.LdapUrl,
+		"userDnPattern":            ldapSetting.UserDnPattern,
+		"search":                   search,
+		"autoCreateUser":           ldapSetting.AutoCreateUser,
+		"emailAttribute":           ldapSetting.EmailAttribute,
+		"ldapPoisoningProtection":  ldapSetting.LdapPoisoningProtection,
+		"allowUserToAccessProfile": ldapSetting.AllowUserToAccessProfile,
+		"pagingSupportEnabled":     ldapSetting.PagingSupportEnabled,
+	}
+}
+
+func ldapGroupSettingPatch(groupSetting LdapGroupSetting) map[string]interface{} {
+	return map[string]interface{}{
+		"enabledLdap":          groupSetting.EnabledLdap,
+		"groupBaseDn":          groupSetting.GroupBaseDn,
+		"groupNameAttribute":   groupSetting.GroupNameAttribute,
+		"groupMemberAttribute": groupSetting.GroupMemberAttribute,
+		"subTree":              groupSetting.SubTree,
+		"filter":               groupSetting.Filter,
+		"descriptionAttribute": groupSetting.DescriptionAttribute,
+		"strategy":             groupSetting.Strategy,
+	}
+}
+
+func crowdSettingsPatch(crowdSettings CrowdSettings) map[string]interface{} {
+	patch := map[string]interface{}{
+		"enableIntegration":          crowdSettings.EnableIntegration,
+		"serverUrl":                  crowdSettings.ServerUrl,
+		"applicationName":            crowdSettings.ApplicationName,
+		"sessionValidationInterval":  crowdSettings.SessionValidationInterval,
+		"noAutoUserCreation":         crowdSettings.NoAutoUserCreation,
+		"useDefaultProxy":            crowdSettings.UseDefaultProxy,
+		"directAuthentication":       crowdSettings.DirectAuthentication,
+		"overrideAllGroupsUponLogin": crowdSettings.OverrideAllGroupsUponLogin,
+	}
+	if crowdSettings.Password != "" {
+		patch["password"] = crowdSettings.Password
+	}
+	return patch
+}
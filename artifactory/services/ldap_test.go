@@ -0,0 +1,37 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLdapSettingValidate(t *testing.T) {
+	ldapSetting := LdapSetting{Key: "corp-ldap", LdapUrl: "ldap.example.com"}
+	assert.ErrorContains(t, ldapSetting.Validate(), "must start with ldap://")
+	ldapSetting.LdapUrl = "ldap://ldap.example.com:389/dc=example,dc=com"
+	assert.ErrorContains(t, ldapSetting.Validate(), "either a user DN pattern or a search filter")
+	ldapSetting.UserDnPattern = "uid={0},ou=People"
+	assert.NoError(t, ldapSetting.Validate())
+}
+
+func TestLdapGroupSettingValidate(t *testing.T) {
+	groupSetting := LdapGroupSetting{Name: "corp-groups", EnabledLdap: "corp-ldap", GroupNameAttribute: "cn", GroupMemberAttribute: "member", Filter: "(objectClass=groupOfNames)", Strategy: "NESTED"}
+	assert.ErrorContains(t, groupSetting.Validate(), "unknown strategy")
+	groupSetting.Strategy = LdapGroupStrategyDynamic
+	assert.NoError(t, groupSetting.Validate())
+}
+
+func TestLdapSettingPatch(t *testing.T) {
+	ldapSetting := LdapSetting{Key: "corp-ldap", LdapUrl: "ldap://ldap.example.com", Search: LdapSettingSearch{SearchFilter: "(uid={0})"}}
+	// An empty manager password keeps the current one.
+	assert.NotContains(t, ldapSettingPatch(ldapSetting)["search"], "managerPassword")
+	ldapSetting.Search.ManagerPassword = "password"
+	assert.Equal(t, "password", ldapSettingPatch(ldapSetting)["search"].(map[string]interface{})["managerPassword"])
+	assert.NotContains(t, crowdSettingsPatch(CrowdSettings{}), "password")
+}
+
+func TestNewLdapGroupsSyncResult(t *testing.T) {
+	result := newLdapGroupsSyncResult([]string{"readers", "developers"}, []string{"readers", "developers", "qa", "admins"}, []string{"developers", "qa"})
+	assert.Equal(t, &LdapGroupsSyncResult{Created: []string{"admins", "qa"}, Synced: []string{"developers"}}, result)
+}
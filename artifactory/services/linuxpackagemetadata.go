@@ -0,0 +1,162 @@
+package services
+
+import (
+	"net/http"
+	"path"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	debianReindexApi        = "api/deb/reindex/"
+	debianIndexCachedApi    = "api/deb/indexCached/"
+	rpmCalculateMetadataApi = "api/yum/"
+	// The passphrase of the GPG signing key configured in Artifactory, used to sign the calculated metadata.
+	gpgPassphraseHeader = "X-GPG-PASSPHRASE"
+)
+
+// Calculates the metadata of Debian and RPM repositories, such as the Packages and Release indices and the YUM
+// repodata, which Linux package managers resolve packages by.
+type LinuxPackageMetadataService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+	DryRun     bool
+}
+
+func NewLinuxPackageMetadataService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *LinuxPackageMetadataService {
+	return &LinuxPackageMetadataService{artDetails: &artDetails, client: client}
+}
+
+func (lms *LinuxPackageMetadataService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *lms.artDetails
+}
+
+func (lms *LinuxPackageMetadataService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return lms.client
+}
+
+func (lms *LinuxPackageMetadataService) IsDryRun() bool {
+	return lms.DryRun
+}
+
+type DebianMetadataParams struct {
+	// The key of the local Debian repository.
+	RepoKey string
+	// Optional. Limit the calculation to the indices of a distribution, e.g. "bionic", and within it to a component,
+	// e.g. "main", and an architecture, e.g. "amd64". By default, the indices of all the distributions, components and
+	// architectures of the repository are calculated.
+	Distribution string
+	Component    string
+	Architecture string
+	// If true, returns once the calculation is scheduled, instead of when it finishes.
+	Async bool
+	// If set, determines whether the fields of the packages' control files are written as properties on the packages.
+	// Artifactory writes them by default.
+	WriteProps *bool
+	// The passphrase of the GPG signing key, if the key configured in Artifactory is protected by one.
+	GpgPassphrase string
+}
+
+func NewDebianMetadataParams(repoKey string) DebianMetadataParams {
+	return DebianMetadataParams{RepoKey: repoKey}
+}
+
+type RpmMetadataParams struct {
+	// The key of the local RPM repository.
+	RepoKey string
+	// The folder whose repodata is calculated, relative to the repository root, when the repository's metadata folder
+	// depth is greater than 0. Defaults to the repository root.
+	Path string
+	// If true, returns once the calculation is scheduled, instead of when it finishes.
+	Async bool
+	// The passphrase of the GPG signing key, if the key configured in Artifactory is protected by one.
+	GpgPassphrase string
+}
+
+func NewRpmMetadataParams(repoKey string) RpmMetadataParams {
+	return RpmMetadataParams{RepoKey: repoKey}
+}
+
+// Calculates the Packages and Release indices of a local Debian repository, signed by the GPG key configured in
+// Artifactory.
+func (lms *LinuxPackageMetadataService) CalculateDebianMetadata(params DebianMetadataParams) error {
+	if params.RepoKey == "" {
+		return errorutils.CheckErrorf("a repository key is required")
+	}
+	if params.Distribution == "" && (params.Component != "" || params.Architecture != "") {
+		return errorutils.CheckErrorf("a component or an architecture requires a distribution")
+	}
+	queryParams := map[string]string{"async": boolToFlag(params.Async)}
+	if params.WriteProps != nil {
+		queryParams["writeProps"] = boolToFlag(*params.WriteProps)
+	}
+	description := "the Debian metadata of '" + params.RepoKey + "'"
+	for name, value := range map[string]string{"distribution": params.Distribution, "component": params.Component, "architecture": params.Architecture} {
+		if value != "" {
+			queryParams[name] = value
+		}
+	}
+	if params.Distribution != "" {
+		description += " for " + path.Join(params.Distribution, params.Component, params.Architecture)
+	}
+	return lms.calculate(debianReindexApi+params.RepoKey, queryParams, params.GpgPassphrase, description)
+}
+
+// Calculates the coordinates (distribution, component and architecture) of the packages cached by a remote Debian
+// repository, so that they can be resolved through virtual repositories.
+func (lms *LinuxPackageMetadataService) CalculateCachedDebianCoordinates(remoteRepoKey string) error {
+	if remoteRepoKey == "" {
+		return errorutils.CheckErrorf("a repository key is required")
+	}
+	return lms.calculate(debianIndexCachedApi+RemoteRepoKeyFromCache(remoteRepoKey), nil, "", "the coordinates of the cached Debian packages of '"+remoteRepoKey+"'")
+}
+
+// Calculates the YUM repodata of a local RPM repository, signed by the GPG key configured in Artifactory.
+func (lms *LinuxPackageMetadataService) CalculateRpmMetadata(params RpmMetadataParams) error {
+	if params.RepoKey == "" {
+		return errorutils.CheckErrorf("a repository key is required")
+	}
+	queryParams := map[string]string{"async": boolToFlag(params.Async)}
+	if params.Path != "" {
+		queryParams["path"] = params.Path
+	}
+	return lms.calculate(rpmCalculateMetadataApi+params.RepoKey, queryParams, params.GpgPassphrase, "the RPM metadata of '"+path.Join(params.RepoKey, params.Path)+"'")
+}
+
+func (lms *LinuxPackageMetadataService) calculate(restApi string, queryParams map[string]string, gpgPassphrase, description string) error {
+	requestFullUrl, err := clientutils.BuildUrl(lms.GetArtifactoryDetails().GetUrl(), restApi, queryParams)
+	if err != nil {
+		return err
+	}
+	if lms.DryRun {
+		log.Info("[Dry run] Calculating " + description)
+		return nil
+	}
+	log.Info("Calculating " + description + "...")
+	httpClientsDetails := lms.GetArtifactoryDetails().CreateHttpClientDetails()
+	if gpgPassphrase != "" {
+		utils.AddHeader(gpgPassphraseHeader, gpgPassphrase, &httpClientsDetails.Headers)
+	}
+	resp, body, err := lms.client.SendPost(requestFullUrl, nil, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusAccepted, http.StatusNoContent); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+// The metadata calculation APIs accept flags as 0 or 1.
+func boolToFlag(value bool) string {
+	if value {
+		return "1"
+	}
+	return "0"
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateLinuxPackageMetadata(t *testing.T) {
+	var requests []string
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI()+" "+r.Header.Get(gpgPassphraseHeader))
+	})
+	metadataService := NewLinuxPackageMetadataService(serviceDetails, client)
+
+	debianParams := NewDebianMetadataParams("debian-local")
+	writeProps := false
+	debianParams.WriteProps = &writeProps
+	debianParams.GpgPassphrase = "secret"
+	assert.NoError(t, metadataService.CalculateDebianMetadata(debianParams))
+	debianParams = NewDebianMetadataParams("debian-local")
+	debianParams.Distribution = "bionic"
+	debianParams.Component = "main"
+	debianParams.Architecture = "amd64"
+	assert.NoError(t, metadataService.CalculateDebianMetadata(debianParams))
+	assert.NoError(t, metadataService.CalculateCachedDebianCoordinates("debian-remote-cache"))
+
+	rpmParams := NewRpmMetadataParams("rpm-local")
+	rpmParams.Path = "centos/7"
+	rpmParams.Async = true
+	assert.NoError(t, metadataService.CalculateRpmMetadata(rpmParams))
+
+	assert.Equal(t, []string{
+		"POST /api/deb/reindex/debian-local?async=0&writeProps=0 secret",
+		"POST /api/deb/reindex/debian-local?architecture=amd64&async=0&component=main&distribution=bionic ",
+		"POST /api/deb/indexCached/debian-remote ",
+		"POST /api/yum/rpm-local?async=1&path=centos%2F7 ",
+	}, requests)
+
+	metadataService.DryRun = true
+	assert.NoError(t, metadataService.CalculateRpmMetadata(NewRpmMetadataParams("rpm-local")))
+	assert.Len(t, requests, 4)
+	assert.ErrorContains(t, metadataService.CalculateDebianMetadata(DebianMetadataParams{}), "repository key is required")
+	assert.ErrorContains(t, metadataService.CalculateDebianMetadata(DebianMetadataParams{RepoKey: "debian-local", Component: "main"}), "requires a distribution")
+}
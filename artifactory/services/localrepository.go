@@ -95,6 +95,10 @@ func (lrs *LocalRepositoryService) Helm(params HelmLocalRepositoryParams) error
 	return lrs.performRequest(params, params.Key)
 }
 
+func (lrs *LocalRepositoryService) HuggingFaceMl(params HuggingFaceMlLocalRepositoryParams) error {
+	return lrs.performRequest(params, params.Key)
+}
+
 func (lrs *LocalRepositoryService) Ivy(params IvyLocalRepositoryParams) error {
 	return lrs.performRequest(params, params.Key)
 }
@@ -302,6 +306,14 @@ func NewHelmLocalRepositoryParams() HelmLocalRepositoryParams {
 	return HelmLocalRepositoryParams{LocalRepositoryBaseParams: NewLocalRepositoryPackageParams("helm")}
 }
 
+type HuggingFaceMlLocalRepositoryParams struct {
+	LocalRepositoryBaseParams
+}
+
+func NewHuggingFaceMlLocalRepositoryParams() HuggingFaceMlLocalRepositoryParams {
+	return HuggingFaceMlLocalRepositoryParams{LocalRepositoryBaseParams: NewLocalRepositoryPackageParams("huggingfaceml")}
+}
+
 type IvyLocalRepositoryParams struct {
 	LocalRepositoryBaseParams
 	JavaPackageManagersRepositoryParams
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"path"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	mavenCalculateMetadataApi        = "api/maven/calculateMetadata/"
+	mavenCalculatePluginsMetadataApi = "api/maven/calculatePluginsMetadata/"
+
+	// Part of the type of the Maven metadata calculation tasks.
+	MavenMetadataCalculationTaskType = "MavenMetadataCalculation"
+)
+
+// Recalculates the maven-metadata.xml files of Maven repositories, for example after bulk imports or copies, which
+// leave the metadata stale.
+type MavenService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+	DryRun     bool
+}
+
+func NewMavenService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *MavenService {
+	return &MavenService{artDetails: &artDetails, client: client}
+}
+
+func (ms *MavenService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *ms.artDetails
+}
+
+func (ms *MavenService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return ms.client
+}
+
+func (ms *MavenService) IsDryRun() bool {
+	return ms.DryRun
+}
+
+type MavenMetadataParams struct {
+	// The key of the local Maven repository.
+	RepoKey string
+	// The folder to recalculate, relative to the repository root, e.g. "org/acme". Defaults to the whole repository.
+	Path string
+	// If true, only the metadata of the folder itself is recalculated, without its sub folders.
+	NonRecursive bool
+	// If true, waits until the calculation tasks finish.
+	Wait bool
+	// The maximum time to wait. Defaults to 60 minutes.
+	Timeout time.Duration
+	// The time to wait between status checks. Defaults to 15 seconds.
+	PollingInterval time.Duration
+}
+
+func NewMavenMetadataParams(repoKey string) MavenMetadataParams {
+	return MavenMetadataParams{RepoKey: repoKey}
+}
+
+// Triggers the recalculation of the maven-metadata.xml files under the path. The calculation runs as a background
+// task, which is waited for if requested.
+func (ms *MavenService) CalculateMetadata(params MavenMetadataParams) error {
+	if params.RepoKey == "" {
+		return errorutils.CheckErrorf("a repository key is required")
+	}
+	var queryParams map[string]string
+	if params.NonRecursive {
+		queryParams = map[string]string{"nonRecursive": "true"}
+	}
+	metadataPath := path.Join(params.RepoKey, params.Path)
+	return ms.triggerCalculation(mavenCalculateMetadataApi+metadataPath, queryParams, "the Maven metadata of '"+metadataPath+"'", params)
+}
+
+// Triggers the recalculation of the Maven plugins metadata of the repository, which is used to resolve plugin prefixes.
+func (ms *MavenService) CalculatePluginsMetadata(params MavenMetadataParams) error {
+	if params.RepoKey == "" {
+		return errorutils.CheckErrorf("a repository key is required")
+	}
+	return ms.triggerCalculation(mavenCalculatePluginsMetadataApi+params.RepoKey, nil, "the Maven plugins metadata of '"+params.RepoKey+"'", params)
+}
+
+func (ms *MavenService) triggerCalculation(restApi string, queryParams map[string]string, description string, params MavenMetadataParams) error {
+	requestFullUrl, err := clientutils.BuildUrl(ms.GetArtifactoryDetails().GetUrl(), restApi, queryParams)
+	if err != nil {
+		return err
+	}
+	if ms.DryRun {
+		log.Info("[Dry run] Calculating " + description)
+		return nil
+	}
+	log.Info("Calculating " + description + "...")
+	var waitParams *WaitForTasksParams
+	if params.Wait {
+		waitParams = &WaitForTasksParams{TypeContains: MavenMetadataCalculationTaskType, Timeout: params.Timeout, PollingInterval: params.PollingInterval}
+	}
+	return triggerBackgroundTasks(*ms.artDetails, ms.client, requestFullUrl, waitParams)
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMavenCalculateMetadata(t *testing.T) {
+	var requests []string
+	tasksPolls := 0
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/tasks" {
+			tasksPolls++
+			// The task starts only after it's triggered, and finishes after a single poll.
+			state := TaskStateStopped
+			if len(requests) > 0 && tasksPolls == 2 {
+				state = TaskStateRunning
+			}
+			_, _ = w.Write([]byte(`{"tasks":[{"id":"1","type":"org.artifactory.maven.MavenMetadataCalculationJob","state":"` + state + `"}]}`))
+			return
+		}
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+	})
+	mavenService := NewMavenService(serviceDetails, client)
+
+	params := NewMavenMetadataParams("libs-release-local")
+	params.Path = "org/acme"
+	params.NonRecursive = true
+	params.Wait = true
+	params.Timeout = time.Second
+	params.PollingInterval = time.Millisecond
+	assert.NoError(t, mavenService.CalculateMetadata(params))
+	assert.Equal(t, 3, tasksPolls)
+
+	assert.NoError(t, mavenService.CalculatePluginsMetadata(NewMavenMetadataParams("libs-release-local")))
+	assert.Equal(t, []string{
+		"POST /api/maven/calculateMetadata/libs-release-local/org/acme?nonRecursive=true",
+		"POST /api/maven/calculatePluginsMetadata/libs-release-local",
+	}, requests)
+
+	mavenService.DryRun = true
+	assert.NoError(t, mavenService.CalculateMetadata(NewMavenMetadataParams("libs-release-local")))
+	assert.Len(t, requests, 2)
+	assert.ErrorContains(t, mavenService.CalculateMetadata(MavenMetadataParams{}), "repository key is required")
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -49,6 +50,28 @@ func (mc *MoveCopyService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient
 }
 
 func (mc *MoveCopyService) MoveCopyServiceMoveFilesWrapper(moveSpecs ...MoveCopyParams) (successCount, failedCount int, err error) {
+	report, err := mc.MoveCopyFilesWithReport(moveSpecs...)
+	if report != nil {
+		successCount, failedCount = report.Succeeded, report.Failed
+	}
+	if err != nil {
+		return
+	}
+	if failedCount > 0 {
+		err = errorutils.CheckErrorf("Failed %s %s artifacts.", moveMsgs[mc.moveType].MovingMsg, strconv.Itoa(failedCount))
+	}
+	return
+}
+
+// Moves or copies the artifacts matching the specs, and returns the outcome of each artifact.
+// Unlike MoveCopyServiceMoveFilesWrapper, failing to move or copy some of the artifacts isn't returned as an error,
+// but only reported. In dry-run, the report previews the outcome without changing anything.
+func (mc *MoveCopyService) MoveCopyFilesWithReport(moveSpecs ...MoveCopyParams) (report *MoveCopyReport, err error) {
+	for _, moveSpec := range moveSpecs {
+		if err = moveSpec.ConflictPolicy.Validate(); err != nil {
+			return
+		}
+	}
 	moveReaders := []*ReaderSpecTuple{}
 	defer func() {
 		for _, readerSpec := range moveReaders {
@@ -84,16 +107,10 @@ func (mc *MoveCopyService) MoveCopyServiceMoveFilesWrapper(moveSpecs ...MoveCopy
 	defer func() {
 		err = errors.Join(err, aggregatedReader.Close())
 	}()
-	successCount, failedCount, err = mc.moveFiles(aggregatedReader, moveSpecs)
-	if err != nil {
-		return
-	}
-
-	log.Debug(moveMsgs[mc.moveType].MovedMsg, strconv.Itoa(successCount), "artifacts.")
-	if failedCount > 0 {
-		err = errorutils.CheckErrorf("Failed %s %s artifacts.", moveMsgs[mc.moveType].MovingMsg, strconv.Itoa(failedCount))
+	report, err = mc.moveFiles(aggregatedReader, moveSpecs)
+	if report != nil {
+		log.Debug(moveMsgs[mc.moveType].MovedMsg, strconv.Itoa(report.Succeeded), "artifacts.")
 	}
-
 	return
 }
 
@@ -137,15 +154,15 @@ func reduceMovePaths(readerItem utils.SearchBasedContentItem, cr *content.Conten
 	return utils.ReduceTopChainDirResult(readerItem, cr)
 }
 
-func (mc *MoveCopyService) moveFiles(reader *content.ContentReader, params []MoveCopyParams) (successCount, failedCount int, err error) {
+func (mc *MoveCopyService) moveFiles(reader *content.ContentReader, params []MoveCopyParams) (*MoveCopyReport, error) {
 	promptMoveCopyMessage(reader, mc.moveType)
 	producerConsumer := parallel.NewBounedRunner(mc.GetThreads(), false)
 	errorsQueue := clientutils.NewErrorsQueue(1)
-	result := *utils.NewResult(mc.Threads)
+	resultsPerThread := make([][]MoveCopyItemResult, max(mc.GetThreads(), 1))
 	go func() {
 		defer producerConsumer.Done()
 		for resultItem := new(MoveResultItem); reader.NextRecord(resultItem) == nil; resultItem = new(MoveResultItem) {
-			fileMoveCopyHandlerFunc := mc.createMoveCopyFileHandlerFunc(&result)
+			fileMoveCopyHandlerFunc := mc.createMoveCopyFileHandlerFunc(resultsPerThread)
 			_, _ = producerConsumer.AddTaskWithError(fileMoveCopyHandlerFunc(resultItem.ResultItem, &params[resultItem.FileSpecId]),
 				errorsQueue.AddError)
 		}
@@ -153,54 +170,100 @@ func (mc *MoveCopyService) moveFiles(reader *content.ContentReader, params []Mov
 			errorsQueue.AddError(err)
 		}
 	}()
-	return mc.performTasks(producerConsumer, errorsQueue, result)
-}
-
-func (mc *MoveCopyService) performTasks(consumer parallel.Runner, errorsQueue *clientutils.ErrorsQueue, result utils.Result) (totalSuccess, totalFails int, err error) {
-	consumer.Run()
-	err = errorsQueue.GetError()
-	totalSuccess = utils.SumIntArray(result.SuccessCount)
-	totalFails = utils.SumIntArray(result.TotalCount) - totalSuccess
-	return
+	producerConsumer.Run()
+	return newMoveCopyReport(resultsPerThread, mc.DryRun), errorsQueue.GetError()
 }
 
 type fileMoveCopyHandlerFunc func(utils.ResultItem, *MoveCopyParams) parallel.TaskFunc
 
-func (mc *MoveCopyService) createMoveCopyFileHandlerFunc(result *utils.Result) fileMoveCopyHandlerFunc {
+func (mc *MoveCopyService) createMoveCopyFileHandlerFunc(resultsPerThread [][]MoveCopyItemResult) fileMoveCopyHandlerFunc {
 	return func(resultItem utils.ResultItem, params *MoveCopyParams) parallel.TaskFunc {
 		return func(threadId int) error {
-			result.TotalCount[threadId]++
-			logMsgPrefix := clientutils.GetLogMsgPrefix(threadId, mc.DryRun)
-
-			// Get destination path.
-			destFile, err := getDestinationPath(params.GetFile().Target, params.GetFile().Pattern, resultItem.Path,
-				resultItem.GetItemRelativePath(), params.IsFlat())
+			itemResult, err := mc.moveCopyItem(threadId, resultItem, params)
 			if err != nil {
-				return err
-			}
-			if strings.HasSuffix(destFile, "/") {
-				if resultItem.Type != string(utils.Folder) {
-					destFile += resultItem.Name
-				} else {
-					_, err = mc.createPathForMoveAction(destFile, logMsgPrefix)
-					if err != nil {
-						return err
-					}
-				}
+				itemResult.Status = MoveCopyItemFailed
+				itemResult.Err = err
 			}
+			resultsPerThread[threadId] = append(resultsPerThread[threadId], itemResult)
+			return err
+		}
+	}
+}
+
+func (mc *MoveCopyService) moveCopyItem(threadId int, resultItem utils.ResultItem, params *MoveCopyParams) (MoveCopyItemResult, error) {
+	logMsgPrefix := clientutils.GetLogMsgPrefix(threadId, mc.DryRun)
+	itemResult := MoveCopyItemResult{Source: resultItem.GetItemRelativePath()}
 
-			// Perform move/copy.
-			success, err := mc.moveOrCopyFile(resultItem.GetItemRelativePath(), destFile, logMsgPrefix)
+	// Get destination path.
+	destFile, err := getDestinationPath(params.GetFile().Target, params.GetFile().Pattern, resultItem.Path,
+		resultItem.GetItemRelativePath(), params.IsFlat())
+	if err != nil {
+		return itemResult, err
+	}
+	isFolder := resultItem.Type == string(utils.Folder)
+	if strings.HasSuffix(destFile, "/") {
+		if !isFolder {
+			destFile += resultItem.Name
+		} else {
+			_, err = mc.createPathForMoveAction(destFile, logMsgPrefix)
 			if err != nil {
-				log.Error(err)
-				return err
+				return itemResult, err
 			}
-			if success {
-				result.SuccessCount[threadId]++
+		}
+	}
+	itemResult.Target = destFile
+
+	// Folders are merged into existing folders, so only files may conflict.
+	if !isFolder && params.ConflictPolicy != ConflictOverwrite && params.ConflictPolicy != "" {
+		exists, err := mc.isFileExists(destFile)
+		if err != nil {
+			return itemResult, err
+		}
+		if exists {
+			if params.ConflictPolicy == ConflictFail {
+				// Only this item fails, and the conflict is recorded in the report.
+				log.Error(logMsgPrefix+"Failed", moveMsgs[mc.moveType].MovingMsg, itemResult.Source+", since the target already exists:", destFile)
+				itemResult.Status = MoveCopyItemFailed
+				itemResult.Err = errorutils.CheckErrorf("the target '%s' of '%s' already exists", destFile, itemResult.Source)
+				return itemResult, nil
 			}
-			return nil
+			log.Info(logMsgPrefix+"Skipping", itemResult.Source+", since the target already exists:", destFile)
+			itemResult.Status = MoveCopyItemSkipped
+			return itemResult, nil
 		}
 	}
+
+	// Perform move/copy.
+	responseErr, err := mc.moveOrCopyFile(resultItem.GetItemRelativePath(), destFile, logMsgPrefix)
+	if err != nil {
+		log.Error(err)
+		return itemResult, err
+	}
+	itemResult.Status = MoveCopyItemSucceeded
+	if responseErr != nil {
+		itemResult.Status = MoveCopyItemFailed
+		itemResult.Err = responseErr
+	}
+	return itemResult, nil
+}
+
+func (mc *MoveCopyService) isFileExists(relativePath string) (bool, error) {
+	requestFullUrl, err := clientutils.BuildUrl(mc.GetArtifactoryDetails().GetUrl(), path.Join(StorageRestApi, relativePath), nil)
+	if err != nil {
+		return false, err
+	}
+	httpClientsDetails := mc.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := mc.client.SendGet(requestFullUrl, true, &httpClientsDetails)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 // Create the destination path of the move/copy.
@@ -227,7 +290,8 @@ func getDestinationPath(specTarget, specPattern, sourceItemPath, sourceItemRelat
 	return destFile, nil
 }
 
-func (mc *MoveCopyService) moveOrCopyFile(sourcePath, destPath, logMsgPrefix string) (bool, error) {
+// Returns a response error if Artifactory failed moving or copying the file.
+func (mc *MoveCopyService) moveOrCopyFile(sourcePath, destPath, logMsgPrefix string) (responseErr, err error) {
 	message := moveMsgs[mc.moveType].MovingMsg + " artifact: " + sourcePath + " to: " + destPath
 	moveUrl := mc.GetArtifactoryDetails().GetUrl()
 	restApi := path.Join("api", string(mc.moveType), sourcePath)
@@ -240,21 +304,21 @@ func (mc *MoveCopyService) moveOrCopyFile(sourcePath, destPath, logMsgPrefix str
 	}
 	requestFullUrl, err := clientutils.BuildUrl(moveUrl, restApi, params)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	httpClientsDetails := mc.GetArtifactoryDetails().CreateHttpClientDetails()
 
 	resp, body, err := mc.client.SendPost(requestFullUrl, nil, &httpClientsDetails)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
-		log.Error(err)
+	if responseErr = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); responseErr != nil {
+		log.Error(responseErr)
 	}
 
 	log.Debug(logMsgPrefix+"Artifactory response:", resp.Status)
-	return resp.StatusCode == http.StatusOK, nil
+	return responseErr, nil
 }
 
 // Create destPath in Artifactory
@@ -336,6 +400,76 @@ type MoveType string
 type MoveCopyParams struct {
 	*utils.CommonParams
 	Flat bool
+	// What to do when a target file already exists. Defaults to ConflictOverwrite.
+	ConflictPolicy ConflictPolicy
+}
+
+type ConflictPolicy string
+
+const (
+	// Overwrite the existing target file.
+	ConflictOverwrite ConflictPolicy = "overwrite"
+	// Leave the existing target file and the source file as they are.
+	ConflictSkip ConflictPolicy = "skip"
+	// Fail the move or copy of the file. The failure is recorded in the report, and the other files are still moved or copied.
+	ConflictFail ConflictPolicy = "fail"
+)
+
+func (cp ConflictPolicy) Validate() error {
+	switch cp {
+	case "", ConflictOverwrite, ConflictSkip, ConflictFail:
+		return nil
+	default:
+		return errorutils.CheckErrorf("unsupported conflict policy '%s', expected one of: %s, %s, %s", cp, ConflictOverwrite, ConflictSkip, ConflictFail)
+	}
+}
+
+type MoveCopyItemStatus string
+
+const (
+	MoveCopyItemSucceeded MoveCopyItemStatus = "succeeded"
+	MoveCopyItemSkipped   MoveCopyItemStatus = "skipped"
+	MoveCopyItemFailed    MoveCopyItemStatus = "failed"
+)
+
+type MoveCopyItemResult struct {
+	Source string
+	// Empty if the target couldn't be resolved.
+	Target string
+	Status MoveCopyItemStatus
+	// The reason of the failure, if the status is failed.
+	Err error
+}
+
+type MoveCopyReport struct {
+	// The results of all the items, sorted by source path.
+	Items     []MoveCopyItemResult
+	Succeeded int
+	Skipped   int
+	Failed    int
+	// If true, nothing was moved or copied, and the report is only a preview.
+	DryRun bool
+}
+
+func newMoveCopyReport(resultsPerThread [][]MoveCopyItemResult, dryRun bool) *MoveCopyReport {
+	report := &MoveCopyReport{DryRun: dryRun}
+	for _, threadResults := range resultsPerThread {
+		report.Items = append(report.Items, threadResults...)
+	}
+	sort.Slice(report.Items, func(i, j int) bool {
+		return report.Items[i].Source < report.Items[j].Source
+	})
+	for _, item := range report.Items {
+		switch item.Status {
+		case MoveCopyItemSucceeded:
+			report.Succeeded++
+		case MoveCopyItemSkipped:
+			report.Skipped++
+		default:
+			report.Failed++
+		}
+	}
+	return report
 }
 
 // Tuple of a 'ResultItem' and its corresponding file-spec's index.
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConflictPolicyValidate(t *testing.T) {
+	for _, policy := range []ConflictPolicy{"", ConflictOverwrite, ConflictSkip, ConflictFail} {
+		assert.NoError(t, policy.Validate())
+	}
+	assert.Error(t, ConflictPolicy("rename").Validate())
+}
+
+func TestNewMoveCopyReport(t *testing.T) {
+	report := newMoveCopyReport([][]MoveCopyItemResult{
+		{{Source: "repo/c", Status: MoveCopyItemFailed, Err: errors.New("failed")}, {Source: "repo/a", Status: MoveCopyItemSucceeded}},
+		{{Source: "repo/b", Status: MoveCopyItemSkipped}},
+	}, true)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, 1, report.Succeeded)
+	assert.Equal(t, 1, report.Skipped)
+	assert.Equal(t, 1, report.Failed)
+	if assert.Len(t, report.Items, 3) {
+		assert.Equal(t, "repo/a", report.Items[0].Source)
+		assert.Equal(t, "repo/b", report.Items[1].Source)
+		assert.Equal(t, "repo/c", report.Items[2].Source)
+	}
+}
+
+func TestMoveCopyItemConflictPolicy(t *testing.T) {
+	var copied []string
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/storage/target/existing.jar":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"repo":"target","path":"/existing.jar"}`))
+		case r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodPost:
+			copied = append(copied, r.URL.Path+"?to="+r.URL.Query().Get("to"))
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+	copyService := NewMoveCopyService(serviceDetails, client, COPY)
+
+	params := NewMoveCopyParams()
+	params.Pattern = "source/*"
+	params.Target = "target/"
+	params.Flat = true
+	existing := utils.ResultItem{Repo: "source", Path: ".", Name: "existing.jar", Type: "file"}
+	created := utils.ResultItem{Repo: "source", Path: ".", Name: "new.jar", Type: "file"}
+
+	params.ConflictPolicy = ConflictSkip
+	result, err := copyService.moveCopyItem(0, existing, &params)
+	assert.NoError(t, err)
+	assert.Equal(t, MoveCopyItemResult{Source: "source/existing.jar", Target: "target/existing.jar", Status: MoveCopyItemSkipped}, result)
+	result, err = copyService.moveCopyItem(0, created, &params)
+	assert.NoError(t, err)
+	assert.Equal(t, MoveCopyItemSucceeded, result.Status)
+
+	params.ConflictPolicy = ConflictFail
+	result, err = copyService.moveCopyItem(0, existing, &params)
+	assert.NoError(t, err)
+	assert.Equal(t, MoveCopyItemFailed, result.Status)
+	assert.ErrorContains(t, result.Err, "already exists")
+
+	params.ConflictPolicy = ConflictOverwrite
+	result, err = copyService.moveCopyItem(0, existing, &params)
+	assert.NoError(t, err)
+	assert.Equal(t, MoveCopyItemSucceeded, result.Status)
+
+	assert.Equal(t, []string{"/api/copy/source/new.jar?to=target/new.jar", "/api/copy/source/existing.jar?to=target/existing.jar"}, copied)
+}
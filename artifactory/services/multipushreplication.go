@@ -0,0 +1,112 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Manages multi-push replications, which replicate a local repository to several targets.
+type MultiPushReplicationService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	ArtDetails auth.ServiceDetails
+}
+
+func NewMultiPushReplicationService(client *jfroghttpclient.JfrogHttpClient) *MultiPushReplicationService {
+	return &MultiPushReplicationService{client: client}
+}
+
+func (mrs *MultiPushReplicationService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return mrs.client
+}
+
+func (mrs *MultiPushReplicationService) CreateMultiPushReplication(params MultiPushReplicationParams) error {
+	return mrs.performRequest(params, false)
+}
+
+// Replaces all the replication targets of the repository with the provided ones.
+func (mrs *MultiPushReplicationService) UpdateMultiPushReplication(params MultiPushReplicationParams) error {
+	return mrs.performRequest(params, true)
+}
+
+func (mrs *MultiPushReplicationService) performRequest(params MultiPushReplicationParams, isUpdate bool) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	content, err := json.Marshal(createMultiPushReplicationBody(params))
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpClientsDetails := mrs.ArtDetails.CreateHttpClientDetails()
+	utils.SetContentType("application/vnd.org.jfrog.artifactory.replications.MultipleReplicationConfigRequest+json", &httpClientsDetails.Headers)
+	var url = mrs.ArtDetails.GetUrl() + "api/replications/multiple/" + params.RepoKey
+	var resp *http.Response
+	var body []byte
+	var operationString string
+	if isUpdate {
+		log.Info("Updating multi-push replication...")
+		operationString = "updating"
+		resp, body, err = mrs.client.SendPost(url, content, &httpClientsDetails)
+	} else {
+		log.Info("Creating multi-push replication...")
+		operationString = "creating"
+		resp, body, err = mrs.client.SendPut(url, content, &httpClientsDetails)
+	}
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	log.Info("Done " + operationString + " multi-push replication.")
+	return nil
+}
+
+type MultiPushReplicationParams struct {
+	// Source replication repository.
+	RepoKey                string
+	CronExp                string
+	EnableEventReplication bool
+	// The replication targets. The RepoKey of each target is ignored, and the RepoKey above is used instead.
+	Replications []utils.ReplicationParams
+}
+
+func NewMultiPushReplicationParams() MultiPushReplicationParams {
+	return MultiPushReplicationParams{}
+}
+
+func (mrp *MultiPushReplicationParams) Validate() error {
+	if mrp.RepoKey == "" {
+		return errorutils.CheckErrorf("a repository key is required for a multi-push replication")
+	}
+	if len(mrp.Replications) == 0 {
+		return errorutils.CheckErrorf("at least one replication target is required for a multi-push replication of '%s'", mrp.RepoKey)
+	}
+	for _, replication := range mrp.Replications {
+		if replication.Url == "" {
+			return errorutils.CheckErrorf("a URL is required for each target of a multi-push replication of '%s'", mrp.RepoKey)
+		}
+	}
+	return nil
+}
+
+type multiPushReplicationBody struct {
+	CronExp                string                        `json:"cronExp"`
+	EnableEventReplication bool                          `json:"enableEventReplication"`
+	Replications           []utils.UpdateReplicationBody `json:"replications"`
+}
+
+func createMultiPushReplicationBody(params MultiPushReplicationParams) *multiPushReplicationBody {
+	body := &multiPushReplicationBody{CronExp: params.CronExp, EnableEventReplication: params.EnableEventReplication}
+	for _, replication := range params.Replications {
+		replication.RepoKey = params.RepoKey
+		body.Replications = append(body.Replications, *utils.CreateUpdateReplicationBody(replication))
+	}
+	return body
+}
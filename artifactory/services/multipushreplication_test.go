@@ -0,0 +1,33 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiPushReplicationParamsValidate(t *testing.T) {
+	params := NewMultiPushReplicationParams()
+	assert.ErrorContains(t, params.Validate(), "repository key is required")
+	params.RepoKey = "my-repository"
+	assert.ErrorContains(t, params.Validate(), "at least one replication target")
+	params.Replications = []utils.ReplicationParams{{Url: "https://a.jfrog.io/artifactory/repo"}, {}}
+	assert.ErrorContains(t, params.Validate(), "URL is required")
+	params.Replications[1].Url = "https://b.jfrog.io/artifactory/repo"
+	assert.NoError(t, params.Validate())
+}
+
+func TestCreateMultiPushReplicationBody(t *testing.T) {
+	params := NewMultiPushReplicationParams()
+	params.RepoKey = "my-repository"
+	params.CronExp = "0 0 12 * * ?"
+	params.Replications = []utils.ReplicationParams{{Url: "https://a.jfrog.io/artifactory/repo", RepoKey: "other"}, {Url: "https://b.jfrog.io/artifactory/repo"}}
+	body := createMultiPushReplicationBody(params)
+	assert.Equal(t, "0 0 12 * * ?", body.CronExp)
+	if assert.Len(t, body.Replications, 2) {
+		assert.Equal(t, "my-repository", body.Replications[0].RepoKey)
+		assert.Equal(t, "my-repository", body.Replications[1].RepoKey)
+		assert.Equal(t, "https://b.jfrog.io/artifactory/repo", body.Replications[1].URL)
+	}
+}
@@ -0,0 +1,307 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	npmApi = "api/npm/"
+	// The dist-tag npm installs by default. It can be moved, but not removed.
+	NpmLatestTag = "latest"
+)
+
+// Manages npm packages through the npm registry API of Artifactory.
+type NpmService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+	DryRun     bool
+}
+
+func NewNpmService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *NpmService {
+	return &NpmService{artDetails: &artDetails, client: client}
+}
+
+func (ns *NpmService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *ns.artDetails
+}
+
+func (ns *NpmService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return ns.client
+}
+
+func (ns *NpmService) IsDryRun() bool {
+	return ns.DryRun
+}
+
+// The metadata document of an npm package, with all its versions.
+type NpmPackageMetadata struct {
+	Name        string                        `json:"name,omitempty"`
+	Description string                        `json:"description,omitempty"`
+	DistTags    map[string]string             `json:"dist-tags,omitempty"`
+	Versions    map[string]NpmVersionMetadata `json:"versions,omitempty"`
+	// The publish time of each version, and the "created" and "modified" times of the package.
+	Time map[string]string `json:"time,omitempty"`
+}
+
+type NpmVersionMetadata struct {
+	Name        string `json:"name,omitempty"`
+	Version     string `json:"version,omitempty"`
+	Description string `json:"description,omitempty"`
+	// The deprecation message, or empty if the version isn't deprecated.
+	Deprecated      string            `json:"deprecated,omitempty"`
+	Dependencies    map[string]string `json:"dependencies,omitempty"`
+	DevDependencies map[string]string `json:"devDependencies,omitempty"`
+	Dist            NpmDist           `json:"dist,omitempty"`
+}
+
+type NpmDist struct {
+	Tarball   string `json:"tarball,omitempty"`
+	Shasum    string `json:"shasum,omitempty"`
+	Integrity string `json:"integrity,omitempty"`
+}
+
+type NpmDeprecateParams struct {
+	RepoKey string
+	// The package name, including its scope, e.g. "@acme/utils".
+	PackageName string
+	// The versions to deprecate. Deprecates all the versions if empty.
+	Versions []string
+	// The deprecation message shown by npm when the version is installed.
+	Message string
+}
+
+func NewNpmDeprecateParams(repoKey, packageName, message string, versions ...string) NpmDeprecateParams {
+	return NpmDeprecateParams{RepoKey: repoKey, PackageName: packageName, Message: message, Versions: versions}
+}
+
+// Returns the metadata of a package, or nil if it doesn't exist.
+func (ns *NpmService) GetPackage(repoKey, packageName string) (*NpmPackageMetadata, error) {
+	body, err := ns.getPackageDocument(repoKey, packageName)
+	if err != nil || body == nil {
+		return nil, err
+	}
+	metadata := &NpmPackageMetadata{}
+	return metadata, errorutils.CheckError(json.Unmarshal(body, metadata))
+}
+
+// Returns the dist-tags of a package, mapped to their versions.
+func (ns *NpmService) GetDistTags(repoKey, packageName string) (map[string]string, error) {
+	requestUrl, err := ns.buildDistTagsUrl(repoKey, packageName, "")
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := ns.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := ns.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	distTags := make(map[string]string)
+	return distTags, errorutils.CheckError(json.Unmarshal(body, &distTags))
+}
+
+// Points the dist-tag to the version, creating the tag if it doesn't exist.
+func (ns *NpmService) SetDistTag(repoKey, packageName, tag, version string) error {
+	if tag == "" || version == "" {
+		return errorutils.CheckErrorf("a dist-tag and a version are required")
+	}
+	requestUrl, err := ns.buildDistTagsUrl(repoKey, packageName, tag)
+	if err != nil {
+		return err
+	}
+	if ns.DryRun {
+		log.Info(fmt.Sprintf("[Dry run] Setting the dist-tag '%s' of %s to %s", tag, packageName, version))
+		return nil
+	}
+	log.Info(fmt.Sprintf("Setting the dist-tag '%s' of %s to %s...", tag, packageName, version))
+	content, err := json.Marshal(version)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpClientsDetails := ns.GetArtifactoryDetails().CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	resp, body, err := ns.client.SendPut(requestUrl, content, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+// Removes a dist-tag. The 'latest' tag can't be removed.
+func (ns *NpmService) DeleteDistTag(repoKey, packageName, tag string) error {
+	if tag == "" {
+		return errorutils.CheckErrorf("a dist-tag is required")
+	}
+	if tag == NpmLatestTag {
+		return errorutils.CheckErrorf("the '%s' dist-tag can't be removed", NpmLatestTag)
+	}
+	requestUrl, err := ns.buildDistTagsUrl(repoKey, packageName, tag)
+	if err != nil {
+		return err
+	}
+	if ns.DryRun {
+		log.Info(fmt.Sprintf("[Dry run] Removing the dist-tag '%s' of %s", tag, packageName))
+		return nil
+	}
+	log.Info(fmt.Sprintf("Removing the dist-tag '%s' of %s...", tag, packageName))
+	httpClientsDetails := ns.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, err := ns.client.SendDelete(requestUrl, nil, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+// Deprecates versions of a package. An empty message undeprecates them.
+// Returns the versions whose deprecation message was changed.
+func (ns *NpmService) Deprecate(params NpmDeprecateParams) ([]string, error) {
+	body, err := ns.getPackageDocument(params.RepoKey, params.PackageName)
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		return nil, errorutils.CheckErrorf("package '%s' was not found in repository '%s'", params.PackageName, params.RepoKey)
+	}
+	// The document is updated as a generic JSON object, so that fields which aren't modeled are sent back as is.
+	var document map[string]interface{}
+	if err = json.Unmarshal(body, &document); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	versions, _ := document["versions"].(map[string]interface{})
+	for _, version := range params.Versions {
+		if _, ok := versions[version]; !ok {
+			return nil, errorutils.CheckErrorf("version '%s' of package '%s' was not found", version, params.PackageName)
+		}
+	}
+	changed := SetNpmDeprecation(versions, params.Versions, params.Message)
+	if len(changed) == 0 {
+		log.Info("No versions of " + params.PackageName + " need to be changed.")
+		return changed, nil
+	}
+	action := "Deprecating"
+	if params.Message == "" {
+		action = "Undeprecating"
+	}
+	if ns.DryRun {
+		log.Info(fmt.Sprintf("[Dry run] %s %d versions of %s", action, len(changed), params.PackageName))
+		return changed, nil
+	}
+	log.Info(fmt.Sprintf("%s %d versions of %s...", action, len(changed), params.PackageName))
+	content, err := json.Marshal(document)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	requestUrl, err := ns.buildPackageUrl(params.RepoKey, params.PackageName)
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := ns.GetArtifactoryDetails().CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	resp, body, err := ns.client.SendPut(requestUrl, content, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return changed, nil
+}
+
+// Undeprecates versions of a package, or all its versions if none are given.
+func (ns *NpmService) Undeprecate(repoKey, packageName string, versions ...string) ([]string, error) {
+	return ns.Deprecate(NewNpmDeprecateParams(repoKey, packageName, "", versions...))
+}
+
+// Sets the deprecation message of the versions in the 'versions' object of a package document, or of all its
+// versions if none are given. An empty message removes the deprecation. Returns the changed versions, sorted.
+func SetNpmDeprecation(versions map[string]interface{}, selected []string, message string) []string {
+	var changed []string
+	for version, value := range versions {
+		versionDocument, ok := value.(map[string]interface{})
+		if !ok || (len(selected) > 0 && !slices.Contains(selected, version)) {
+			continue
+		}
+		current, _ := versionDocument["deprecated"].(string)
+		if current == message {
+			continue
+		}
+		if message == "" {
+			delete(versionDocument, "deprecated")
+		} else {
+			versionDocument["deprecated"] = message
+		}
+		changed = append(changed, version)
+	}
+	slices.Sort(changed)
+	return changed
+}
+
+// Returns nil if the package doesn't exist.
+func (ns *NpmService) getPackageDocument(repoKey, packageName string) ([]byte, error) {
+	requestUrl, err := ns.buildPackageUrl(repoKey, packageName)
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := ns.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := ns.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return body, nil
+}
+
+// The slash of scoped package names is escaped, e.g. "@acme%2Futils", so the name is appended after building the URL.
+func (ns *NpmService) buildPackageUrl(repoKey, packageName string) (string, error) {
+	if repoKey == "" || packageName == "" {
+		return "", errorutils.CheckErrorf("a repository and a package name are required")
+	}
+	repoUrl, err := clientutils.BuildUrl(ns.GetArtifactoryDetails().GetUrl(), npmApi+repoKey+"/", nil)
+	if err != nil {
+		return "", err
+	}
+	return repoUrl + url.PathEscape(packageName), nil
+}
+
+func (ns *NpmService) buildDistTagsUrl(repoKey, packageName, tag string) (string, error) {
+	if repoKey == "" || packageName == "" {
+		return "", errorutils.CheckErrorf("a repository and a package name are required")
+	}
+	repoUrl, err := clientutils.BuildUrl(ns.GetArtifactoryDetails().GetUrl(), npmApi+repoKey+"/-/package/", nil)
+	if err != nil {
+		return "", err
+	}
+	requestUrl := repoUrl + url.PathEscape(packageName) + "/dist-tags"
+	if tag != "" {
+		requestUrl += "/" + url.PathEscape(tag)
+	}
+	return requestUrl, nil
+}
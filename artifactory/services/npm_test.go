@@ -0,0 +1,99 @@
+package services
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testNpmPackage = `{"name":"@acme/utils","_rev":"3-abc","dist-tags":{"latest":"1.1.0","beta":"2.0.0-beta"},` +
+	`"versions":{"1.0.0":{"name":"@acme/utils","version":"1.0.0","deprecated":"old"},"1.1.0":{"name":"@acme/utils","version":"1.1.0","dist":{"tarball":"t"}},` +
+	`"2.0.0-beta":{"name":"@acme/utils","version":"2.0.0-beta"}}}`
+
+func createNpmTestService(t *testing.T, handler http.HandlerFunc) *NpmService {
+	return NewNpmService(newTestServer(t, handler))
+}
+
+func TestNpmGetPackageAndDistTags(t *testing.T) {
+	var setTag string
+	npmService := createNpmTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "GET /api/npm/npm-local/@acme%2Futils":
+			_, _ = w.Write([]byte(testNpmPackage))
+		case "GET /api/npm/npm-local/-/package/@acme%2Futils/dist-tags":
+			_, _ = w.Write([]byte(`{"latest":"1.1.0","beta":"2.0.0-beta"}`))
+		case "PUT /api/npm/npm-local/-/package/@acme%2Futils/dist-tags/next":
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.NoError(t, json.Unmarshal(body, &setTag))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	metadata, err := npmService.GetPackage("npm-local", "@acme/utils")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.1.0", metadata.DistTags[NpmLatestTag])
+	assert.Equal(t, "old", metadata.Versions["1.0.0"].Deprecated)
+	assert.Equal(t, "t", metadata.Versions["1.1.0"].Dist.Tarball)
+	metadata, err = npmService.GetPackage("npm-local", "missing")
+	assert.NoError(t, err)
+	assert.Nil(t, metadata)
+
+	distTags, err := npmService.GetDistTags("npm-local", "@acme/utils")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"latest": "1.1.0", "beta": "2.0.0-beta"}, distTags)
+
+	assert.NoError(t, npmService.SetDistTag("npm-local", "@acme/utils", "next", "2.0.0-beta"))
+	assert.Equal(t, "2.0.0-beta", setTag)
+	assert.ErrorContains(t, npmService.DeleteDistTag("npm-local", "@acme/utils", NpmLatestTag), "can't be removed")
+}
+
+func TestNpmDeprecate(t *testing.T) {
+	var updated map[string]interface{}
+	npmService := createNpmTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/npm/npm-local/@acme%2Futils", r.URL.EscapedPath())
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(testNpmPackage))
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(body, &updated))
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	changed, err := npmService.Deprecate(NewNpmDeprecateParams("npm-local", "@acme/utils", "use 2.x", "1.1.0"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1.1.0"}, changed)
+	// Fields which aren't modeled are preserved.
+	assert.Equal(t, "3-abc", updated["_rev"])
+	versions := updated["versions"].(map[string]interface{})
+	assert.Equal(t, "use 2.x", versions["1.1.0"].(map[string]interface{})["deprecated"])
+	assert.Equal(t, "old", versions["1.0.0"].(map[string]interface{})["deprecated"])
+
+	updated = nil
+	changed, err = npmService.Undeprecate("npm-local", "@acme/utils")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1.0.0"}, changed)
+	assert.NotContains(t, updated["versions"].(map[string]interface{})["1.0.0"], "deprecated")
+
+	_, err = npmService.Deprecate(NewNpmDeprecateParams("npm-local", "@acme/utils", "bad", "9.9.9"))
+	assert.ErrorContains(t, err, "was not found")
+}
+
+func TestNpmDeprecateDryRun(t *testing.T) {
+	npmService := createNpmTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = w.Write([]byte(testNpmPackage))
+	})
+	npmService.DryRun = true
+
+	changed, err := npmService.Deprecate(NewNpmDeprecateParams("npm-local", "@acme/utils", "old"))
+	assert.NoError(t, err)
+	// 1.0.0 already has the same message.
+	assert.Equal(t, []string{"1.1.0", "2.0.0-beta"}, changed)
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type packageIndexApi struct {
+	// The reindex endpoint, with a placeholder for the repository key.
+	restApi string
+	// Part of the type of the reindex tasks.
+	taskType string
+}
+
+// The package types whose index can be recalculated, mapped to their reindex endpoints.
+var packageIndexApis = map[string]packageIndexApi{
+	"nuget": {restApi: "api/nuget/repositories/%s/reindex", taskType: "Nuget"},
+	"pypi":  {restApi: "api/pypi/%s/reindex", taskType: "Pypi"},
+	"gems":  {restApi: "api/gems/%s/reindex", taskType: "Gems"},
+	"cran":  {restApi: "api/cran/reindex/%s", taskType: "Cran"},
+	"conda": {restApi: "api/conda/%s/reindex", taskType: "Conda"},
+	"helm":  {restApi: "api/helm/%s/reindex", taskType: "Helm"},
+}
+
+// Recalculates the indexes of local repositories, for example after bulk uploads, which may leave the index
+// incomplete until the periodic calculation runs.
+type PackageIndexService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+	DryRun     bool
+}
+
+func NewPackageIndexService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *PackageIndexService {
+	return &PackageIndexService{artDetails: &artDetails, client: client}
+}
+
+func (pis *PackageIndexService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *pis.artDetails
+}
+
+func (pis *PackageIndexService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return pis.client
+}
+
+func (pis *PackageIndexService) IsDryRun() bool {
+	return pis.DryRun
+}
+
+type RecalculateIndexParams struct {
+	// One of: nuget, pypi, gems, cran, conda or helm.
+	PackageType string
+	// The key of the local repository.
+	RepoKey string
+	// If true, waits until the reindex tasks finish.
+	Wait bool
+	// The maximum time to wait. Defaults to 60 minutes.
+	Timeout time.Duration
+	// The time to wait between status checks. Defaults to 15 seconds.
+	PollingInterval time.Duration
+}
+
+func NewRecalculateIndexParams(packageType, repoKey string) RecalculateIndexParams {
+	return RecalculateIndexParams{PackageType: packageType, RepoKey: repoKey}
+}
+
+func (rip RecalculateIndexParams) Validate() error {
+	if rip.RepoKey == "" {
+		return errorutils.CheckErrorf("a repository key is required")
+	}
+	if _, ok := packageIndexApis[strings.ToLower(rip.PackageType)]; !ok {
+		return errorutils.CheckErrorf("recalculating the index of %s repositories is not supported", rip.PackageType)
+	}
+	return nil
+}
+
+// Triggers the recalculation of the repository index. The calculation runs as a background task, which is waited
+// for if requested.
+func (pis *PackageIndexService) RecalculateIndex(params RecalculateIndexParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	indexApi := packageIndexApis[strings.ToLower(params.PackageType)]
+	requestFullUrl, err := clientutils.BuildUrl(pis.GetArtifactoryDetails().GetUrl(), fmt.Sprintf(indexApi.restApi, params.RepoKey), nil)
+	if err != nil {
+		return err
+	}
+	if pis.DryRun {
+		log.Info(fmt.Sprintf("[Dry run] Recalculating the %s index of '%s'", params.PackageType, params.RepoKey))
+		return nil
+	}
+	log.Info(fmt.Sprintf("Recalculating the %s index of '%s'...", params.PackageType, params.RepoKey))
+	var waitParams *WaitForTasksParams
+	if params.Wait {
+		waitParams = &WaitForTasksParams{TypeContains: indexApi.taskType, Timeout: params.Timeout, PollingInterval: params.PollingInterval}
+	}
+	return triggerBackgroundTasks(*pis.artDetails, pis.client, requestFullUrl, waitParams)
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecalculateIndex(t *testing.T) {
+	var requests []string
+	tasksPolls := 0
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/tasks" {
+			tasksPolls++
+			// The task starts only after it's triggered, and finishes after a single poll.
+			state := TaskStateStopped
+			if len(requests) > 0 && tasksPolls == 2 {
+				state = TaskStateRunning
+			}
+			// Tasks of other types aren't waited for.
+			_, _ = w.Write([]byte(`{"tasks":[{"id":"1","type":"org.artifactory.PypiReindexJob","state":"` + state + `"},` +
+				`{"id":"2","type":"org.artifactory.GarbageCollectorJob","state":"running"}]}`))
+			return
+		}
+		requests = append(requests, r.Method+" "+r.URL.Path)
+	})
+	indexService := NewPackageIndexService(serviceDetails, client)
+
+	params := NewRecalculateIndexParams("PyPI", "pypi-local")
+	params.Wait = true
+	params.Timeout = time.Second
+	params.PollingInterval = time.Millisecond
+	assert.NoError(t, indexService.RecalculateIndex(params))
+	assert.Equal(t, 3, tasksPolls)
+
+	for _, packageType := range []string{"nuget", "gems", "cran", "conda", "helm"} {
+		assert.NoError(t, indexService.RecalculateIndex(NewRecalculateIndexParams(packageType, packageType+"-local")))
+	}
+	assert.Equal(t, []string{
+		"POST /api/pypi/pypi-local/reindex",
+		"POST /api/nuget/repositories/nuget-local/reindex",
+		"POST /api/gems/gems-local/reindex",
+		"POST /api/cran/reindex/cran-local",
+		"POST /api/conda/conda-local/reindex",
+		"POST /api/helm/helm-local/reindex",
+	}, requests)
+
+	assert.ErrorContains(t, indexService.RecalculateIndex(NewRecalculateIndexParams("maven", "libs-local")), "not supported")
+	indexService.DryRun = true
+	assert.NoError(t, indexService.RecalculateIndex(NewRecalculateIndexParams("nuget", "nuget-local")))
+	assert.Len(t, requests, 6)
+}
@@ -0,0 +1,370 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// How the versions of a package are laid out in a repository of a package type.
+type packageVersionLayout struct {
+	// The properties of the package name and version, set by Artifactory when indexing the package's files.
+	nameProperty    string
+	versionProperty string
+	// For layouts without such properties, returns the folder of the package, in which each version is a subfolder.
+	packageFolder func(packageName string) (string, error)
+	// For folder layouts, returns true if the file marks its folder as a version, e.g. the POM of a Maven version.
+	// A subfolder of the package without such a file directly in it isn't a version, since it may be the folder of a
+	// nested package. If nil, any file directly in the subfolder marks it as a version.
+	isVersionMarker func(packageName, version, fileName string) bool
+	// If true, only the files directly in a version's folder belong to the version, and its subfolders belong to
+	// nested packages.
+	flatVersions bool
+}
+
+var packageVersionLayouts = map[string]packageVersionLayout{
+	"npm":    {nameProperty: "npm.name", versionProperty: "npm.version"},
+	"pypi":   {nameProperty: "pypi.name", versionProperty: "pypi.version"},
+	"nuget":  {nameProperty: "nuget.id", versionProperty: "nuget.version"},
+	"gems":   {nameProperty: "gem.name", versionProperty: "gem.version"},
+	"debian": {nameProperty: "deb.name", versionProperty: "deb.version"},
+	"rpm":    {nameProperty: "rpm.metadata.name", versionProperty: "rpm.metadata.version"},
+	"helm":   {nameProperty: "chart.name", versionProperty: "chart.version"},
+	"conda":  {nameProperty: "conda.name", versionProperty: "conda.version"},
+	"cran":   {nameProperty: "cran.name", versionProperty: "cran.version"},
+	"cargo":  {nameProperty: "cargo.name", versionProperty: "cargo.version"},
+	"conan":  {nameProperty: "conan.package.name", versionProperty: "conan.package.version"},
+	"maven":  {packageFolder: mavenPackageFolder, isVersionMarker: isMavenVersionMarker, flatVersions: true},
+	"gradle": {packageFolder: mavenPackageFolder, isVersionMarker: isMavenVersionMarker, flatVersions: true},
+	// The tags of an image, and the versions of a generic package, are subfolders of the image or package.
+	"docker":  {packageFolder: packageNameFolder, isVersionMarker: isDockerVersionMarker, flatVersions: true},
+	"oci":     {packageFolder: packageNameFolder, isVersionMarker: isDockerVersionMarker, flatVersions: true},
+	"generic": {packageFolder: packageNameFolder},
+}
+
+// Lists and deletes the versions of a package, regardless of its package type.
+type PackageVersionsService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+	DryRun     bool
+	Threads    int
+}
+
+func NewPackageVersionsService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *PackageVersionsService {
+	return &PackageVersionsService{artDetails: &artDetails, client: client}
+}
+
+func (pvs *PackageVersionsService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *pvs.artDetails
+}
+
+func (pvs *PackageVersionsService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return pvs.client
+}
+
+func (pvs *PackageVersionsService) IsDryRun() bool {
+	return pvs.DryRun
+}
+
+type PackageVersionsParams struct {
+	RepoKey string
+	// The name of the package as known to its package type, e.g. "@acme/lib" for npm, "org.acme:lib" for Maven or
+	// "acme/app" for Docker.
+	PackageName string
+	// The package type of the repository, e.g. "npm". Taken from the repository's configuration if empty.
+	PackageType string
+}
+
+func NewPackageVersionsParams(repoKey, packageName string) PackageVersionsParams {
+	return PackageVersionsParams{RepoKey: repoKey, PackageName: packageName}
+}
+
+type PackageVersion struct {
+	Version string
+	// The creation time of the version's earliest artifact.
+	Created string
+	// The total size of the version's artifacts.
+	Size  int64
+	Items []utils.ResultItem
+}
+
+// Returns the versions of the package, the most recently created first.
+func (pvs *PackageVersionsService) ListPackageVersions(params PackageVersionsParams) ([]PackageVersion, error) {
+	layout, err := pvs.getLayout(&params)
+	if err != nil {
+		return nil, err
+	}
+	return pvs.listVersions(params, layout)
+}
+
+func (pvs *PackageVersionsService) listVersions(params PackageVersionsParams, layout packageVersionLayout) ([]PackageVersion, error) {
+	query, err := CreatePackageVersionsAqlQuery(params, layout)
+	if err != nil {
+		return nil, err
+	}
+	items, err := pvs.searchItems(query)
+	if err != nil {
+		return nil, err
+	}
+	return GroupPackageVersions(items, params, layout)
+}
+
+// Deletes all the artifacts of a version of the package. Returns the number of deleted artifacts, or of the deleted
+// version folder for package types which keep each version in its own folder.
+func (pvs *PackageVersionsService) DeletePackageVersion(params PackageVersionsParams, version string) (int, error) {
+	if version == "" {
+		return 0, errorutils.CheckErrorf("a version is required")
+	}
+	layout, err := pvs.getLayout(&params)
+	if err != nil {
+		return 0, err
+	}
+	query, err := CreatePackageVersionsAqlQuery(params, layout)
+	if err != nil {
+		return 0, err
+	}
+	items, err := pvs.searchItems(query)
+	if err != nil {
+		return 0, err
+	}
+	versions, err := GroupPackageVersions(items, params, layout)
+	if err != nil {
+		return 0, err
+	}
+	var toDelete []utils.ResultItem
+	for _, packageVersion := range versions {
+		if packageVersion.Version != version {
+			continue
+		}
+		toDelete = packageVersion.Items
+		if layout.packageFolder != nil {
+			folder, err := layout.packageFolder(params.PackageName)
+			if err != nil {
+				return 0, err
+			}
+			// Delete the whole folder, so that no empty folder is left behind, unless it holds nested packages.
+			if !layout.flatVersions || !hasNestedItems(items, path.Join(folder, version)) {
+				toDelete = []utils.ResultItem{{Repo: params.RepoKey, Path: folder, Name: version, Type: string(utils.Folder)}}
+			}
+		}
+	}
+	if toDelete == nil {
+		return 0, errorutils.CheckErrorf("version '%s' of package '%s' wasn't found in repository '%s'", version, params.PackageName, params.RepoKey)
+	}
+	log.Info(fmt.Sprintf("Deleting version '%s' of package '%s' from repository '%s'...", version, params.PackageName, params.RepoKey))
+	return pvs.deleteItems(toDelete)
+}
+
+func (pvs *PackageVersionsService) getLayout(params *PackageVersionsParams) (packageVersionLayout, error) {
+	if params.RepoKey == "" || params.PackageName == "" {
+		return packageVersionLayout{}, errorutils.CheckErrorf("a repository key and a package name are required")
+	}
+	if params.PackageType == "" {
+		repositoriesService := NewRepositoriesService(pvs.client)
+		repositoriesService.ArtDetails = *pvs.artDetails
+		repoDetails := &RepositoryDetails{}
+		if err := repositoriesService.Get(params.RepoKey, repoDetails); err != nil {
+			return packageVersionLayout{}, err
+		}
+		params.PackageType = repoDetails.PackageType
+	}
+	layout, exists := packageVersionLayouts[strings.ToLower(params.PackageType)]
+	if !exists {
+		return packageVersionLayout{}, errorutils.CheckErrorf("listing the versions of '%s' packages is not supported", params.PackageType)
+	}
+	return layout, nil
+}
+
+func (pvs *PackageVersionsService) searchItems(query string) (items []utils.ResultItem, err error) {
+	reader, err := utils.ExecAqlSaveToFile(query, pvs)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	for item := new(utils.ResultItem); reader.NextRecord(item) == nil; item = new(utils.ResultItem) {
+		items = append(items, *item)
+	}
+	return items, reader.GetError()
+}
+
+func (pvs *PackageVersionsService) deleteItems(items []utils.ResultItem) (deleted int, err error) {
+	writer, err := content.NewContentWriter(content.DefaultKey, true, false)
+	if err != nil {
+		return 0, err
+	}
+	for _, item := range items {
+		writer.Write(item)
+	}
+	if err = writer.Close(); err != nil {
+		return 0, err
+	}
+	reader := content.NewContentReader(writer.GetFilePath(), content.DefaultKey)
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	deleteService := NewDeleteService(*pvs.artDetails, pvs.client)
+	deleteService.DryRun = pvs.DryRun
+	deleteService.Threads = pvs.Threads
+	return deleteService.DeleteFiles(reader)
+}
+
+// Creates the AQL query of the files of all the versions of the package.
+func CreatePackageVersionsAqlQuery(params PackageVersionsParams, layout packageVersionLayout) (string, error) {
+	conditions := []map[string]interface{}{
+		{"repo": params.RepoKey},
+		{"type": "file"},
+	}
+	include := `"repo","path","name","type","size","created"`
+	if layout.packageFolder != nil {
+		folder, err := layout.packageFolder(params.PackageName)
+		if err != nil {
+			return "", err
+		}
+		conditions = append(conditions, map[string]interface{}{"path": map[string]string{"$match": folder + "/*"}})
+	} else {
+		conditions = append(conditions, map[string]interface{}{"@" + layout.nameProperty: params.PackageName})
+		include += `,"@` + layout.versionProperty + `"`
+	}
+	criteria, err := json.Marshal(map[string]interface{}{"$and": conditions})
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return fmt.Sprintf(`items.find(%s).include(%s)`, criteria, include), nil
+}
+
+// Groups the files returned by the package's query by version. Returns the versions, the most recently created first.
+func GroupPackageVersions(items []utils.ResultItem, params PackageVersionsParams, layout packageVersionLayout) ([]PackageVersion, error) {
+	var folder string
+	if layout.packageFolder != nil {
+		var err error
+		if folder, err = layout.packageFolder(params.PackageName); err != nil {
+			return nil, err
+		}
+	}
+	versions := make(map[string]*PackageVersion)
+	for _, item := range items {
+		version := getItemPackageVersion(item, folder, layout)
+		if version == "" {
+			continue
+		}
+		packageVersion, exists := versions[version]
+		if !exists {
+			packageVersion = &PackageVersion{Version: version, Created: item.Created}
+			versions[version] = packageVersion
+		}
+		if isEarlierArtifactoryDate(item.Created, packageVersion.Created) {
+			packageVersion.Created = item.Created
+		}
+		packageVersion.Size += item.Size
+		packageVersion.Items = append(packageVersion.Items, item)
+	}
+	result := make([]PackageVersion, 0, len(versions))
+	for _, packageVersion := range versions {
+		if layout.packageFolder != nil && !hasVersionMarker(*packageVersion, params.PackageName, folder, layout) {
+			continue
+		}
+		result = append(result, *packageVersion)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if isEarlierArtifactoryDate(result[j].Created, result[i].Created) {
+			return true
+		}
+		if isEarlierArtifactoryDate(result[i].Created, result[j].Created) {
+			return false
+		}
+		return result[i].Version > result[j].Version
+	})
+	return result, nil
+}
+
+// Returns the version of a file of the package, or an empty string if it isn't part of a version.
+func getItemPackageVersion(item utils.ResultItem, folder string, layout packageVersionLayout) string {
+	if layout.packageFolder == nil {
+		for _, property := range item.Properties {
+			if property.Key == layout.versionProperty {
+				return property.Value
+			}
+		}
+		return ""
+	}
+	relativePath, found := strings.CutPrefix(item.Path, folder+"/")
+	if !found {
+		return ""
+	}
+	version, subfolder, _ := strings.Cut(relativePath, "/")
+	if layout.flatVersions && subfolder != "" {
+		return ""
+	}
+	return version
+}
+
+// Returns true if a file directly in the version's folder marks it as a version.
+func hasVersionMarker(packageVersion PackageVersion, packageName, folder string, layout packageVersionLayout) bool {
+	versionFolder := path.Join(folder, packageVersion.Version)
+	for _, item := range packageVersion.Items {
+		if item.Path == versionFolder && (layout.isVersionMarker == nil || layout.isVersionMarker(packageName, packageVersion.Version, item.Name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the items include files in the subfolders of the folder.
+func hasNestedItems(items []utils.ResultItem, folder string) bool {
+	for _, item := range items {
+		if strings.HasPrefix(item.Path, folder+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// A Maven version holds the POM of the artifact. The POMs of snapshot versions are named by their timestamps,
+// e.g. "lib-1.0-20240101.120000-1.pom" in the "1.0-SNAPSHOT" folder.
+func isMavenVersionMarker(packageName, version, fileName string) bool {
+	_, artifactId, _ := strings.Cut(packageName, ":")
+	if baseVersion, isSnapshot := strings.CutSuffix(version, "-SNAPSHOT"); isSnapshot {
+		return strings.HasPrefix(fileName, artifactId+"-"+baseVersion+"-") && strings.HasSuffix(fileName, ".pom")
+	}
+	return fileName == artifactId+"-"+version+".pom"
+}
+
+// A tag holds the manifest of the image, or the manifest list of a multi-arch image.
+func isDockerVersionMarker(_, _, fileName string) bool {
+	return fileName == "manifest.json" || fileName == "list.manifest.json"
+}
+
+// Returns the folder of a Maven package, e.g. "org/acme/lib" for "org.acme:lib".
+func mavenPackageFolder(packageName string) (string, error) {
+	groupId, artifactId, found := strings.Cut(packageName, ":")
+	if !found || groupId == "" || artifactId == "" {
+		return "", errorutils.CheckErrorf("invalid Maven package name '%s', expected groupId:artifactId", packageName)
+	}
+	return path.Join(strings.ReplaceAll(groupId, ".", "/"), artifactId), nil
+}
+
+func packageNameFolder(packageName string) (string, error) {
+	return strings.Trim(packageName, "/"), nil
+}
+
+// Unknown dates are considered later than any valid date, so that versions with unknown dates are treated as new.
+func isEarlierArtifactoryDate(date, other string) bool {
+	parsed, err := parseArtifactoryDate(date)
+	if err != nil {
+		return false
+	}
+	otherParsed, err := parseArtifactoryDate(other)
+	return err != nil || parsed.Before(otherParsed)
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePackageVersionsAqlQuery(t *testing.T) {
+	query, err := CreatePackageVersionsAqlQuery(NewPackageVersionsParams("npm-local", "@acme/lib"), packageVersionLayouts["npm"])
+	assert.NoError(t, err)
+	assert.Equal(t, `items.find({"$and":[{"repo":"npm-local"},{"type":"file"},{"@npm.name":"@acme/lib"}]}).include("repo","path","name","type","size","created","@npm.version")`, query)
+
+	query, err = CreatePackageVersionsAqlQuery(NewPackageVersionsParams("maven-local", "org.acme:lib"), packageVersionLayouts["maven"])
+	assert.NoError(t, err)
+	assert.Equal(t, `items.find({"$and":[{"repo":"maven-local"},{"type":"file"},{"path":{"$match":"org/acme/lib/*"}}]}).include("repo","path","name","type","size","created")`, query)
+
+	_, err = CreatePackageVersionsAqlQuery(NewPackageVersionsParams("maven-local", "lib"), packageVersionLayouts["maven"])
+	assert.ErrorContains(t, err, "expected groupId:artifactId")
+}
+
+func TestGroupPackageVersions(t *testing.T) {
+	items := []utils.ResultItem{
+		{Repo: "maven-local", Path: "org/acme/lib/1.0", Name: "lib-1.0.jar", Size: 10, Created: "2024-01-01T00:00:00.000Z"},
+		{Repo: "maven-local", Path: "org/acme/lib/1.0", Name: "lib-1.0.pom", Size: 1, Created: "2023-12-31T00:00:00.000Z"},
+		{Repo: "maven-local", Path: "org/acme/lib/2.0-SNAPSHOT", Name: "lib-2.0-20240201.120000-1.jar", Size: 20, Created: "2024-02-01T00:00:00.000Z"},
+		{Repo: "maven-local", Path: "org/acme/lib/2.0-SNAPSHOT", Name: "lib-2.0-20240201.120000-1.pom", Created: "2024-02-01T00:00:00.000Z"},
+		{Repo: "maven-local", Path: "org/acme/library/1.0", Name: "library-1.0.jar", Created: "2024-03-01T00:00:00.000Z"},
+		// The nested package org.acme.lib:plugin isn't a version of org.acme:lib.
+		{Repo: "maven-local", Path: "org/acme/lib/plugin/1.0", Name: "plugin-1.0.pom", Created: "2024-04-01T00:00:00.000Z"},
+		// A folder without a POM isn't a version.
+		{Repo: "maven-local", Path: "org/acme/lib/3.0", Name: "lib-3.0.jar", Created: "2024-05-01T00:00:00.000Z"},
+	}
+	versions, err := GroupPackageVersions(items, NewPackageVersionsParams("maven-local", "org.acme:lib"), packageVersionLayouts["maven"])
+	assert.NoError(t, err)
+	if assert.Len(t, versions, 2) {
+		assert.Equal(t, "2.0-SNAPSHOT", versions[0].Version)
+		assert.Equal(t, "1.0", versions[1].Version)
+		assert.Equal(t, "2023-12-31T00:00:00.000Z", versions[1].Created)
+		assert.Equal(t, int64(11), versions[1].Size)
+		assert.Len(t, versions[1].Items, 2)
+	}
+
+	items = []utils.ResultItem{
+		{Repo: "npm-local", Path: "lib/-", Name: "lib-1.0.0.tgz", Created: "2024-01-01T00:00:00.000Z", Properties: []utils.Property{{Key: "npm.version", Value: "1.0.0"}}},
+		{Repo: "npm-local", Path: "lib/-", Name: "unknown.tgz"},
+	}
+	versions, err = GroupPackageVersions(items, NewPackageVersionsParams("npm-local", "lib"), packageVersionLayouts["npm"])
+	assert.NoError(t, err)
+	if assert.Len(t, versions, 1) {
+		assert.Equal(t, "1.0.0", versions[0].Version)
+	}
+}
+
+func TestPackageVersionsService(t *testing.T) {
+	var deleted []string
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/repositories/docker-local":
+			_, _ = w.Write([]byte(`{"key":"docker-local","rclass":"local","packageType":"docker"}`))
+		case r.URL.Path == "/api/search/aql":
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Contains(t, string(body), `"$match":"acme/app/*"`)
+			// The nested images acme/app/sub and acme/app/1.0/sub are not touched.
+			_, _ = w.Write([]byte(`{"results":[
+				{"repo":"docker-local","path":"acme/app/1.0","name":"manifest.json","type":"file","size":5,"created":"2024-01-01T00:00:00.000Z"},
+				{"repo":"docker-local","path":"acme/app/latest","name":"list.manifest.json","type":"file","size":5,"created":"2024-02-01T00:00:00.000Z"},
+				{"repo":"docker-local","path":"acme/app/sub/1.0","name":"manifest.json","type":"file","size":5,"created":"2024-03-01T00:00:00.000Z"},
+				{"repo":"docker-local","path":"acme/app/latest/sub/1.0","name":"manifest.json","type":"file","size":5,"created":"2024-03-01T00:00:00.000Z"}]}`))
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	packageVersionsService := NewPackageVersionsService(serviceDetails, client)
+	packageVersionsService.Threads = 1
+	params := NewPackageVersionsParams("docker-local", "acme/app")
+
+	versions, err := packageVersionsService.ListPackageVersions(params)
+	assert.NoError(t, err)
+	if assert.Len(t, versions, 2) {
+		assert.Equal(t, "latest", versions[0].Version)
+		assert.Equal(t, "1.0", versions[1].Version)
+	}
+	count, err := packageVersionsService.DeletePackageVersion(params, "1.0")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, []string{"/docker-local/acme/app/1.0/"}, deleted)
+
+	// The folder of a tag which holds a nested image isn't deleted, only the files of the tag.
+	deleted = nil
+	count, err = packageVersionsService.DeletePackageVersion(params, "latest")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, []string{"/docker-local/acme/app/latest/list.manifest.json"}, deleted)
+	_, err = packageVersionsService.DeletePackageVersion(params, "sub")
+	assert.ErrorContains(t, err, "wasn't found")
+
+	_, err = packageVersionsService.DeletePackageVersion(params, "2.0")
+	assert.ErrorContains(t, err, "wasn't found")
+	params.PackageType = "swift"
+	_, err = packageVersionsService.ListPackageVersions(params)
+	assert.ErrorContains(t, err, "not supported")
+}
@@ -2,7 +2,12 @@ package services
 
 import (
 	"encoding/json"
+	"maps"
 	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
@@ -10,6 +15,37 @@ import (
 	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
+const (
+	PermissionActionRead            = "read"
+	PermissionActionWrite           = "write"
+	PermissionActionAnnotate        = "annotate"
+	PermissionActionDelete          = "delete"
+	PermissionActionManage          = "manage"
+	PermissionActionManagedXrayMeta = "managedXrayMeta"
+	PermissionActionDistribute      = "distribute"
+
+	// Special values for the repositories of a permission target section.
+	PermissionAnyRepository       = "ANY"
+	PermissionAnyLocalRepository  = "ANY LOCAL"
+	PermissionAnyRemoteRepository = "ANY REMOTE"
+	// The only repository allowed in the build section.
+	PermissionBuildInfoRepository = "artifactory-build-info"
+)
+
+var permissionActions = []string{PermissionActionRead, PermissionActionWrite, PermissionActionAnnotate, PermissionActionDelete,
+	PermissionActionManage, PermissionActionManagedXrayMeta, PermissionActionDistribute}
+
+type PermissionTargetAction string
+
+const (
+	PermissionTargetCreate    PermissionTargetAction = "create"
+	PermissionTargetUpdate    PermissionTargetAction = "update"
+	PermissionTargetDelete    PermissionTargetAction = "delete"
+	PermissionTargetUnchanged PermissionTargetAction = "unchanged"
+	// A permission target which is in the prune scope, but isn't deleted because the pruning wasn't confirmed.
+	PermissionTargetPendingDelete PermissionTargetAction = "pending_delete"
+)
+
 type PermissionTargetService struct {
 	client     *jfroghttpclient.JfrogHttpClient
 	ArtDetails auth.ServiceDetails
@@ -130,11 +166,12 @@ func NewPermissionTargetParams() PermissionTargetParams {
 // Using struct pointers to keep the fields null if they are empty.
 // Artifactory evaluates inner struct typed fields if they are not null, which can lead to failures in the request.
 type PermissionTargetParams struct {
-	Name          string                   `json:"name"`
-	Repo          *PermissionTargetSection `json:"repo,omitempty"`
-	Build         *PermissionTargetSection `json:"build,omitempty"`
-	ReleaseBundle *PermissionTargetSection `json:"releaseBundle,omitempty"`
-	Uri           string                   `json:"uri,omitempty"`
+	Name           string                   `json:"name"`
+	Repo           *PermissionTargetSection `json:"repo,omitempty"`
+	Build          *PermissionTargetSection `json:"build,omitempty"`
+	ReleaseBundle  *PermissionTargetSection `json:"releaseBundle,omitempty"`
+	PipelineSource *PermissionTargetSection `json:"pipelineSource,omitempty"` // Available only when JFrog Pipelines is installed.
+	Uri            string                   `json:"uri,omitempty"`
 }
 
 type PermissionTargetSection struct {
@@ -149,6 +186,268 @@ type Actions struct {
 	Groups map[string][]string `json:"groups,omitempty"`
 }
 
+func NewActions() *Actions {
+	return &Actions{Users: map[string][]string{}, Groups: map[string][]string{}}
+}
+
+// Grants the actions to the user, in addition to the actions which are already granted.
+func (a *Actions) AddUserActions(username string, actions ...string) {
+	if a.Users == nil {
+		a.Users = map[string][]string{}
+	}
+	a.Users[username] = addPermissionActions(a.Users[username], actions)
+}
+
+// Grants the actions to the group, in addition to the actions which are already granted.
+func (a *Actions) AddGroupActions(groupName string, actions ...string) {
+	if a.Groups == nil {
+		a.Groups = map[string][]string{}
+	}
+	a.Groups[groupName] = addPermissionActions(a.Groups[groupName], actions)
+}
+
+func addPermissionActions(current, actions []string) []string {
+	for _, action := range actions {
+		if !slices.Contains(current, action) {
+			current = append(current, action)
+		}
+	}
+	return current
+}
+
+func (ptp *PermissionTargetParams) sections() map[string]*PermissionTargetSection {
+	return map[string]*PermissionTargetSection{
+		"repo":           ptp.Repo,
+		"build":          ptp.Build,
+		"releaseBundle":  ptp.ReleaseBundle,
+		"pipelineSource": ptp.PipelineSource,
+	}
+}
+
+func (ptp *PermissionTargetParams) Validate() error {
+	if ptp.Name == "" {
+		return errorutils.CheckErrorf("a permission target name is required")
+	}
+	sections := ptp.sections()
+	hasSection := false
+	for _, name := range sortedPermissionTargetSectionNames(sections) {
+		section := sections[name]
+		if section == nil {
+			continue
+		}
+		hasSection = true
+		if err := section.validate(); err != nil {
+			return errorutils.CheckErrorf("permission target '%s', section '%s': %s", ptp.Name, name, err.Error())
+		}
+	}
+	if !hasSection {
+		return errorutils.CheckErrorf("permission target '%s' must contain at least one of the 'repo', 'build', 'releaseBundle' or 'pipelineSource' sections", ptp.Name)
+	}
+	if ptp.Build != nil && (len(ptp.Build.Repositories) != 1 || ptp.Build.Repositories[0] != PermissionBuildInfoRepository) {
+		return errorutils.CheckErrorf("permission target '%s': the repositories of the 'build' section must be [\"%s\"]", ptp.Name, PermissionBuildInfoRepository)
+	}
+	return nil
+}
+
+func (pts *PermissionTargetSection) validate() error {
+	if pts.Actions == nil {
+		return nil
+	}
+	for _, actionsByName := range []map[string][]string{pts.Actions.Users, pts.Actions.Groups} {
+		for _, name := range slices.Sorted(maps.Keys(actionsByName)) {
+			for _, action := range actionsByName[name] {
+				if !slices.Contains(permissionActions, action) {
+					return errorutils.CheckErrorf("unknown action '%s' for '%s'. Valid actions are: %v", action, name, permissionActions)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type ApplyPermissionTargetsParams struct {
+	// The desired state of the permission targets.
+	Targets []PermissionTargetParams
+	// If set, existing permission targets in the prune scope which aren't in Targets are deleted.
+	Prune *PrunePermissionTargetsParams
+	// If true, only the differences are returned and nothing is changed.
+	DryRun bool
+}
+
+// The scope of the permission targets which may be deleted when applying permission targets. Built-in permission targets
+// are never deleted. Permission targets created for projects and release bundles don't have fixed names, so the scope
+// should be limited to the permission targets which are managed by the caller.
+type PrunePermissionTargetsParams struct {
+	// Only permission targets whose names start with the prefix are deleted. Required, unless Selector is set.
+	NamePrefix string
+	// Only permission targets for which the selector returns true are deleted. If both are set, both must match.
+	Selector func(name string) bool
+	// Unless confirmed, the permission targets to delete are only reported as PermissionTargetPendingDelete, even if
+	// DryRun is false.
+	Confirm bool
+}
+
+func (pp *PrunePermissionTargetsParams) inScope(name string) bool {
+	if IsBuiltInPermissionTarget(name) || !strings.HasPrefix(name, pp.NamePrefix) {
+		return false
+	}
+	return pp.Selector == nil || pp.Selector(name)
+}
+
+// The permission targets which are created by Artifactory.
+var builtInPermissionTargets = []string{"Anything", "Any Remote"}
+
+func IsBuiltInPermissionTarget(name string) bool {
+	return slices.Contains(builtInPermissionTargets, name)
+}
+
+func NewApplyPermissionTargetsParams(targets []PermissionTargetParams) ApplyPermissionTargetsParams {
+	return ApplyPermissionTargetsParams{Targets: targets}
+}
+
+type PermissionTargetDiff struct {
+	Name   string
+	Action PermissionTargetAction
+	// The names of the sections which differ, for updated permission targets.
+	Changes []string
+}
+
+// Brings the permission targets in Artifactory to the desired state. Permission targets are created or replaced only
+// if they differ from the desired state. Returns the differences, including the ones applied before a failure.
+func (pts *PermissionTargetService) Apply(params ApplyPermissionTargetsParams) ([]PermissionTargetDiff, error) {
+	if params.Prune != nil && params.Prune.NamePrefix == "" && params.Prune.Selector == nil {
+		return nil, errorutils.CheckErrorf("pruning permission targets requires a name prefix or a selector")
+	}
+	desiredNames := make(map[string]bool, len(params.Targets))
+	for i := range params.Targets {
+		if err := params.Targets[i].Validate(); err != nil {
+			return nil, err
+		}
+		if desiredNames[params.Targets[i].Name] {
+			return nil, errorutils.CheckErrorf("permission target '%s' appears more than once", params.Targets[i].Name)
+		}
+		desiredNames[params.Targets[i].Name] = true
+	}
+	var diffs []PermissionTargetDiff
+	for _, desired := range params.Targets {
+		current, err := pts.Get(desired.Name)
+		if err != nil {
+			return diffs, err
+		}
+		diff := DiffPermissionTarget(current, desired)
+		diffs = append(diffs, diff)
+		if params.DryRun {
+			continue
+		}
+		switch diff.Action {
+		case PermissionTargetCreate:
+			err = pts.Create(desired)
+		case PermissionTargetUpdate:
+			err = pts.Update(desired)
+		}
+		if err != nil {
+			return diffs, err
+		}
+	}
+	if params.Prune == nil {
+		return diffs, nil
+	}
+	existing, err := pts.GetAll()
+	if err != nil {
+		return diffs, err
+	}
+	for _, target := range *existing {
+		if desiredNames[target.Name] || !params.Prune.inScope(target.Name) {
+			continue
+		}
+		if !params.Prune.Confirm {
+			diffs = append(diffs, PermissionTargetDiff{Name: target.Name, Action: PermissionTargetPendingDelete})
+			log.Info("Permission target '" + target.Name + "' would be deleted. Confirm the pruning to delete it.")
+			continue
+		}
+		diffs = append(diffs, PermissionTargetDiff{Name: target.Name, Action: PermissionTargetDelete})
+		if params.DryRun {
+			continue
+		}
+		if err = pts.Delete(target.Name); err != nil {
+			return diffs, err
+		}
+	}
+	return diffs, nil
+}
+
+// Compares the current permission target, or nil if it doesn't exist, with the desired one.
+// The order of patterns, repositories and actions is ignored.
+func DiffPermissionTarget(current *PermissionTargetParams, desired PermissionTargetParams) PermissionTargetDiff {
+	diff := PermissionTargetDiff{Name: desired.Name, Action: PermissionTargetUnchanged}
+	if current == nil {
+		diff.Action = PermissionTargetCreate
+		return diff
+	}
+	currentSections := current.sections()
+	desiredSections := desired.sections()
+	for _, name := range sortedPermissionTargetSectionNames(desiredSections) {
+		if !reflect.DeepEqual(normalizePermissionTargetSection(currentSections[name]), normalizePermissionTargetSection(desiredSections[name])) {
+			diff.Changes = append(diff.Changes, name)
+		}
+	}
+	if len(diff.Changes) > 0 {
+		diff.Action = PermissionTargetUpdate
+	}
+	return diff
+}
+
+// Returns a sorted copy of the section, in which empty fields are nil, so that equivalent sections are deeply equal.
+func normalizePermissionTargetSection(section *PermissionTargetSection) *PermissionTargetSection {
+	if section == nil {
+		return nil
+	}
+	normalized := &PermissionTargetSection{
+		IncludePatterns: sortedOrNil(section.IncludePatterns),
+		ExcludePatterns: sortedOrNil(section.ExcludePatterns),
+		Repositories:    sortedOrNil(section.Repositories),
+	}
+	if section.Actions != nil {
+		normalized.Actions = &Actions{
+			Users:  normalizePermissionActions(section.Actions.Users),
+			Groups: normalizePermissionActions(section.Actions.Groups),
+		}
+		if normalized.Actions.Users == nil && normalized.Actions.Groups == nil {
+			normalized.Actions = nil
+		}
+	}
+	return normalized
+}
+
+func normalizePermissionActions(actions map[string][]string) map[string][]string {
+	if len(actions) == 0 {
+		return nil
+	}
+	normalized := make(map[string][]string, len(actions))
+	for name, nameActions := range actions {
+		normalized[name] = sortedOrNil(nameActions)
+	}
+	return normalized
+}
+
+func sortedOrNil(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(values)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func sortedPermissionTargetSectionNames(sections map[string]*PermissionTargetSection) []string {
+	names := make([]string, 0, len(sections))
+	for name := range sections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 type PermissionTargetAlreadyExistsError struct {
 	InnerError error
 }
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissionTargetValidate(t *testing.T) {
+	params := NewPermissionTargetParams()
+	assert.ErrorContains(t, params.Validate(), "name is required")
+
+	params.Name = "my-target"
+	assert.ErrorContains(t, params.Validate(), "at least one")
+
+	params.Repo = &PermissionTargetSection{Repositories: []string{PermissionAnyLocalRepository}, Actions: NewActions()}
+	params.Repo.Actions.AddUserActions("admin", PermissionActionRead, "fly")
+	assert.ErrorContains(t, params.Validate(), "unknown action 'fly'")
+
+	params.Repo.Actions = NewActions()
+	params.Repo.Actions.AddGroupActions("readers", PermissionActionRead, PermissionActionAnnotate)
+	assert.NoError(t, params.Validate())
+
+	params.Build = &PermissionTargetSection{Repositories: []string{"my-builds"}}
+	assert.ErrorContains(t, params.Validate(), PermissionBuildInfoRepository)
+	params.Build.Repositories = []string{PermissionBuildInfoRepository}
+	assert.NoError(t, params.Validate())
+}
+
+func TestPermissionTargetValidateOrder(t *testing.T) {
+	params := NewPermissionTargetParams()
+	params.Name = "my-target"
+	params.Repo = &PermissionTargetSection{Repositories: []string{PermissionAnyLocalRepository}, Actions: NewActions()}
+	for _, name := range []string{"carol", "alice", "bob", "dave"} {
+		params.Repo.Actions.AddUserActions(name, "fly-"+name)
+	}
+	for i := 0; i < 10; i++ {
+		assert.ErrorContains(t, params.Validate(), "unknown action 'fly-alice' for 'alice'")
+	}
+}
+
+func TestActionsAddActions(t *testing.T) {
+	actions := &Actions{}
+	actions.AddUserActions("user", PermissionActionRead)
+	actions.AddUserActions("user", PermissionActionRead, PermissionActionWrite)
+	assert.Equal(t, []string{PermissionActionRead, PermissionActionWrite}, actions.Users["user"])
+	assert.Nil(t, actions.Groups)
+}
+
+func TestDiffPermissionTarget(t *testing.T) {
+	desired := PermissionTargetParams{
+		Name: "my-target",
+		Repo: &PermissionTargetSection{
+			IncludePatterns: []string{"**"},
+			Repositories:    []string{"libs-release", "libs-snapshot"},
+			Actions:         &Actions{Groups: map[string][]string{"readers": {PermissionActionRead, PermissionActionAnnotate}}},
+		},
+		Build: &PermissionTargetSection{Repositories: []string{PermissionBuildInfoRepository}},
+	}
+	assert.Equal(t, PermissionTargetCreate, DiffPermissionTarget(nil, desired).Action)
+
+	// Same content in a different order, with empty fields returned by Artifactory.
+	current := &PermissionTargetParams{
+		Name: "my-target",
+		Uri:  "http://localhost:8081/artifactory/api/v2/security/permissions/my-target",
+		Repo: &PermissionTargetSection{
+			IncludePatterns: []string{"**"},
+			ExcludePatterns: []string{},
+			Repositories:    []string{"libs-snapshot", "libs-release"},
+			Actions:         &Actions{Users: map[string][]string{}, Groups: map[string][]string{"readers": {PermissionActionAnnotate, PermissionActionRead}}},
+		},
+		Build: &PermissionTargetSection{Repositories: []string{PermissionBuildInfoRepository}},
+	}
+	diff := DiffPermissionTarget(current, desired)
+	assert.Equal(t, PermissionTargetUnchanged, diff.Action)
+	assert.Empty(t, diff.Changes)
+
+	current.Build = nil
+	current.Repo.ExcludePatterns = []string{"*.tmp"}
+	diff = DiffPermissionTarget(current, desired)
+	assert.Equal(t, PermissionTargetUpdate, diff.Action)
+	assert.Equal(t, []string{"build", "repo"}, diff.Changes)
+}
+
+func TestApplyPermissionTargetsPrune(t *testing.T) {
+	var deleted []string
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/api/v2/security/permissions/"))
+			return
+		}
+		assert.Equal(t, "/api/v2/security/permissions", r.URL.Path)
+		_, _ = w.Write([]byte(`[{"name":"Anything"},{"name":"Any Remote"},{"name":"team-a-old"},{"name":"team-b"}]`))
+	})
+	pts := NewPermissionTargetService(client)
+	pts.ArtDetails = serviceDetails
+	params := NewApplyPermissionTargetsParams(nil)
+
+	params.Prune = &PrunePermissionTargetsParams{}
+	_, err := pts.Apply(params)
+	assert.ErrorContains(t, err, "requires a name prefix or a selector")
+
+	// Unless confirmed, the permission targets to delete are only reported.
+	params.Prune = &PrunePermissionTargetsParams{NamePrefix: "team-a-"}
+	diffs, err := pts.Apply(params)
+	assert.NoError(t, err)
+	assert.Equal(t, []PermissionTargetDiff{{Name: "team-a-old", Action: PermissionTargetPendingDelete}}, diffs)
+	assert.Empty(t, deleted)
+
+	params.Prune.Confirm = true
+	diffs, err = pts.Apply(params)
+	assert.NoError(t, err)
+	assert.Equal(t, []PermissionTargetDiff{{Name: "team-a-old", Action: PermissionTargetDelete}}, diffs)
+	assert.Equal(t, []string{"team-a-old"}, deleted)
+
+	// Built-in permission targets are never deleted.
+	deleted = nil
+	params.Prune = &PrunePermissionTargetsParams{Selector: func(string) bool { return true }, Confirm: true}
+	_, err = pts.Apply(params)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"team-a-old", "team-b"}, deleted)
+}
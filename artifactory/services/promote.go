@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"path"
+	"strings"
 
 	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/jfrog/jfrog-client-go/auth"
@@ -27,11 +28,20 @@ func (ps *PromoteService) isDryRun() *bool {
 }
 
 func (ps *PromoteService) BuildPromote(promotionParams PromotionParams) error {
+	_, err := ps.PromoteBuildWithResult(promotionParams)
+	return err
+}
+
+// Promotes a build, and returns the result of the promotion, including the messages about specific artifacts.
+func (ps *PromoteService) PromoteBuildWithResult(promotionParams PromotionParams) (*BuildPromotionResult, error) {
 	message := "Promoting build..."
 	if ps.DryRun {
 		message = "[Dry run] " + message
 	}
 	log.Info(message)
+	if err := utils.ValidateBuildProjectKey(promotionParams.ProjectKey, ps.ArtDetails); err != nil {
+		return nil, err
+	}
 
 	promoteUrl := ps.ArtDetails.GetUrl()
 	restApi := path.Join("api/build/promote/")
@@ -46,27 +56,31 @@ func (ps *PromoteService) BuildPromote(promotionParams PromotionParams) error {
 
 	requestURLWithEscapedSlash, err := utils.BuildUrlWithEscapingSlash(promoteUrl, restApi, buildName, buildNumber, queryParams)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	props, err := utils.ParseProperties(promotionParams.GetProperties())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	data := BuildPromotionBody{
 		Status:              promotionParams.GetStatus(),
 		Comment:             promotionParams.GetComment(),
+		CiUser:              promotionParams.CiUser,
+		Timestamp:           promotionParams.Timestamp,
 		Copy:                promotionParams.IsCopy(),
 		FailFast:            promotionParams.IsFailFast(),
+		IncludeArtifacts:    promotionParams.IsIncludeArtifacts(),
 		IncludeDependencies: promotionParams.IsIncludeDependencies(),
+		Scopes:              promotionParams.Scopes,
 		SourceRepo:          promotionParams.GetSourceRepo(),
 		TargetRepo:          promotionParams.GetTargetRepo(),
 		DryRun:              ps.isDryRun(),
 		Properties:          props.ToMap()}
 	requestContent, err := json.Marshal(data)
 	if err != nil {
-		return errorutils.CheckError(err)
+		return nil, errorutils.CheckError(err)
 	}
 
 	httpClientsDetails := ps.ArtDetails.CreateHttpClientDetails()
@@ -74,27 +88,58 @@ func (ps *PromoteService) BuildPromote(promotionParams PromotionParams) error {
 
 	resp, body, err := ps.client.SendPost(requestURLWithEscapedSlash, requestContent, &httpClientsDetails)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
-		return err
+		return nil, err
 	}
 	log.Debug("Artifactory response:", resp.Status)
 	if !data.FailFast {
 		log.Info(string(body))
 	}
+	result := &BuildPromotionResult{}
+	if len(body) > 0 {
+		if err = json.Unmarshal(body, result); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+	}
 	log.Info("Promoted build", promotionParams.GetBuildName()+"/"+promotionParams.GetBuildNumber(), "to:", promotionParams.GetTargetRepo(), "repository.")
-	return nil
+	return result, nil
+}
+
+type BuildPromotionResult struct {
+	// The messages of the promotion, including the failures of promoting specific artifacts.
+	Messages []BuildPromotionMessage `json:"messages,omitempty"`
+}
+
+type BuildPromotionMessage struct {
+	Level   string `json:"level,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Returns the error messages of the promotion.
+func (bpr *BuildPromotionResult) GetErrors() []BuildPromotionMessage {
+	var errorMessages []BuildPromotionMessage
+	for _, message := range bpr.Messages {
+		if strings.EqualFold(message.Level, "error") {
+			errorMessages = append(errorMessages, message)
+		}
+	}
+	return errorMessages
 }
 
 type BuildPromotionBody struct {
-	Comment             string `json:"comment,omitempty"`
-	SourceRepo          string `json:"sourceRepo,omitempty"`
-	TargetRepo          string `json:"targetRepo,omitempty"`
-	Status              string `json:"status,omitempty"`
-	IncludeDependencies *bool  `json:"dependencies,omitempty"`
-	Copy                *bool  `json:"copy,omitempty"`
+	Comment             string   `json:"comment,omitempty"`
+	SourceRepo          string   `json:"sourceRepo,omitempty"`
+	TargetRepo          string   `json:"targetRepo,omitempty"`
+	Status              string   `json:"status,omitempty"`
+	CiUser              string   `json:"ciUser,omitempty"`
+	Timestamp           string   `json:"timestamp,omitempty"`
+	IncludeArtifacts    *bool    `json:"artifacts,omitempty"`
+	IncludeDependencies *bool    `json:"dependencies,omitempty"`
+	Scopes              []string `json:"scopes,omitempty"`
+	Copy                *bool    `json:"copy,omitempty"`
 	// Notice that FailFast is boolean and therfore if not assigned, FailFast is false.
 	FailFast   bool                `json:"failFast"`
 	DryRun     *bool               `json:"dryRun,omitempty"`
@@ -113,6 +158,16 @@ type PromotionParams struct {
 	IncludeDependencies bool
 	SourceRepo          string
 	Properties          string
+
+	// The user who promoted the build. Defaults to the authenticated user.
+	CiUser string
+	// The time of the promotion, in the build info time format. Defaults to the current time.
+	Timestamp string
+	// Keeps the build's artifacts in place, e.g. to promote only its dependencies.
+	// The artifacts are promoted by default, so they are sent in the request only if excluded.
+	ExcludeArtifacts bool
+	// The scopes of the dependencies to promote, e.g. "compile". All the scopes are promoted if empty.
+	Scopes []string
 }
 
 func (bp *PromotionParams) GetBuildName() string {
@@ -147,6 +202,14 @@ func (bp *PromotionParams) IsFailFast() bool {
 	return bp.FailFast
 }
 
+func (bp *PromotionParams) IsIncludeArtifacts() *bool {
+	if !bp.ExcludeArtifacts {
+		return nil
+	}
+	includeArtifacts := false
+	return &includeArtifacts
+}
+
 func (bp *PromotionParams) IsIncludeDependencies() *bool {
 	return &bp.IncludeDependencies
 }
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromoteBuild(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/build/promote/build/1", r.URL.Path)
+		content, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		body := BuildPromotionBody{}
+		assert.NoError(t, json.Unmarshal(content, &body))
+		assert.False(t, *body.IncludeArtifacts)
+		assert.True(t, *body.IncludeDependencies)
+		assert.Equal(t, []string{"compile"}, body.Scopes)
+		assert.Equal(t, "ci", body.CiUser)
+		assert.True(t, body.FailFast)
+		_, _ = w.Write([]byte(`{"messages":[{"level":"error","message":"artifact a.jar wasn't promoted"},{"level":"info","message":"done"}]}`))
+	}))
+	defer server.Close()
+	promoteService := createTestPromoteService(t, server.URL)
+
+	params := NewPromotionParams()
+	params.BuildName, params.BuildNumber, params.TargetRepo = "build", "1", "release"
+	params.ExcludeArtifacts = true
+	params.IncludeDependencies = true
+	params.Scopes = []string{"compile"}
+	params.CiUser = "ci"
+	params.FailFast = true
+	result, err := promoteService.PromoteBuildWithResult(params)
+	assert.NoError(t, err)
+	assert.Equal(t, []BuildPromotionMessage{{Level: "error", Message: "artifact a.jar wasn't promoted"}}, result.GetErrors())
+}
+
+func TestPromoteBuildIncludesArtifactsByDefault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(content), `"artifacts"`)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	promoteService := createTestPromoteService(t, server.URL)
+
+	params := NewPromotionParams()
+	params.BuildName, params.BuildNumber, params.TargetRepo = "build", "1", "release"
+	result, err := promoteService.PromoteBuildWithResult(params)
+	assert.NoError(t, err)
+	assert.Empty(t, result.GetErrors())
+}
+
+func createTestPromoteService(t *testing.T, serverUrl string) *PromoteService {
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	assert.NoError(t, err)
+	var serviceDetails auth.ServiceDetails = &testServiceDetails{}
+	serviceDetails.SetUrl(serverUrl + "/")
+	promoteService := NewPromotionService(client)
+	promoteService.ArtDetails = serviceDetails
+	return promoteService
+}
@@ -2,9 +2,12 @@ package services
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"path"
+	"sort"
 	"strings"
 
 	"github.com/jfrog/gofrog/parallel"
@@ -258,3 +261,146 @@ func (ps *PropsService) GetItemProperties(relativePath string) (*utils.ItemPrope
 	err = json.Unmarshal(body, result)
 	return result, errorutils.CheckError(err)
 }
+
+const (
+	defaultBatchPropsRetries            = 3
+	defaultBatchPropsRetryWaitMilliSecs = 1000
+)
+
+type BatchPropsParams struct {
+	// The items to update, usually the results of a search.
+	Reader *content.ContentReader
+	// The properties to set, e.g. "key1=value1;key2=value2".
+	SetProps string
+	// The keys of the properties to delete, e.g. "key1,key2". Deleted before setting SetProps.
+	DeleteProps string
+	// The number of times to retry an item after a connection error, a server error or a rate limit response.
+	Retries            int
+	RetryWaitMilliSecs int
+}
+
+func NewBatchPropsParams() BatchPropsParams {
+	return BatchPropsParams{Retries: defaultBatchPropsRetries, RetryWaitMilliSecs: defaultBatchPropsRetryWaitMilliSecs}
+}
+
+type PropsItemResult struct {
+	Path string
+	// The number of attempts made, including retries.
+	Attempts int
+	// Nil if the properties of the item were updated.
+	Err error
+}
+
+type BatchPropsResult struct {
+	// The results of all the items, sorted by path.
+	Items     []PropsItemResult
+	Succeeded int
+	Failed    int
+}
+
+// Sets and deletes properties of many items in parallel, using a worker per thread. Unlike SetProps and DeleteProps,
+// a failure doesn't stop the update of the other items. The result of each item is reported, and the returned error
+// aggregates the errors of the failed items.
+func (ps *PropsService) UpdatePropsBatch(params BatchPropsParams) (*BatchPropsResult, error) {
+	if params.SetProps == "" && params.DeleteProps == "" {
+		return nil, errorutils.CheckErrorf("at least one property to set or delete is required")
+	}
+	var setParam, deleteParam string
+	var err error
+	if params.SetProps != "" {
+		if setParam, err = ps.getEncodedParam(PropsParams{Props: params.SetProps}, false); err != nil {
+			return nil, err
+		}
+	}
+	if params.DeleteProps != "" {
+		if deleteParam, err = ps.getEncodedParam(PropsParams{Props: params.DeleteProps}, true); err != nil {
+			return nil, err
+		}
+	}
+	threads := max(ps.GetThreads(), 1)
+	resultsPerThread := make([][]PropsItemResult, threads)
+	producerConsumer := parallel.NewBounedRunner(threads, false)
+	var readerErr error
+	go func() {
+		defer producerConsumer.Done()
+		reader := params.Reader
+		for resultItem := new(utils.ResultItem); reader.NextRecord(resultItem) == nil; resultItem = new(utils.ResultItem) {
+			relativePath := resultItem.GetItemRelativePath()
+			_, _ = producerConsumer.AddTask(func(threadId int) error {
+				itemResult := ps.updateItemProps(threadId, relativePath, setParam, deleteParam, params)
+				resultsPerThread[threadId] = append(resultsPerThread[threadId], itemResult)
+				return nil
+			})
+		}
+		readerErr = reader.GetError()
+		reader.Reset()
+	}()
+	producerConsumer.Run()
+
+	result := &BatchPropsResult{}
+	var errs []error
+	for _, threadResults := range resultsPerThread {
+		result.Items = append(result.Items, threadResults...)
+	}
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Path < result.Items[j].Path
+	})
+	for _, item := range result.Items {
+		if item.Err != nil {
+			result.Failed++
+			errs = append(errs, fmt.Errorf("%s: %w", item.Path, item.Err))
+			continue
+		}
+		result.Succeeded++
+	}
+	log.Info(fmt.Sprintf("Updated the properties of %d items, %d failed.", result.Succeeded, result.Failed))
+	return result, errors.Join(append(errs, readerErr)...)
+}
+
+func (ps *PropsService) updateItemProps(threadId int, relativePath, setParam, deleteParam string, params BatchPropsParams) PropsItemResult {
+	logMsgPrefix := clientutils.GetLogMsgPrefix(threadId, ps.IsDryRun())
+	itemResult := PropsItemResult{Path: relativePath}
+	storageUrl, err := clientutils.BuildUrl(ps.GetArtifactoryDetails().GetUrl(), path.Join("api", "storage", relativePath), make(map[string]string))
+	if err != nil {
+		itemResult.Err = err
+		return itemResult
+	}
+	type propsAction struct {
+		encodedParam string
+		send         func(string, string, string) (*http.Response, []byte, error)
+	}
+	var actions []propsAction
+	if deleteParam != "" {
+		actions = append(actions, propsAction{deleteParam, ps.sendDeleteRequest})
+	}
+	if setParam != "" {
+		actions = append(actions, propsAction{setParam, ps.sendPutRequest})
+	}
+	for _, action := range actions {
+		propertiesUrl := storageUrl + "?properties=" + action.encodedParam + "&recursive=0"
+		retryExecutor := clientutils.RetryExecutor{
+			MaxRetries:               params.Retries,
+			RetriesIntervalMilliSecs: params.RetryWaitMilliSecs,
+			ErrorMessage:             "Failed updating properties of " + relativePath,
+			LogMsgPrefix:             logMsgPrefix,
+			ExecutionHandler: func() (bool, error) {
+				itemResult.Attempts++
+				resp, body, err := action.send(logMsgPrefix, relativePath, propertiesUrl)
+				if err != nil {
+					// Connection errors and server errors which remained after the client's own retries.
+					return true, err
+				}
+				err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusNoContent)
+				return err != nil && isRetriableStatus(resp.StatusCode), err
+			},
+		}
+		if itemResult.Err = retryExecutor.Execute(); itemResult.Err != nil {
+			return itemResult
+		}
+	}
+	return itemResult
+}
+
+func isRetriableStatus(statusCode int) bool {
+	return statusCode >= http.StatusInternalServerError || statusCode == http.StatusTooManyRequests
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdatePropsBatch(t *testing.T) {
+	var lock sync.Mutex
+	requests := make(map[string]int)
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		requests[r.Method+" "+r.URL.Path]++
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/missing.jar"):
+			w.WriteHeader(http.StatusNotFound)
+		case strings.HasSuffix(r.URL.Path, "/flaky.jar") && requests["DELETE "+r.URL.Path] == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	reader, err := utils.StreamToContentReader(strings.NewReader(`{"results":[
+		{"repo":"repo","path":"a","name":"flaky.jar"},
+		{"repo":"repo","path":"a","name":"missing.jar"},
+		{"repo":"repo","path":"a","name":"ok.jar"}]}`), content.DefaultKey)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, reader.Close())
+	}()
+
+	propsService := NewPropsService(client)
+	propsService.ArtDetails = serviceDetails
+	propsService.Threads = 2
+
+	params := NewBatchPropsParams()
+	params.Reader = reader
+	params.SetProps = "status=approved"
+	params.DeleteProps = "status"
+	params.RetryWaitMilliSecs = 0
+	result, err := propsService.UpdatePropsBatch(params)
+	assert.ErrorContains(t, err, "repo/a/missing.jar")
+	assert.Equal(t, 2, result.Succeeded)
+	assert.Equal(t, 1, result.Failed)
+	if assert.Len(t, result.Items, 3) {
+		// The flaky item is retried once after the server error, and then its properties are set.
+		assert.Equal(t, PropsItemResult{Path: "repo/a/flaky.jar", Attempts: 3}, result.Items[0])
+		// Client errors aren't retried.
+		assert.Equal(t, "repo/a/missing.jar", result.Items[1].Path)
+		assert.Equal(t, 1, result.Items[1].Attempts)
+		assert.Error(t, result.Items[1].Err)
+		assert.Equal(t, PropsItemResult{Path: "repo/a/ok.jar", Attempts: 2}, result.Items[2])
+	}
+	assert.Equal(t, 2, requests["DELETE /api/storage/repo/a/flaky.jar"])
+	assert.Equal(t, 1, requests["PUT /api/storage/repo/a/flaky.jar"])
+	assert.Zero(t, requests["PUT /api/storage/repo/a/missing.jar"])
+}
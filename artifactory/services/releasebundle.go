@@ -40,6 +40,15 @@ func (rs *releaseService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient
 	return rs.client
 }
 
+// Validates the archive of an exported release bundle against the checksum manifest next to it, and imports it.
+// Use it to import an archive which was transferred from another instance, e.g. to an air-gapped instance.
+func (rs *releaseService) ImportReleaseBundleArchive(filePath string) error {
+	if _, err := fileutils.ValidateChecksumManifest(filePath); err != nil {
+		return err
+	}
+	return rs.ImportReleaseBundle(filePath)
+}
+
 func (rs *releaseService) ImportReleaseBundle(filePath string) (err error) {
 	// Load desired file
 	content, err := fileutils.ReadFile(filePath)
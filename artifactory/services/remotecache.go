@@ -0,0 +1,124 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	zapApi = "api/zap/"
+	// The artifacts of a remote repository are cached in a repository with the same key and this suffix.
+	remoteCacheRepoSuffix = "-cache"
+)
+
+// Manages the cache of remote repositories, for example to recover from bad artifacts cached during an upstream outage.
+type RemoteCacheService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+	DryRun     bool
+	Threads    int
+}
+
+func NewRemoteCacheService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *RemoteCacheService {
+	return &RemoteCacheService{artDetails: &artDetails, client: client}
+}
+
+func (rcs *RemoteCacheService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *rcs.artDetails
+}
+
+func (rcs *RemoteCacheService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return rcs.client
+}
+
+func (rcs *RemoteCacheService) IsDryRun() bool {
+	return rcs.DryRun
+}
+
+// Zaps the cache of a remote repository under the given path, or the whole cache if the path is empty.
+// Zapped items are considered expired, so Artifactory checks the upstream for a newer version the next time they're
+// requested, instead of waiting for the retrieval cache period to pass. The cached content isn't deleted.
+func (rcs *RemoteCacheService) ZapCache(repoKey, relativePath string) error {
+	repoKey = RemoteRepoKeyFromCache(repoKey)
+	if repoKey == "" {
+		return errorutils.CheckErrorf("a repository key is required")
+	}
+	zapPath := path.Join(repoKey, relativePath)
+	requestFullUrl, err := clientutils.BuildUrl(rcs.GetArtifactoryDetails().GetUrl(), zapApi+zapPath, nil)
+	if err != nil {
+		return err
+	}
+	if rcs.DryRun {
+		log.Info("[Dry run] Zapping the cache of '" + zapPath + "'")
+		return nil
+	}
+	log.Info("Zapping the cache of '" + zapPath + "'...")
+	httpClientsDetails := rcs.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, err := rcs.client.SendPost(requestFullUrl, nil, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+type DeleteCachedItemsParams struct {
+	// The key of the remote repository. The key of its cache repository is also accepted.
+	RepoKey string
+	// A wildcard pattern of the cached items, relative to the root of the cache, e.g. "org/acme/*.jar". Defaults to
+	// the whole cache.
+	Pattern string
+	// Filter the items by properties, e.g. "key1=value1;key2=value2".
+	Props string
+}
+
+func NewDeleteCachedItemsParams(repoKey string) DeleteCachedItemsParams {
+	return DeleteCachedItemsParams{RepoKey: repoKey}
+}
+
+// Deletes the cached items matching the pattern, so that they're downloaded again from the upstream the next time
+// they're requested. Returns the number of deleted items.
+func (rcs *RemoteCacheService) DeleteCachedItems(params DeleteCachedItemsParams) (deleted int, err error) {
+	if params.RepoKey == "" {
+		return 0, errorutils.CheckErrorf("a repository key is required")
+	}
+	deleteService := NewDeleteService(*rcs.artDetails, rcs.client)
+	deleteService.DryRun = rcs.DryRun
+	deleteService.Threads = rcs.Threads
+	deleteParams := NewDeleteParams()
+	deleteParams.Pattern = path.Join(CacheRepoKey(params.RepoKey), defaultPattern(params.Pattern))
+	deleteParams.Props = params.Props
+	deleteParams.Recursive = true
+	reader, err := deleteService.GetPathsToDelete(deleteParams)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	return deleteService.DeleteFiles(reader)
+}
+
+// Returns the key of the cache repository of a remote repository.
+func CacheRepoKey(repoKey string) string {
+	if strings.HasSuffix(repoKey, remoteCacheRepoSuffix) {
+		return repoKey
+	}
+	return repoKey + remoteCacheRepoSuffix
+}
+
+// Returns the key of the remote repository of a cache repository. Other keys are returned as is.
+func RemoteRepoKeyFromCache(repoKey string) string {
+	return strings.TrimSuffix(repoKey, remoteCacheRepoSuffix)
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheRepoKey(t *testing.T) {
+	assert.Equal(t, "npm-remote-cache", CacheRepoKey("npm-remote"))
+	assert.Equal(t, "npm-remote-cache", CacheRepoKey("npm-remote-cache"))
+	assert.Equal(t, "npm-remote", RemoteRepoKeyFromCache("npm-remote-cache"))
+	assert.Equal(t, "npm-remote", RemoteRepoKeyFromCache("npm-remote"))
+}
+
+func TestZapCache(t *testing.T) {
+	var zapped []string
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		zapped = append(zapped, r.URL.Path)
+		_, _ = w.Write([]byte("Zapped"))
+	})
+	remoteCacheService := NewRemoteCacheService(serviceDetails, client)
+
+	assert.NoError(t, remoteCacheService.ZapCache("npm-remote-cache", "lodash/-/lodash-4.17.21.tgz"))
+	assert.NoError(t, remoteCacheService.ZapCache("npm-remote", ""))
+	assert.Error(t, remoteCacheService.ZapCache("", ""))
+	remoteCacheService.DryRun = true
+	assert.NoError(t, remoteCacheService.ZapCache("npm-remote", "lodash"))
+	assert.Equal(t, []string{"/api/zap/npm-remote/lodash/-/lodash-4.17.21.tgz", "/api/zap/npm-remote"}, zapped)
+}
@@ -0,0 +1,251 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The endpoint used by the UI to test the connection of a remote repository, before it is saved.
+// Artifactory has no public REST API for testing an unsaved repository, so this endpoint isn't documented, and its
+// request format follows the UI's repository model.
+const testRemoteRepositoryApi = "ui/admin/repositories/testremote"
+
+type RemoteConnectionStatus string
+
+const (
+	RemoteConnectionOk RemoteConnectionStatus = "ok"
+	// The upstream rejected the credentials.
+	RemoteConnectionUnauthorized RemoteConnectionStatus = "unauthorized"
+	// The upstream URL doesn't exist.
+	RemoteConnectionNotFound RemoteConnectionStatus = "not_found"
+	// The upstream host couldn't be resolved or connected to, or the connection timed out.
+	RemoteConnectionUnreachable RemoteConnectionStatus = "unreachable"
+	// The TLS handshake with the upstream failed, for example because its certificate isn't trusted.
+	RemoteConnectionTlsError RemoteConnectionStatus = "tls_error"
+	// Any other failure.
+	RemoteConnectionFailed RemoteConnectionStatus = "failed"
+)
+
+// The result of testing the connection from Artifactory to the upstream of a remote repository.
+type RemoteConnectionDiagnostics struct {
+	Url    string
+	Status RemoteConnectionStatus
+	// The HTTP status returned by the upstream, if Artifactory reported it.
+	UpstreamStatusCode int
+	// The message returned by Artifactory.
+	Message string
+}
+
+func (rcd *RemoteConnectionDiagnostics) IsOk() bool {
+	return rcd.Status == RemoteConnectionOk
+}
+
+type testRemoteRequest struct {
+	Type         string                 `json:"type"`
+	General      testRemoteGeneral      `json:"general"`
+	Basic        testRemoteBasic        `json:"basic"`
+	Advanced     testRemoteAdvanced     `json:"advanced"`
+	TypeSpecific testRemoteTypeSpecific `json:"typeSpecific"`
+}
+
+type testRemoteGeneral struct {
+	RepoKey string `json:"repoKey"`
+}
+
+type testRemoteBasic struct {
+	Url string `json:"url"`
+}
+
+type testRemoteAdvanced struct {
+	Network     testRemoteNetwork `json:"network"`
+	QueryParams string            `json:"queryParams,omitempty"`
+}
+
+type testRemoteNetwork struct {
+	Username                     string `json:"username,omitempty"`
+	Password                     string `json:"password,omitempty"`
+	Proxy                        string `json:"proxy,omitempty"`
+	LocalAddress                 string `json:"localAddress,omitempty"`
+	SocketTimeout                *int   `json:"socketTimeout,omitempty"`
+	LenientHostAuth              *bool  `json:"lenientHostAuth,omitempty"`
+	CookieManagement             *bool  `json:"cookieManagement,omitempty"`
+	SelectedInstalledCertificate string `json:"selectedInstalledCertificate,omitempty"`
+}
+
+type testRemoteTypeSpecific struct {
+	RepoType string `json:"repoType"`
+}
+
+type testRemoteResponse struct {
+	Info   string   `json:"info,omitempty"`
+	Error  string   `json:"error,omitempty"`
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Tests the connection from Artifactory to the remote URL of the repository, using its credentials, proxy and network
+// settings. The repository doesn't need to exist, so the connection can be verified before creating it.
+// A failure to connect to the upstream is returned in the diagnostics. An error is returned only if the test itself
+// couldn't be performed.
+func (rrs *RemoteRepositoryService) TestConnection(params RemoteRepositoryBaseParams) (*RemoteConnectionDiagnostics, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if params.Url == "" {
+		return nil, errorutils.CheckErrorf("repository '%s': a remote URL is required", params.Key)
+	}
+	request, err := newTestRemoteRequest(params)
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.Marshal(request)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	httpClientsDetails := rrs.ArtDetails.CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	log.Info("Testing the connection of repository '" + params.Key + "' to " + params.Url + "...")
+	resp, body, err := rrs.client.SendPost(rrs.ArtDetails.GetUrl()+testRemoteRepositoryApi, content, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	// A failed connection to the upstream is returned with a bad request status.
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusBadRequest); err != nil {
+		return nil, err
+	}
+	response := &testRemoteResponse{}
+	if len(body) > 0 {
+		if err = json.Unmarshal(body, response); err != nil {
+			return nil, errorutils.CheckErrorf("failed parsing the connection test response: %s", err.Error())
+		}
+	}
+	diagnostics := &RemoteConnectionDiagnostics{Url: params.Url, Status: RemoteConnectionOk, Message: response.Info}
+	if resp.StatusCode == http.StatusBadRequest {
+		message := response.Error
+		if message == "" {
+			message = strings.Join(response.Errors, "; ")
+		}
+		diagnostics.Status, diagnostics.UpstreamStatusCode = ClassifyRemoteConnectionFailure(message)
+		diagnostics.Message = message
+	}
+	return diagnostics, nil
+}
+
+func newTestRemoteRequest(params RemoteRepositoryBaseParams) (testRemoteRequest, error) {
+	repoType, err := uiRepoType(params.PackageType)
+	if err != nil {
+		return testRemoteRequest{}, err
+	}
+	return testRemoteRequest{
+		Type:    "remoteRepoConfig",
+		General: testRemoteGeneral{RepoKey: params.Key},
+		Basic:   testRemoteBasic{Url: params.Url},
+		Advanced: testRemoteAdvanced{
+			Network: testRemoteNetwork{
+				Username:                     params.Username,
+				Password:                     params.Password,
+				Proxy:                        params.Proxy,
+				LocalAddress:                 params.LocalAddress,
+				SocketTimeout:                params.SocketTimeoutMillis,
+				LenientHostAuth:              params.AllowAnyHostAuth,
+				CookieManagement:             params.EnableCookieManagement,
+				SelectedInstalledCertificate: params.ClientTlsCertificate,
+			},
+			QueryParams: params.QueryParams,
+		},
+		TypeSpecific: testRemoteTypeSpecific{RepoType: repoType},
+	}, nil
+}
+
+// The UI identifies package types by its own names, which can't be derived from the package types of the REST API.
+var uiRepoTypes = map[string]string{
+	"alpine":        "Alpine",
+	"ansible":       "Ansible",
+	"bower":         "Bower",
+	"cargo":         "Cargo",
+	"chef":          "Chef",
+	"cocoapods":     "CocoaPods",
+	"composer":      "Composer",
+	"conan":         "Conan",
+	"conda":         "Conda",
+	"cran":          "CRAN",
+	"debian":        "Debian",
+	"docker":        "Docker",
+	"gems":          "Gems",
+	"generic":       "Generic",
+	"gitlfs":        "GitLfs",
+	"go":            "Go",
+	"gradle":        "Gradle",
+	"helm":          "Helm",
+	"helmoci":       "HelmOCI",
+	"huggingfaceml": "HuggingFaceML",
+	"ivy":           "Ivy",
+	"maven":         "Maven",
+	"npm":           "Npm",
+	"nuget":         "NuGet",
+	"oci":           "OCI",
+	"opkg":          "Opkg",
+	"p2":            "P2",
+	"pub":           "Pub",
+	"puppet":        "Puppet",
+	"pypi":          "Pypi",
+	"rpm":           "RPM",
+	"sbt":           "SBT",
+	"swift":         "Swift",
+	"terraform":     "Terraform",
+	"vcs":           "VCS",
+	"yum":           "YUM",
+}
+
+func uiRepoType(packageType string) (string, error) {
+	if packageType == "" {
+		packageType = "generic"
+	}
+	repoType, ok := uiRepoTypes[packageType]
+	if !ok {
+		return "", errorutils.CheckErrorf("testing the connection of '%s' remote repositories is not supported", packageType)
+	}
+	return repoType, nil
+}
+
+var upstreamStatusRegexp = regexp.MustCompile(`(?i)(?:error|status(?: code)?:?)\s+(\d{3})\b`)
+
+// Classifies the failure message returned by Artifactory, such as "Connection failed: Error 401: Unauthorized".
+// Returns the upstream HTTP status too, if the message includes it.
+func ClassifyRemoteConnectionFailure(message string) (status RemoteConnectionStatus, upstreamStatusCode int) {
+	if match := upstreamStatusRegexp.FindStringSubmatch(message); match != nil {
+		upstreamStatusCode, _ = strconv.Atoi(match[1])
+		switch upstreamStatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden, http.StatusProxyAuthRequired:
+			return RemoteConnectionUnauthorized, upstreamStatusCode
+		case http.StatusNotFound:
+			return RemoteConnectionNotFound, upstreamStatusCode
+		default:
+			return RemoteConnectionFailed, upstreamStatusCode
+		}
+	}
+	lowerMessage := strings.ToLower(message)
+	switch {
+	case containsAny(lowerMessage, "ssl", "tls", "certificate", "pkix"):
+		return RemoteConnectionTlsError, 0
+	case containsAny(lowerMessage, "unknownhost", "unknown host", "connection refused", "timed out", "timeout", "no route to host", "unreachable"):
+		return RemoteConnectionUnreachable, 0
+	default:
+		return RemoteConnectionFailed, 0
+	}
+}
+
+func containsAny(s string, substrings ...string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(s, substring) {
+			return true
+		}
+	}
+	return false
+}
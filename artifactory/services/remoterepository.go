@@ -1,11 +1,22 @@
 package services
 
 import (
+	"net/url"
+	"slices"
+
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
 
 const RemoteRepositoryRepoType = "remote"
 
+// All the package types supported by remote repositories.
+var RemoteRepositoryPackageTypes = []string{
+	"alpine", "ansible", "bower", "cargo", "chef", "cocoapods", "composer", "conan", "conda", "cran", "debian", "docker", "gems",
+	"generic", "gitlfs", "go", "gradle", "helm", "helmoci", "huggingfaceml", "ivy", "maven", "npm", "nuget", "oci", "opkg", "p2",
+	"pub", "puppet", "pypi", "rpm", "sbt", "swift", "terraform", "vcs", "yum",
+}
+
 type RemoteRepositoryService struct {
 	RepositoryService
 }
@@ -24,13 +35,26 @@ func (rrs *RemoteRepositoryService) GetJfrogHttpClient() *jfroghttpclient.JfrogH
 }
 
 func (rrs *RemoteRepositoryService) performRequest(params interface{}, repoKey string) error {
+	if validator, ok := params.(remoteRepositoryValidator); ok {
+		if err := validator.Validate(); err != nil {
+			return err
+		}
+	}
 	return rrs.RepositoryService.performRequest(params, repoKey)
 }
 
+type remoteRepositoryValidator interface {
+	Validate() error
+}
+
 func (rrs *RemoteRepositoryService) Alpine(params AlpineRemoteRepositoryParams) error {
 	return rrs.performRequest(params, params.Key)
 }
 
+func (rrs *RemoteRepositoryService) Ansible(params AnsibleRemoteRepositoryParams) error {
+	return rrs.performRequest(params, params.Key)
+}
+
 func (rrs *RemoteRepositoryService) Bower(params BowerRemoteRepositoryParams) error {
 	return rrs.performRequest(params, params.Key)
 }
@@ -95,6 +119,14 @@ func (rrs *RemoteRepositoryService) Helm(params HelmRemoteRepositoryParams) erro
 	return rrs.performRequest(params, params.Key)
 }
 
+func (rrs *RemoteRepositoryService) HelmOci(params HelmOciRemoteRepositoryParams) error {
+	return rrs.performRequest(params, params.Key)
+}
+
+func (rrs *RemoteRepositoryService) HuggingFaceMl(params HuggingFaceMlRemoteRepositoryParams) error {
+	return rrs.performRequest(params, params.Key)
+}
+
 func (rrs *RemoteRepositoryService) Ivy(params IvyRemoteRepositoryParams) error {
 	return rrs.performRequest(params, params.Key)
 }
@@ -111,6 +143,10 @@ func (rrs *RemoteRepositoryService) Nuget(params NugetRemoteRepositoryParams) er
 	return rrs.performRequest(params, params.Key)
 }
 
+func (rrs *RemoteRepositoryService) Oci(params OciRemoteRepositoryParams) error {
+	return rrs.performRequest(params, params.Key)
+}
+
 func (rrs *RemoteRepositoryService) Opkg(params OpkgRemoteRepositoryParams) error {
 	return rrs.performRequest(params, params.Key)
 }
@@ -119,6 +155,10 @@ func (rrs *RemoteRepositoryService) P2(params P2RemoteRepositoryParams) error {
 	return rrs.performRequest(params, params.Key)
 }
 
+func (rrs *RemoteRepositoryService) Pub(params PubRemoteRepositoryParams) error {
+	return rrs.performRequest(params, params.Key)
+}
+
 func (rrs *RemoteRepositoryService) Puppet(params PuppetRemoteRepositoryParams) error {
 	return rrs.performRequest(params, params.Key)
 }
@@ -199,6 +239,42 @@ type RemoteRepositoryBaseParams struct {
 	QueryParams                       string                  `json:"queryParams,omitempty"`
 }
 
+// Validates the fields which are common to all remote repositories, before sending them to Artifactory.
+func (rrbp RemoteRepositoryBaseParams) Validate() error {
+	if rrbp.Key == "" {
+		return errorutils.CheckErrorf("a repository key is required")
+	}
+	if rrbp.Rclass != "" && rrbp.Rclass != RemoteRepositoryRepoType {
+		return errorutils.CheckErrorf("repository '%s': expected repository class '%s', got '%s'", rrbp.Key, RemoteRepositoryRepoType, rrbp.Rclass)
+	}
+	if rrbp.PackageType != "" && !slices.Contains(RemoteRepositoryPackageTypes, rrbp.PackageType) {
+		return errorutils.CheckErrorf("repository '%s': unsupported package type '%s' for a remote repository", rrbp.Key, rrbp.PackageType)
+	}
+	if rrbp.Url != "" {
+		remoteUrl, err := url.Parse(rrbp.Url)
+		if err != nil || remoteUrl.Host == "" {
+			return errorutils.CheckErrorf("repository '%s': invalid remote URL '%s'", rrbp.Key, rrbp.Url)
+		}
+	}
+	// A slice rather than a map, so the same field is reported when several fields are invalid.
+	for _, field := range []struct {
+		name  string
+		value *int
+	}{
+		{"assumedOfflinePeriodSecs", rrbp.AssumedOfflinePeriodSecs},
+		{"metadataRetrievalTimeoutSecs", rrbp.MetadataRetrievalTimeoutSecs},
+		{"missedRetrievalCachePeriodSecs", rrbp.MissedRetrievalCachePeriodSecs},
+		{"retrievalCachePeriodSecs", rrbp.RetrievalCachePeriodSecs},
+		{"socketTimeoutMillis", rrbp.SocketTimeoutMillis},
+		{"unusedArtifactsCleanupPeriodHours", rrbp.UnusedArtifactsCleanupPeriodHours},
+	} {
+		if field.value != nil && *field.value < 0 {
+			return errorutils.CheckErrorf("repository '%s': '%s' must not be negative", rrbp.Key, field.name)
+		}
+	}
+	return nil
+}
+
 func NewRemoteRepositoryBaseParams() RemoteRepositoryBaseParams {
 	return RemoteRepositoryBaseParams{RepositoryBaseParams: RepositoryBaseParams{Rclass: RemoteRepositoryRepoType}}
 }
@@ -232,6 +308,14 @@ func NewAlpineRemoteRepositoryParams() AlpineRemoteRepositoryParams {
 	return AlpineRemoteRepositoryParams{RemoteRepositoryBaseParams: NewRemoteRepositoryPackageParams("alpine")}
 }
 
+type AnsibleRemoteRepositoryParams struct {
+	RemoteRepositoryBaseParams
+}
+
+func NewAnsibleRemoteRepositoryParams() AnsibleRemoteRepositoryParams {
+	return AnsibleRemoteRepositoryParams{RemoteRepositoryBaseParams: NewRemoteRepositoryPackageParams("ansible")}
+}
+
 type BowerRemoteRepositoryParams struct {
 	RemoteRepositoryBaseParams
 	VcsGitRemoteRepositoryParams
@@ -245,7 +329,8 @@ func NewBowerRemoteRepositoryParams() BowerRemoteRepositoryParams {
 type CargoRemoteRepositoryParams struct {
 	RemoteRepositoryBaseParams
 	CargoRepositoryParams
-	GitRegistryUrl string `json:"gitRegistryUrl,omitempty"`
+	GitRegistryUrl     string `json:"gitRegistryUrl,omitempty"`
+	CargoInternalIndex *bool  `json:"cargoInternalIndex,omitempty"`
 }
 
 func NewCargoRemoteRepositoryParams() CargoRemoteRepositoryParams {
@@ -380,6 +465,26 @@ func NewHelmRemoteRepositoryParams() HelmRemoteRepositoryParams {
 	return HelmRemoteRepositoryParams{RemoteRepositoryBaseParams: NewRemoteRepositoryPackageParams("helm")}
 }
 
+type HelmOciRemoteRepositoryParams struct {
+	RemoteRepositoryBaseParams
+	DockerRepositoryParams
+	ExternalDependenciesEnabled  *bool    `json:"externalDependenciesEnabled,omitempty"`
+	ExternalDependenciesPatterns []string `json:"externalDependenciesPatterns,omitempty"`
+	EnableTokenAuthentication    *bool    `json:"enableTokenAuthentication,omitempty"`
+}
+
+func NewHelmOciRemoteRepositoryParams() HelmOciRemoteRepositoryParams {
+	return HelmOciRemoteRepositoryParams{RemoteRepositoryBaseParams: NewRemoteRepositoryPackageParams("helmoci")}
+}
+
+type HuggingFaceMlRemoteRepositoryParams struct {
+	RemoteRepositoryBaseParams
+}
+
+func NewHuggingFaceMlRemoteRepositoryParams() HuggingFaceMlRemoteRepositoryParams {
+	return HuggingFaceMlRemoteRepositoryParams{RemoteRepositoryBaseParams: NewRemoteRepositoryPackageParams("huggingfaceml")}
+}
+
 type IvyRemoteRepositoryParams struct {
 	RemoteRepositoryBaseParams
 	JavaPackageManagersRemoteRepositoryParams
@@ -418,6 +523,18 @@ func NewNugetRemoteRepositoryParams() NugetRemoteRepositoryParams {
 	return NugetRemoteRepositoryParams{RemoteRepositoryBaseParams: NewRemoteRepositoryPackageParams("nuget")}
 }
 
+type OciRemoteRepositoryParams struct {
+	RemoteRepositoryBaseParams
+	DockerRepositoryParams
+	ExternalDependenciesEnabled  *bool    `json:"externalDependenciesEnabled,omitempty"`
+	ExternalDependenciesPatterns []string `json:"externalDependenciesPatterns,omitempty"`
+	EnableTokenAuthentication    *bool    `json:"enableTokenAuthentication,omitempty"`
+}
+
+func NewOciRemoteRepositoryParams() OciRemoteRepositoryParams {
+	return OciRemoteRepositoryParams{RemoteRepositoryBaseParams: NewRemoteRepositoryPackageParams("oci")}
+}
+
 type OpkgRemoteRepositoryParams struct {
 	RemoteRepositoryBaseParams
 }
@@ -435,6 +552,15 @@ func NewP2RemoteRepositoryParams() P2RemoteRepositoryParams {
 	return P2RemoteRepositoryParams{RemoteRepositoryBaseParams: NewRemoteRepositoryPackageParams("p2")}
 }
 
+type PubRemoteRepositoryParams struct {
+	RemoteRepositoryBaseParams
+	VcsGitRemoteRepositoryParams
+}
+
+func NewPubRemoteRepositoryParams() PubRemoteRepositoryParams {
+	return PubRemoteRepositoryParams{RemoteRepositoryBaseParams: NewRemoteRepositoryPackageParams("pub")}
+}
+
 type PuppetRemoteRepositoryParams struct {
 	RemoteRepositoryBaseParams
 }
@@ -473,6 +599,7 @@ func NewSbtRemoteRepositoryParams() SbtRemoteRepositoryParams {
 
 type SwiftRemoteRepositoryParams struct {
 	RemoteRepositoryBaseParams
+	VcsGitRemoteRepositoryParams
 }
 
 func NewSwiftRemoteRepositoryParams() SwiftRemoteRepositoryParams {
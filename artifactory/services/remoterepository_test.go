@@ -0,0 +1,128 @@
+package services
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteRepositoryValidate(t *testing.T) {
+	negative := -1
+	tests := []struct {
+		name          string
+		params        RemoteRepositoryBaseParams
+		expectedError string
+	}{
+		{"valid", RemoteRepositoryBaseParams{RepositoryBaseParams: RepositoryBaseParams{Key: "hf-remote", Rclass: "remote", PackageType: "huggingfaceml"}, Url: "https://huggingface.co"}, ""},
+		{"partial update", RemoteRepositoryBaseParams{RepositoryBaseParams: RepositoryBaseParams{Key: "hf-remote"}}, ""},
+		{"missing key", RemoteRepositoryBaseParams{Url: "https://huggingface.co"}, "key is required"},
+		{"wrong class", RemoteRepositoryBaseParams{RepositoryBaseParams: RepositoryBaseParams{Key: "a", Rclass: "local"}}, "expected repository class"},
+		{"unsupported type", RemoteRepositoryBaseParams{RepositoryBaseParams: RepositoryBaseParams{Key: "a", PackageType: "vagrant"}}, "unsupported package type"},
+		{"invalid url", RemoteRepositoryBaseParams{RepositoryBaseParams: RepositoryBaseParams{Key: "a"}, Url: "not-a-url"}, "invalid remote URL"},
+		{"negative period", RemoteRepositoryBaseParams{RepositoryBaseParams: RepositoryBaseParams{Key: "a"}, RetrievalCachePeriodSecs: &negative}, "must not be negative"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.params.Validate()
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestRemoteRepositoryValidateOrder(t *testing.T) {
+	negative := -1
+	params := RemoteRepositoryBaseParams{RepositoryBaseParams: RepositoryBaseParams{Key: "a"}, SocketTimeoutMillis: &negative, RetrievalCachePeriodSecs: &negative}
+	for i := 0; i < 10; i++ {
+		assert.ErrorContains(t, params.Validate(), "'retrievalCachePeriodSecs' must not be negative")
+	}
+}
+
+func TestClassifyRemoteConnectionFailure(t *testing.T) {
+	tests := []struct {
+		message            string
+		expectedStatus     RemoteConnectionStatus
+		expectedStatusCode int
+	}{
+		{"Connection failed: Error 401: Unauthorized", RemoteConnectionUnauthorized, 401},
+		{"Connection failed: Error 407: Proxy Authentication Required", RemoteConnectionUnauthorized, 407},
+		{"Connection failed: Error 404: Not Found", RemoteConnectionNotFound, 404},
+		{"Connection failed: status code 502", RemoteConnectionFailed, 502},
+		{"Connection failed: java.net.UnknownHostException: upstream.invalid", RemoteConnectionUnreachable, 0},
+		{"Connection failed: Connect to upstream:443 failed: Connect timed out", RemoteConnectionUnreachable, 0},
+		{"Connection failed: PKIX path building failed", RemoteConnectionTlsError, 0},
+		{"Connection failed", RemoteConnectionFailed, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.message, func(t *testing.T) {
+			status, statusCode := ClassifyRemoteConnectionFailure(test.message)
+			assert.Equal(t, test.expectedStatus, status)
+			assert.Equal(t, test.expectedStatusCode, statusCode)
+		})
+	}
+}
+
+func TestUiRepoType(t *testing.T) {
+	for _, packageType := range RemoteRepositoryPackageTypes {
+		_, err := uiRepoType(packageType)
+		assert.NoError(t, err, packageType)
+	}
+	for packageType, expected := range map[string]string{"": "Generic", "gitlfs": "GitLfs", "nuget": "NuGet", "pypi": "Pypi", "rpm": "RPM", "helmoci": "HelmOCI"} {
+		repoType, err := uiRepoType(packageType)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, repoType)
+	}
+	_, err := uiRepoType("unknown")
+	assert.ErrorContains(t, err, "not supported")
+}
+
+func TestRemoteRepositoryTestConnection(t *testing.T) {
+	var request testRemoteRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+testRemoteRepositoryApi, r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(body, &request))
+		if request.Advanced.Network.Password == "wrong" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"Connection failed: Error 401: Unauthorized"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"info":"Successfully connected to server"}`))
+	}))
+	defer server.Close()
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	assert.NoError(t, err)
+	rrs := NewRemoteRepositoryService(client, false)
+	rrs.ArtDetails = &testServiceDetails{}
+	rrs.ArtDetails.SetUrl(server.URL + "/")
+
+	params := NewNpmRemoteRepositoryParams()
+	params.Key = "npm-remote"
+	params.Url = "https://registry.npmjs.org"
+	params.Username = "user"
+	params.Password = "secret"
+	diagnostics, err := rrs.TestConnection(params.RemoteRepositoryBaseParams)
+	assert.NoError(t, err)
+	assert.True(t, diagnostics.IsOk())
+	assert.Equal(t, "Npm", request.TypeSpecific.RepoType)
+	assert.Equal(t, "user", request.Advanced.Network.Username)
+
+	params.Password = "wrong"
+	diagnostics, err = rrs.TestConnection(params.RemoteRepositoryBaseParams)
+	assert.NoError(t, err)
+	assert.Equal(t, &RemoteConnectionDiagnostics{Url: params.Url, Status: RemoteConnectionUnauthorized, UpstreamStatusCode: 401,
+		Message: "Connection failed: Error 401: Unauthorized"}, diagnostics)
+
+	params.Url = ""
+	_, err = rrs.TestConnection(params.RemoteRepositoryBaseParams)
+	assert.ErrorContains(t, err, "a remote URL is required")
+}
@@ -0,0 +1,268 @@
+package services
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	ReplicationStatusNeverRun     = "never_run"
+	ReplicationStatusIncomplete   = "incomplete"
+	ReplicationStatusError        = "error"
+	ReplicationStatusOk           = "ok"
+	ReplicationStatusInconsistent = "inconsistent"
+
+	defaultReplicationWaitTimeout     = 30 * time.Minute
+	defaultReplicationPollingInterval = 10 * time.Second
+)
+
+// Runs configured replications on demand, and reports their status.
+type ReplicationExecutionService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	ArtDetails auth.ServiceDetails
+}
+
+func NewReplicationExecutionService(client *jfroghttpclient.JfrogHttpClient) *ReplicationExecutionService {
+	return &ReplicationExecutionService{client: client}
+}
+
+func (res *ReplicationExecutionService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return res.client
+}
+
+type RunReplicationParams struct {
+	// The repository to replicate, optionally followed by a path inside it to limit the replication scope.
+	// For example: "my-repository" or "my-repository/org/acme".
+	RepoPath string
+	// Push replication targets. If empty, the replications configured for the repository are run.
+	// Not applicable to pull replications of remote repositories.
+	Targets []ReplicationTarget
+}
+
+func NewRunReplicationParams(repoPath string) RunReplicationParams {
+	return RunReplicationParams{RepoPath: repoPath}
+}
+
+type ReplicationTarget struct {
+	Url        string `json:"url"`
+	Username   string `json:"username,omitempty"`
+	Password   string `json:"password,omitempty"`
+	Properties *bool  `json:"properties,omitempty"`
+	Deletes    *bool  `json:"deletes,omitempty"`
+}
+
+type ReplicationStatus struct {
+	// One of the ReplicationStatus* constants.
+	Status        string                                 `json:"status,omitempty"`
+	LastCompleted string                                 `json:"lastCompleted,omitempty"`
+	Targets       []ReplicationTargetStatus              `json:"targets,omitempty"`
+	Repositories  map[string]ReplicationRepositoryStatus `json:"repositories,omitempty"`
+}
+
+// Returns true if the replication is still in progress or was interrupted.
+func (rs *ReplicationStatus) IsIncomplete() bool {
+	return rs.Status == ReplicationStatusIncomplete
+}
+
+type ReplicationTargetStatus struct {
+	Url           string `json:"url,omitempty"`
+	RepoKey       string `json:"repoKey,omitempty"`
+	Status        string `json:"status,omitempty"`
+	LastCompleted string `json:"lastCompleted,omitempty"`
+}
+
+type ReplicationRepositoryStatus struct {
+	Status        string `json:"status,omitempty"`
+	LastCompleted string `json:"lastCompleted,omitempty"`
+}
+
+// The replications of a repository, counted by their status. Derived from the per-target statuses of a
+// multi-push replication, or from the per-repository statuses of a pull replication.
+type ReplicationQueueMetrics struct {
+	Total        int
+	InProgress   int
+	Failed       int
+	Succeeded    int
+	Inconsistent int
+	NeverRun     int
+	// The earliest completion time of the replications, to detect targets which fall behind.
+	OldestLastCompleted string
+}
+
+func (rs *ReplicationStatus) QueueMetrics() ReplicationQueueMetrics {
+	var statuses []ReplicationRepositoryStatus
+	for _, target := range rs.Targets {
+		statuses = append(statuses, ReplicationRepositoryStatus{Status: target.Status, LastCompleted: target.LastCompleted})
+	}
+	for _, key := range slices.Sorted(maps.Keys(rs.Repositories)) {
+		statuses = append(statuses, rs.Repositories[key])
+	}
+	if len(statuses) == 0 && rs.Status != "" {
+		statuses = append(statuses, ReplicationRepositoryStatus{Status: rs.Status, LastCompleted: rs.LastCompleted})
+	}
+	metrics := ReplicationQueueMetrics{Total: len(statuses)}
+	for _, status := range statuses {
+		switch status.Status {
+		case ReplicationStatusIncomplete:
+			metrics.InProgress++
+		case ReplicationStatusError:
+			metrics.Failed++
+		case ReplicationStatusOk:
+			metrics.Succeeded++
+		case ReplicationStatusInconsistent:
+			metrics.Inconsistent++
+		case ReplicationStatusNeverRun:
+			metrics.NeverRun++
+		}
+		// The timestamps have the same ISO 8601 format, so they are compared as strings.
+		if status.LastCompleted != "" && (metrics.OldestLastCompleted == "" || status.LastCompleted < metrics.OldestLastCompleted) {
+			metrics.OldestLastCompleted = status.LastCompleted
+		}
+	}
+	return metrics
+}
+
+type WaitForReplicationParams struct {
+	RepoPath string
+	// The status before the replication was triggered. If set, the wait continues until a newer run completes, so
+	// that the status of the previous run isn't mistaken for the status of the triggered one.
+	PreviousStatus *ReplicationStatus
+	// The maximum time to wait. Defaults to 30 minutes.
+	Timeout time.Duration
+	// The time to wait between status checks. Defaults to 10 seconds.
+	PollingInterval time.Duration
+}
+
+func NewWaitForReplicationParams(repoPath string) WaitForReplicationParams {
+	return WaitForReplicationParams{RepoPath: repoPath}
+}
+
+// Schedules an immediate run of the replications of a repository. The replication itself runs asynchronously.
+func (res *ReplicationExecutionService) RunReplicationNow(params RunReplicationParams) error {
+	repoPath := strings.Trim(params.RepoPath, "/")
+	if repoPath == "" {
+		return errorutils.CheckErrorf("a repository is required to run a replication")
+	}
+	var content []byte
+	var err error
+	if len(params.Targets) > 0 {
+		if content, err = json.Marshal(params.Targets); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	httpClientsDetails := res.ArtDetails.CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	log.Info("Scheduling replication of '" + repoPath + "'...")
+	resp, body, err := res.client.SendPost(res.ArtDetails.GetUrl()+"api/replication/execute/"+repoPath, content, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusAccepted); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	log.Info("Done scheduling replication.")
+	return nil
+}
+
+func (res *ReplicationExecutionService) GetReplicationStatus(repoPath string) (*ReplicationStatus, error) {
+	body, err := res.getReplicationStatus(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	status := &ReplicationStatus{}
+	if err = json.Unmarshal(body, status); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return status, nil
+}
+
+func (res *ReplicationExecutionService) getReplicationStatus(repoPath string) ([]byte, error) {
+	httpClientsDetails := res.ArtDetails.CreateHttpClientDetails()
+	resp, body, _, err := res.client.SendGet(res.ArtDetails.GetUrl()+"api/replication/"+strings.Trim(repoPath, "/"), true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return body, nil
+}
+
+// Runs the replications of a repository, and waits until the triggered run completes.
+// The status is read before triggering, so the wait ends only when a newer run completes.
+func (res *ReplicationExecutionService) RunReplicationAndWait(runParams RunReplicationParams, waitParams WaitForReplicationParams) (*ReplicationStatus, error) {
+	if waitParams.RepoPath == "" {
+		waitParams.RepoPath = runParams.RepoPath
+	}
+	previousStatus, err := res.GetReplicationStatus(waitParams.RepoPath)
+	if err != nil {
+		return nil, err
+	}
+	waitParams.PreviousStatus = previousStatus
+	if err = res.RunReplicationNow(runParams); err != nil {
+		return nil, err
+	}
+	return res.WaitForReplication(waitParams)
+}
+
+// Polls the replication status until the replication is no longer incomplete, and returns the final status.
+// Set PreviousStatus to wait for a replication which was triggered after it was read.
+// If the replication failed, the final status is returned with an error.
+func (res *ReplicationExecutionService) WaitForReplication(params WaitForReplicationParams) (*ReplicationStatus, error) {
+	if params.Timeout <= 0 {
+		params.Timeout = defaultReplicationWaitTimeout
+	}
+	if params.PollingInterval <= 0 {
+		params.PollingInterval = defaultReplicationPollingInterval
+	}
+	var status *ReplicationStatus
+	sawIncomplete := false
+	pollingAction := func() (shouldStop bool, responseBody []byte, err error) {
+		responseBody, err = res.getReplicationStatus(params.RepoPath)
+		if err != nil {
+			return true, nil, err
+		}
+		// A new status is unmarshalled on every poll, so that the repositories of previous polls aren't kept.
+		status = &ReplicationStatus{}
+		if err = errorutils.CheckError(json.Unmarshal(responseBody, status)); err != nil {
+			return true, nil, err
+		}
+		sawIncomplete = sawIncomplete || status.IsIncomplete()
+		return isReplicationDone(status, params.PreviousStatus, sawIncomplete), responseBody, nil
+	}
+	pollingExecutor := &httputils.PollingExecutor{
+		Timeout:         params.Timeout,
+		PollingInterval: params.PollingInterval,
+		PollingAction:   pollingAction,
+		MsgPrefix:       "Waiting for replication of '" + params.RepoPath + "'...",
+	}
+	if _, err := pollingExecutor.Execute(); err != nil {
+		return nil, err
+	}
+	if status.Status == ReplicationStatusError {
+		return status, errorutils.CheckErrorf("the replication of '%s' failed", params.RepoPath)
+	}
+	return status, nil
+}
+
+// The replication is done once it's no longer incomplete, and its status is of a run which is newer than the previous
+// status. A failed run may not update the completion time, so a run which was seen in progress is newer too.
+func isReplicationDone(status, previousStatus *ReplicationStatus, sawIncomplete bool) bool {
+	if status.IsIncomplete() {
+		return false
+	}
+	return previousStatus == nil || sawIncomplete || status.LastCompleted != previousStatus.LastCompleted ||
+		status.Status != previousStatus.Status
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunReplicationAndWait(t *testing.T) {
+	var lock sync.Mutex
+	triggered := false
+	statusChecks := 0
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if r.Method == http.MethodPost {
+			assert.Equal(t, "/api/replication/execute/my-repository", r.URL.Path)
+			triggered = true
+			return
+		}
+		assert.Equal(t, "/api/replication/my-repository", r.URL.Path)
+		if triggered {
+			statusChecks++
+		}
+		switch {
+		// The status of the previous run is returned until the triggered run starts.
+		case !triggered || statusChecks == 1:
+			_, _ = w.Write([]byte(`{"status":"ok","lastCompleted":"2026-10-01T10:00:00.000Z"}`))
+		case statusChecks == 2:
+			_, _ = w.Write([]byte(`{"status":"incomplete","lastCompleted":"2026-10-01T10:00:00.000Z"}`))
+		default:
+			_, _ = w.Write([]byte(`{"status":"ok","lastCompleted":"2026-10-17T10:00:00.000Z"}`))
+		}
+	})
+	replicationService := NewReplicationExecutionService(client)
+	replicationService.ArtDetails = serviceDetails
+
+	waitParams := NewWaitForReplicationParams("")
+	waitParams.PollingInterval = time.Millisecond
+	status, err := replicationService.RunReplicationAndWait(NewRunReplicationParams("my-repository"), waitParams)
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-10-17T10:00:00.000Z", status.LastCompleted)
+	assert.Equal(t, 3, statusChecks)
+}
+
+func TestReplicationQueueMetrics(t *testing.T) {
+	status := ReplicationStatus{Status: ReplicationStatusIncomplete, Targets: []ReplicationTargetStatus{
+		{Url: "https://a", Status: ReplicationStatusOk, LastCompleted: "2026-10-17T10:00:00.000Z"},
+		{Url: "https://b", Status: ReplicationStatusIncomplete, LastCompleted: "2026-10-16T10:00:00.000Z"},
+		{Url: "https://c", Status: ReplicationStatusError},
+		{Url: "https://d", Status: ReplicationStatusNeverRun},
+	}}
+	assert.Equal(t, ReplicationQueueMetrics{Total: 4, InProgress: 1, Failed: 1, Succeeded: 1, NeverRun: 1, OldestLastCompleted: "2026-10-16T10:00:00.000Z"}, status.QueueMetrics())
+
+	// A single pull replication.
+	status = ReplicationStatus{Status: ReplicationStatusOk, LastCompleted: "2026-10-17T10:00:00.000Z"}
+	assert.Equal(t, ReplicationQueueMetrics{Total: 1, Succeeded: 1, OldestLastCompleted: "2026-10-17T10:00:00.000Z"}, status.QueueMetrics())
+}
+
+func TestWaitForReplicationFailure(t *testing.T) {
+	statusChecks := 0
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		statusChecks++
+		switch statusChecks {
+		case 1:
+			_, _ = w.Write([]byte(`{"status":"incomplete","repositories":{"first":{"status":"ok"}}}`))
+		default:
+			// The failed run doesn't update the completion time.
+			_, _ = w.Write([]byte(`{"status":"error","lastCompleted":"2026-10-01T10:00:00.000Z","repositories":{"second":{"status":"error"}}}`))
+		}
+	})
+	replicationService := NewReplicationExecutionService(client)
+	replicationService.ArtDetails = serviceDetails
+
+	waitParams := NewWaitForReplicationParams("my-repository")
+	waitParams.PollingInterval = time.Millisecond
+	waitParams.PreviousStatus = &ReplicationStatus{Status: ReplicationStatusError, LastCompleted: "2026-10-01T10:00:00.000Z"}
+	status, err := replicationService.WaitForReplication(waitParams)
+	assert.ErrorContains(t, err, "failed")
+	assert.Equal(t, 2, statusChecks)
+	if assert.NotNil(t, status) {
+		// The repositories of the previous polls aren't kept.
+		assert.Len(t, status.Repositories, 1)
+		assert.Contains(t, status.Repositories, "second")
+	}
+}
@@ -0,0 +1,274 @@
+package services
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
+)
+
+type RepositoriesConfigFormat string
+
+const (
+	RepositoriesConfigYaml RepositoriesConfigFormat = "yaml"
+	RepositoriesConfigJson RepositoriesConfigFormat = "json"
+)
+
+type RepositoryConfigAction string
+
+const (
+	RepositoryConfigCreate    RepositoryConfigAction = "create"
+	RepositoryConfigUpdate    RepositoryConfigAction = "update"
+	RepositoryConfigUnchanged RepositoryConfigAction = "unchanged"
+)
+
+// Exports repository configurations to a document, and applies such a document to an Artifactory instance.
+type RepositoriesConfigService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	ArtDetails auth.ServiceDetails
+}
+
+func NewRepositoriesConfigService(client *jfroghttpclient.JfrogHttpClient) *RepositoriesConfigService {
+	return &RepositoriesConfigService{client: client}
+}
+
+func (rcs *RepositoriesConfigService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return rcs.client
+}
+
+// A canonical document of repository configurations. Each repository is represented by its configuration,
+// as returned by the repositories REST API. Repositories are sorted by key, and fields by name.
+type RepositoriesConfig struct {
+	Repositories []map[string]interface{} `json:"repositories" yaml:"repositories"`
+}
+
+type ApplyRepositoriesConfigParams struct {
+	Config *RepositoriesConfig
+	// If true, only the diff is returned and no repository is created or updated.
+	DryRun bool
+}
+
+func NewApplyRepositoriesConfigParams(config *RepositoriesConfig) ApplyRepositoriesConfigParams {
+	return ApplyRepositoriesConfigParams{Config: config}
+}
+
+// The difference between a repository in the document and the same repository in Artifactory.
+type RepositoryConfigDiff struct {
+	Key     string
+	Action  RepositoryConfigAction
+	Changes []RepositoryConfigChange
+}
+
+type RepositoryConfigChange struct {
+	Field string
+	// The value in Artifactory, or nil if the field is not set.
+	Current interface{}
+	// The value in the document.
+	Desired interface{}
+}
+
+// Exports the configurations of the given repositories. If no repository is given, all repositories are exported.
+func (rcs *RepositoriesConfigService) Export(repoKeys ...string) (*RepositoriesConfig, error) {
+	repositoriesService := rcs.getRepositoriesService()
+	if len(repoKeys) == 0 {
+		allRepositories, err := repositoriesService.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		for _, repo := range *allRepositories {
+			repoKeys = append(repoKeys, repo.Key)
+		}
+	}
+	config := &RepositoriesConfig{}
+	for _, repoKey := range repoKeys {
+		repoConfig := make(map[string]interface{})
+		if err := repositoriesService.Get(repoKey, &repoConfig); err != nil {
+			return nil, err
+		}
+		config.Repositories = append(config.Repositories, repoConfig)
+	}
+	config.sort()
+	log.Info("Exported the configuration of", len(config.Repositories), "repositories.")
+	return config, nil
+}
+
+// Creates the repositories of the document which don't exist in Artifactory, and updates those which differ from it.
+// Fields which are missing from the document are left untouched. Returns the diff of each repository in the document.
+func (rcs *RepositoriesConfigService) Apply(params ApplyRepositoriesConfigParams) ([]RepositoryConfigDiff, error) {
+	if params.Config == nil {
+		return nil, errorutils.CheckErrorf("a repositories configuration document is required")
+	}
+	if err := params.Config.Validate(); err != nil {
+		return nil, err
+	}
+	repositoriesService := rcs.getRepositoriesService()
+	var diffs []RepositoryConfigDiff
+	for _, desired := range params.Config.Repositories {
+		repoKey := desired["key"].(string)
+		current, err := rcs.getCurrentConfig(repositoriesService, repoKey)
+		if err != nil {
+			return diffs, err
+		}
+		diff, err := DiffRepositoryConfig(repoKey, current, desired)
+		if err != nil {
+			return diffs, err
+		}
+		diffs = append(diffs, *diff)
+		if params.DryRun {
+			continue
+		}
+		switch diff.Action {
+		case RepositoryConfigCreate:
+			err = repositoriesService.Create(desired, repoKey)
+		case RepositoryConfigUpdate:
+			err = repositoriesService.Update(desired, repoKey)
+		}
+		if err != nil {
+			return diffs, err
+		}
+	}
+	return diffs, nil
+}
+
+// Returns nil if the repository doesn't exist.
+func (rcs *RepositoriesConfigService) getCurrentConfig(repositoriesService *RepositoriesService, repoKey string) (map[string]interface{}, error) {
+	exists, err := repositoriesService.IsExists(repoKey)
+	if err != nil || !exists {
+		return nil, err
+	}
+	current := make(map[string]interface{})
+	return current, repositoriesService.Get(repoKey, &current)
+}
+
+func (rcs *RepositoriesConfigService) getRepositoriesService() *RepositoriesService {
+	repositoriesService := NewRepositoriesService(rcs.client)
+	repositoriesService.ArtDetails = rcs.ArtDetails
+	return repositoriesService
+}
+
+// Compares the desired configuration of a repository with its current configuration.
+// A nil current configuration means that the repository doesn't exist. Fields which are missing from the desired configuration are ignored.
+func DiffRepositoryConfig(repoKey string, current, desired map[string]interface{}) (*RepositoryConfigDiff, error) {
+	if current == nil {
+		return &RepositoryConfigDiff{Key: repoKey, Action: RepositoryConfigCreate}, nil
+	}
+	// Documents parsed from YAML and JSON hold different numeric types, so both sides are normalized to JSON types.
+	normalizedCurrent, err := normalizeRepositoryConfig(current)
+	if err != nil {
+		return nil, err
+	}
+	normalizedDesired, err := normalizeRepositoryConfig(desired)
+	if err != nil {
+		return nil, err
+	}
+	diff := &RepositoryConfigDiff{Key: repoKey, Action: RepositoryConfigUnchanged}
+	for _, field := range sortedRepositoryConfigFields(normalizedDesired) {
+		currentValue := normalizedCurrent[field]
+		if !reflect.DeepEqual(currentValue, normalizedDesired[field]) {
+			diff.Changes = append(diff.Changes, RepositoryConfigChange{Field: field, Current: currentValue, Desired: normalizedDesired[field]})
+		}
+	}
+	if len(diff.Changes) > 0 {
+		diff.Action = RepositoryConfigUpdate
+	}
+	return diff, nil
+}
+
+func normalizeRepositoryConfig(config map[string]interface{}) (map[string]interface{}, error) {
+	content, err := json.Marshal(config)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	normalized := make(map[string]interface{})
+	return normalized, errorutils.CheckError(json.Unmarshal(content, &normalized))
+}
+
+func sortedRepositoryConfigFields(config map[string]interface{}) []string {
+	fields := make([]string, 0, len(config))
+	for field := range config {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func (rc *RepositoriesConfig) Validate() error {
+	keys := make(map[string]bool)
+	for i, repoConfig := range rc.Repositories {
+		key, ok := repoConfig["key"].(string)
+		if !ok || key == "" {
+			return errorutils.CheckErrorf("repository #%d in the configuration document has no key", i+1)
+		}
+		if keys[key] {
+			return errorutils.CheckErrorf("repository '%s' appears more than once in the configuration document", key)
+		}
+		keys[key] = true
+		if rclass, ok := repoConfig["rclass"].(string); !ok || rclass == "" {
+			return errorutils.CheckErrorf("repository '%s' in the configuration document has no rclass", key)
+		}
+	}
+	return nil
+}
+
+func (rc *RepositoriesConfig) sort() {
+	sort.SliceStable(rc.Repositories, func(i, j int) bool {
+		first, _ := rc.Repositories[i]["key"].(string)
+		second, _ := rc.Repositories[j]["key"].(string)
+		return first < second
+	})
+}
+
+// Serializes the document. Map fields are sorted, so the output is stable and can be kept in source control.
+func (rc *RepositoriesConfig) Marshal(format RepositoriesConfigFormat) ([]byte, error) {
+	rc.sort()
+	switch format {
+	case RepositoriesConfigYaml:
+		content, err := yaml.Marshal(rc)
+		return content, errorutils.CheckError(err)
+	case RepositoriesConfigJson:
+		content, err := json.MarshalIndent(rc, "", "  ")
+		return content, errorutils.CheckError(err)
+	}
+	return nil, errorutils.CheckErrorf("unsupported repositories configuration format '%s'", format)
+}
+
+func ParseRepositoriesConfig(content []byte, format RepositoriesConfigFormat) (*RepositoriesConfig, error) {
+	config := &RepositoriesConfig{}
+	var err error
+	switch format {
+	case RepositoriesConfigYaml:
+		err = yaml.Unmarshal(content, config)
+	case RepositoriesConfigJson:
+		err = json.Unmarshal(content, config)
+	default:
+		return nil, errorutils.CheckErrorf("unsupported repositories configuration format '%s'", format)
+	}
+	if err != nil {
+		return nil, errorutils.CheckErrorf("invalid repositories configuration document: %s", err.Error())
+	}
+	// YAML documents may hold nested maps with non-string keys, which can't be sent as JSON.
+	for i := range config.Repositories {
+		if config.Repositories[i], err = normalizeRepositoryConfig(config.Repositories[i]); err != nil {
+			return nil, err
+		}
+	}
+	return config, config.Validate()
+}
+
+// Returns the format matching the file extension, e.g. "repos.yml" -> yaml.
+func GetRepositoriesConfigFormat(filePath string) (RepositoriesConfigFormat, error) {
+	lower := strings.ToLower(filePath)
+	switch {
+	case strings.HasSuffix(lower, ".yaml"), strings.HasSuffix(lower, ".yml"):
+		return RepositoriesConfigYaml, nil
+	case strings.HasSuffix(lower, ".json"):
+		return RepositoriesConfigJson, nil
+	}
+	return "", errorutils.CheckErrorf("cannot determine the format of '%s', expected a .yaml, .yml or .json file", filePath)
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const repositoriesConfigYaml = `repositories:
+  - key: libs-release
+    rclass: local
+    packageType: maven
+    maxUniqueSnapshots: 10
+  - key: docker-remote
+    rclass: remote
+    packageType: docker
+    url: https://registry-1.docker.io/
+`
+
+func TestParseRepositoriesConfig(t *testing.T) {
+	config, err := ParseRepositoriesConfig([]byte(repositoriesConfigYaml), RepositoriesConfigYaml)
+	assert.NoError(t, err)
+	assert.Len(t, config.Repositories, 2)
+	// Numbers are normalized to JSON types.
+	assert.Equal(t, float64(10), config.Repositories[0]["maxUniqueSnapshots"])
+
+	// Marshalling sorts the repositories by key, so that the output is stable.
+	content, err := config.Marshal(RepositoriesConfigJson)
+	assert.NoError(t, err)
+	fromJson, err := ParseRepositoriesConfig(content, RepositoriesConfigJson)
+	assert.NoError(t, err)
+	assert.Equal(t, "docker-remote", fromJson.Repositories[0]["key"])
+	assert.Equal(t, config.Repositories, fromJson.Repositories)
+}
+
+func TestParseRepositoriesConfigValidation(t *testing.T) {
+	_, err := ParseRepositoriesConfig([]byte("repositories:\n  - rclass: local\n"), RepositoriesConfigYaml)
+	assert.ErrorContains(t, err, "has no key")
+	_, err = ParseRepositoriesConfig([]byte("repositories:\n  - key: a\n    rclass: local\n  - key: a\n    rclass: local\n"), RepositoriesConfigYaml)
+	assert.ErrorContains(t, err, "more than once")
+	_, err = ParseRepositoriesConfig([]byte("repositories:\n  - key: a\n"), RepositoriesConfigYaml)
+	assert.ErrorContains(t, err, "has no rclass")
+	_, err = ParseRepositoriesConfig(nil, "toml")
+	assert.ErrorContains(t, err, "unsupported")
+}
+
+func TestDiffRepositoryConfig(t *testing.T) {
+	desired := map[string]interface{}{"key": "libs-release", "rclass": "local", "maxUniqueSnapshots": 10, "description": "Releases"}
+
+	diff, err := DiffRepositoryConfig("libs-release", nil, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, RepositoryConfigCreate, diff.Action)
+
+	current := map[string]interface{}{"key": "libs-release", "rclass": "local", "maxUniqueSnapshots": float64(10), "notes": "ignored"}
+	diff, err = DiffRepositoryConfig("libs-release", current, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, RepositoryConfigUpdate, diff.Action)
+	assert.Equal(t, []RepositoryConfigChange{{Field: "description", Current: nil, Desired: "Releases"}}, diff.Changes)
+
+	current["description"] = "Releases"
+	diff, err = DiffRepositoryConfig("libs-release", current, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, RepositoryConfigUnchanged, diff.Action)
+	assert.Empty(t, diff.Changes)
+}
+
+func TestGetRepositoriesConfigFormat(t *testing.T) {
+	format, err := GetRepositoriesConfigFormat("repos.YML")
+	assert.NoError(t, err)
+	assert.Equal(t, RepositoriesConfigYaml, format)
+	format, err = GetRepositoriesConfigFormat("repos.json")
+	assert.NoError(t, err)
+	assert.Equal(t, RepositoriesConfigJson, format)
+	_, err = GetRepositoriesConfigFormat("repos.txt")
+	assert.Error(t, err)
+}
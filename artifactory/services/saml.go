@@ -0,0 +1,164 @@
+package services
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type SamlSettings struct {
+	EnableIntegration bool `xml:"enableIntegration" json:"enableIntegration"`
+	// The SSO URL of the identity provider.
+	LoginUrl  string `xml:"loginUrl,omitempty" json:"loginUrl,omitempty"`
+	LogoutUrl string `xml:"logoutUrl,omitempty" json:"logoutUrl,omitempty"`
+	// The X.509 signing certificate of the identity provider, either PEM encoded or as its base64 encoded body.
+	Certificate string `xml:"certificate,omitempty" json:"certificate,omitempty"`
+	// The entity ID of Artifactory in the identity provider.
+	ServiceProviderName       string `xml:"serviceProviderName,omitempty" json:"serviceProviderName,omitempty"`
+	NoAutoUserCreation        bool   `xml:"noAutoUserCreation" json:"noAutoUserCreation"`
+	AllowUserToAccessProfile  bool   `xml:"allowUserToAccessProfile" json:"allowUserToAccessProfile"`
+	AutoRedirect              bool   `xml:"autoRedirect" json:"autoRedirect"`
+	UseEncryptedAssertion     bool   `xml:"useEncryptedAssertion" json:"useEncryptedAssertion"`
+	VerifyAudienceRestriction bool   `xml:"verifyAudienceRestriction" json:"verifyAudienceRestriction"`
+	// If true, the groups of the user are synced from the GroupAttribute of the assertion on every login.
+	SyncGroups     bool   `xml:"syncGroups" json:"syncGroups"`
+	GroupAttribute string `xml:"groupAttribute,omitempty" json:"groupAttribute,omitempty"`
+	EmailAttribute string `xml:"emailAttribute,omitempty" json:"emailAttribute,omitempty"`
+}
+
+// Only enabled settings are fully validated, so an integration can be disabled without fixing its settings.
+func (ss *SamlSettings) Validate() error {
+	if !ss.EnableIntegration {
+		return nil
+	}
+	if err := validateSamlUrl("login", ss.LoginUrl, true); err != nil {
+		return err
+	}
+	if err := validateSamlUrl("logout", ss.LogoutUrl, false); err != nil {
+		return err
+	}
+	if ss.ServiceProviderName == "" {
+		return errorutils.CheckErrorf("a service provider name is required to enable the SAML integration")
+	}
+	if ss.SyncGroups && ss.GroupAttribute == "" {
+		return errorutils.CheckErrorf("a group attribute is required to sync the groups of SAML users")
+	}
+	certificate, err := ParseSamlCertificate(ss.Certificate)
+	if err != nil {
+		return err
+	}
+	if time.Now().After(certificate.NotAfter) {
+		log.Warn("The SAML certificate expired on " + certificate.NotAfter.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func validateSamlUrl(name, value string, required bool) error {
+	if value == "" {
+		if required {
+			return errorutils.CheckErrorf("a %s URL is required to enable the SAML integration", name)
+		}
+		return nil
+	}
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return errorutils.CheckErrorf("the SAML %s URL must be an absolute http or https URL, got '%s'", name, value)
+	}
+	return nil
+}
+
+// Parses an X.509 certificate, either PEM encoded or as its base64 encoded body, as stored by Artifactory.
+func ParseSamlCertificate(certificate string) (*x509.Certificate, error) {
+	certificate = strings.TrimSpace(certificate)
+	if certificate == "" {
+		return nil, errorutils.CheckErrorf("a certificate is required to enable the SAML integration")
+	}
+	var der []byte
+	if block, _ := pem.Decode([]byte(certificate)); block != nil {
+		der = block.Bytes
+	} else {
+		var err error
+		if der, err = base64.StdEncoding.DecodeString(strings.Join(strings.Fields(certificate), "")); err != nil {
+			return nil, errorutils.CheckErrorf("the SAML certificate is neither PEM nor base64 encoded: %s", err.Error())
+		}
+	}
+	parsed, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("invalid SAML certificate: %s", err.Error())
+	}
+	return parsed, nil
+}
+
+// Returns disabled settings if SAML isn't configured.
+func (cs *ConfigurationService) GetSamlSettings() (*SamlSettings, error) {
+	descriptor, err := cs.getConfigDescriptor()
+	if err != nil {
+		return nil, err
+	}
+	if descriptor.Security.SamlSettings == nil {
+		return &SamlSettings{}, nil
+	}
+	return descriptor.Security.SamlSettings, nil
+}
+
+// Replaces the SAML settings with the given ones, so to change only some of the settings, get the current settings,
+// modify them and update. Empty URLs, certificate and attributes are cleared. The PEM header and footer of the
+// certificate are removed, as Artifactory stores only its body.
+func (cs *ConfigurationService) UpdateSamlSettings(samlSettings SamlSettings) error {
+	if err := samlSettings.Validate(); err != nil {
+		return err
+	}
+	log.Info("Applying the SAML settings...")
+	return cs.patch(map[string]interface{}{"security": map[string]interface{}{"samlSettings": samlSettingsPatch(samlSettings)}})
+}
+
+// Disables the SAML integration, keeping the rest of its settings, so it can be enabled again later.
+func (cs *ConfigurationService) DisableSamlIntegration() error {
+	samlSettings, err := cs.GetSamlSettings()
+	if err != nil {
+		return err
+	}
+	samlSettings.EnableIntegration = false
+	return cs.UpdateSamlSettings(*samlSettings)
+}
+
+func samlSettingsPatch(samlSettings SamlSettings) map[string]interface{} {
+	patch := map[string]interface{}{
+		"enableIntegration":         samlSettings.EnableIntegration,
+		"noAutoUserCreation":        samlSettings.NoAutoUserCreation,
+		"allowUserToAccessProfile":  samlSettings.AllowUserToAccessProfile,
+		"autoRedirect":              samlSettings.AutoRedirect,
+		"useEncryptedAssertion":     samlSettings.UseEncryptedAssertion,
+		"verifyAudienceRestriction": samlSettings.VerifyAudienceRestriction,
+		"syncGroups":                samlSettings.SyncGroups,
+	}
+	for key, value := range map[string]string{
+		"loginUrl":            samlSettings.LoginUrl,
+		"logoutUrl":           samlSettings.LogoutUrl,
+		"certificate":         samlCertificateBody(samlSettings.Certificate),
+		"serviceProviderName": samlSettings.ServiceProviderName,
+		"groupAttribute":      samlSettings.GroupAttribute,
+		"emailAttribute":      samlSettings.EmailAttribute,
+	} {
+		// A null value removes the setting from the configuration.
+		if value == "" {
+			patch[key] = nil
+		} else {
+			patch[key] = value
+		}
+	}
+	return patch
+}
+
+func samlCertificateBody(certificate string) string {
+	if block, _ := pem.Decode([]byte(strings.TrimSpace(certificate))); block != nil {
+		return base64.StdEncoding.EncodeToString(block.Bytes)
+	}
+	return strings.Join(strings.Fields(certificate), "")
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCertificate(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return der
+}
+
+func TestParseSamlCertificate(t *testing.T) {
+	der := createTestCertificate(t)
+	pemCertificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	for _, certificate := range []string{pemCertificate, base64.StdEncoding.EncodeToString(der)} {
+		parsed, err := ParseSamlCertificate(certificate)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "idp.example.com", parsed.Subject.CommonName)
+		}
+	}
+	_, err := ParseSamlCertificate("not a certificate")
+	assert.ErrorContains(t, err, "neither PEM nor base64")
+	_, err = ParseSamlCertificate(base64.StdEncoding.EncodeToString([]byte("not a certificate")))
+	assert.ErrorContains(t, err, "invalid SAML certificate")
+	// The body of a PEM certificate is stored without its header and footer.
+	assert.Equal(t, base64.StdEncoding.EncodeToString(der), samlCertificateBody(pemCertificate))
+}
+
+func TestSamlSettingsValidate(t *testing.T) {
+	// Disabled settings aren't validated.
+	samlSettings := SamlSettings{LoginUrl: "idp.example.com"}
+	assert.NoError(t, samlSettings.Validate())
+
+	samlSettings.EnableIntegration = true
+	assert.ErrorContains(t, samlSettings.Validate(), "absolute http or https URL")
+	samlSettings.LoginUrl = "https://idp.example.com/sso"
+	assert.ErrorContains(t, samlSettings.Validate(), "service provider name is required")
+	samlSettings.ServiceProviderName = "artifactory"
+	samlSettings.SyncGroups = true
+	assert.ErrorContains(t, samlSettings.Validate(), "group attribute is required")
+	samlSettings.GroupAttribute = "groups"
+	assert.ErrorContains(t, samlSettings.Validate(), "certificate is required")
+	samlSettings.Certificate = base64.StdEncoding.EncodeToString(createTestCertificate(t))
+	assert.NoError(t, samlSettings.Validate())
+}
+
+func TestSamlSettingsPatch(t *testing.T) {
+	// All the settings are replaced, and empty values are cleared.
+	patch := samlSettingsPatch(SamlSettings{EnableIntegration: true, LoginUrl: "https://idp.example.com/sso", Certificate: "abc", SyncGroups: true})
+	assert.Equal(t, true, patch["enableIntegration"])
+	assert.Equal(t, true, patch["syncGroups"])
+	assert.Equal(t, "https://idp.example.com/sso", patch["loginUrl"])
+	assert.Equal(t, "abc", patch["certificate"])
+	if assert.Contains(t, patch, "logoutUrl") {
+		assert.Nil(t, patch["logoutUrl"])
+	}
+}
+
+func TestDisableSamlIntegration(t *testing.T) {
+	var patchBody string
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			patchBody = string(body)
+			return
+		}
+		_, _ = w.Write([]byte(`<config><security><samlSettings><enableIntegration>true</enableIntegration>` +
+			`<loginUrl>https://idp.example.com/sso</loginUrl><certificate>abc</certificate><syncGroups>true</syncGroups>` +
+			`<groupAttribute>groups</groupAttribute></samlSettings></security></config>`))
+	})
+	configurationService := NewConfigurationService(serviceDetails, client)
+	assert.NoError(t, configurationService.DisableSamlIntegration())
+	// The rest of the settings are kept.
+	assert.Contains(t, patchBody, "enableIntegration: false")
+	assert.Contains(t, patchBody, "loginUrl: https://idp.example.com/sso")
+	assert.Contains(t, patchBody, "certificate: abc")
+	assert.Contains(t, patchBody, "syncGroups: true")
+	assert.Contains(t, patchBody, "groupAttribute: groups")
+}
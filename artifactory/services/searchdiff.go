@@ -0,0 +1,151 @@
+package services
+
+import (
+	"errors"
+	"path"
+	"sort"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type SearchDiffService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+}
+
+func NewSearchDiffService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *SearchDiffService {
+	return &SearchDiffService{artDetails: &artDetails, client: client}
+}
+
+func (sds *SearchDiffService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *sds.artDetails
+}
+
+func (sds *SearchDiffService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return sds.client
+}
+
+func (sds *SearchDiffService) IsDryRun() bool {
+	return false
+}
+
+type SearchDiffParams struct {
+	// The search used as the baseline, e.g. the production repository.
+	Source SearchParams
+	// The search compared against the baseline, e.g. the staging repository.
+	Target SearchParams
+	// If true, items are matched by their full path including the repository name.
+	// By default, items are matched by their path inside the repository, so that the same path in two different repositories is considered the same item.
+	IncludeRepoInPath bool
+}
+
+func NewSearchDiffParams(source, target SearchParams) SearchDiffParams {
+	return SearchDiffParams{Source: source, Target: target}
+}
+
+type SearchDiffResult struct {
+	// Items that exist only in the target search results.
+	Added []utils.ResultItem
+	// Items that exist only in the source search results.
+	Removed []utils.ResultItem
+	// Items that exist in both search results with different checksums.
+	Changed []ChangedSearchItem
+}
+
+type ChangedSearchItem struct {
+	Source utils.ResultItem
+	Target utils.ResultItem
+}
+
+func (sdr *SearchDiffResult) IsEmpty() bool {
+	return len(sdr.Added) == 0 && len(sdr.Removed) == 0 && len(sdr.Changed) == 0
+}
+
+// Runs both searches and returns the items that were added, removed or changed between them, compared by checksum.
+// Folders are ignored.
+func (sds *SearchDiffService) Diff(params SearchDiffParams) (*SearchDiffResult, error) {
+	sourceItems, err := sds.searchFiles(params.Source, params.IncludeRepoInPath)
+	if err != nil {
+		return nil, err
+	}
+	targetItems, err := sds.searchFiles(params.Target, params.IncludeRepoInPath)
+	if err != nil {
+		return nil, err
+	}
+	result := DiffSearchResults(sourceItems, targetItems)
+	log.Info("Search diff: added:", len(result.Added), "removed:", len(result.Removed), "changed:", len(result.Changed))
+	return result, nil
+}
+
+func (sds *SearchDiffService) searchFiles(params SearchParams, includeRepoInPath bool) (items map[string]utils.ResultItem, err error) {
+	reader, err := SearchBySpecFiles(params, sds, utils.NONE)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	return readSearchItemsByPath(reader, includeRepoInPath)
+}
+
+func readSearchItemsByPath(reader *content.ContentReader, includeRepoInPath bool) (map[string]utils.ResultItem, error) {
+	items := make(map[string]utils.ResultItem)
+	for item := new(utils.ResultItem); reader.NextRecord(item) == nil; item = new(utils.ResultItem) {
+		if item.Type == string(utils.Folder) {
+			continue
+		}
+		items[getSearchDiffKey(*item, includeRepoInPath)] = *item
+	}
+	return items, reader.GetError()
+}
+
+func getSearchDiffKey(item utils.ResultItem, includeRepoInPath bool) string {
+	if includeRepoInPath {
+		return item.GetItemRelativePath()
+	}
+	return path.Join(item.Path, item.Name)
+}
+
+// Compares two sets of search results, keyed by the item's path, and returns the differences between them.
+// The results are sorted by path.
+func DiffSearchResults(source, target map[string]utils.ResultItem) *SearchDiffResult {
+	result := &SearchDiffResult{}
+	for _, key := range sortedSearchDiffKeys(target) {
+		targetItem := target[key]
+		sourceItem, exists := source[key]
+		if !exists {
+			result.Added = append(result.Added, targetItem)
+			continue
+		}
+		if !isSameChecksum(sourceItem, targetItem) {
+			result.Changed = append(result.Changed, ChangedSearchItem{Source: sourceItem, Target: targetItem})
+		}
+	}
+	for _, key := range sortedSearchDiffKeys(source) {
+		if _, exists := target[key]; !exists {
+			result.Removed = append(result.Removed, source[key])
+		}
+	}
+	return result
+}
+
+// Compares by SHA-256 when both items have one, since older items may lack it. Otherwise, falls back to SHA-1.
+func isSameChecksum(source, target utils.ResultItem) bool {
+	if source.Sha256 != "" && target.Sha256 != "" {
+		return source.Sha256 == target.Sha256
+	}
+	return source.Actual_Sha1 == target.Actual_Sha1
+}
+
+func sortedSearchDiffKeys(items map[string]utils.ResultItem) []string {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffSearchResults(t *testing.T) {
+	source := map[string]utils.ResultItem{
+		"a/same.zip":    {Repo: "staging", Path: "a", Name: "same.zip", Sha256: "1"},
+		"a/changed.zip": {Repo: "staging", Path: "a", Name: "changed.zip", Sha256: "2"},
+		"a/removed.zip": {Repo: "staging", Path: "a", Name: "removed.zip", Sha256: "3"},
+		"a/legacy.zip":  {Repo: "staging", Path: "a", Name: "legacy.zip", Actual_Sha1: "4"},
+	}
+	target := map[string]utils.ResultItem{
+		"a/same.zip":    {Repo: "prod", Path: "a", Name: "same.zip", Sha256: "1"},
+		"a/changed.zip": {Repo: "prod", Path: "a", Name: "changed.zip", Sha256: "5"},
+		"a/added.zip":   {Repo: "prod", Path: "a", Name: "added.zip", Sha256: "6"},
+		"a/legacy.zip":  {Repo: "prod", Path: "a", Name: "legacy.zip", Actual_Sha1: "4", Sha256: "7"},
+	}
+	result := DiffSearchResults(source, target)
+	assert.Len(t, result.Added, 1)
+	assert.Equal(t, "added.zip", result.Added[0].Name)
+	assert.Len(t, result.Removed, 1)
+	assert.Equal(t, "removed.zip", result.Removed[0].Name)
+	assert.Len(t, result.Changed, 1)
+	assert.Equal(t, "2", result.Changed[0].Source.Sha256)
+	assert.Equal(t, "5", result.Changed[0].Target.Sha256)
+	assert.False(t, result.IsEmpty())
+	assert.True(t, DiffSearchResults(source, source).IsEmpty())
+}
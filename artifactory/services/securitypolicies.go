@@ -0,0 +1,96 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	passwordExpirationPolicyPath = "api/security/configuration/passwordExpirationPolicy"
+	userLockPolicyPath           = "api/security/userLockPolicy"
+)
+
+type PasswordExpirationPolicy struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// The number of days until a password expires.
+	PasswordMaxAge int   `json:"passwordMaxAge,omitempty"`
+	NotifyByEmail  *bool `json:"notifyByEmail,omitempty"`
+}
+
+// Locks users after a number of failed login attempts.
+type UserLockPolicy struct {
+	Enabled       *bool `json:"enabled,omitempty"`
+	LoginAttempts int   `json:"loginAttempts,omitempty"`
+}
+
+func (ss *SecurityService) GetPasswordExpirationPolicy() (*PasswordExpirationPolicy, error) {
+	policy := &PasswordExpirationPolicy{}
+	return policy, ss.getPolicy(passwordExpirationPolicyPath, policy)
+}
+
+func (ss *SecurityService) UpdatePasswordExpirationPolicy(policy PasswordExpirationPolicy) error {
+	if policy.PasswordMaxAge < 0 {
+		return errorutils.CheckErrorf("the password max age can't be negative, got %d", policy.PasswordMaxAge)
+	}
+	return ss.updatePolicy(passwordExpirationPolicyPath, policy)
+}
+
+func (ss *SecurityService) GetUserLockPolicy() (*UserLockPolicy, error) {
+	policy := &UserLockPolicy{}
+	return policy, ss.getPolicy(userLockPolicyPath, policy)
+}
+
+func (ss *SecurityService) UpdateUserLockPolicy(policy UserLockPolicy) error {
+	if policy.Enabled != nil && *policy.Enabled && policy.LoginAttempts <= 0 {
+		return errorutils.CheckErrorf("the number of login attempts must be positive when the user lock policy is enabled, got %d", policy.LoginAttempts)
+	}
+	return ss.updatePolicy(userLockPolicyPath, policy)
+}
+
+// Revokes the API key of the user. API keys are deprecated, in favor of access tokens.
+func (ss *SecurityService) RevokeUserAPIKey(username string) error {
+	return ss.deleteAPIKeys(APIKeyPath + "/" + url.PathEscape(username))
+}
+
+// Revokes the API keys of all the users.
+func (ss *SecurityService) RevokeAllAPIKeys() error {
+	return ss.deleteAPIKeys(APIKeyPath + "?deleteAll=1")
+}
+
+func (ss *SecurityService) deleteAPIKeys(apiPath string) error {
+	httpDetails := ss.ArtDetails.CreateHttpClientDetails()
+	resp, body, err := ss.client.SendDelete(ss.ArtDetails.GetUrl()+apiPath, nil, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent)
+}
+
+func (ss *SecurityService) getPolicy(apiPath string, policy any) error {
+	httpDetails := ss.ArtDetails.CreateHttpClientDetails()
+	resp, body, _, err := ss.client.SendGet(ss.ArtDetails.GetUrl()+apiPath, true, &httpDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	return errorutils.CheckError(json.Unmarshal(body, policy))
+}
+
+func (ss *SecurityService) updatePolicy(apiPath string, policy any) error {
+	content, err := json.Marshal(policy)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpDetails := ss.ArtDetails.CreateHttpClientDetails()
+	httpDetails.SetContentTypeApplicationJson()
+	resp, body, err := ss.client.SendPut(ss.ArtDetails.GetUrl()+apiPath, content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent)
+}
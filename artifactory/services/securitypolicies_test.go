@@ -0,0 +1,60 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRecordingSecurityService(t *testing.T, responseBody string) (*SecurityService, *[]recordedRequest) {
+	serviceDetails, client, requests := newRecordingTestServer(t, responseBody)
+	securityService := NewSecurityService(client)
+	securityService.ArtDetails = serviceDetails
+	return securityService, requests
+}
+
+func TestPasswordExpirationPolicy(t *testing.T) {
+	securityService, requests := newRecordingSecurityService(t, `{"enabled":true,"passwordMaxAge":60,"notifyByEmail":false}`)
+
+	policy, err := securityService.GetPasswordExpirationPolicy()
+	assert.NoError(t, err)
+	assert.Equal(t, &PasswordExpirationPolicy{Enabled: clientutils.Pointer(true), PasswordMaxAge: 60, NotifyByEmail: clientutils.Pointer(false)}, policy)
+
+	assert.NoError(t, securityService.UpdatePasswordExpirationPolicy(PasswordExpirationPolicy{Enabled: clientutils.Pointer(true), PasswordMaxAge: 90}))
+	assert.ErrorContains(t, securityService.UpdatePasswordExpirationPolicy(PasswordExpirationPolicy{PasswordMaxAge: -1}), "can't be negative")
+	assert.Equal(t, []recordedRequest{
+		{Method: http.MethodGet, Uri: "/" + passwordExpirationPolicyPath},
+		{Method: http.MethodPut, Uri: "/" + passwordExpirationPolicyPath, Body: `{"enabled":true,"passwordMaxAge":90}`},
+	}, *requests)
+}
+
+func TestUserLockPolicy(t *testing.T) {
+	securityService, requests := newRecordingSecurityService(t, `{"enabled":true,"loginAttempts":5}`)
+
+	policy, err := securityService.GetUserLockPolicy()
+	assert.NoError(t, err)
+	assert.Equal(t, &UserLockPolicy{Enabled: clientutils.Pointer(true), LoginAttempts: 5}, policy)
+
+	// The number of login attempts is required only when the policy is enabled.
+	assert.ErrorContains(t, securityService.UpdateUserLockPolicy(UserLockPolicy{Enabled: clientutils.Pointer(true)}), "must be positive")
+	assert.NoError(t, securityService.UpdateUserLockPolicy(UserLockPolicy{Enabled: clientutils.Pointer(false)}))
+	assert.NoError(t, securityService.UpdateUserLockPolicy(UserLockPolicy{Enabled: clientutils.Pointer(true), LoginAttempts: 3}))
+	assert.Equal(t, []recordedRequest{
+		{Method: http.MethodGet, Uri: "/" + userLockPolicyPath},
+		{Method: http.MethodPut, Uri: "/" + userLockPolicyPath, Body: `{"enabled":false}`},
+		{Method: http.MethodPut, Uri: "/" + userLockPolicyPath, Body: `{"enabled":true,"loginAttempts":3}`},
+	}, *requests)
+}
+
+func TestRevokeAPIKeys(t *testing.T) {
+	securityService, requests := newRecordingSecurityService(t, "")
+
+	assert.NoError(t, securityService.RevokeUserAPIKey("john doe"))
+	assert.NoError(t, securityService.RevokeAllAPIKeys())
+	assert.Equal(t, []recordedRequest{
+		{Method: http.MethodDelete, Uri: "/api/security/apiKey/john%20doe"},
+		{Method: http.MethodDelete, Uri: "/api/security/apiKey?deleteAll=1"},
+	}, *requests)
+}
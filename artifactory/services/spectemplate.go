@@ -0,0 +1,287 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// Matches a single ${var} placeholder inside a File Spec template.
+var specVarRegexp = regexp.MustCompile(`\$\{([^${}]+)}`)
+
+// A File Spec containing ${var} placeholders, which can be shared between the JFrog CLI and programs built on this client.
+type SpecTemplate struct {
+	content []byte
+}
+
+func NewSpecTemplate(content []byte) *SpecTemplate {
+	return &SpecTemplate{content: content}
+}
+
+func LoadSpecTemplate(specPath string) (*SpecTemplate, error) {
+	content, err := os.ReadFile(specPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return NewSpecTemplate(content), nil
+}
+
+// Returns the sorted and distinct names of the variables used in the template.
+func (st *SpecTemplate) Variables() []string {
+	distinct := make(map[string]bool)
+	for _, match := range specVarRegexp.FindAllSubmatch(st.content, -1) {
+		distinct[string(match[1])] = true
+	}
+	variables := make([]string, 0, len(distinct))
+	for variable := range distinct {
+		variables = append(variables, variable)
+	}
+	sort.Strings(variables)
+	return variables
+}
+
+// Substitutes the given variables in the template, and parses and validates the resulting File Spec.
+// Fails if a placeholder is left without a value.
+func (st *SpecTemplate) Render(vars map[string]string) (*FileSpec, error) {
+	var missing []string
+	reported := make(map[string]bool)
+	content := specVarRegexp.ReplaceAllFunc(st.content, func(match []byte) []byte {
+		name := string(specVarRegexp.FindSubmatch(match)[1])
+		value, ok := vars[name]
+		if !ok {
+			if !reported[name] {
+				reported[name] = true
+				missing = append(missing, name)
+			}
+			return match
+		}
+		// The value is placed inside a JSON string, so it must be escaped accordingly.
+		escaped, _ := json.Marshal(value)
+		return escaped[1 : len(escaped)-1]
+	})
+	if len(missing) > 0 {
+		return nil, errorutils.CheckErrorf("no value was provided for the following File Spec variables: %s", strings.Join(missing, ", "))
+	}
+	return ParseFileSpec(content)
+}
+
+// Parses and validates File Spec JSON content. Unknown fields are rejected.
+func ParseFileSpec(content []byte) (*FileSpec, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	spec := &FileSpec{}
+	if err := decoder.Decode(spec); err != nil {
+		return nil, errorutils.CheckErrorf("invalid File Spec: %s", err.Error())
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+type FileSpec struct {
+	Files []FileSpecFile `json:"files"`
+}
+
+// A single entry of a File Spec. Boolean fields are represented as strings ("true" / "false"), as in the JFrog CLI.
+type FileSpecFile struct {
+	Aql                     utils.Aql `json:"aql,omitempty"`
+	Pattern                 string    `json:"pattern,omitempty"`
+	Exclusions              []string  `json:"exclusions,omitempty"`
+	Target                  string    `json:"target,omitempty"`
+	Props                   string    `json:"props,omitempty"`
+	TargetProps             string    `json:"targetProps,omitempty"`
+	ExcludeProps            string    `json:"excludeProps,omitempty"`
+	SortOrder               string    `json:"sortOrder,omitempty"`
+	SortBy                  []string  `json:"sortBy,omitempty"`
+	Offset                  int       `json:"offset,omitempty"`
+	Limit                   int       `json:"limit,omitempty"`
+	Build                   string    `json:"build,omitempty"`
+	Project                 string    `json:"project,omitempty"`
+	ExcludeArtifacts        string    `json:"excludeArtifacts,omitempty"`
+	IncludeDeps             string    `json:"includeDeps,omitempty"`
+	Bundle                  string    `json:"bundle,omitempty"`
+	Recursive               string    `json:"recursive,omitempty"`
+	Flat                    string    `json:"flat,omitempty"`
+	Explode                 string    `json:"explode,omitempty"`
+	BypassArchiveInspection string    `json:"bypassArchiveInspection,omitempty"`
+	Regexp                  string    `json:"regexp,omitempty"`
+	Ant                     string    `json:"ant,omitempty"`
+	IncludeDirs             string    `json:"includeDirs,omitempty"`
+	ArchiveEntries          string    `json:"archiveEntries,omitempty"`
+	ValidateSymlinks        string    `json:"validateSymlinks,omitempty"`
+	Symlinks                string    `json:"symlinks,omitempty"`
+	Transitive              string    `json:"transitive,omitempty"`
+	Archive                 string    `json:"archive,omitempty"`
+	TargetPathInArchive     string    `json:"targetPathInArchive,omitempty"`
+}
+
+func (fs *FileSpec) Validate() error {
+	if len(fs.Files) == 0 {
+		return errorutils.CheckErrorf("invalid File Spec: the spec must contain at least one entry under 'files'")
+	}
+	for i := range fs.Files {
+		if err := fs.Files[i].Validate(); err != nil {
+			return errorutils.CheckErrorf("invalid File Spec entry #%d: %s", i+1, err.Error())
+		}
+	}
+	return nil
+}
+
+func (f *FileSpecFile) Validate() error {
+	isAql := f.Aql.ItemsFind != ""
+	switch {
+	case f.Pattern == "" && !isAql && f.Build == "" && f.Bundle == "":
+		return errorutils.CheckErrorf("one of 'pattern', 'aql', 'build' or 'bundle' must be provided")
+	case f.Pattern != "" && isAql:
+		return errorutils.CheckErrorf("'pattern' and 'aql' cannot be used together")
+	case parseSpecBool(f.Regexp, false) && parseSpecBool(f.Ant, false):
+		return errorutils.CheckErrorf("'regexp' and 'ant' cannot both be true")
+	case f.SortOrder != "" && f.SortOrder != "asc" && f.SortOrder != "desc":
+		return errorutils.CheckErrorf("'sortOrder' must be either 'asc' or 'desc', got '%s'", f.SortOrder)
+	case f.SortOrder != "" && len(f.SortBy) == 0:
+		return errorutils.CheckErrorf("'sortOrder' cannot be used without 'sortBy'")
+	case f.Offset < 0 || f.Limit < 0:
+		return errorutils.CheckErrorf("'offset' and 'limit' must not be negative")
+	case f.Archive != "" && f.Archive != "zip":
+		return errorutils.CheckErrorf("the only supported 'archive' value is 'zip', got '%s'", f.Archive)
+	}
+	booleans := map[string]string{
+		"excludeArtifacts":        f.ExcludeArtifacts,
+		"includeDeps":             f.IncludeDeps,
+		"recursive":               f.Recursive,
+		"flat":                    f.Flat,
+		"explode":                 f.Explode,
+		"bypassArchiveInspection": f.BypassArchiveInspection,
+		"regexp":                  f.Regexp,
+		"ant":                     f.Ant,
+		"includeDirs":             f.IncludeDirs,
+		"validateSymlinks":        f.ValidateSymlinks,
+		"symlinks":                f.Symlinks,
+		"transitive":              f.Transitive,
+	}
+	// Sorted, so the same field is reported when several fields are invalid.
+	for _, name := range slices.Sorted(maps.Keys(booleans)) {
+		value := booleans[name]
+		if value == "" {
+			continue
+		}
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errorutils.CheckErrorf("'%s' must be either 'true' or 'false', got '%s'", name, value)
+		}
+	}
+	if f.TargetProps != "" {
+		if _, err := utils.ParseProperties(f.TargetProps); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseSpecBool(value string, defaultValue bool) bool {
+	if value == "" {
+		return defaultValue
+	}
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
+
+func (f *FileSpecFile) ToCommonParams() (*utils.CommonParams, error) {
+	params := &utils.CommonParams{
+		Aql:              f.Aql,
+		Pattern:          f.Pattern,
+		Exclusions:       f.Exclusions,
+		Target:           f.Target,
+		Props:            f.Props,
+		ExcludeProps:     f.ExcludeProps,
+		SortOrder:        f.SortOrder,
+		SortBy:           f.SortBy,
+		Offset:           f.Offset,
+		Limit:            f.Limit,
+		Build:            f.Build,
+		Project:          f.Project,
+		ExcludeArtifacts: parseSpecBool(f.ExcludeArtifacts, false),
+		IncludeDeps:      parseSpecBool(f.IncludeDeps, false),
+		Bundle:           f.Bundle,
+		Recursive:        parseSpecBool(f.Recursive, true),
+		IncludeDirs:      parseSpecBool(f.IncludeDirs, false),
+		Regexp:           parseSpecBool(f.Regexp, false),
+		Ant:              parseSpecBool(f.Ant, false),
+		ArchiveEntries:   f.ArchiveEntries,
+		Transitive:       parseSpecBool(f.Transitive, false),
+	}
+	if f.TargetProps != "" {
+		targetProps, err := utils.ParseProperties(f.TargetProps)
+		if err != nil {
+			return nil, err
+		}
+		params.TargetProps = targetProps
+	}
+	return params, nil
+}
+
+func (fs *FileSpec) ToSearchParams() ([]SearchParams, error) {
+	var result []SearchParams
+	for i := range fs.Files {
+		commonParams, err := fs.Files[i].ToCommonParams()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, SearchParams{CommonParams: commonParams})
+	}
+	return result, nil
+}
+
+func (fs *FileSpec) ToDownloadParams() ([]DownloadParams, error) {
+	var result []DownloadParams
+	for i := range fs.Files {
+		file := &fs.Files[i]
+		commonParams, err := file.ToCommonParams()
+		if err != nil {
+			return nil, err
+		}
+		params := NewDownloadParams()
+		params.CommonParams = commonParams
+		params.Flat = parseSpecBool(file.Flat, false)
+		params.Explode = parseSpecBool(file.Explode, false)
+		params.BypassArchiveInspection = parseSpecBool(file.BypassArchiveInspection, false)
+		params.ValidateSymlink = parseSpecBool(file.ValidateSymlinks, false)
+		result = append(result, params)
+	}
+	return result, nil
+}
+
+func (fs *FileSpec) ToUploadParams() ([]UploadParams, error) {
+	var result []UploadParams
+	for i := range fs.Files {
+		file := &fs.Files[i]
+		if file.Pattern == "" {
+			return nil, errorutils.CheckErrorf("invalid File Spec entry #%d: upload requires a 'pattern'", i+1)
+		}
+		commonParams, err := file.ToCommonParams()
+		if err != nil {
+			return nil, err
+		}
+		params := NewUploadParams()
+		params.CommonParams = commonParams
+		params.Flat = parseSpecBool(file.Flat, false)
+		params.ExplodeArchive = parseSpecBool(file.Explode, false)
+		params.Symlink = parseSpecBool(file.Symlinks, false)
+		params.Archive = file.Archive
+		params.TargetPathInArchive = file.TargetPathInArchive
+		result = append(result, params)
+	}
+	return result, nil
+}
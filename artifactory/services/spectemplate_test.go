@@ -0,0 +1,73 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSpecTemplate = `{
+  "files": [
+    {
+      "pattern": "${repo}/${path}/*.zip",
+      "target": "out/${version}/",
+      "flat": "true",
+      "targetProps": "version=${version}"
+    }
+  ]
+}`
+
+func TestSpecTemplateVariables(t *testing.T) {
+	template := NewSpecTemplate([]byte(testSpecTemplate))
+	assert.Equal(t, []string{"path", "repo", "version"}, template.Variables())
+}
+
+func TestSpecTemplateRender(t *testing.T) {
+	template := NewSpecTemplate([]byte(testSpecTemplate))
+	spec, err := template.Render(map[string]string{"repo": "generic-local", "path": "a\"b", "version": "1.0"})
+	assert.NoError(t, err)
+	assert.Equal(t, "generic-local/a\"b/*.zip", spec.Files[0].Pattern)
+
+	downloadParams, err := spec.ToDownloadParams()
+	assert.NoError(t, err)
+	assert.Len(t, downloadParams, 1)
+	assert.True(t, downloadParams[0].Flat)
+	assert.True(t, downloadParams[0].Recursive)
+	assert.Equal(t, "out/1.0/", downloadParams[0].Target)
+	assert.Equal(t, map[string][]string{"version": {"1.0"}}, downloadParams[0].TargetProps.ToMap())
+}
+
+func TestSpecTemplateRenderMissingVariable(t *testing.T) {
+	template := NewSpecTemplate([]byte(testSpecTemplate))
+	_, err := template.Render(map[string]string{"repo": "generic-local"})
+	assert.ErrorContains(t, err, "path, version")
+}
+
+func TestParseFileSpecValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		spec          string
+		expectedError string
+	}{
+		{"valid", `{"files":[{"pattern":"repo/*"}]}`, ""},
+		{"valid aql", `{"files":[{"aql":{"items.find":{"repo":"a"}}}]}`, ""},
+		{"empty", `{"files":[]}`, "at least one entry"},
+		{"unknown field", `{"files":[{"pattern":"repo/*","unknown":"x"}]}`, "unknown field"},
+		{"no source", `{"files":[{"target":"a/"}]}`, "must be provided"},
+		{"pattern and aql", `{"files":[{"pattern":"repo/*","aql":{"items.find":{"repo":"a"}}}]}`, "cannot be used together"},
+		{"invalid boolean", `{"files":[{"pattern":"repo/*","flat":"yes"}]}`, "'flat' must be either"},
+		{"invalid booleans", `{"files":[{"pattern":"repo/*","recursive":"no","flat":"yes","explode":"maybe"}]}`, "'explode' must be either"},
+		{"invalid sort order", `{"files":[{"pattern":"repo/*","sortBy":["name"],"sortOrder":"up"}]}`, "'sortOrder' must be"},
+		{"regexp and ant", `{"files":[{"pattern":"repo/*","regexp":"true","ant":"true"}]}`, "cannot both be true"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseFileSpec([]byte(test.spec))
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expectedError)
+			}
+		})
+	}
+}
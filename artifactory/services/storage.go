@@ -2,15 +2,19 @@ package services
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"path"
 	"strconv"
+	"strings"
 
 	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
 	clientutils "github.com/jfrog/jfrog-client-go/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
 	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
@@ -110,6 +114,167 @@ func (s *StorageService) FileList(relativePath string, optionalParams utils.File
 	return result, errorutils.CheckError(err)
 }
 
+// A file returned by a deep listing.
+type DeepListEntry struct {
+	// The path of the file, including the repository, e.g. "libs-release-local/org/acme/app.jar".
+	Path         string
+	Size         int64
+	Sha1         string
+	Sha256       string
+	LastModified string
+}
+
+type DeepListParams struct {
+	// The repository path to list, e.g. "libs-release-local/org/acme".
+	RelativePath string
+	// If true, the files are listed using AQL instead of the storage list API. AQL is recommended for very large
+	// paths, since the storage list API builds the whole response on the server before sending it.
+	UseAql bool
+}
+
+func NewDeepListParams(relativePath string) DeepListParams {
+	return DeepListParams{RelativePath: relativePath}
+}
+
+// Iterates over the entries of a deep listing. The entries are streamed into a temporary file, so that the memory
+// used doesn't depend on the number of files. Must be closed after use.
+type DeepListReader struct {
+	reader *content.ContentReader
+	// The path which the storage list API's URIs are relative to. Empty for AQL results.
+	basePath string
+}
+
+// Reads the next entry. Returns io.EOF when there are no more entries.
+func (dlr *DeepListReader) NextEntry(entry *DeepListEntry) error {
+	if dlr.basePath == "" {
+		item := new(utils.ResultItem)
+		if err := dlr.reader.NextRecord(item); err != nil {
+			return err
+		}
+		*entry = DeepListEntry{Path: item.GetItemRelativePath(), Size: item.Size, Sha1: item.Actual_Sha1, Sha256: item.Sha256, LastModified: item.Modified}
+		return nil
+	}
+	file := new(utils.FileListFile)
+	if err := dlr.reader.NextRecord(file); err != nil {
+		return err
+	}
+	size, err := file.Size.Int64()
+	if err != nil {
+		return errorutils.CheckErrorf("invalid size '%s' of '%s': %s", file.Size, file.Uri, err.Error())
+	}
+	*entry = DeepListEntry{Path: path.Join(dlr.basePath, file.Uri), Size: size, Sha1: file.Sha1, Sha256: file.Sha2, LastModified: file.LastModified}
+	return nil
+}
+
+// Returns the error which occurred while reading the entries, if any.
+func (dlr *DeepListReader) GetError() error {
+	return dlr.reader.GetError()
+}
+
+func (dlr *DeepListReader) Close() error {
+	return dlr.reader.Close()
+}
+
+// Lists all the files under a repository path, recursively.
+func (s *StorageService) DeepList(params DeepListParams) (deepListReader *DeepListReader, err error) {
+	relativePath := strings.Trim(path.Clean(params.RelativePath), "/")
+	if relativePath == "" || relativePath == "." {
+		return nil, errorutils.CheckErrorf("a repository path to list is required")
+	}
+	if params.UseAql {
+		var reader *content.ContentReader
+		if reader, err = utils.ExecAqlSaveToFile(CreateDeepListAqlQuery(relativePath), s); err != nil {
+			return nil, err
+		}
+		return &DeepListReader{reader: reader}, nil
+	}
+	listUrl, err := clientutils.BuildUrl(s.GetArtifactoryDetails().GetUrl(), path.Join(StorageRestApi, relativePath), map[string]string{"list": "true", "deep": "1"})
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := s.GetArtifactoryDetails().CreateHttpClientDetails()
+	body, resp, err := s.client.ReadRemoteFile(listUrl, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatus(resp, http.StatusOK); err != nil {
+		return nil, errors.Join(err, errorutils.CheckError(resp.Body.Close()))
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(body.Close()))
+	}()
+	log.Debug("Artifactory response:", resp.Status)
+	var reader *content.ContentReader
+	if reader, err = utils.StreamToContentReader(body, "files"); err != nil {
+		return nil, err
+	}
+	return &DeepListReader{reader: reader, basePath: relativePath}, nil
+}
+
+// Creates an AQL query of all the files under a repository path.
+func CreateDeepListAqlQuery(relativePath string) string {
+	repo, folder, _ := strings.Cut(strings.Trim(relativePath, "/"), "/")
+	criteria := fmt.Sprintf(`"repo":%s,"type":"file"`, strconv.Quote(repo))
+	if folder != "" {
+		criteria += fmt.Sprintf(`,"$or":[{"path":%s},{"path":{"$match":%s}}]`, strconv.Quote(folder), strconv.Quote(folder+"/*"))
+	}
+	return fmt.Sprintf(`items.find({%s}).include("repo","path","name","size","modified","actual_sha1","sha256")`, criteria)
+}
+
+// Returns the download statistics of a single item.
+func (s *StorageService) FileStats(relativePath string) (*utils.FileStats, error) {
+	client := s.GetJfrogHttpClient()
+	restAPI := path.Join(StorageRestApi, path.Clean(relativePath))
+	fullUrl, err := clientutils.BuildUrl(s.GetArtifactoryDetails().GetUrl(), restAPI, map[string]string{"stats": ""})
+	if err != nil {
+		return nil, err
+	}
+
+	httpClientsDetails := s.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := client.SendGet(fullUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+
+	result := &utils.FileStats{}
+	err = json.Unmarshal(body, result)
+	return result, errorutils.CheckError(err)
+}
+
+// Returns the download statistics of all the files matching the search params, using the AQL 'stat' domain.
+// This requires a single AQL request instead of a stats request per file.
+func (s *StorageService) SearchFilesStats(params SearchParams) (result []utils.ItemStats, err error) {
+	if params.CommonParams == nil {
+		return nil, errorutils.CheckErrorf("the search params are required")
+	}
+	commonParams := *params.CommonParams
+	params.CommonParams = &commonParams
+	params.Include = append([]string{"actual_md5", "actual_sha1", "sha256", "size", "type", "modified", "created"}, params.Include...)
+	params.Include = append(params.Include, "stat")
+	reader, err := SearchBySpecFiles(params, s, utils.NONE)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	for item := new(utils.ResultItem); reader.NextRecord(item) == nil; item = new(utils.ResultItem) {
+		if item.Type == string(utils.Folder) {
+			continue
+		}
+		itemStats, err := utils.NewItemStats(*item)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *itemStats)
+	}
+	return result, reader.GetError()
+}
+
 func (s *StorageService) StorageInfo() (*utils.StorageInfo, error) {
 	client := s.GetJfrogHttpClient()
 	url := s.GetArtifactoryDetails().GetUrl() + "api/storageinfo"
@@ -129,6 +294,41 @@ func (s *StorageService) StorageInfo() (*utils.StorageInfo, error) {
 	return result, errorutils.CheckError(err)
 }
 
+// Returns the storage info with its formatted sizes, counts and percentages parsed.
+func (s *StorageService) StorageSummary() (*utils.StorageSummary, error) {
+	storageInfo, err := s.StorageInfo()
+	if err != nil {
+		return nil, err
+	}
+	return storageInfo.ToSummary()
+}
+
+// Returns the storage used by a repository. The usage is as of the last storage info calculation, see StorageInfoRefresh.
+func (s *StorageService) RepositoryStorageUsage(repoKey string) (*utils.RepositoryUsage, error) {
+	summary, err := s.StorageSummary()
+	if err != nil {
+		return nil, err
+	}
+	usage := summary.FindRepository(repoKey)
+	if usage == nil {
+		return nil, errorutils.CheckErrorf("repository '%s' was not found in the storage info", repoKey)
+	}
+	return usage, nil
+}
+
+// Returns the storage used by the repositories assigned to a project. The usage is as of the last storage info
+// calculation, see StorageInfoRefresh.
+func (s *StorageService) ProjectStorageUsage(projectKey string) (*utils.ProjectStorageUsage, error) {
+	if projectKey == "" {
+		return nil, errorutils.CheckErrorf("a project key is required")
+	}
+	summary, err := s.StorageSummary()
+	if err != nil {
+		return nil, err
+	}
+	return summary.ProjectUsage(projectKey), nil
+}
+
 func (s *StorageService) StorageInfoRefresh() error {
 	client := s.GetJfrogHttpClient()
 	url := s.GetArtifactoryDetails().GetUrl() + "api/storageinfo/calculate"
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDeepListAqlQuery(t *testing.T) {
+	assert.Equal(t, `items.find({"repo":"libs-release-local","type":"file"}).include("repo","path","name","size","modified","actual_sha1","sha256")`,
+		CreateDeepListAqlQuery("libs-release-local"))
+	assert.Equal(t, `items.find({"repo":"libs-release-local","type":"file","$or":[{"path":"org/acme"},{"path":{"$match":"org/acme/*"}}]}).include("repo","path","name","size","modified","actual_sha1","sha256")`,
+		CreateDeepListAqlQuery("libs-release-local/org/acme/"))
+}
+
+func TestDeepListReader(t *testing.T) {
+	storageListResponse := `{"uri":"http://localhost:8081/artifactory/api/storage/libs-release-local/org","created":"2024-01-01T00:00:00.000Z","files":[
+		{"uri":"/acme/app.jar","size":1024,"lastModified":"2024-01-02T00:00:00.000Z","folder":false,"sha1":"abc","sha2":"def"}]}`
+	aqlResponse := `{"results":[{"repo":"libs-release-local","path":"org/acme","name":"app.jar","size":1024,"modified":"2024-01-02T00:00:00.000Z","actual_sha1":"abc","sha256":"def"}]}`
+	expected := DeepListEntry{Path: "libs-release-local/org/acme/app.jar", Size: 1024, Sha1: "abc", Sha256: "def", LastModified: "2024-01-02T00:00:00.000Z"}
+
+	for _, test := range []struct {
+		name     string
+		body     string
+		arrayKey string
+		basePath string
+	}{
+		{"storageList", storageListResponse, "files", "libs-release-local/org"},
+		{"aql", aqlResponse, content.DefaultKey, ""},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			reader, err := utils.StreamToContentReader(strings.NewReader(test.body), test.arrayKey)
+			assert.NoError(t, err)
+			deepListReader := &DeepListReader{reader: reader, basePath: test.basePath}
+			defer func() {
+				assert.NoError(t, deepListReader.Close())
+			}()
+			entry := DeepListEntry{}
+			assert.NoError(t, deepListReader.NextEntry(&entry))
+			assert.Equal(t, expected, entry)
+			assert.ErrorIs(t, deepListReader.NextEntry(&entry), io.EOF)
+			assert.NoError(t, deepListReader.GetError())
+		})
+	}
+}
+
+func TestSearchFilesStatsWithoutCommonParams(t *testing.T) {
+	_, err := NewStorageService(nil, nil).SearchFilesStats(SearchParams{})
+	assert.ErrorContains(t, err, "search params are required")
+}
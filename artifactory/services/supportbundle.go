@@ -0,0 +1,283 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/httpclient"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	apiSupportBundle = "api/system/support/bundle"
+
+	SupportBundleStatusInProgress = "in progress"
+	SupportBundleStatusSuccess    = "success"
+	SupportBundleStatusFailure    = "failure"
+
+	supportBundleDateFormat = "2006-01-02"
+
+	defaultSupportBundleWaitTimeout     = 30 * time.Minute
+	defaultSupportBundlePollingInterval = 5 * time.Second
+)
+
+// Creates support bundles, which collect diagnostic information from Artifactory, and downloads them.
+type SupportBundleService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+}
+
+func NewSupportBundleService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *SupportBundleService {
+	return &SupportBundleService{artDetails: &artDetails, client: client}
+}
+
+func (sbs *SupportBundleService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *sbs.artDetails
+}
+
+func (sbs *SupportBundleService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return sbs.client
+}
+
+func (sbs *SupportBundleService) IsDryRun() bool {
+	return false
+}
+
+type SupportBundleParams struct {
+	Name        string
+	Description string
+	// Include the system configuration descriptors.
+	IncludeConfiguration bool
+	// Include system information, such as the JVM and storage details.
+	IncludeSystem bool
+	IncludeLogs   bool
+	// The range of the collected logs. Ignored if zero.
+	LogsStartDate time.Time
+	LogsEndDate   time.Time
+	// The number of thread dumps to take. No thread dump is taken if 0.
+	ThreadDumpCount int
+	// The interval between thread dumps.
+	ThreadDumpInterval time.Duration
+}
+
+// Returns params which include the configuration, system information and logs, with no thread dumps.
+func NewSupportBundleParams(name string) SupportBundleParams {
+	return SupportBundleParams{Name: name, IncludeConfiguration: true, IncludeSystem: true, IncludeLogs: true}
+}
+
+func (sbp *SupportBundleParams) Validate() error {
+	if sbp.ThreadDumpCount < 0 || sbp.ThreadDumpInterval < 0 {
+		return errorutils.CheckErrorf("the thread dump count and interval must not be negative")
+	}
+	if !sbp.LogsStartDate.IsZero() && !sbp.LogsEndDate.IsZero() && sbp.LogsEndDate.Before(sbp.LogsStartDate) {
+		return errorutils.CheckErrorf("the logs end date must not be before the logs start date")
+	}
+	return nil
+}
+
+type supportBundleBody struct {
+	Name        string                  `json:"name,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	Parameters  supportBundleParameters `json:"parameters"`
+}
+
+type supportBundleParameters struct {
+	Configuration bool                    `json:"configuration"`
+	System        bool                    `json:"system"`
+	Logs          supportBundleLogs       `json:"logs"`
+	ThreadDump    supportBundleThreadDump `json:"thread_dump"`
+}
+
+type supportBundleLogs struct {
+	Include   bool   `json:"include"`
+	StartDate string `json:"start_date,omitempty"`
+	EndDate   string `json:"end_date,omitempty"`
+}
+
+type supportBundleThreadDump struct {
+	Count int `json:"count"`
+	// In milliseconds.
+	Interval int64 `json:"interval"`
+}
+
+func createSupportBundleBody(params SupportBundleParams) supportBundleBody {
+	body := supportBundleBody{
+		Name:        params.Name,
+		Description: params.Description,
+		Parameters: supportBundleParameters{
+			Configuration: params.IncludeConfiguration,
+			System:        params.IncludeSystem,
+			Logs:          supportBundleLogs{Include: params.IncludeLogs},
+			ThreadDump:    supportBundleThreadDump{Count: params.ThreadDumpCount, Interval: params.ThreadDumpInterval.Milliseconds()},
+		},
+	}
+	if !params.LogsStartDate.IsZero() {
+		body.Parameters.Logs.StartDate = params.LogsStartDate.Format(supportBundleDateFormat)
+	}
+	if !params.LogsEndDate.IsZero() {
+		body.Parameters.Logs.EndDate = params.LogsEndDate.Format(supportBundleDateFormat)
+	}
+	return body
+}
+
+type SupportBundle struct {
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Created     string `json:"created,omitempty"`
+	// One of the SupportBundleStatus* constants.
+	Status string `json:"status,omitempty"`
+}
+
+func (sb *SupportBundle) IsInProgress() bool {
+	return strings.EqualFold(sb.Status, SupportBundleStatusInProgress)
+}
+
+type supportBundlesResponse struct {
+	Count   int             `json:"count,omitempty"`
+	Bundles []SupportBundle `json:"bundles,omitempty"`
+}
+
+// Starts the creation of a support bundle and returns its ID. The bundle is created asynchronously.
+func (sbs *SupportBundleService) Create(params SupportBundleParams) (string, error) {
+	if err := params.Validate(); err != nil {
+		return "", err
+	}
+	content, err := json.Marshal(createSupportBundleBody(params))
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	httpClientsDetails := sbs.GetArtifactoryDetails().CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	log.Info("Creating a support bundle...")
+	resp, body, err := sbs.client.SendPost(sbs.GetArtifactoryDetails().GetUrl()+apiSupportBundle, content, &httpClientsDetails)
+	if err != nil {
+		return "", err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated, http.StatusAccepted); err != nil {
+		return "", err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	bundle := &SupportBundle{}
+	if err = errorutils.CheckError(json.Unmarshal(body, bundle)); err != nil {
+		return "", err
+	}
+	log.Info("Support bundle '" + bundle.Id + "' creation started.")
+	return bundle.Id, nil
+}
+
+func (sbs *SupportBundleService) Get(bundleId string) (*SupportBundle, error) {
+	body, err := sbs.sendGet(apiSupportBundle + "/" + url.PathEscape(bundleId))
+	if err != nil {
+		return nil, err
+	}
+	bundle := &SupportBundle{}
+	return bundle, errorutils.CheckError(json.Unmarshal(body, bundle))
+}
+
+func (sbs *SupportBundleService) List() ([]SupportBundle, error) {
+	body, err := sbs.sendGet("api/system/support/bundles")
+	if err != nil {
+		return nil, err
+	}
+	result := &supportBundlesResponse{}
+	if err = json.Unmarshal(body, result); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return result.Bundles, nil
+}
+
+type WaitForSupportBundleParams struct {
+	BundleId string
+	// The maximum time to wait. Defaults to 30 minutes.
+	Timeout time.Duration
+}
+
+func NewWaitForSupportBundleParams(bundleId string) WaitForSupportBundleParams {
+	return WaitForSupportBundleParams{BundleId: bundleId}
+}
+
+// Polls the support bundle until it is no longer in progress. Fails if the bundle creation failed.
+func (sbs *SupportBundleService) WaitForCompletion(params WaitForSupportBundleParams) (*SupportBundle, error) {
+	bundleId := params.BundleId
+	timeout := params.Timeout
+	if timeout <= 0 {
+		timeout = defaultSupportBundleWaitTimeout
+	}
+	var bundle *SupportBundle
+	pollingAction := func() (shouldStop bool, responseBody []byte, err error) {
+		bundle, err = sbs.Get(bundleId)
+		if err != nil {
+			return true, nil, err
+		}
+		return !bundle.IsInProgress(), nil, nil
+	}
+	pollingExecutor := &httputils.PollingExecutor{
+		Timeout:         timeout,
+		PollingInterval: defaultSupportBundlePollingInterval,
+		PollingAction:   pollingAction,
+		MsgPrefix:       "Waiting for support bundle '" + bundleId + "'...",
+	}
+	if _, err := pollingExecutor.Execute(); err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(bundle.Status, SupportBundleStatusSuccess) {
+		return bundle, errorutils.CheckErrorf("support bundle '%s' creation ended with status '%s'", bundleId, bundle.Status)
+	}
+	return bundle, nil
+}
+
+// Downloads the archive of a support bundle to the given local file path.
+func (sbs *SupportBundleService) Download(bundleId, localPath string) error {
+	httpClientsDetails := sbs.GetArtifactoryDetails().CreateHttpClientDetails()
+	downloadFileDetails := &httpclient.DownloadFileDetails{
+		DownloadPath:  sbs.GetArtifactoryDetails().GetUrl() + apiSupportBundle + "/" + url.PathEscape(bundleId) + "/archive",
+		LocalPath:     filepath.Dir(localPath),
+		LocalFileName: filepath.Base(localPath),
+		SkipChecksum:  true,
+	}
+	log.Info("Downloading support bundle '" + bundleId + "' to '" + localPath + "'...")
+	resp, err := sbs.client.DownloadFile(downloadFileDetails, "", &httpClientsDetails, false, false)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatus(resp, http.StatusOK); err != nil {
+		return err
+	}
+	log.Info("Done downloading the support bundle.")
+	return nil
+}
+
+func (sbs *SupportBundleService) Delete(bundleId string) error {
+	httpClientsDetails := sbs.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, err := sbs.client.SendDelete(sbs.GetArtifactoryDetails().GetUrl()+apiSupportBundle+"/"+url.PathEscape(bundleId), nil, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+func (sbs *SupportBundleService) sendGet(restApi string) ([]byte, error) {
+	httpClientsDetails := sbs.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := sbs.client.SendGet(sbs.GetArtifactoryDetails().GetUrl()+restApi, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return body, nil
+}
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSupportBundleBody(t *testing.T) {
+	params := NewSupportBundleParams("nightly")
+	params.LogsStartDate = time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	params.LogsEndDate = time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC)
+	params.ThreadDumpCount = 2
+	params.ThreadDumpInterval = 3 * time.Second
+	content, err := json.Marshal(createSupportBundleBody(params))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name": "nightly",
+		"parameters": {
+			"configuration": true,
+			"system": true,
+			"logs": {"include": true, "start_date": "2024-03-01", "end_date": "2024-03-02"},
+			"thread_dump": {"count": 2, "interval": 3000}
+		}
+	}`, string(content))
+}
+
+func TestSupportBundleParamsValidate(t *testing.T) {
+	params := NewSupportBundleParams("nightly")
+	assert.NoError(t, params.Validate())
+	params.LogsStartDate = time.Now()
+	params.LogsEndDate = params.LogsStartDate.Add(-time.Hour)
+	assert.ErrorContains(t, params.Validate(), "end date")
+	params.LogsEndDate = time.Time{}
+	params.ThreadDumpCount = -1
+	assert.ErrorContains(t, params.Validate(), "negative")
+}
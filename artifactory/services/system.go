@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/jfrog/gofrog/version"
+	artifactoryutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
 	"github.com/jfrog/jfrog-client-go/utils"
@@ -44,11 +47,25 @@ func (ss *SystemService) GetVersion() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	var version artifactoryVersion
-	if err = json.Unmarshal(body, &version); err != nil {
+	var artVersion artifactoryVersion
+	if err = json.Unmarshal(body, &artVersion); err != nil {
 		return "", errorutils.CheckErrorf("couldn't parse JFrog Artifactory server version response: %s", err.Error())
 	}
-	return strings.TrimSpace(version.Version), nil
+	return strings.TrimSpace(artVersion.Version), nil
+}
+
+// Returns the version of Artifactory along with its revision, license type and enabled addons.
+func (ss *SystemService) GetVersionInfo() (*VersionInfo, error) {
+	body, err := ss.sendGet("version")
+	if err != nil {
+		return nil, err
+	}
+	versionInfo := &VersionInfo{}
+	if err = json.Unmarshal(body, versionInfo); err != nil {
+		return nil, errorutils.CheckErrorf("couldn't parse JFrog Artifactory server version response: %s", err.Error())
+	}
+	versionInfo.Version = strings.TrimSpace(versionInfo.Version)
+	return versionInfo, nil
 }
 
 func (ss *SystemService) GetServiceId() (string, error) {
@@ -71,13 +88,69 @@ func (ss *SystemService) GetRunningNodes() ([]string, error) {
 	}
 	var runningNodes []string
 	for _, node := range status.Nodes {
-		if node.State == runningNodeStatus {
+		if node.IsRunning() {
 			runningNodes = append(runningNodes, strings.TrimSpace(node.Id))
 		}
 	}
 	return runningNodes, nil
 }
 
+// Returns all the nodes of the Artifactory cluster, including nodes which are not running.
+// A single node is returned for a non-HA installation.
+func (ss *SystemService) GetNodes() ([]ArtifactoryNode, error) {
+	body, err := ss.sendGet("status")
+	if err != nil {
+		return nil, err
+	}
+	var status artifactoryStatus
+	if err = json.Unmarshal(body, &status); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return status.Nodes, nil
+}
+
+func (ss *SystemService) GetLicense() (*LicenseDetails, error) {
+	body, err := ss.sendGet("license")
+	if err != nil {
+		return nil, err
+	}
+	license := &LicenseDetails{}
+	return license, errorutils.CheckError(json.Unmarshal(body, license))
+}
+
+// Returns the licenses of all the nodes of an HA cluster.
+func (ss *SystemService) GetHaLicenses() ([]LicenseDetails, error) {
+	body, err := ss.sendGet("licenses")
+	if err != nil {
+		return nil, err
+	}
+	var licenses haLicenses
+	if err = json.Unmarshal(body, &licenses); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return licenses.Licenses, nil
+}
+
+func (ss *SystemService) InstallLicense(licenseKey string) error {
+	content, err := json.Marshal(map[string]string{"licenseKey": licenseKey})
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Info("Installing Artifactory license...")
+	httpDetails := (*ss.artDetails).CreateHttpClientDetails()
+	httpDetails.SetContentTypeApplicationJson()
+	resp, body, err := ss.client.SendPost(utils.AddTrailingSlashIfNeeded((*ss.artDetails).GetUrl())+apiSystem+"licenses", content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", string(body), resp.Status)
+	log.Info("Artifactory license installed.")
+	return nil
+}
+
 func (ss *SystemService) GetConfigDescriptor() (string, error) {
 	log.Info("Fetching config descriptor from Artifactory...")
 	body, err := ss.sendGet("configuration")
@@ -116,6 +189,51 @@ func (ss *SystemService) DeactivateKeyEncryption() (wasEncrypted bool, err error
 	return true, nil
 }
 
+type GarbageCollectionParams struct {
+	// If true, waits until the garbage collection finishes.
+	Wait bool
+	// The maximum time to wait. Defaults to 60 minutes.
+	Timeout time.Duration
+	// The time to wait between status checks. Defaults to 15 seconds.
+	PollingInterval time.Duration
+}
+
+func NewGarbageCollectionParams() GarbageCollectionParams {
+	return GarbageCollectionParams{}
+}
+
+// Triggers a run of the garbage collection, which deletes binaries that are no longer referenced by any artifact.
+// The garbage collection runs as a background task, which is waited for if requested.
+func (ss *SystemService) RunGarbageCollection(params GarbageCollectionParams) error {
+	log.Info("Triggering garbage collection in Artifactory...")
+	var waitParams *WaitForTasksParams
+	if params.Wait {
+		waitParams = &WaitForTasksParams{TypeContains: GarbageCollectionTaskType, Timeout: params.Timeout, PollingInterval: params.PollingInterval}
+	}
+	err := triggerAndWaitForTasks(*ss.artDetails, ss.client, waitParams, func() error {
+		return ss.sendEmptyPost("storage/gc")
+	})
+	if err != nil {
+		return err
+	}
+	if params.Wait {
+		log.Info("Garbage collection finished.")
+	} else {
+		log.Info("Garbage collection triggered.")
+	}
+	return nil
+}
+
+// Removes empty folders and files that are not referenced in the database from the filestore.
+func (ss *SystemService) PruneUnreferencedData() error {
+	log.Info("Pruning unreferenced data in Artifactory...")
+	if err := ss.sendEmptyPost("storage/prune"); err != nil {
+		return err
+	}
+	log.Info("Unreferenced data pruning triggered.")
+	return nil
+}
+
 func (ss *SystemService) sendGet(endpoint string) ([]byte, error) {
 	httpDetails := (*ss.artDetails).CreateHttpClientDetails()
 	resp, body, _, err := ss.client.SendGet(utils.AddTrailingSlashIfNeeded((*ss.artDetails).GetUrl())+apiSystem+endpoint, true, &httpDetails)
@@ -147,8 +265,53 @@ type artifactoryVersion struct {
 }
 
 type artifactoryStatus struct {
-	Nodes []struct {
-		Id    string `json:"id,omitempty"`
-		State string `json:"state,omitempty"`
-	} `json:"nodes,omitempty"`
+	Nodes []ArtifactoryNode `json:"nodes,omitempty"`
+}
+
+type ArtifactoryNode struct {
+	Id    string `json:"id,omitempty"`
+	State string `json:"state,omitempty"`
+}
+
+func (an *ArtifactoryNode) IsRunning() bool {
+	return an.State == runningNodeStatus
+}
+
+type VersionInfo struct {
+	Version  string   `json:"version,omitempty"`
+	Revision string   `json:"revision,omitempty"`
+	Addons   []string `json:"addons,omitempty"`
+	License  string   `json:"license,omitempty"`
+}
+
+func (vi *VersionInfo) AtLeast(minVersion string) bool {
+	return version.NewVersion(vi.Version).AtLeast(minVersion)
+}
+
+func (vi *VersionInfo) HasAddon(addon string) bool {
+	for _, enabledAddon := range vi.Addons {
+		if strings.EqualFold(enabledAddon, addon) {
+			return true
+		}
+	}
+	return false
+}
+
+func (vi *VersionInfo) SupportsMultipartUpload() bool {
+	return vi.AtLeast(artifactoryutils.MultipartUploadMinArtifactoryVersion)
+}
+
+type LicenseDetails struct {
+	Type         string `json:"type,omitempty"`
+	ValidThrough string `json:"validThrough,omitempty"`
+	LicensedTo   string `json:"licensedTo,omitempty"`
+	// The following fields are returned for HA licenses only.
+	LicenseHash string `json:"licenseHash,omitempty"`
+	NodeId      string `json:"nodeId,omitempty"`
+	NodeUrl     string `json:"nodeUrl,omitempty"`
+	Expired     bool   `json:"expired,omitempty"`
+}
+
+type haLicenses struct {
+	Licenses []LicenseDetails `json:"licenses,omitempty"`
 }
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionInfo(t *testing.T) {
+	versionInfo := VersionInfo{Version: "7.84.3", Addons: []string{"build", "replication"}}
+	assert.True(t, versionInfo.AtLeast("7.84.3"))
+	assert.False(t, versionInfo.AtLeast("7.90.0"))
+	assert.True(t, versionInfo.SupportsMultipartUpload())
+	assert.True(t, versionInfo.HasAddon("Replication"))
+	assert.False(t, versionInfo.HasAddon("ha"))
+
+	versionInfo.Version = "7.77.0"
+	assert.False(t, versionInfo.SupportsMultipartUpload())
+}
+
+func TestRunGarbageCollectionWait(t *testing.T) {
+	var requests []string
+	triggered := false
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodPost {
+			triggered = true
+			return
+		}
+		state := "scheduled"
+		if triggered && len(requests) == 3 {
+			state = "running"
+		}
+		_, _ = w.Write([]byte(`{"tasks":[{"id":"gc","type":"BinaryStoreGarbageCollectorJob","state":"` + state + `"}]}`))
+	})
+	systemService := NewSystemService(serviceDetails, client)
+	params := NewGarbageCollectionParams()
+	params.Wait = true
+	params.Timeout = time.Second
+	params.PollingInterval = time.Millisecond
+	assert.NoError(t, systemService.RunGarbageCollection(params))
+	// The tasks are listed before the trigger, and then polled until the started task is no longer running.
+	assert.Equal(t, []string{"GET /api/tasks", "POST /api/system/storage/gc", "GET /api/tasks", "GET /api/tasks"}, requests)
+}
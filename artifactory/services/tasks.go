@@ -0,0 +1,320 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	apiTasks = "api/tasks"
+
+	TaskStateRunning   = "running"
+	TaskStateScheduled = "scheduled"
+	TaskStateStopped   = "stopped"
+	TaskStatePaused    = "paused"
+	TaskStateCancelled = "cancelled"
+
+	// Part of the type of the garbage collection tasks, e.g. "org.artifactory.storage.binstore.service.BinaryStoreGarbageCollectorJob".
+	GarbageCollectionTaskType = "GarbageCollector"
+
+	defaultTasksWaitTimeout      = 60 * time.Minute
+	defaultTasksPollingInterval  = 15 * time.Second
+	defaultTasksStartGracePeriod = time.Minute
+)
+
+// Lists the background tasks of Artifactory, such as garbage collection and replication jobs.
+type TasksService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+}
+
+func NewTasksService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *TasksService {
+	return &TasksService{artDetails: &artDetails, client: client}
+}
+
+func (ts *TasksService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *ts.artDetails
+}
+
+func (ts *TasksService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return ts.client
+}
+
+func (ts *TasksService) IsDryRun() bool {
+	return false
+}
+
+type Task struct {
+	Id          string `json:"id,omitempty"`
+	Type        string `json:"type,omitempty"`
+	State       string `json:"state,omitempty"`
+	Description string `json:"description,omitempty"`
+	NodeId      string `json:"nodeId,omitempty"`
+}
+
+func (t *Task) IsRunning() bool {
+	return t.State == TaskStateRunning
+}
+
+type tasksResponse struct {
+	Tasks []Task `json:"tasks,omitempty"`
+}
+
+type WaitForTasksParams struct {
+	// Only tasks whose type contains this value are waited for, e.g. GarbageCollectionTaskType. If empty, all the tasks are waited for.
+	TypeContains string
+	// The maximum time to wait. Defaults to 60 minutes.
+	Timeout time.Duration
+	// The time to wait between status checks. Defaults to 15 seconds.
+	PollingInterval time.Duration
+	// If true, a matching task must start before waiting for the matching tasks to finish. Otherwise, the wait ends as
+	// soon as no matching task is running, which is also the case when a triggered task hasn't started yet.
+	// A task counts as started if a matching task which isn't one of the PreexistingTasks appears in any state, or if a
+	// preexisting task which wasn't running is running. A short task may start and finish between two polls, so if no
+	// task is seen starting within the StartGracePeriod, the wait ends as soon as no matching task is running.
+	RequireStart bool
+	// The matching tasks, in any state, which existed before the awaited operation was triggered.
+	PreexistingTasks []Task
+	// The time to wait for a task to be seen starting, if RequireStart is true. Defaults to 1 minute.
+	StartGracePeriod time.Duration
+}
+
+func NewWaitForTasksParams(typeContains string) WaitForTasksParams {
+	return WaitForTasksParams{TypeContains: typeContains}
+}
+
+type WaitForTaskParams struct {
+	TaskId string
+	// The maximum time to wait. Defaults to 60 minutes.
+	Timeout time.Duration
+	// The time to wait between status checks. Defaults to 15 seconds.
+	PollingInterval time.Duration
+}
+
+func NewWaitForTaskParams(taskId string) WaitForTaskParams {
+	return WaitForTaskParams{TaskId: taskId}
+}
+
+// Returned when Artifactory doesn't support cancelling the task.
+type TaskCancellationNotSupportedError struct {
+	TaskId string
+}
+
+func (e *TaskCancellationNotSupportedError) Error() string {
+	return "cancelling task '" + e.TaskId + "' is not supported by Artifactory"
+}
+
+func (ts *TasksService) GetTasks() ([]Task, error) {
+	httpClientsDetails := ts.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := ts.client.SendGet(ts.GetArtifactoryDetails().GetUrl()+apiTasks, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	result := &tasksResponse{}
+	if err = json.Unmarshal(body, result); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return result.Tasks, nil
+}
+
+// Returns the running tasks.
+func (ts *TasksService) GetRunningTasks() ([]Task, error) {
+	tasks, err := ts.GetTasks()
+	if err != nil {
+		return nil, err
+	}
+	return FilterRunningTasks(tasks, ""), nil
+}
+
+// Returns nil if the task doesn't exist, which is also the case for tasks which finished and aren't scheduled again.
+func (ts *TasksService) GetTask(taskId string) (*Task, error) {
+	tasks, err := ts.GetTasks()
+	if err != nil {
+		return nil, err
+	}
+	return FindTask(tasks, taskId), nil
+}
+
+// Cancels a running task. Returns TaskCancellationNotSupportedError if Artifactory doesn't support cancelling it.
+func (ts *TasksService) CancelTask(taskId string) error {
+	if taskId == "" {
+		return errorutils.CheckErrorf("a task ID is required")
+	}
+	httpClientsDetails := ts.GetArtifactoryDetails().CreateHttpClientDetails()
+	log.Info("Cancelling task '" + taskId + "'...")
+	resp, body, err := ts.client.SendDelete(ts.GetArtifactoryDetails().GetUrl()+apiTasks+"/"+url.PathEscape(taskId), nil, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return errorutils.CheckError(&TaskCancellationNotSupportedError{TaskId: taskId})
+	case http.StatusNotFound:
+		return errorutils.CheckErrorf("task '%s' was not found", taskId)
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusAccepted, http.StatusNoContent); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+// Polls the task until it is no longer running. Returns the last state of the task, or nil if it no longer exists.
+func (ts *TasksService) WaitForTask(params WaitForTaskParams) (*Task, error) {
+	if params.Timeout <= 0 {
+		params.Timeout = defaultTasksWaitTimeout
+	}
+	if params.PollingInterval <= 0 {
+		params.PollingInterval = defaultTasksPollingInterval
+	}
+	var task *Task
+	pollingAction := func() (shouldStop bool, responseBody []byte, err error) {
+		task, err = ts.GetTask(params.TaskId)
+		if err != nil {
+			return true, nil, err
+		}
+		return task == nil || !task.IsRunning(), nil, nil
+	}
+	pollingExecutor := &httputils.PollingExecutor{
+		Timeout:         params.Timeout,
+		PollingInterval: params.PollingInterval,
+		PollingAction:   pollingAction,
+		MsgPrefix:       "Waiting for Artifactory task '" + params.TaskId + "' to finish...",
+	}
+	_, err := pollingExecutor.Execute()
+	return task, err
+}
+
+// Polls the tasks until none of the matching tasks is running.
+// Periodic tasks, such as the garbage collection, remain scheduled after they finish, so scheduled tasks are not waited for.
+func (ts *TasksService) WaitForTasks(params WaitForTasksParams) error {
+	if params.Timeout <= 0 {
+		params.Timeout = defaultTasksWaitTimeout
+	}
+	if params.PollingInterval <= 0 {
+		params.PollingInterval = defaultTasksPollingInterval
+	}
+	if params.StartGracePeriod <= 0 {
+		params.StartGracePeriod = defaultTasksStartGracePeriod
+	}
+	started := !params.RequireStart
+	startDeadline := time.Now().Add(params.StartGracePeriod)
+	pollingAction := func() (shouldStop bool, responseBody []byte, err error) {
+		tasks, err := ts.GetTasks()
+		if err != nil {
+			return true, nil, err
+		}
+		if !started {
+			started = hasStartedTask(FilterTasks(tasks, params.TypeContains), params.PreexistingTasks) || time.Now().After(startDeadline)
+			if !started {
+				return false, nil, nil
+			}
+		}
+		return len(FilterRunningTasks(tasks, params.TypeContains)) == 0, nil, nil
+	}
+	pollingExecutor := &httputils.PollingExecutor{
+		Timeout:         params.Timeout,
+		PollingInterval: params.PollingInterval,
+		PollingAction:   pollingAction,
+		MsgPrefix:       "Waiting for Artifactory tasks to finish...",
+	}
+	_, err := pollingExecutor.Execute()
+	if err != nil && !started {
+		return errorutils.CheckErrorf("no task of type '%s' was found starting within %s: %s", params.TypeContains, params.Timeout, err.Error())
+	}
+	return err
+}
+
+// Returns true if one of the tasks isn't one of the preexisting tasks, or is running while the preexisting task wasn't.
+func hasStartedTask(tasks, preexistingTasks []Task) bool {
+	for _, task := range tasks {
+		preexistingTask := FindTask(preexistingTasks, task.Id)
+		if preexistingTask == nil || (task.IsRunning() && !preexistingTask.IsRunning()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Triggers an operation which Artifactory runs as background tasks, such as a metadata calculation.
+// If waitParams isn't nil, waits for a matching task to start, and then until the matching tasks are no longer running.
+func triggerBackgroundTasks(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient, requestUrl string, waitParams *WaitForTasksParams) error {
+	return triggerAndWaitForTasks(artDetails, client, waitParams, func() error {
+		httpClientsDetails := artDetails.CreateHttpClientDetails()
+		resp, body, err := client.SendPost(requestUrl, nil, &httpClientsDetails)
+		if err != nil {
+			return err
+		}
+		if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusAccepted, http.StatusNoContent); err != nil {
+			return err
+		}
+		log.Debug("Artifactory response:", resp.Status)
+		return nil
+	})
+}
+
+// Runs the trigger, and if waitParams isn't nil, waits for the triggered tasks. The matching tasks which exist before
+// the trigger are recorded, so that they aren't mistaken for the triggered ones.
+func triggerAndWaitForTasks(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient, waitParams *WaitForTasksParams, trigger func() error) error {
+	tasksService := NewTasksService(artDetails, client)
+	if waitParams != nil {
+		tasks, err := tasksService.GetTasks()
+		if err != nil {
+			return err
+		}
+		waitParams.RequireStart = true
+		waitParams.PreexistingTasks = FilterTasks(tasks, waitParams.TypeContains)
+	}
+	if err := trigger(); err != nil {
+		return err
+	}
+	if waitParams == nil {
+		return nil
+	}
+	return tasksService.WaitForTasks(*waitParams)
+}
+
+// Returns nil if there's no task with the given ID.
+func FindTask(tasks []Task, taskId string) *Task {
+	for i := range tasks {
+		if tasks[i].Id == taskId {
+			return &tasks[i]
+		}
+	}
+	return nil
+}
+
+// Returns the tasks whose type contains the given value, in any state.
+func FilterTasks(tasks []Task, typeContains string) []Task {
+	var result []Task
+	for _, task := range tasks {
+		if strings.Contains(task.Type, typeContains) {
+			result = append(result, task)
+		}
+	}
+	return result
+}
+
+// Returns the running tasks whose type contains the given value.
+func FilterRunningTasks(tasks []Task, typeContains string) []Task {
+	var result []Task
+	for _, task := range FilterTasks(tasks, typeContains) {
+		if task.IsRunning() {
+			result = append(result, task)
+		}
+	}
+	return result
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterRunningTasks(t *testing.T) {
+	gcRunning := Task{Id: "1", Type: "org.artifactory.storage.binstore.service.BinaryStoreGarbageCollectorJob", State: TaskStateRunning}
+	gcScheduled := Task{Id: "2", Type: "org.artifactory.storage.binstore.service.BinaryStoreGarbageCollectorJob", State: TaskStateScheduled}
+	replicationRunning := Task{Id: "3", Type: "org.artifactory.addon.replication.core.LocalReplicationJob", State: TaskStateRunning}
+	tasks := []Task{gcRunning, gcScheduled, replicationRunning}
+
+	assert.Equal(t, []Task{gcRunning}, FilterRunningTasks(tasks, GarbageCollectionTaskType))
+	assert.Equal(t, []Task{gcRunning, replicationRunning}, FilterRunningTasks(tasks, ""))
+	assert.Empty(t, FilterRunningTasks(tasks, "ImportJob"))
+}
+
+func TestFindTask(t *testing.T) {
+	tasks := []Task{{Id: "1", State: TaskStateRunning}, {Id: "2", State: TaskStateScheduled}}
+	assert.Equal(t, &tasks[1], FindTask(tasks, "2"))
+	assert.Nil(t, FindTask(tasks, "3"))
+}
+
+func TestCancelTask(t *testing.T) {
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		switch r.URL.Path {
+		case "/api/tasks/running":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/tasks/gc":
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	tasksService := NewTasksService(serviceDetails, client)
+
+	assert.NoError(t, tasksService.CancelTask("running"))
+	var notSupportedErr *TaskCancellationNotSupportedError
+	assert.ErrorAs(t, tasksService.CancelTask("gc"), &notSupportedErr)
+	assert.ErrorContains(t, tasksService.CancelTask("missing"), "was not found")
+	assert.Error(t, tasksService.CancelTask(""))
+}
+
+func TestWaitForTasksRequireStart(t *testing.T) {
+	tasksPolls := 0
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		tasksPolls++
+		var tasks []string
+		if tasksPolls <= 2 {
+			tasks = append(tasks, `{"id":"old","type":"org.artifactory.maven.MavenMetadataCalculationJob","state":"running"}`)
+		}
+		if tasksPolls == 2 {
+			tasks = append(tasks, `{"id":"new","type":"org.artifactory.maven.MavenMetadataCalculationJob","state":"running"}`)
+		}
+		_, _ = w.Write([]byte(`{"tasks":[` + strings.Join(tasks, ",") + `]}`))
+	})
+	tasksService := NewTasksService(serviceDetails, client)
+	oldTask := Task{Id: "old", Type: "org.artifactory.maven.MavenMetadataCalculationJob", State: TaskStateRunning}
+	params := WaitForTasksParams{TypeContains: MavenMetadataCalculationTaskType, Timeout: time.Second, PollingInterval: time.Millisecond,
+		RequireStart: true, PreexistingTasks: []Task{oldTask}}
+
+	// The preexisting task doesn't count as a start, so the wait ends only after the new task starts and finishes.
+	assert.NoError(t, tasksService.WaitForTasks(params))
+	assert.Equal(t, 3, tasksPolls)
+
+	// A task which never starts fails the wait.
+	tasksPolls = 0
+	params.PreexistingTasks = []Task{oldTask, {Id: "new", Type: oldTask.Type, State: TaskStateRunning}}
+	params.Timeout = 100 * time.Millisecond
+	assert.ErrorContains(t, tasksService.WaitForTasks(params), "no task of type")
+}
+
+func TestWaitForTasksNeverSeenRunning(t *testing.T) {
+	var tasksResponse string
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(tasksResponse))
+	})
+	tasksService := NewTasksService(serviceDetails, client)
+	params := WaitForTasksParams{TypeContains: GarbageCollectionTaskType, Timeout: 5 * time.Second, PollingInterval: time.Millisecond,
+		RequireStart: true, StartGracePeriod: 20 * time.Millisecond}
+
+	// The task started and finished between two polls, so it's never seen running.
+	tasksResponse = `{"tasks":[]}`
+	start := time.Now()
+	assert.NoError(t, tasksService.WaitForTasks(params))
+	assert.Less(t, time.Since(start), params.Timeout)
+
+	// A periodic task which ran between two polls is scheduled again.
+	tasksResponse = `{"tasks":[{"id":"gc","type":"BinaryStoreGarbageCollectorJob","state":"scheduled"}]}`
+	params.PreexistingTasks = []Task{{Id: "gc", Type: "BinaryStoreGarbageCollectorJob", State: TaskStateScheduled}}
+	assert.NoError(t, tasksService.WaitForTasks(params))
+}
+
+func TestTriggerBackgroundTasksRecordsPreexistingTasks(t *testing.T) {
+	triggered := false
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			triggered = true
+			return
+		}
+		// A finished task of a previous run remains listed, and the triggered task is listed as stopped once it finishes.
+		tasks := `{"id":"previous","type":"org.artifactory.maven.MavenMetadataCalculationJob","state":"stopped"}`
+		if triggered {
+			tasks += `,{"id":"triggered","type":"org.artifactory.maven.MavenMetadataCalculationJob","state":"stopped"}`
+		}
+		_, _ = w.Write([]byte(`{"tasks":[` + tasks + `]}`))
+	})
+	waitParams := &WaitForTasksParams{TypeContains: MavenMetadataCalculationTaskType, Timeout: time.Second, PollingInterval: time.Millisecond}
+	assert.NoError(t, triggerBackgroundTasks(serviceDetails, client, serviceDetails.GetUrl()+"api/maven/calculateMetadata/repo", waitParams))
+	assert.True(t, triggered)
+	if assert.Len(t, waitParams.PreexistingTasks, 1) {
+		assert.Equal(t, "previous", waitParams.PreexistingTasks[0].Id)
+	}
+}
@@ -0,0 +1,265 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	terraformModulesApi   = "api/terraform/v1/modules/"
+	terraformProvidersApi = "api/terraform/v1/providers/"
+	// The header in which the registry returns the download URL of a module.
+	terraformGetHeader = "X-Terraform-Get"
+)
+
+// Publishes and resolves Terraform modules and providers in Terraform repositories, using the Terraform registry
+// protocol exposed by Artifactory.
+type TerraformService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+	DryRun     bool
+}
+
+func NewTerraformService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *TerraformService {
+	return &TerraformService{artDetails: &artDetails, client: client}
+}
+
+func (ts *TerraformService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *ts.artDetails
+}
+
+func (ts *TerraformService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return ts.client
+}
+
+func (ts *TerraformService) IsDryRun() bool {
+	return ts.DryRun
+}
+
+type TerraformModuleParams struct {
+	RepoKey   string
+	Namespace string
+	Name      string
+	// The main provider of the module, e.g. "aws".
+	Provider string
+	Version  string
+	// The path of the module's zip archive.
+	ArchivePath string
+}
+
+func NewTerraformModuleParams(repoKey, namespace, name, provider, version, archivePath string) TerraformModuleParams {
+	return TerraformModuleParams{RepoKey: repoKey, Namespace: namespace, Name: name, Provider: provider, Version: version, ArchivePath: archivePath}
+}
+
+// Returns the path of the module in the repository, e.g. "terraform-local/acme/vpc/aws/1.0.0.zip".
+func (tmp TerraformModuleParams) GetTargetPath() string {
+	return path.Join(tmp.RepoKey, tmp.Namespace, tmp.Name, tmp.Provider, tmp.Version+".zip")
+}
+
+type TerraformProviderParams struct {
+	RepoKey   string
+	Namespace string
+	// The provider type, e.g. "aws".
+	Type    string
+	Version string
+	Os      string
+	Arch    string
+	// The path of the provider's zip archive for the OS and architecture.
+	ArchivePath string
+}
+
+func NewTerraformProviderParams(repoKey, namespace, providerType, version, os, arch, archivePath string) TerraformProviderParams {
+	return TerraformProviderParams{RepoKey: repoKey, Namespace: namespace, Type: providerType, Version: version, Os: os, Arch: arch, ArchivePath: archivePath}
+}
+
+// Returns the path of the provider archive in the repository, named as the Terraform registry expects, e.g.
+// "terraform-local/acme/aws/1.0.0/terraform-provider-aws_1.0.0_linux_amd64.zip".
+func (tpp TerraformProviderParams) GetTargetPath() string {
+	fileName := fmt.Sprintf("terraform-provider-%s_%s_%s_%s.zip", tpp.Type, tpp.Version, tpp.Os, tpp.Arch)
+	return path.Join(tpp.RepoKey, tpp.Namespace, tpp.Type, tpp.Version, fileName)
+}
+
+type TerraformProviderVersion struct {
+	Version   string              `json:"version,omitempty"`
+	Protocols []string            `json:"protocols,omitempty"`
+	Platforms []TerraformPlatform `json:"platforms,omitempty"`
+}
+
+type TerraformPlatform struct {
+	Os   string `json:"os,omitempty"`
+	Arch string `json:"arch,omitempty"`
+}
+
+// The package of a provider version for a single platform.
+type TerraformProviderPackage struct {
+	Os                  string   `json:"os,omitempty"`
+	Arch                string   `json:"arch,omitempty"`
+	Filename            string   `json:"filename,omitempty"`
+	DownloadUrl         string   `json:"download_url,omitempty"`
+	Shasum              string   `json:"shasum,omitempty"`
+	ShasumsUrl          string   `json:"shasums_url,omitempty"`
+	ShasumsSignatureUrl string   `json:"shasums_signature_url,omitempty"`
+	Protocols           []string `json:"protocols,omitempty"`
+}
+
+type terraformModuleVersionsResponse struct {
+	Modules []struct {
+		Versions []struct {
+			Version string `json:"version"`
+		} `json:"versions"`
+	} `json:"modules"`
+}
+
+type terraformProviderVersionsResponse struct {
+	Versions []TerraformProviderVersion `json:"versions"`
+}
+
+// Returns the namespace by which the Terraform registry of Artifactory addresses a namespace in a repository,
+// e.g. "terraform-local__acme". Module sources and provider addresses use it as their namespace.
+func TerraformRegistryNamespace(repoKey, namespace string) string {
+	return repoKey + "__" + namespace
+}
+
+// Publishes a module archive. Returns the path of the module in the repository.
+func (ts *TerraformService) PublishModule(params TerraformModuleParams) (string, error) {
+	if params.RepoKey == "" || params.Namespace == "" || params.Name == "" || params.Provider == "" || params.Version == "" || params.ArchivePath == "" {
+		return "", errorutils.CheckErrorf("a repository, namespace, name, provider, version and archive path are required to publish a Terraform module")
+	}
+	targetPath := params.GetTargetPath()
+	return targetPath, ts.uploadArchive(params.ArchivePath, targetPath)
+}
+
+// Publishes a provider archive for a single OS and architecture. Returns the path of the archive in the repository.
+func (ts *TerraformService) PublishProvider(params TerraformProviderParams) (string, error) {
+	if params.RepoKey == "" || params.Namespace == "" || params.Type == "" || params.Version == "" || params.Os == "" || params.Arch == "" || params.ArchivePath == "" {
+		return "", errorutils.CheckErrorf("a repository, namespace, type, version, OS, architecture and archive path are required to publish a Terraform provider")
+	}
+	targetPath := params.GetTargetPath()
+	return targetPath, ts.uploadArchive(params.ArchivePath, targetPath)
+}
+
+func (ts *TerraformService) uploadArchive(localPath, targetPath string) error {
+	if ts.DryRun {
+		log.Info("[Dry run] Publishing " + localPath + " to " + targetPath)
+		return nil
+	}
+	log.Info("Publishing " + localPath + " to " + targetPath + "...")
+	targetUrl, err := clientutils.BuildUrl(ts.GetArtifactoryDetails().GetUrl(), targetPath, nil)
+	if err != nil {
+		return err
+	}
+	httpClientsDetails := ts.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, err := utils.UploadFile(localPath, targetUrl, "", ts.artDetails, nil, httpClientsDetails, ts.client, true, nil)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusCreated, http.StatusOK); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+// Returns the published versions of a module.
+func (ts *TerraformService) ListModuleVersions(repoKey, namespace, name, provider string) ([]string, error) {
+	restApi := terraformModulesApi + path.Join(TerraformRegistryNamespace(repoKey, namespace), name, provider, "versions")
+	body, err := ts.getRegistryResponse(restApi)
+	if err != nil {
+		return nil, err
+	}
+	response := &terraformModuleVersionsResponse{}
+	if err = errorutils.CheckError(json.Unmarshal(body, response)); err != nil {
+		return nil, err
+	}
+	var versions []string
+	for _, module := range response.Modules {
+		for _, moduleVersion := range module.Versions {
+			versions = append(versions, moduleVersion.Version)
+		}
+	}
+	return versions, nil
+}
+
+// Returns the URL to download a module version from, as Terraform resolves it.
+func (ts *TerraformService) GetModuleDownloadUrl(repoKey, namespace, name, provider, version string) (string, error) {
+	restApi := terraformModulesApi + path.Join(TerraformRegistryNamespace(repoKey, namespace), name, provider, version, "download")
+	requestUrl, err := clientutils.BuildUrl(ts.GetArtifactoryDetails().GetUrl(), restApi, nil)
+	if err != nil {
+		return "", err
+	}
+	httpClientsDetails := ts.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := ts.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return "", err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusNoContent, http.StatusOK); err != nil {
+		return "", err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	location := resp.Header.Get(terraformGetHeader)
+	if location == "" {
+		return "", errorutils.CheckErrorf("the registry didn't return the download URL of module %s/%s/%s %s", namespace, name, provider, version)
+	}
+	// The download URL may be relative to the request URL.
+	base, err := url.Parse(requestUrl)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	downloadUrl, err := url.Parse(location)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return base.ResolveReference(downloadUrl).String(), nil
+}
+
+// Returns the published versions of a provider and the platforms of each version.
+func (ts *TerraformService) ListProviderVersions(repoKey, namespace, providerType string) ([]TerraformProviderVersion, error) {
+	restApi := terraformProvidersApi + path.Join(TerraformRegistryNamespace(repoKey, namespace), providerType, "versions")
+	body, err := ts.getRegistryResponse(restApi)
+	if err != nil {
+		return nil, err
+	}
+	response := &terraformProviderVersionsResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return response.Versions, nil
+}
+
+// Returns the package of a provider version for the OS and architecture, as Terraform resolves it.
+func (ts *TerraformService) GetProviderPackage(repoKey, namespace, providerType, version, os, arch string) (*TerraformProviderPackage, error) {
+	restApi := terraformProvidersApi + path.Join(TerraformRegistryNamespace(repoKey, namespace), providerType, version, "download", os, arch)
+	body, err := ts.getRegistryResponse(restApi)
+	if err != nil {
+		return nil, err
+	}
+	providerPackage := &TerraformProviderPackage{}
+	return providerPackage, errorutils.CheckError(json.Unmarshal(body, providerPackage))
+}
+
+func (ts *TerraformService) getRegistryResponse(restApi string) ([]byte, error) {
+	requestUrl, err := clientutils.BuildUrl(ts.GetArtifactoryDetails().GetUrl(), restApi, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := ts.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := ts.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return body, nil
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTerraformTestService(t *testing.T, handler http.HandlerFunc) *TerraformService {
+	return NewTerraformService(newTestServer(t, handler))
+}
+
+func TestTerraformPublish(t *testing.T) {
+	uploaded := make(map[string]string)
+	terraformService := createTerraformTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		content, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		uploaded[r.URL.Path] = string(content)
+		w.WriteHeader(http.StatusCreated)
+	})
+	archivePath := filepath.Join(t.TempDir(), "archive.zip")
+	assert.NoError(t, os.WriteFile(archivePath, []byte("zip"), 0600))
+
+	modulePath, err := terraformService.PublishModule(NewTerraformModuleParams("terraform-local", "acme", "vpc", "aws", "1.0.0", archivePath))
+	assert.NoError(t, err)
+	assert.Equal(t, "terraform-local/acme/vpc/aws/1.0.0.zip", modulePath)
+	providerPath, err := terraformService.PublishProvider(NewTerraformProviderParams("terraform-local", "acme", "cloud", "2.1.0", "linux", "amd64", archivePath))
+	assert.NoError(t, err)
+	assert.Equal(t, "terraform-local/acme/cloud/2.1.0/terraform-provider-cloud_2.1.0_linux_amd64.zip", providerPath)
+	assert.Equal(t, map[string]string{"/" + modulePath: "zip", "/" + providerPath: "zip"}, uploaded)
+
+	_, err = terraformService.PublishModule(NewTerraformModuleParams("terraform-local", "acme", "vpc", "", "1.0.0", archivePath))
+	assert.ErrorContains(t, err, "are required")
+}
+
+func TestTerraformResolve(t *testing.T) {
+	terraformService := createTerraformTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/terraform/v1/modules/terraform-virtual__acme/vpc/aws/versions":
+			_, _ = w.Write([]byte(`{"modules":[{"versions":[{"version":"1.0.0"},{"version":"1.1.0"}]}]}`))
+		case "/api/terraform/v1/modules/terraform-virtual__acme/vpc/aws/1.1.0/download":
+			w.Header().Set(terraformGetHeader, "/terraform-virtual/acme/vpc/aws/1.1.0.zip")
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/terraform/v1/providers/terraform-virtual__acme/cloud/versions":
+			_, _ = w.Write([]byte(`{"versions":[{"version":"2.1.0","protocols":["5.0"],"platforms":[{"os":"linux","arch":"amd64"}]}]}`))
+		case "/api/terraform/v1/providers/terraform-virtual__acme/cloud/2.1.0/download/linux/amd64":
+			_, _ = w.Write([]byte(`{"os":"linux","arch":"amd64","filename":"terraform-provider-cloud_2.1.0_linux_amd64.zip","shasum":"abc"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	versions, err := terraformService.ListModuleVersions("terraform-virtual", "acme", "vpc", "aws")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1.0.0", "1.1.0"}, versions)
+	downloadUrl, err := terraformService.GetModuleDownloadUrl("terraform-virtual", "acme", "vpc", "aws", "1.1.0")
+	assert.NoError(t, err)
+	assert.Equal(t, terraformService.GetArtifactoryDetails().GetUrl()+"terraform-virtual/acme/vpc/aws/1.1.0.zip", downloadUrl)
+
+	providerVersions, err := terraformService.ListProviderVersions("terraform-virtual", "acme", "cloud")
+	assert.NoError(t, err)
+	assert.Equal(t, []TerraformProviderVersion{{Version: "2.1.0", Protocols: []string{"5.0"}, Platforms: []TerraformPlatform{{Os: "linux", Arch: "amd64"}}}}, providerVersions)
+	providerPackage, err := terraformService.GetProviderPackage("terraform-virtual", "acme", "cloud", "2.1.0", "linux", "amd64")
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", providerPackage.Shasum)
+
+	_, err = terraformService.GetModuleDownloadUrl("terraform-virtual", "acme", "vpc", "aws", "9.9.9")
+	assert.Error(t, err)
+}
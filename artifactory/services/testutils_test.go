@@ -0,0 +1,51 @@
+package services
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+// Artifactory details for tests which run against a mock server. Reports a fixed version, so that version checks pass.
+type testServiceDetails struct {
+	auth.CommonConfigFields
+}
+
+func (tsd *testServiceDetails) GetVersion() (string, error) {
+	return "7.90.0", nil
+}
+
+// Starts a mock Artifactory server, which is closed when the test ends, and returns the details and a client to access it.
+func newTestServer(t *testing.T, handler http.HandlerFunc) (auth.ServiceDetails, *jfroghttpclient.JfrogHttpClient) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	assert.NoError(t, err)
+	var serviceDetails auth.ServiceDetails = &testServiceDetails{}
+	serviceDetails.SetUrl(server.URL + "/")
+	return serviceDetails, client
+}
+
+// A request received by the mock server.
+type recordedRequest struct {
+	Method string
+	Uri    string
+	Body   string
+}
+
+// Starts a mock Artifactory server which records the requests and responds to all of them with the given body.
+func newRecordingTestServer(t *testing.T, responseBody string) (auth.ServiceDetails, *jfroghttpclient.JfrogHttpClient, *[]recordedRequest) {
+	var requests []recordedRequest
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		requests = append(requests, recordedRequest{Method: r.Method, Uri: r.URL.RequestURI(), Body: string(body)})
+		_, _ = w.Write([]byte(responseBody))
+	})
+	return serviceDetails, client, &requests
+}
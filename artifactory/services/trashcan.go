@@ -0,0 +1,215 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The repository that holds the trashed items, under a folder named after the repository they were deleted from.
+	TrashcanRepo = "auto-trashcan"
+
+	trashcanDeletedByProp = "trash.deletedBy"
+	trashcanTimeProp      = "trash.time"
+)
+
+// Lists, restores and permanently deletes items in the trash can.
+type TrashcanService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+}
+
+func NewTrashcanService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *TrashcanService {
+	return &TrashcanService{artDetails: &artDetails, client: client}
+}
+
+func (ts *TrashcanService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *ts.artDetails
+}
+
+func (ts *TrashcanService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return ts.client
+}
+
+func (ts *TrashcanService) IsDryRun() bool {
+	return false
+}
+
+type TrashcanListParams struct {
+	// Only items deleted from this repository.
+	OriginalRepo string
+	// Only items whose path inside the original repository matches this wildcard pattern, e.g. "org/acme/*.jar".
+	PathPattern string
+	// Only items deleted by this user.
+	DeletedBy string
+	// Only items deleted after or before these times. Ignored if zero.
+	DeletedAfter  time.Time
+	DeletedBefore time.Time
+}
+
+func NewTrashcanListParams() TrashcanListParams {
+	return TrashcanListParams{}
+}
+
+type TrashcanItem struct {
+	// The path of the item inside the trash can, e.g. "libs-release/org/acme/a.jar". Used to restore or delete the item.
+	TrashPath    string
+	OriginalRepo string
+	// The path of the item inside the original repository, e.g. "org/acme/a.jar".
+	OriginalPath string
+	Size         int64
+	Sha256       string
+	DeletedBy    string
+	DeletedTime  time.Time
+}
+
+// Returns the files in the trash can which match the given filters.
+func (ts *TrashcanService) List(params TrashcanListParams) (items []TrashcanItem, err error) {
+	searchParams := NewSearchParams()
+	searchParams.Pattern = getTrashcanSearchPattern(params)
+	searchParams.Recursive = true
+	reader, err := SearchBySpecFiles(searchParams, ts, utils.ALL)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	for resultItem := new(utils.ResultItem); reader.NextRecord(resultItem) == nil; resultItem = new(utils.ResultItem) {
+		item := NewTrashcanItem(*resultItem)
+		if params.matches(item) {
+			items = append(items, item)
+		}
+	}
+	return items, reader.GetError()
+}
+
+func getTrashcanSearchPattern(params TrashcanListParams) string {
+	repo := params.OriginalRepo
+	if repo == "" {
+		repo = "*"
+	}
+	pathPattern := strings.TrimPrefix(params.PathPattern, "/")
+	if pathPattern == "" {
+		pathPattern = "*"
+	}
+	return TrashcanRepo + "/" + repo + "/" + pathPattern
+}
+
+func (tlp *TrashcanListParams) matches(item TrashcanItem) bool {
+	if tlp.DeletedBy != "" && tlp.DeletedBy != item.DeletedBy {
+		return false
+	}
+	if !tlp.DeletedAfter.IsZero() && !item.DeletedTime.After(tlp.DeletedAfter) {
+		return false
+	}
+	if !tlp.DeletedBefore.IsZero() && !item.DeletedTime.Before(tlp.DeletedBefore) {
+		return false
+	}
+	return true
+}
+
+// Converts a search result from the trash can repository to a TrashcanItem.
+func NewTrashcanItem(resultItem utils.ResultItem) TrashcanItem {
+	trashPath := strings.TrimPrefix(resultItem.GetItemRelativePath(), TrashcanRepo+"/")
+	item := TrashcanItem{TrashPath: trashPath, Size: resultItem.Size, Sha256: resultItem.Sha256}
+	item.OriginalRepo, item.OriginalPath, _ = strings.Cut(trashPath, "/")
+	for _, property := range resultItem.Properties {
+		switch property.Key {
+		case trashcanDeletedByProp:
+			item.DeletedBy = property.Value
+		case trashcanTimeProp:
+			item.DeletedTime = parseTrashcanTime(property.Value)
+		}
+	}
+	return item
+}
+
+// The deletion time is stored in milliseconds since the epoch.
+func parseTrashcanTime(value string) time.Time {
+	millis, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		log.Debug("Couldn't parse the trash can deletion time '" + value + "': " + err.Error())
+		return time.Time{}
+	}
+	return time.UnixMilli(millis)
+}
+
+// Restores an item from the trash can. The trash path is in the form of "repo/path".
+// If the target path is empty, the item is restored to its original location.
+func (ts *TrashcanService) Restore(trashPath, targetPath string) error {
+	trashPath = strings.Trim(trashPath, "/")
+	if targetPath == "" {
+		targetPath = trashPath
+	}
+	requestUrl, err := clientutils.BuildUrl(ts.GetArtifactoryDetails().GetUrl(), path.Join("api/trash/restore", trashPath), map[string]string{"to": targetPath})
+	if err != nil {
+		return err
+	}
+	log.Info("Restoring '" + trashPath + "' from the trash can to '" + targetPath + "'...")
+	if err = ts.send(http.MethodPost, requestUrl); err != nil {
+		return err
+	}
+	log.Info("Done restoring.")
+	return nil
+}
+
+// Permanently deletes an item from the trash can. The trash path is either a repository name, which deletes all the
+// items deleted from that repository, or a path in the form of "repo/path".
+func (ts *TrashcanService) Delete(trashPath string) error {
+	trashPath = strings.Trim(trashPath, "/")
+	if trashPath == "" {
+		return errorutils.CheckErrorf("a path in the trash can is required. To empty the trash can, use Empty() instead")
+	}
+	requestUrl, err := clientutils.BuildUrl(ts.GetArtifactoryDetails().GetUrl(), path.Join("api/trash/clean", trashPath), nil)
+	if err != nil {
+		return err
+	}
+	log.Info("Permanently deleting '" + trashPath + "' from the trash can...")
+	if err = ts.send(http.MethodDelete, requestUrl); err != nil {
+		return err
+	}
+	log.Info("Done deleting.")
+	return nil
+}
+
+// Permanently deletes all the items in the trash can.
+func (ts *TrashcanService) Empty() error {
+	log.Info("Emptying the trash can...")
+	if err := ts.send(http.MethodPost, ts.GetArtifactoryDetails().GetUrl()+"api/trash/empty"); err != nil {
+		return err
+	}
+	log.Info("Done emptying the trash can.")
+	return nil
+}
+
+func (ts *TrashcanService) send(method, requestUrl string) error {
+	httpClientsDetails := ts.GetArtifactoryDetails().CreateHttpClientDetails()
+	var resp *http.Response
+	var body []byte
+	var err error
+	if method == http.MethodDelete {
+		resp, body, err = ts.client.SendDelete(requestUrl, nil, &httpClientsDetails)
+	} else {
+		resp, body, err = ts.client.SendPost(requestUrl, nil, &httpClientsDetails)
+	}
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusAccepted, http.StatusNoContent); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
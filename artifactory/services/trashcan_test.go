@@ -0,0 +1,41 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrashcanItem(t *testing.T) {
+	resultItem := utils.ResultItem{
+		Repo: TrashcanRepo, Path: "libs-release/org/acme", Name: "a.jar", Size: 10, Sha256: "abc",
+		Properties: []utils.Property{{Key: "trash.deletedBy", Value: "admin"}, {Key: "trash.time", Value: "1700000000000"}},
+	}
+	item := NewTrashcanItem(resultItem)
+	assert.Equal(t, TrashcanItem{
+		TrashPath:    "libs-release/org/acme/a.jar",
+		OriginalRepo: "libs-release",
+		OriginalPath: "org/acme/a.jar",
+		Size:         10,
+		Sha256:       "abc",
+		DeletedBy:    "admin",
+		DeletedTime:  time.UnixMilli(1700000000000),
+	}, item)
+}
+
+func TestGetTrashcanSearchPattern(t *testing.T) {
+	assert.Equal(t, "auto-trashcan/*/*", getTrashcanSearchPattern(NewTrashcanListParams()))
+	assert.Equal(t, "auto-trashcan/libs-release/org/acme/*.jar", getTrashcanSearchPattern(TrashcanListParams{OriginalRepo: "libs-release", PathPattern: "/org/acme/*.jar"}))
+}
+
+func TestTrashcanListParamsMatches(t *testing.T) {
+	deletedTime := time.UnixMilli(1700000000000)
+	item := TrashcanItem{DeletedBy: "admin", DeletedTime: deletedTime}
+	assert.True(t, (&TrashcanListParams{}).matches(item))
+	assert.True(t, (&TrashcanListParams{DeletedBy: "admin", DeletedAfter: deletedTime.Add(-time.Hour), DeletedBefore: deletedTime.Add(time.Hour)}).matches(item))
+	assert.False(t, (&TrashcanListParams{DeletedBy: "other"}).matches(item))
+	assert.False(t, (&TrashcanListParams{DeletedAfter: deletedTime.Add(time.Hour)}).matches(item))
+	assert.False(t, (&TrashcanListParams{DeletedBefore: deletedTime.Add(-time.Hour)}).matches(item))
+}
@@ -4,41 +4,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	usersUtils "github.com/jfrog/jfrog-client-go/utils/users"
 )
 
-type UserParams struct {
-	UserDetails     User
-	ReplaceIfExists bool
-	ClearGroups     bool
-}
+// The user model is shared with the Access users API, so it's defined in the utils/users package.
+type UserParams = usersUtils.UserParams
+
+type User = usersUtils.User
 
 func NewUserParams() UserParams {
-	return UserParams{}
-}
-
-// application/vnd.org.jfrog.artifactory.security.User+json
-type User struct {
-	Name                     string    `json:"name,omitempty" csv:"username,omitempty"`
-	Email                    string    `json:"email,omitempty" csv:"email,omitempty"`
-	Password                 string    `json:"password,omitempty" csv:"password,omitempty"`
-	Admin                    *bool     `json:"admin,omitempty" csv:"admin,omitempty"`
-	ProfileUpdatable         *bool     `json:"profileUpdatable,omitempty" csv:"profileUpdatable,omitempty"`
-	DisableUIAccess          *bool     `json:"disableUIAccess,omitempty" csv:"disableUIAccess,omitempty"`
-	InternalPasswordDisabled *bool     `json:"internalPasswordDisabled,omitempty" csv:"internalPasswordDisabled,omitempty"`
-	LastLoggedIn             string    `json:"lastLoggedIn,omitempty" csv:"lastLoggedIn,omitempty"`
-	Realm                    string    `json:"realm,omitempty" csv:"realm,omitempty"`
-	Groups                   *[]string `json:"groups,omitempty" csv:"groups,omitempty"`
-	ShouldInvite             *bool     `json:"shouldInvite,omitempty" csv:"shouldInvite,omitempty"`
-	Source                   string    `json:"source,omitempty" csv:"source,omitempty"`
-	WatchManager             *bool     `json:"watchManager,omitempty" csv:"watchManager,omitempty"`
-	ReportsManager           *bool     `json:"reportsManager,omitempty" csv:"reportsManager,omitempty"`
-	PolicyManager            *bool     `json:"policyManager,omitempty" csv:"policyManager,omitempty"`
-	ProjectAdmin             *bool     `json:"projectAdmin,omitempty" csv:"projectAdmin,omitempty"`
+	return usersUtils.NewUserParams()
 }
 
 type UserService struct {
@@ -184,3 +166,78 @@ func (us *UserService) UnlockUser(name string) error {
 	}
 	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
 }
+
+func (us *UserService) UnlockUsers(names ...string) error {
+	content, err := json.Marshal(names)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	return us.sendSecurityPost("api/security/unlockUsers", content)
+}
+
+func (us *UserService) UnlockAllUsers() error {
+	return us.sendSecurityPost("api/security/unlockAllUsers", nil)
+}
+
+// Expires the password of the user, so that the user must change it on the next login.
+func (us *UserService) ExpirePassword(name string) error {
+	return us.sendSecurityPost("api/security/users/authorization/expirePassword/"+url.PathEscape(name), nil)
+}
+
+func (us *UserService) ExpirePasswords(names ...string) error {
+	content, err := json.Marshal(names)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	return us.sendSecurityPost("api/security/users/authorization/expirePassword", content)
+}
+
+func (us *UserService) ExpireAllPasswords() error {
+	return us.sendSecurityPost("api/security/users/authorization/expirePasswordForAllUsers", nil)
+}
+
+func (us *UserService) UnexpirePassword(name string) error {
+	return us.sendSecurityPost("api/security/users/authorization/unexpirePassword/"+url.PathEscape(name), nil)
+}
+
+func (us *UserService) sendSecurityPost(apiPath string, content []byte) error {
+	httpDetails := us.ArtDetails.CreateHttpClientDetails()
+	if content != nil {
+		httpDetails.SetContentTypeApplicationJson()
+	}
+	resp, body, err := us.client.SendPost(us.ArtDetails.GetUrl()+apiPath, content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+}
+
+// Lists users using the security API. Since the security API doesn't support pagination, all the users are fetched,
+// and the filters and pagination are applied on the client side. The cursor is the offset of the page.
+func (us *UserService) ListUsers(params usersUtils.ListUsersParams) (*usersUtils.UsersPage, error) {
+	users, err := us.GetAllUsers()
+	if err != nil {
+		return nil, err
+	}
+	var matching []User
+	for _, user := range users {
+		if params.Matches(*user) {
+			matching = append(matching, *user)
+		}
+	}
+	return paginateUsers(matching, params.Limit, params.Cursor)
+}
+
+func paginateUsers(users []User, limit int, cursor string) (*usersUtils.UsersPage, error) {
+	page, nextCursor, err := clientutils.PaginateByOffset(users, limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &usersUtils.UsersPage{Users: page, NextCursor: nextCursor}, nil
+}
+
+func (us *UserService) GetUserDetails(name string) (*User, error) {
+	params := NewUserParams()
+	params.UserDetails.Name = name
+	return us.GetUser(params)
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginateUsers(t *testing.T) {
+	users := []User{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+
+	page, err := paginateUsers(users, 2, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []User{{Name: "a"}, {Name: "b"}}, page.Users)
+	assert.Equal(t, "2", page.NextCursor)
+
+	page, err = paginateUsers(users, 2, page.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, []User{{Name: "c"}}, page.Users)
+	assert.Empty(t, page.NextCursor)
+
+	page, err = paginateUsers(users, 0, "")
+	assert.NoError(t, err)
+	assert.Len(t, page.Users, 3)
+	assert.Empty(t, page.NextCursor)
+
+	page, err = paginateUsers(users, 2, "5")
+	assert.NoError(t, err)
+	assert.Empty(t, page.Users)
+
+	_, err = paginateUsers(users, 2, "not-a-cursor")
+	assert.Error(t, err)
+}
+
+func TestUsersSecurityActions(t *testing.T) {
+	serviceDetails, client, requests := newRecordingTestServer(t, "")
+	userService := NewUserService(client)
+	userService.ArtDetails = serviceDetails
+
+	assert.NoError(t, userService.UnlockUsers("first", "second"))
+	assert.NoError(t, userService.UnlockAllUsers())
+	assert.NoError(t, userService.ExpirePassword("john doe"))
+	assert.NoError(t, userService.ExpirePasswords("first", "second"))
+	assert.NoError(t, userService.ExpireAllPasswords())
+	assert.NoError(t, userService.UnexpirePassword("first"))
+	assert.Equal(t, []recordedRequest{
+		{Method: http.MethodPost, Uri: "/api/security/unlockUsers", Body: `["first","second"]`},
+		{Method: http.MethodPost, Uri: "/api/security/unlockAllUsers"},
+		{Method: http.MethodPost, Uri: "/api/security/users/authorization/expirePassword/john%20doe"},
+		{Method: http.MethodPost, Uri: "/api/security/users/authorization/expirePassword", Body: `["first","second"]`},
+		{Method: http.MethodPost, Uri: "/api/security/users/authorization/expirePasswordForAllUsers"},
+		{Method: http.MethodPost, Uri: "/api/security/users/authorization/unexpirePassword/first"},
+	}, *requests)
+}
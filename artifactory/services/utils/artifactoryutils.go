@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"path"
 	"strings"
 	"sync"
@@ -31,6 +32,8 @@ const (
 	buildRepositoriesSuffix      = "-build-info"
 	defaultBuildRepositoriesName = "artifactory"
 	defaultProjectKey            = "default"
+	// Builds can be assigned to projects since this version.
+	MinArtifactoryVersionForBuildProjects = "7.19.0"
 )
 
 func UploadFile(localPath, url, logMsgPrefix string, artifactoryDetails *auth.ServiceDetails, details *fileutils.FileDetails,
@@ -110,7 +113,19 @@ func GetProjectQueryParam(projectKey string) string {
 	if projectKey == "" {
 		return ""
 	}
-	return "?project=" + projectKey
+	return "?project=" + url.QueryEscape(projectKey)
+}
+
+// Validates that the Artifactory version supports project-scoped builds, if a project key is given.
+func ValidateBuildProjectKey(projectKey string, artDetails auth.ServiceDetails) error {
+	if projectKey == "" {
+		return nil
+	}
+	artifactoryVersion, err := artDetails.GetVersion()
+	if err != nil {
+		return err
+	}
+	return utils.ValidateMinimumVersion(utils.Artifactory, artifactoryVersion, MinArtifactoryVersionForBuildProjects)
 }
 
 // paths - Sorted array.
@@ -595,6 +610,9 @@ func GetBuildRuns(buildName, projectKey string, flags CommonConf) (runs *buildin
 }
 
 func sendGetBuildInfo(restApi, projectKey string, flags CommonConf) (body []byte, found bool, err error) {
+	if err = ValidateBuildProjectKey(projectKey, flags.GetArtifactoryDetails()); err != nil {
+		return nil, false, err
+	}
 	httpClientsDetails := flags.GetArtifactoryDetails().CreateHttpClientDetails()
 
 	queryParams := make(map[string]string)
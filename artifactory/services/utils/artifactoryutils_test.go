@@ -67,3 +67,14 @@ func TestHasPrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestGetProjectQueryParam(t *testing.T) {
+	assert.Empty(t, GetProjectQueryParam(""))
+	assert.Equal(t, "?project=proj", GetProjectQueryParam("proj"))
+}
+
+func TestValidateBuildProjectKey(t *testing.T) {
+	assert.NoError(t, ValidateBuildProjectKey("", &dummyArtifactoryServiceDetails{version: "6.0.0"}))
+	assert.NoError(t, ValidateBuildProjectKey("proj", &dummyArtifactoryServiceDetails{version: MinArtifactoryVersionForBuildProjects}))
+	assert.Error(t, ValidateBuildProjectKey("proj", &dummyArtifactoryServiceDetails{version: "7.10.0"}))
+}
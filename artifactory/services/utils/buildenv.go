@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"os"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/gofrog/stringutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The value set instead of the value of a redacted environment variable.
+const RedactedEnvValue = "***"
+
+// The patterns of the environment variables which are redacted by default, as they may hold secrets.
+var DefaultSecretEnvPatterns = []string{"*password*", "*psw*", "*secret*", "*key*", "*token*", "*auth*", "*credential*"}
+
+// Collects environment variables as build info properties, each named "buildInfo.env.<variable>".
+// Patterns are wildcard patterns, e.g. "CI_*", and are matched case-insensitively against the variables' names.
+type BuildEnvCollector struct {
+	// The variables to collect. All the variables are collected if empty.
+	IncludePatterns []string
+	// The variables to skip, even if included.
+	ExcludePatterns []string
+	// The variables whose values are replaced by RedactedEnvValue. Defaults to DefaultSecretEnvPatterns.
+	// Set to an empty, non-nil slice to collect all the values as is.
+	RedactPatterns []string
+}
+
+func NewBuildEnvCollector() *BuildEnvCollector {
+	return &BuildEnvCollector{}
+}
+
+// Collects the variables of the current process.
+func (bec *BuildEnvCollector) CollectFromOs() (buildinfo.Env, error) {
+	return bec.Collect(os.Environ())
+}
+
+// Collects the variables from "name=value" entries, as returned by os.Environ.
+func (bec *BuildEnvCollector) Collect(environ []string) (buildinfo.Env, error) {
+	redactPatterns := bec.RedactPatterns
+	if redactPatterns == nil {
+		redactPatterns = DefaultSecretEnvPatterns
+	}
+	properties := make(buildinfo.Env)
+	for _, entry := range environ {
+		name, value, found := strings.Cut(entry, "=")
+		if !found || name == "" {
+			continue
+		}
+		if len(bec.IncludePatterns) > 0 {
+			included, err := matchAnyEnvPattern(bec.IncludePatterns, name)
+			if err != nil {
+				return nil, err
+			}
+			if !included {
+				continue
+			}
+		}
+		excluded, err := matchAnyEnvPattern(bec.ExcludePatterns, name)
+		if err != nil {
+			return nil, err
+		}
+		if excluded {
+			continue
+		}
+		redacted, err := matchAnyEnvPattern(redactPatterns, name)
+		if err != nil {
+			return nil, err
+		}
+		if redacted {
+			value = RedactedEnvValue
+		}
+		properties[buildinfo.BuildInfoEnvPrefix+name] = value
+	}
+	return properties, nil
+}
+
+// Collects the variables of the current process into the properties of the build info. Existing properties with the
+// same names are overridden.
+func (bec *BuildEnvCollector) AddToBuildInfo(build *buildinfo.BuildInfo) error {
+	properties, err := bec.CollectFromOs()
+	if err != nil {
+		return err
+	}
+	if build.Properties == nil {
+		build.Properties = make(buildinfo.Env, len(properties))
+	}
+	for key, value := range properties {
+		build.Properties[key] = value
+	}
+	return nil
+}
+
+func matchAnyEnvPattern(patterns []string, name string) (bool, error) {
+	for _, pattern := range patterns {
+		matched, err := stringutils.MatchWildcardPattern(strings.ToLower(pattern), strings.ToLower(name))
+		if err != nil {
+			return false, errorutils.CheckError(err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"testing"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildEnvCollector(t *testing.T) {
+	environ := []string{"CI_JOB=build", "CI_TOKEN=abc", "HOME=/home/ci", "DB_PASSWORD=pass", "EMPTY=", "EQUALS=a=b", "invalid"}
+	tests := []struct {
+		testName  string
+		collector BuildEnvCollector
+		expected  buildinfo.Env
+	}{
+		{"default", BuildEnvCollector{}, buildinfo.Env{
+			"buildInfo.env.CI_JOB":      "build",
+			"buildInfo.env.CI_TOKEN":    RedactedEnvValue,
+			"buildInfo.env.HOME":        "/home/ci",
+			"buildInfo.env.DB_PASSWORD": RedactedEnvValue,
+			"buildInfo.env.EMPTY":       "",
+			"buildInfo.env.EQUALS":      "a=b",
+		}},
+		{"include_and_exclude", BuildEnvCollector{IncludePatterns: []string{"ci_*"}, ExcludePatterns: []string{"*token"}}, buildinfo.Env{
+			"buildInfo.env.CI_JOB": "build",
+		}},
+		{"no_redaction", BuildEnvCollector{IncludePatterns: []string{"CI_*"}, RedactPatterns: []string{}}, buildinfo.Env{
+			"buildInfo.env.CI_JOB":   "build",
+			"buildInfo.env.CI_TOKEN": "abc",
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			properties, err := test.collector.Collect(environ)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, properties)
+		})
+	}
+}
+
+func TestBuildEnvCollectorAddToBuildInfo(t *testing.T) {
+	t.Setenv("BUILD_ENV_COLLECTOR_TEST", "value")
+	build := &buildinfo.BuildInfo{}
+	collector := NewBuildEnvCollector()
+	collector.IncludePatterns = []string{"BUILD_ENV_COLLECTOR_*"}
+	assert.NoError(t, collector.AddToBuildInfo(build))
+	assert.Equal(t, buildinfo.Env{"buildInfo.env.BUILD_ENV_COLLECTOR_TEST": "value"}, build.Properties)
+}
@@ -31,7 +31,7 @@ type supportedStatus int
 type completionStatus string
 
 const (
-	minArtifactoryVersion = "7.82.2"
+	MultipartUploadMinArtifactoryVersion = "7.82.2"
 
 	// Supported status
 	// Multipart upload support is not yet determined
@@ -93,8 +93,8 @@ func (mu *MultipartUpload) IsSupported(serviceDetails auth.ServiceDetails) (supp
 		return
 	}
 
-	if versionErr := utils.ValidateMinimumVersion(utils.Artifactory, artifactoryVersion, minArtifactoryVersion); versionErr != nil {
-		log.Debug("Multipart upload is not supported in versions below " + minArtifactoryVersion + ". Proceeding with regular upload...")
+	if versionErr := utils.ValidateMinimumVersion(utils.Artifactory, artifactoryVersion, MultipartUploadMinArtifactoryVersion); versionErr != nil {
+		log.Debug("Multipart upload is not supported in versions below " + MultipartUploadMinArtifactoryVersion + ". Proceeding with regular upload...")
 		mu.supportedStatus = multipartNotSupported
 		return
 	}
@@ -55,7 +55,7 @@ func TestIsSupported(t *testing.T) {
 	defer cleanUp()
 
 	// Create Artifactory service details
-	rtDetails := &dummyArtifactoryServiceDetails{version: minArtifactoryVersion}
+	rtDetails := &dummyArtifactoryServiceDetails{version: MultipartUploadMinArtifactoryVersion}
 
 	// Execute IsSupported
 	supported, err := multipartUpload.IsSupported(rtDetails)
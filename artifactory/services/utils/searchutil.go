@@ -321,6 +321,16 @@ func streamToFile(reader io.Reader) (filePath string, err error) {
 	return fd.Name(), errorutils.CheckError(err)
 }
 
+// Streams a JSON body to a temporary file, and returns a reader of the array under the given key.
+// The returned reader must be closed to remove the temporary file.
+func StreamToContentReader(body io.Reader, arrayKey string) (*content.ContentReader, error) {
+	filePath, err := streamToFile(body)
+	if err != nil {
+		return nil, err
+	}
+	return content.NewContentReader(filePath, arrayKey), nil
+}
+
 func LogSearchResults(numOfArtifacts int) {
 	var msgSuffix = "artifacts."
 	if numOfArtifacts == 1 {
@@ -4,6 +4,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
 
 const (
@@ -82,6 +87,74 @@ type MetadataTimestamps struct {
 	Properties string `json:"properties,omitempty"`
 }
 
+// Download statistics of a single item, as returned by the storage stats API.
+// Timestamps are in milliseconds since the epoch, where 0 means the item was never downloaded.
+type FileStats struct {
+	Uri                    string `json:"uri,omitempty"`
+	DownloadCount          int64  `json:"downloadCount,omitempty"`
+	LastDownloaded         int64  `json:"lastDownloaded,omitempty"`
+	LastDownloadedBy       string `json:"lastDownloadedBy,omitempty"`
+	RemoteDownloadCount    int64  `json:"remoteDownloadCount,omitempty"`
+	RemoteLastDownloaded   int64  `json:"remoteLastDownloaded,omitempty"`
+	RemoteLastDownloadedBy string `json:"remoteLastDownloadedBy,omitempty"`
+}
+
+// Returns the time of the last download, or the zero time if the item was never downloaded.
+func (fs *FileStats) GetLastDownloaded() time.Time {
+	return millisToTime(fs.LastDownloaded)
+}
+
+// Returns the time of the last download through a remote (smart) repository, or the zero time if there was none.
+func (fs *FileStats) GetRemoteLastDownloaded() time.Time {
+	return millisToTime(fs.RemoteLastDownloaded)
+}
+
+func millisToTime(millis int64) time.Time {
+	if millis <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(millis)
+}
+
+// Download statistics of an item found by a search.
+type ItemStats struct {
+	Item  ResultItem
+	Stats FileStats
+}
+
+// Converts the AQL 'stat' domain fields of a search result item to FileStats.
+func NewItemStats(item ResultItem) (*ItemStats, error) {
+	itemStats := &ItemStats{Item: item, Stats: FileStats{Uri: item.GetItemRelativePath()}}
+	if len(item.Stats) == 0 {
+		return itemStats, nil
+	}
+	stat := item.Stats[0]
+	var err error
+	if itemStats.Stats.DownloadCount, err = parseStatCount(stat.Downloads); err != nil {
+		return nil, err
+	}
+	if itemStats.Stats.RemoteDownloadCount, err = parseStatCount(stat.RemoteDownloads); err != nil {
+		return nil, err
+	}
+	if stat.Downloaded != "" {
+		downloaded, err := time.Parse(time.RFC3339, stat.Downloaded)
+		if err != nil {
+			return nil, errorutils.CheckErrorf("couldn't parse the last downloaded time '%s' of '%s': %s", stat.Downloaded, itemStats.Stats.Uri, err.Error())
+		}
+		itemStats.Stats.LastDownloaded = downloaded.UnixMilli()
+	}
+	itemStats.Stats.LastDownloadedBy = stat.DownloadedBy
+	return itemStats, nil
+}
+
+func parseStatCount(count json.Number) (int64, error) {
+	if count == "" {
+		return 0, nil
+	}
+	result, err := count.Int64()
+	return result, errorutils.CheckError(err)
+}
+
 type StorageInfo struct {
 	BinariesSummary         `json:"binariesSummary,omitempty"`
 	RepositoriesSummaryList []RepositorySummary `json:"repositoriesSummaryList,omitempty"`
@@ -128,6 +201,201 @@ type FileStoreSummary struct {
 	FreeSpace        string `json:"freeSpace,omitempty"`
 }
 
+// A typed view of StorageInfo, in which the formatted values returned by Artifactory, such as "1.2 GB" or "12.5%", are parsed.
+type StorageSummary struct {
+	BinariesCount        int64
+	BinariesSizeInBytes  int64
+	ArtifactsCount       int64
+	ArtifactsSizeInBytes int64
+	ItemsCount           int64
+	// The percentage of the artifacts size saved by deduplication.
+	Optimization float64
+	FileStore    FileStoreUsage
+	Repositories []RepositoryUsage
+}
+
+type FileStoreUsage struct {
+	StorageType       string
+	StorageDirectory  string
+	TotalSpaceInBytes int64
+	UsedSpaceInBytes  int64
+	FreeSpaceInBytes  int64
+}
+
+type RepositoryUsage struct {
+	RepoKey          string
+	RepoType         string
+	PackageType      string
+	ProjectKey       string
+	FoldersCount     int64
+	FilesCount       int64
+	ItemsCount       int64
+	UsedSpaceInBytes int64
+	// The percentage of the total artifacts size used by the repository.
+	Percentage float64
+}
+
+func (si *StorageInfo) ToSummary() (*StorageSummary, error) {
+	summary := &StorageSummary{}
+	var err error
+	if summary.BinariesCount, err = parseStorageCount(si.BinariesCount); err != nil {
+		return nil, err
+	}
+	if summary.BinariesSizeInBytes, err = ParseStorageSize(si.BinariesSize); err != nil {
+		return nil, err
+	}
+	if summary.ArtifactsCount, err = parseStorageCount(si.ArtifactsCount); err != nil {
+		return nil, err
+	}
+	if summary.ArtifactsSizeInBytes, err = ParseStorageSize(si.ArtifactsSize); err != nil {
+		return nil, err
+	}
+	if summary.ItemsCount, err = parseStorageCount(si.BinariesSummary.ItemsCount); err != nil {
+		return nil, err
+	}
+	if summary.Optimization, err = parseStoragePercentage(si.Optimization); err != nil {
+		return nil, err
+	}
+	summary.FileStore = FileStoreUsage{StorageType: si.StorageType, StorageDirectory: si.StorageDirectory}
+	if summary.FileStore.TotalSpaceInBytes, err = ParseStorageSize(si.TotalSpace); err != nil {
+		return nil, err
+	}
+	if summary.FileStore.UsedSpaceInBytes, err = ParseStorageSize(si.FileStoreSummary.UsedSpace); err != nil {
+		return nil, err
+	}
+	if summary.FileStore.FreeSpaceInBytes, err = ParseStorageSize(si.FreeSpace); err != nil {
+		return nil, err
+	}
+	for _, repo := range si.RepositoriesSummaryList {
+		usage, err := repo.toUsage()
+		if err != nil {
+			return nil, err
+		}
+		summary.Repositories = append(summary.Repositories, *usage)
+	}
+	return summary, nil
+}
+
+// Returns nil if the repository doesn't exist.
+func (ss *StorageSummary) FindRepository(repoKey string) *RepositoryUsage {
+	for i := range ss.Repositories {
+		if ss.Repositories[i].RepoKey == repoKey {
+			return &ss.Repositories[i]
+		}
+	}
+	return nil
+}
+
+// The storage used by the repositories assigned to a project.
+type ProjectStorageUsage struct {
+	ProjectKey       string
+	UsedSpaceInBytes int64
+	Repositories     []RepositoryUsage
+}
+
+func (ss *StorageSummary) ProjectUsage(projectKey string) *ProjectStorageUsage {
+	usage := &ProjectStorageUsage{ProjectKey: projectKey}
+	for _, repo := range ss.Repositories {
+		if repo.ProjectKey != projectKey {
+			continue
+		}
+		usage.Repositories = append(usage.Repositories, repo)
+		usage.UsedSpaceInBytes += repo.UsedSpaceInBytes
+	}
+	return usage
+}
+
+// Returns the percentage of the file store's total space which is used, or 0 if the total space is unknown.
+func (fsu *FileStoreUsage) UsedPercentage() float64 {
+	if fsu.TotalSpaceInBytes <= 0 {
+		return 0
+	}
+	return float64(fsu.UsedSpaceInBytes) * 100 / float64(fsu.TotalSpaceInBytes)
+}
+
+func (rs *RepositorySummary) toUsage() (*RepositoryUsage, error) {
+	usage := &RepositoryUsage{RepoKey: rs.RepoKey, RepoType: rs.RepoType, PackageType: rs.PackageType, ProjectKey: rs.ProjectKey}
+	var err error
+	if usage.FoldersCount, err = parseStorageCount(rs.FoldersCount.String()); err != nil {
+		return nil, err
+	}
+	if usage.FilesCount, err = parseStorageCount(rs.FilesCount.String()); err != nil {
+		return nil, err
+	}
+	if usage.ItemsCount, err = parseStorageCount(rs.ItemsCount.String()); err != nil {
+		return nil, err
+	}
+	if rs.UsedSpaceInBytes != "" {
+		usage.UsedSpaceInBytes, err = parseStorageCount(rs.UsedSpaceInBytes.String())
+	} else {
+		usage.UsedSpaceInBytes, err = ParseStorageSize(rs.UsedSpace)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if usage.Percentage, err = parseStoragePercentage(rs.Percentage); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// Parses a size formatted by Artifactory, such as "1.57 GB" or "210.03 GB (10.46%)", to bytes.
+func ParseStorageSize(size string) (int64, error) {
+	// Remove the percentage which follows some of the sizes.
+	size, _, _ = strings.Cut(strings.TrimSpace(size), "(")
+	fields := strings.Fields(size)
+	if len(fields) == 0 {
+		return 0, nil
+	}
+	value, err := strconv.ParseFloat(strings.ReplaceAll(fields[0], ",", ""), 64)
+	if err != nil {
+		return 0, errorutils.CheckErrorf("couldn't parse the storage size '%s': %s", size, err.Error())
+	}
+	multiplier := int64(1)
+	if len(fields) > 1 {
+		switch strings.ToUpper(fields[1]) {
+		case "B", "BYTE", "BYTES":
+		case "KB":
+			multiplier = SizeKib
+		case "MB":
+			multiplier = SizeMiB
+		case "GB":
+			multiplier = SizeGiB
+		case "TB":
+			multiplier = SizeTiB
+		default:
+			return 0, errorutils.CheckErrorf("couldn't parse the storage size '%s': unknown unit '%s'", size, fields[1])
+		}
+	}
+	return int64(value * float64(multiplier)), nil
+}
+
+// Parses a count formatted by Artifactory, such as "125,726".
+func parseStorageCount(count string) (int64, error) {
+	count = strings.ReplaceAll(strings.TrimSpace(count), ",", "")
+	if count == "" {
+		return 0, nil
+	}
+	result, err := strconv.ParseInt(count, 10, 64)
+	if err != nil {
+		return 0, errorutils.CheckErrorf("couldn't parse the count '%s': %s", count, err.Error())
+	}
+	return result, nil
+}
+
+// Parses a percentage formatted by Artifactory, such as "12.5%". Returns 0 if the percentage isn't available ("N/A").
+func parseStoragePercentage(percentage string) (float64, error) {
+	percentage = strings.TrimSuffix(strings.TrimSpace(percentage), "%")
+	if percentage == "" || strings.EqualFold(percentage, "N/A") {
+		return 0, nil
+	}
+	result, err := strconv.ParseFloat(percentage, 64)
+	if err != nil {
+		return 0, errorutils.CheckErrorf("couldn't parse the percentage '%s': %s", percentage, err.Error())
+	}
+	return result, nil
+}
+
 func ConvertIntToStorageSizeString(num int64) string {
 	if num > SizeTiB {
 		newNum := float64(num) / float64(SizeTiB)
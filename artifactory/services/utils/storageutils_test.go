@@ -1,8 +1,10 @@
 package utils
 
 import (
-	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestFindRepositoryThatExists(t *testing.T) {
@@ -52,3 +54,85 @@ func TestConvertIntToStorageSizeString(t *testing.T) {
 		assert.Equal(t, test.output, ConvertIntToStorageSizeString(int64(test.num)))
 	}
 }
+
+func TestNewItemStats(t *testing.T) {
+	item := ResultItem{Repo: "repo", Path: "a", Name: "b.zip", Stats: []Stat{{Downloaded: "2024-01-02T03:04:05.000Z", Downloads: "7", DownloadedBy: "admin", RemoteDownloads: "2"}}}
+	itemStats, err := NewItemStats(item)
+	assert.NoError(t, err)
+	assert.Equal(t, "repo/a/b.zip", itemStats.Stats.Uri)
+	assert.Equal(t, int64(7), itemStats.Stats.DownloadCount)
+	assert.Equal(t, int64(2), itemStats.Stats.RemoteDownloadCount)
+	assert.Equal(t, "admin", itemStats.Stats.LastDownloadedBy)
+	assert.True(t, itemStats.Stats.GetLastDownloaded().Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))
+
+	neverDownloaded, err := NewItemStats(ResultItem{Repo: "repo", Path: ".", Name: "c.zip"})
+	assert.NoError(t, err)
+	assert.Zero(t, neverDownloaded.Stats.DownloadCount)
+	assert.True(t, neverDownloaded.Stats.GetLastDownloaded().IsZero())
+}
+
+func TestParseStorageSize(t *testing.T) {
+	tests := []struct {
+		size     string
+		expected int64
+	}{
+		{"", 0},
+		{"512 bytes", 512},
+		{"1.5 KB", 1536},
+		{"2 MB", 2 * SizeMiB},
+		{"1,024 GB", 1024 * SizeGiB},
+		{"210.5 GB (10.46%)", int64(210.5 * float64(SizeGiB))},
+		{"1 TB", SizeTiB},
+	}
+	for _, test := range tests {
+		t.Run(test.size, func(t *testing.T) {
+			size, err := ParseStorageSize(test.size)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, size)
+		})
+	}
+	_, err := ParseStorageSize("1 PB")
+	assert.ErrorContains(t, err, "unknown unit")
+}
+
+func TestStorageInfoToSummary(t *testing.T) {
+	storageInfo := StorageInfo{
+		BinariesSummary: BinariesSummary{BinariesCount: "125,726", BinariesSize: "3.48 GB", ArtifactsSize: "59.77 GB", Optimization: "5.82%", ItemsCount: "2,176,580", ArtifactsCount: "2,054,653"},
+		RepositoriesSummaryList: []RepositorySummary{
+			{RepoKey: "libs-release", RepoType: "LOCAL", FilesCount: "10", FoldersCount: "2", ItemsCount: "12", UsedSpace: "1 MB", UsedSpaceInBytes: "1048576", Percentage: "0.5%"},
+			{RepoKey: "TOTAL", RepoType: "NA", UsedSpace: "2 MB", Percentage: "N/A"},
+		},
+		FileStoreSummary: FileStoreSummary{StorageType: "filesystem", TotalSpace: "1 TB", UsedSpace: "512 GB (50%)", FreeSpace: "512 GB (50%)"},
+	}
+	summary, err := storageInfo.ToSummary()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(125726), summary.BinariesCount)
+	assert.Equal(t, int64(2054653), summary.ArtifactsCount)
+	assert.Equal(t, int64(2176580), summary.ItemsCount)
+	assert.Equal(t, 5.82, summary.Optimization)
+	assert.Equal(t, FileStoreUsage{StorageType: "filesystem", TotalSpaceInBytes: SizeTiB, UsedSpaceInBytes: 512 * SizeGiB, FreeSpaceInBytes: 512 * SizeGiB}, summary.FileStore)
+	assert.Equal(t, []RepositoryUsage{
+		{RepoKey: "libs-release", RepoType: "LOCAL", FoldersCount: 2, FilesCount: 10, ItemsCount: 12, UsedSpaceInBytes: SizeMiB, Percentage: 0.5},
+		{RepoKey: "TOTAL", RepoType: "NA", UsedSpaceInBytes: 2 * SizeMiB},
+	}, summary.Repositories)
+}
+
+func TestStorageSummaryProjectUsage(t *testing.T) {
+	summary := StorageSummary{
+		FileStore: FileStoreUsage{TotalSpaceInBytes: 4 * SizeGiB, UsedSpaceInBytes: SizeGiB},
+		Repositories: []RepositoryUsage{
+			{RepoKey: "proj-npm", ProjectKey: "proj", UsedSpaceInBytes: SizeMiB},
+			{RepoKey: "proj-maven", ProjectKey: "proj", UsedSpaceInBytes: 2 * SizeMiB},
+			{RepoKey: "other", ProjectKey: "other", UsedSpaceInBytes: SizeGiB},
+		},
+	}
+	usage := summary.ProjectUsage("proj")
+	assert.Equal(t, 3*SizeMiB, usage.UsedSpaceInBytes)
+	assert.Len(t, usage.Repositories, 2)
+	assert.Empty(t, summary.ProjectUsage("missing").Repositories)
+
+	assert.Equal(t, "other", summary.FindRepository("other").RepoKey)
+	assert.Nil(t, summary.FindRepository("missing"))
+	assert.Equal(t, 25.0, summary.FileStore.UsedPercentage())
+	assert.Zero(t, (&FileStoreUsage{}).UsedPercentage())
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path/filepath"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/httpclient"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const vcsApi = "api/vcs/"
+
+// Lists and downloads the tags and branches of the Git repositories proxied by VCS remote repositories.
+type VcsService struct {
+	client     *jfroghttpclient.JfrogHttpClient
+	artDetails *auth.ServiceDetails
+}
+
+func NewVcsService(artDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *VcsService {
+	return &VcsService{artDetails: &artDetails, client: client}
+}
+
+func (vs *VcsService) GetArtifactoryDetails() auth.ServiceDetails {
+	return *vs.artDetails
+}
+
+func (vs *VcsService) GetJfrogHttpClient() *jfroghttpclient.JfrogHttpClient {
+	return vs.client
+}
+
+// A tag or a branch of a Git repository.
+type VcsRef struct {
+	Name     string `json:"name,omitempty"`
+	CommitId string `json:"commitId,omitempty"`
+	IsBranch bool   `json:"isBranch,omitempty"`
+}
+
+type VcsDownloadParams struct {
+	RepoKey string
+	// The user or organization that owns the Git repository, e.g. "jfrog".
+	Owner string
+	// The name of the Git repository, e.g. "jfrog-client-go".
+	Repo string
+	// The name of the tag or branch.
+	Ref string
+	// The local path of the downloaded tarball.
+	LocalPath string
+}
+
+func NewVcsDownloadParams(repoKey, owner, repo, ref, localPath string) VcsDownloadParams {
+	return VcsDownloadParams{RepoKey: repoKey, Owner: owner, Repo: repo, Ref: ref, LocalPath: localPath}
+}
+
+// Returns the tags of a Git repository.
+func (vs *VcsService) ListTags(repoKey, owner, repo string) ([]VcsRef, error) {
+	return vs.listRefs("tags", repoKey, owner, repo)
+}
+
+// Returns the branches of a Git repository.
+func (vs *VcsService) ListBranches(repoKey, owner, repo string) ([]VcsRef, error) {
+	return vs.listRefs("branches", repoKey, owner, repo)
+}
+
+// Downloads the source tarball of a tag.
+func (vs *VcsService) DownloadTag(params VcsDownloadParams) error {
+	return vs.download("downloadTag", params)
+}
+
+// Downloads the source tarball of a branch.
+func (vs *VcsService) DownloadBranch(params VcsDownloadParams) error {
+	return vs.download("downloadBranch", params)
+}
+
+func (vs *VcsService) listRefs(refsType, repoKey, owner, repo string) ([]VcsRef, error) {
+	requestUrl, err := vs.buildUrl(refsType, repoKey, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := vs.GetArtifactoryDetails().CreateHttpClientDetails()
+	resp, body, _, err := vs.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	var refs []VcsRef
+	if err = json.Unmarshal(body, &refs); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return refs, nil
+}
+
+func (vs *VcsService) download(downloadApi string, params VcsDownloadParams) error {
+	if params.Ref == "" || params.LocalPath == "" {
+		return errorutils.CheckErrorf("a tag or branch and a local path are required")
+	}
+	requestUrl, err := vs.buildUrl(downloadApi, params.RepoKey, params.Owner, params.Repo)
+	if err != nil {
+		return err
+	}
+	httpClientsDetails := vs.GetArtifactoryDetails().CreateHttpClientDetails()
+	downloadFileDetails := &httpclient.DownloadFileDetails{
+		// Branch names may contain slashes, which must not be taken as path separators.
+		DownloadPath:  requestUrl + "/" + url.PathEscape(params.Ref),
+		LocalPath:     filepath.Dir(params.LocalPath),
+		LocalFileName: filepath.Base(params.LocalPath),
+		SkipChecksum:  true,
+	}
+	log.Info("Downloading " + params.Owner + "/" + params.Repo + " " + params.Ref + " to '" + params.LocalPath + "'...")
+	resp, err := vs.client.DownloadFile(downloadFileDetails, "", &httpClientsDetails, false, false)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatus(resp, http.StatusOK); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+func (vs *VcsService) buildUrl(vcsApiName, repoKey, owner, repo string) (string, error) {
+	if repoKey == "" || owner == "" || repo == "" {
+		return "", errorutils.CheckErrorf("a repository key, a Git repository owner and a Git repository name are required")
+	}
+	return clientutils.BuildUrl(vs.GetArtifactoryDetails().GetUrl(), vcsApi+vcsApiName+"/"+repoKey+"/"+owner+"/"+repo, nil)
+}
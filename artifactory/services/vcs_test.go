@@ -0,0 +1,40 @@
+package services
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVcsService(t *testing.T) {
+	serviceDetails, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/api/vcs/tags/github/jfrog/jfrog-client-go":
+			_, _ = w.Write([]byte(`[{"name":"v1.0.0","commitId":"abc","isBranch":false}]`))
+		case "/api/vcs/branches/github/jfrog/jfrog-client-go":
+			_, _ = w.Write([]byte(`[{"name":"feature/x","commitId":"def","isBranch":true}]`))
+		case "/api/vcs/downloadBranch/github/jfrog/jfrog-client-go/feature%2Fx":
+			_, _ = w.Write([]byte("tarball"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	vcsService := NewVcsService(serviceDetails, client)
+
+	tags, err := vcsService.ListTags("github", "jfrog", "jfrog-client-go")
+	assert.NoError(t, err)
+	assert.Equal(t, []VcsRef{{Name: "v1.0.0", CommitId: "abc"}}, tags)
+	branches, err := vcsService.ListBranches("github", "jfrog", "jfrog-client-go")
+	assert.NoError(t, err)
+	assert.Equal(t, []VcsRef{{Name: "feature/x", CommitId: "def", IsBranch: true}}, branches)
+
+	localPath := filepath.Join(t.TempDir(), "feature-x.tar.gz")
+	assert.NoError(t, vcsService.DownloadBranch(NewVcsDownloadParams("github", "jfrog", "jfrog-client-go", "feature/x", localPath)))
+	content, err := os.ReadFile(localPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "tarball", string(content))
+	assert.Error(t, vcsService.DownloadTag(NewVcsDownloadParams("github", "jfrog", "jfrog-client-go", "v9", localPath)))
+}
@@ -87,6 +87,10 @@ func (vrs *VirtualRepositoryService) Helm(params HelmVirtualRepositoryParams) er
 	return vrs.performRequest(params, params.Key)
 }
 
+func (vrs *VirtualRepositoryService) HuggingFaceMl(params HuggingFaceMlVirtualRepositoryParams) error {
+	return vrs.performRequest(params, params.Key)
+}
+
 func (vrs *VirtualRepositoryService) Ivy(params IvyVirtualRepositoryParams) error {
 	return vrs.performRequest(params, params.Key)
 }
@@ -297,6 +301,14 @@ func NewHelmVirtualRepositoryParams() HelmVirtualRepositoryParams {
 	return HelmVirtualRepositoryParams{VirtualRepositoryBaseParams: NewVirtualRepositoryPackageParams("helm")}
 }
 
+type HuggingFaceMlVirtualRepositoryParams struct {
+	VirtualRepositoryBaseParams
+}
+
+func NewHuggingFaceMlVirtualRepositoryParams() HuggingFaceMlVirtualRepositoryParams {
+	return HuggingFaceMlVirtualRepositoryParams{VirtualRepositoryBaseParams: NewVirtualRepositoryPackageParams("huggingfaceml")}
+}
+
 type IvyVirtualRepositoryParams struct {
 	VirtualRepositoryBaseParams
 	CommonJavaVirtualRepositoryParams
@@ -37,6 +37,9 @@ func NewXrayScanService(client *jfroghttpclient.JfrogHttpClient) *XrayScanServic
 
 // Deprecated legacy scan build. The new build scan command is in "/xray/commands/scan/buildscan"
 func (ps *XrayScanService) ScanBuild(scanParams XrayScanParams) ([]byte, error) {
+	if err := utils.ValidateBuildProjectKey(scanParams.GetProjectKey(), ps.ArtDetails); err != nil {
+		return []byte{}, err
+	}
 	url := ps.ArtDetails.GetUrl()
 	requestFullUrl, err := clientutils.BuildUrl(url, apiUri, make(map[string]string))
 	if err != nil {
@@ -39,6 +39,12 @@ func (sm *DistributionServicesManager) SetSigningKey(params services.SetSigningK
 	return setSigningKeyService.SetSigningKey(params)
 }
 
+func (sm *DistributionServicesManager) PropagateSigningKey(params services.SetSigningKeyParams) (*services.PropagateSigningKeyResponse, error) {
+	setSigningKeyService := services.NewSetSigningKeyService(sm.client)
+	setSigningKeyService.DistDetails = sm.config.GetServiceDetails()
+	return setSigningKeyService.PropagateSigningKey(params)
+}
+
 func (sm *DistributionServicesManager) CreateReleaseBundle(params services.CreateReleaseBundleParams) (*clientutils.Sha256Summary, error) {
 	createBundleService := services.NewCreateReleaseBundleService(sm.client)
 	createBundleService.DistDetails = sm.config.GetServiceDetails()
@@ -53,6 +59,19 @@ func (sm *DistributionServicesManager) UpdateReleaseBundle(params services.Updat
 	return createBundleService.UpdateReleaseBundle(params)
 }
 
+func (sm *DistributionServicesManager) GetReleaseBundle(name, version string) (*services.ReleaseBundleInfo, error) {
+	getBundleService := services.NewGetReleaseBundleService(sm.client)
+	getBundleService.DistDetails = sm.config.GetServiceDetails()
+	return getBundleService.GetReleaseBundle(name, version)
+}
+
+func (sm *DistributionServicesManager) UpdateReleaseNotes(params services.UpdateReleaseNotesParams) error {
+	updateBundleService := services.NewUpdateReleaseBundleService(sm.client)
+	updateBundleService.DistDetails = sm.config.GetServiceDetails()
+	updateBundleService.DryRun = sm.config.IsDryRun()
+	return updateBundleService.UpdateReleaseNotes(params)
+}
+
 func (sm *DistributionServicesManager) SignReleaseBundle(params services.SignBundleParams) (*clientutils.Sha256Summary, error) {
 	signBundleService := services.NewSignBundleService(sm.client)
 	signBundleService.DistDetails = sm.config.GetServiceDetails()
@@ -79,12 +98,39 @@ func (sm *DistributionServicesManager) DistributeReleaseBundleSync(params distri
 	return distributeBundleService.Distribute()
 }
 
+func (sm *DistributionServicesManager) DistributeReleaseBundleWithTracker(params distribution.DistributionParams, autoCreateRepo bool) (*distribution.DistributionTracker, error) {
+	distributeBundleService := services.NewDistributeReleaseBundleV1Service(sm.client)
+	distributeBundleService.DistDetails = sm.config.GetServiceDetails()
+	distributeBundleService.DryRun = sm.config.IsDryRun()
+	distributeBundleService.AutoCreateRepo = autoCreateRepo
+	distributeBundleService.DistributeParams = params
+	return distributeBundleService.DistributeWithTracker()
+}
+
+func (sm *DistributionServicesManager) NewDistributionTracker(name, version, trackerId string) *distribution.DistributionTracker {
+	distributeBundleService := services.NewDistributionStatusService(sm.client)
+	distributeBundleService.DistDetails = sm.config.GetServiceDetails()
+	return distributeBundleService.NewTracker(name, version, trackerId)
+}
+
 func (sm *DistributionServicesManager) GetDistributionStatus(params services.DistributionStatusParams) (*[]distribution.DistributionStatusResponse, error) {
 	distributeBundleService := services.NewDistributionStatusService(sm.client)
 	distributeBundleService.DistDetails = sm.config.GetServiceDetails()
 	return distributeBundleService.GetStatus(params)
 }
 
+func (sm *DistributionServicesManager) GetDistributionTargets() ([]services.DistributionTarget, error) {
+	targetsService := services.NewDistributionTargetsService(sm.client)
+	targetsService.DistDetails = sm.config.GetServiceDetails()
+	return targetsService.GetTargets()
+}
+
+func (sm *DistributionServicesManager) ResolveDistributionRules(rules []*distribution.DistributionCommonParams) ([]services.DistributionTarget, error) {
+	targetsService := services.NewDistributionTargetsService(sm.client)
+	targetsService.DistDetails = sm.config.GetServiceDetails()
+	return targetsService.ResolveDistributionRules(rules)
+}
+
 func (sm *DistributionServicesManager) DeleteReleaseBundle(params services.DeleteDistributionParams) error {
 	deleteBundleService := services.NewDeleteReleaseBundleService(sm.client)
 	deleteBundleService.DistDetails = sm.config.GetServiceDetails()
@@ -92,6 +138,13 @@ func (sm *DistributionServicesManager) DeleteReleaseBundle(params services.Delet
 	return deleteBundleService.DeleteDistribution(params)
 }
 
+func (sm *DistributionServicesManager) DeleteReleaseBundleWithResult(params services.DeleteDistributionParams) (*services.DeleteDistributionResult, error) {
+	deleteBundleService := services.NewDeleteReleaseBundleService(sm.client)
+	deleteBundleService.DistDetails = sm.config.GetServiceDetails()
+	deleteBundleService.DryRun = sm.config.IsDryRun()
+	return deleteBundleService.DeleteDistributionWithResult(params)
+}
+
 func (sm *DistributionServicesManager) DeleteLocalReleaseBundle(params services.DeleteDistributionParams) error {
 	deleteLocalBundleService := services.NewDeleteLocalDistributionService(sm.client)
 	deleteLocalBundleService.DistDetails = sm.config.GetServiceDetails()
@@ -44,6 +44,13 @@ func (dr *DeleteReleaseBundleService) IsDryRun() bool {
 }
 
 func (dr *DeleteReleaseBundleService) DeleteDistribution(deleteDistributionParams DeleteDistributionParams) error {
+	deleteDistribution := dr.createDeleteDistributionBody(deleteDistributionParams)
+	dr.Sync = deleteDistributionParams.Sync
+	dr.MaxWaitMinutes = deleteDistributionParams.MaxWaitMinutes
+	return dr.execDeleteDistribute(deleteDistributionParams.Name, deleteDistributionParams.Version, deleteDistribution)
+}
+
+func (dr *DeleteReleaseBundleService) createDeleteDistributionBody(deleteDistributionParams DeleteDistributionParams) DeleteRemoteDistributionBody {
 	var distributionRules []distribution.DistributionRulesBody
 	for _, rule := range deleteDistributionParams.DistributionRules {
 		distributionRule := distribution.DistributionRulesBody{
@@ -61,19 +68,32 @@ func (dr *DeleteReleaseBundleService) DeleteDistribution(deleteDistributionParam
 		onSuccess = Keep
 	}
 
-	deleteDistribution := DeleteRemoteDistributionBody{
+	return DeleteRemoteDistributionBody{
 		ReleaseBundleDistributeV1Body: distribution.ReleaseBundleDistributeV1Body{
 			DryRun:            dr.DryRun,
 			DistributionRules: distributionRules,
 		},
 		OnSuccess: onSuccess,
 	}
-	dr.Sync = deleteDistributionParams.Sync
-	dr.MaxWaitMinutes = deleteDistributionParams.MaxWaitMinutes
-	return dr.execDeleteDistribute(deleteDistributionParams.Name, deleteDistributionParams.Version, deleteDistribution)
 }
 
 func (dr *DeleteReleaseBundleService) execDeleteDistribute(name, version string, deleteDistribution DeleteRemoteDistributionBody) error {
+	resp, body, err := dr.sendDeleteDistribution(name, version, deleteDistribution)
+	if err != nil {
+		return err
+	}
+	if dr.Sync {
+		err := dr.waitForDeletion(name, version)
+		if err != nil {
+			return err
+		}
+	}
+	log.Debug("Distribution response:", resp.Status)
+	log.Debug(utils.IndentJson(body))
+	return errorutils.CheckError(err)
+}
+
+func (dr *DeleteReleaseBundleService) sendDeleteDistribution(name, version string, deleteDistribution DeleteRemoteDistributionBody) (*http.Response, []byte, error) {
 	dryRunStr := ""
 	if dr.IsDryRun() {
 		dryRunStr = "[Dry run] "
@@ -83,26 +103,93 @@ func (dr *DeleteReleaseBundleService) execDeleteDistribute(name, version string,
 	httpClientsDetails := dr.DistDetails.CreateHttpClientDetails()
 	content, err := json.Marshal(deleteDistribution)
 	if err != nil {
-		return errorutils.CheckError(err)
+		return nil, nil, errorutils.CheckError(err)
 	}
 	url := dr.DistDetails.GetUrl() + "api/v1/distribution/" + name + "/" + version + "/delete"
 	httpClientsDetails.SetContentTypeApplicationJson()
 	resp, body, err := dr.client.SendPost(url, content, &httpClientsDetails)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusAccepted); err != nil {
-		return err
+		return nil, nil, err
 	}
-	if dr.Sync {
-		err := dr.waitForDeletion(name, version)
+	return resp, body, nil
+}
+
+// Deletes a release bundle from the edge nodes, like DeleteDistribution, and returns the edge nodes it's deleted from.
+// On a dry run, nothing is deleted, and the result previews the edge nodes and the artifacts which would be deleted.
+// On a sync deletion, the result includes the final status of the deletion on each of the edge nodes, and an error is
+// returned if the deletion failed on any of them.
+func (dr *DeleteReleaseBundleService) DeleteDistributionWithResult(deleteDistributionParams DeleteDistributionParams) (*DeleteDistributionResult, error) {
+	name, version := deleteDistributionParams.Name, deleteDistributionParams.Version
+	_, body, err := dr.sendDeleteDistribution(name, version, dr.createDeleteDistributionBody(deleteDistributionParams))
+	if err != nil {
+		return nil, err
+	}
+	log.Debug(utils.IndentJson(body))
+	response := deleteDistributionResponse{}
+	if err = json.Unmarshal(body, &response); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	result := &DeleteDistributionResult{TrackerId: response.TrackerId, DryRun: dr.DryRun, Sites: response.Sites}
+	if dr.DryRun {
+		result.Artifacts, err = dr.getReleaseBundleArtifacts(name, version)
+		return result, err
+	}
+	if !deleteDistributionParams.Sync {
+		return result, nil
+	}
+	statusService := NewDistributionStatusService(dr.client)
+	statusService.DistDetails = dr.DistDetails
+	tracker := statusService.NewTracker(name, version, response.TrackerId.String())
+	if deleteDistributionParams.DeleteFromDistribution {
+		// The release bundle is deleted from Distribution once it's deleted from all the edge nodes, along with the
+		// status of the deletion.
+		tracker.GetStatus = dr.deletedReleaseBundleStatusGetter(name, version, response, tracker.GetStatus)
+	}
+	if deleteDistributionParams.MaxWaitMinutes > 0 {
+		tracker.Timeout = time.Duration(deleteDistributionParams.MaxWaitMinutes) * time.Minute
+	}
+	result.Progress, err = tracker.Track()
+	return result, err
+}
+
+func (dr *DeleteReleaseBundleService) deletedReleaseBundleStatusGetter(name, version string, response deleteDistributionResponse,
+	getStatus func() (*distribution.DistributionStatusResponse, error)) func() (*distribution.DistributionStatusResponse, error) {
+	return func() (*distribution.DistributionStatusResponse, error) {
+		httpClientsDetails := dr.DistDetails.CreateHttpClientDetails()
+		resp, _, _, err := dr.client.SendGet(dr.DistDetails.GetUrl()+"api/v1/release_bundle/"+name+"/"+version+"/distribution", true, &httpClientsDetails)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			return getStatus()
 		}
+		status := &distribution.DistributionStatusResponse{Id: response.TrackerId, Name: name, Version: version, Status: distribution.Completed}
+		for _, site := range response.Sites {
+			status.Sites = append(status.Sites, distribution.DistributionSiteStatus{Status: distribution.Completed, TargetArtifactory: site})
+		}
+		return status, nil
 	}
-	log.Debug("Distribution response:", resp.Status)
-	log.Debug(utils.IndentJson(body))
-	return errorutils.CheckError(err)
+}
+
+func (dr *DeleteReleaseBundleService) getReleaseBundleArtifacts(name, version string) ([]ReleaseBundleArtifact, error) {
+	httpClientsDetails := dr.DistDetails.CreateHttpClientDetails()
+	resp, body, _, err := dr.client.SendGet(dr.DistDetails.GetUrl()+"api/v1/release_bundle/"+name+"/"+version, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	releaseBundle := struct {
+		Artifacts []ReleaseBundleArtifact `json:"artifacts,omitempty"`
+	}{}
+	if err = json.Unmarshal(body, &releaseBundle); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return releaseBundle.Artifacts, nil
 }
 
 func (dr *DeleteReleaseBundleService) waitForDeletion(name, version string) error {
@@ -133,6 +220,28 @@ func (dr *DeleteReleaseBundleService) waitForDeletion(name, version string) erro
 	return errorutils.CheckErrorf("timeout for sync deletion. ")
 }
 
+type deleteDistributionResponse struct {
+	TrackerId json.Number                      `json:"id,omitempty"`
+	Sites     []distribution.TargetArtifactory `json:"sites,omitempty"`
+}
+
+type DeleteDistributionResult struct {
+	TrackerId json.Number
+	DryRun    bool
+	// The edge nodes the release bundle is deleted from.
+	Sites []distribution.TargetArtifactory
+	// On a dry run, the artifacts which would be deleted from each of the edge nodes.
+	Artifacts []ReleaseBundleArtifact
+	// On a sync deletion, the final status of the deletion on each of the edge nodes.
+	Progress *distribution.DistributionProgress
+}
+
+type ReleaseBundleArtifact struct {
+	SourceRepoPath string `json:"sourceRepoPath,omitempty"`
+	TargetRepoPath string `json:"targetRepoPath,omitempty"`
+	Checksum       string `json:"checksum,omitempty"`
+}
+
 type DeleteRemoteDistributionBody struct {
 	distribution.ReleaseBundleDistributeV1Body
 	OnSuccess OnSuccess `json:"on_success"`
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/distribution"
+	"github.com/stretchr/testify/assert"
+)
+
+const deleteDistributionResponseBody = `{"id":"123","sites":[{"service_id":"jfrt@1","name":"edge-1","type":"edge"},{"service_id":"jfrt@2","name":"edge-2","type":"edge"}]}`
+
+func createTestDeleteReleaseBundleService(t *testing.T, serverUrl string) *DeleteReleaseBundleService {
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	assert.NoError(t, err)
+	deleteBundleService := NewDeleteReleaseBundleService(client)
+	deleteBundleService.DistDetails = auth.NewArtifactoryDetails()
+	deleteBundleService.DistDetails.SetUrl(serverUrl + "/")
+	return deleteBundleService
+}
+
+func TestDeleteDistributionWithResultDryRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/distribution/rb/1.0/delete":
+			body := DeleteRemoteDistributionBody{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.True(t, body.DryRun)
+			assert.Equal(t, Keep, body.OnSuccess)
+			_, _ = w.Write([]byte(deleteDistributionResponseBody))
+		case "/api/v1/release_bundle/rb/1.0":
+			_, _ = w.Write([]byte(`{"name":"rb","version":"1.0","artifacts":[{"sourceRepoPath":"generic/a.zip","targetRepoPath":"generic/a.zip","checksum":"abc"}]}`))
+		default:
+			assert.Fail(t, "unexpected request", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	deleteBundleService := createTestDeleteReleaseBundleService(t, server.URL)
+	deleteBundleService.DryRun = true
+
+	result, err := deleteBundleService.DeleteDistributionWithResult(NewDeleteReleaseBundleParams("rb", "1.0"))
+	assert.NoError(t, err)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, json.Number("123"), result.TrackerId)
+	assert.Len(t, result.Sites, 2)
+	assert.Equal(t, []ReleaseBundleArtifact{{SourceRepoPath: "generic/a.zip", TargetRepoPath: "generic/a.zip", Checksum: "abc"}}, result.Artifacts)
+	assert.Nil(t, result.Progress)
+}
+
+func TestDeleteDistributionWithResultSync(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/distribution/rb/1.0/delete":
+			_, _ = w.Write([]byte(deleteDistributionResponseBody))
+		case "/api/v1/release_bundle/rb/1.0/distribution/123":
+			_, _ = w.Write([]byte(`[{"distribution_id":"123","release_bundle_name":"rb","release_bundle_version":"1.0","status":"Failed","sites":[` +
+				`{"status":"Completed","target_artifactory":{"name":"edge-1"}},` +
+				`{"status":"Failed","general_error":"edge-2 is unreachable","target_artifactory":{"name":"edge-2"}}]}]`))
+		default:
+			assert.Fail(t, "unexpected request", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	deleteBundleService := createTestDeleteReleaseBundleService(t, server.URL)
+	params := NewDeleteReleaseBundleParams("rb", "1.0")
+	params.Sync = true
+
+	result, err := deleteBundleService.DeleteDistributionWithResult(params)
+	assert.ErrorContains(t, err, "site edge-2: Failed, edge-2 is unreachable")
+	assert.Equal(t, distribution.Failed, result.Progress.Status)
+	assert.Len(t, result.Progress.FailedSites(), 1)
+}
+
+func TestDeleteDistributionWithResultFromDistribution(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/distribution/rb/1.0/delete":
+			body := DeleteRemoteDistributionBody{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, Delete, body.OnSuccess)
+			_, _ = w.Write([]byte(deleteDistributionResponseBody))
+		case "/api/v1/release_bundle/rb/1.0/distribution":
+			// The release bundle was already deleted from all the edge nodes and from Distribution
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			assert.Fail(t, "unexpected request", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	deleteBundleService := createTestDeleteReleaseBundleService(t, server.URL)
+	params := NewDeleteReleaseBundleParams("rb", "1.0")
+	params.Sync = true
+	params.DeleteFromDistribution = true
+
+	result, err := deleteBundleService.DeleteDistributionWithResult(params)
+	assert.NoError(t, err)
+	assert.Equal(t, distribution.Completed, result.Progress.Status)
+	assert.Len(t, result.Progress.Sites, 2)
+	assert.Equal(t, "edge-2", result.Progress.Sites[1].Name)
+}
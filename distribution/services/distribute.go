@@ -51,7 +51,13 @@ func (dr *DistributeReleaseBundleV1Service) GetRestApi(name, version string) str
 }
 
 func (dr *DistributeReleaseBundleV1Service) GetDistributeBody() any {
-	return distribution.CreateDistributeV1Body(dr.DistributeParams.DistributionRules, dr.DryRun, dr.AutoCreateRepo)
+	body := distribution.CreateDistributeV1Body(dr.DistributeParams.DistributionRules, dr.DryRun, dr.AutoCreateRepo)
+	body.Scheduling = dr.DistributeParams.Scheduling.ToBody()
+	return body
+}
+
+func (dr *DistributeReleaseBundleV1Service) GetDistributionVersion() (string, error) {
+	return dr.DistDetails.GetVersion()
 }
 
 func (dr *DistributeReleaseBundleV1Service) GetDistributionParams() distribution.DistributionParams {
@@ -76,6 +82,18 @@ func (dr *DistributeReleaseBundleV1Service) Distribute() error {
 	return dr.waitForDistribution(&dr.DistributeParams, trackerId)
 }
 
+// Starts the distribution, and returns a tracker of its progress. The Sync field is ignored.
+// Returns a nil tracker on a dry run.
+func (dr *DistributeReleaseBundleV1Service) DistributeWithTracker() (*distribution.DistributionTracker, error) {
+	trackerId, err := distribution.DoDistribute(dr)
+	if err != nil || dr.IsDryRun() {
+		return nil, err
+	}
+	statusService := NewDistributionStatusService(dr.GetHttpClient())
+	statusService.DistDetails = dr.ServiceDetails()
+	return statusService.NewTracker(dr.DistributeParams.Name, dr.DistributeParams.Version, trackerId.String()), nil
+}
+
 func (dr *DistributeReleaseBundleV1Service) waitForDistribution(distributeParams *distribution.DistributionParams, trackerId json.Number) error {
 	distributeBundleService := NewDistributionStatusService(dr.GetHttpClient())
 	distributeBundleService.DistDetails = dr.ServiceDetails()
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	distributionServiceUtils "github.com/jfrog/jfrog-client-go/distribution/services/utils"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type ReleaseBundleState string
+
+const (
+	ReleaseBundleOpen                 ReleaseBundleState = "OPEN"
+	ReleaseBundleSigned               ReleaseBundleState = "SIGNED"
+	ReleaseBundleStored               ReleaseBundleState = "STORED"
+	ReleaseBundleReadyForDistribution ReleaseBundleState = "READY_FOR_DISTRIBUTION"
+)
+
+type GetReleaseBundleService struct {
+	client      *jfroghttpclient.JfrogHttpClient
+	DistDetails auth.ServiceDetails
+}
+
+func NewGetReleaseBundleService(client *jfroghttpclient.JfrogHttpClient) *GetReleaseBundleService {
+	return &GetReleaseBundleService{client: client}
+}
+
+func (gb *GetReleaseBundleService) GetDistDetails() auth.ServiceDetails {
+	return gb.DistDetails
+}
+
+// Returns the release bundle, including its description, release notes and artifacts.
+func (gb *GetReleaseBundleService) GetReleaseBundle(name, version string) (*ReleaseBundleInfo, error) {
+	httpClientsDetails := gb.DistDetails.CreateHttpClientDetails()
+	resp, body, _, err := gb.client.SendGet(gb.DistDetails.GetUrl()+"api/v1/release_bundle/"+name+"/"+version, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Distribution response:", resp.Status)
+	releaseBundle := &ReleaseBundleInfo{}
+	err = json.Unmarshal(body, releaseBundle)
+	return releaseBundle, errorutils.CheckError(err)
+}
+
+type ReleaseBundleInfo struct {
+	Name              string                                 `json:"name,omitempty"`
+	Version           string                                 `json:"version,omitempty"`
+	State             ReleaseBundleState                     `json:"state,omitempty"`
+	StoringRepository string                                 `json:"storing_repository,omitempty"`
+	Description       string                                 `json:"description,omitempty"`
+	ReleaseNotes      *distributionServiceUtils.ReleaseNotes `json:"release_notes,omitempty"`
+	Created           string                                 `json:"created,omitempty"`
+	CreatedBy         string                                 `json:"created_by,omitempty"`
+	Spec              distributionServiceUtils.BundleSpec    `json:"spec,omitempty"`
+	Artifacts         []ReleaseBundleArtifact                `json:"artifacts,omitempty"`
+}
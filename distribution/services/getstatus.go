@@ -34,6 +34,20 @@ func (ds *DistributionStatusService) GetStatus(distributionStatusParams Distribu
 	return ds.execGetStatus(distributionStatusParams.Name, distributionStatusParams.Version, distributionStatusParams.TrackerId)
 }
 
+// Returns a tracker of the progress of a distribution on each of its target sites.
+func (ds *DistributionStatusService) NewTracker(name, version, trackerId string) *distribution.DistributionTracker {
+	return distribution.NewDistributionTracker(func() (*distribution.DistributionStatusResponse, error) {
+		statuses, err := ds.GetStatus(DistributionStatusParams{Name: name, Version: version, TrackerId: trackerId})
+		if err != nil {
+			return nil, err
+		}
+		if len(*statuses) == 0 {
+			return nil, errorutils.CheckErrorf("distribution %s of %s/%s wasn't found", trackerId, name, version)
+		}
+		return &(*statuses)[0], nil
+	})
+}
+
 func (ds *DistributionStatusService) checkParameters(distributionStatusParams DistributionStatusParams) error {
 	var err error
 	if distributionStatusParams.Name == "" && (distributionStatusParams.Version != "" || distributionStatusParams.TrackerId != "") {
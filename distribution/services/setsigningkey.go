@@ -2,6 +2,7 @@ package services
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/jfrog/jfrog-client-go/auth"
@@ -53,6 +54,50 @@ func (ssk *SetSigningKeyService) execSetSigningKey(setSigningKeyBody *SetSigning
 	return errorutils.CheckError(err)
 }
 
+// Uploads the signing key to Distribution, sets it as the default signing key, and propagates its public key to all the
+// Edge nodes, so that they can verify the Release Bundles signed with it. Returns an error if any of the Edge nodes
+// failed to install the key, along with the propagation report.
+func (ssk *SetSigningKeyService) PropagateSigningKey(signBundleParams SetSigningKeyParams) (*PropagateSigningKeyResponse, error) {
+	content, err := json.Marshal(&SetSigningKeyBody{PublicKey: signBundleParams.PublicKey, PrivateKey: signBundleParams.PrivateKey})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	httpClientsDetails := ssk.DistDetails.CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	resp, body, err := ssk.client.SendPut(ssk.DistDetails.GetUrl()+"api/v1/keys/pgp/propagate", content, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Distribution response:", resp.Status)
+	log.Debug(utils.IndentJson(body))
+	report := &PropagateSigningKeyResponse{}
+	if err = json.Unmarshal(body, report); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if len(report.Failed) > 0 {
+		message := "failed to propagate the signing key to the Edge nodes:"
+		for _, target := range report.Failed {
+			message += fmt.Sprintf("\n%s (%s): %s", target.Name, target.ServiceId, target.Error)
+		}
+		return report, errorutils.CheckErrorf("%s", message)
+	}
+	return report, nil
+}
+
+type PropagateSigningKeyResponse struct {
+	Installed []SigningKeyTarget `json:"installed,omitempty"`
+	Failed    []SigningKeyTarget `json:"failed,omitempty"`
+}
+
+type SigningKeyTarget struct {
+	ServiceId string `json:"service_id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
 type SetSigningKeyBody struct {
 	PublicKey  string `json:"public_key,omitempty"`
 	PrivateKey string `json:"private_key,omitempty"`
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPropagateSigningKey(t *testing.T) {
+	response := `{"installed":[{"service_id":"jfrt@1","name":"edge-1"}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/keys/pgp/propagate", r.URL.Path)
+		body := SetSigningKeyBody{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, SetSigningKeyBody{PublicKey: "public", PrivateKey: "private"}, body)
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	assert.NoError(t, err)
+	setSigningKeyService := NewSetSigningKeyService(client)
+	setSigningKeyService.DistDetails = auth.NewArtifactoryDetails()
+	setSigningKeyService.DistDetails.SetUrl(server.URL + "/")
+
+	report, err := setSigningKeyService.PropagateSigningKey(NewSetSigningKeyParams("public", "private"))
+	assert.NoError(t, err)
+	assert.Equal(t, []SigningKeyTarget{{ServiceId: "jfrt@1", Name: "edge-1"}}, report.Installed)
+
+	response = `{"installed":[{"service_id":"jfrt@1","name":"edge-1"}],"failed":[{"service_id":"jfrt@2","name":"edge-2","error":"unreachable"}]}`
+	report, err = setSigningKeyService.PropagateSigningKey(NewSetSigningKeyParams("public", "private"))
+	assert.ErrorContains(t, err, "edge-2 (jfrt@2): unreachable")
+	assert.Len(t, report.Failed, 1)
+}
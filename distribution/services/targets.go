@@ -0,0 +1,142 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/jfrog/gofrog/stringutils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/distribution"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type TargetStatus string
+
+const (
+	TargetOnline  TargetStatus = "ONLINE"
+	TargetOffline TargetStatus = "OFFLINE"
+	TargetUnknown TargetStatus = "UNKNOWN"
+)
+
+// Lists the distribution targets, e.g. the edge nodes, which release bundles can be distributed to.
+type DistributionTargetsService struct {
+	client      *jfroghttpclient.JfrogHttpClient
+	DistDetails auth.ServiceDetails
+}
+
+func NewDistributionTargetsService(client *jfroghttpclient.JfrogHttpClient) *DistributionTargetsService {
+	return &DistributionTargetsService{client: client}
+}
+
+func (dt *DistributionTargetsService) GetDistDetails() auth.ServiceDetails {
+	return dt.DistDetails
+}
+
+func (dt *DistributionTargetsService) GetTargets() ([]DistributionTarget, error) {
+	httpClientsDetails := dt.DistDetails.CreateHttpClientDetails()
+	resp, body, _, err := dt.client.SendGet(dt.DistDetails.GetUrl()+"api/v1/distribution_targets", true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Distribution response:", resp.Status)
+	var targets []DistributionTarget
+	err = json.Unmarshal(body, &targets)
+	return targets, errorutils.CheckError(err)
+}
+
+// Resolves the distribution rules against the current distribution targets, and returns the targets a release bundle
+// would be distributed to, without distributing it.
+func (dt *DistributionTargetsService) ResolveDistributionRules(rules []*distribution.DistributionCommonParams) ([]DistributionTarget, error) {
+	targets, err := dt.GetTargets()
+	if err != nil {
+		return nil, err
+	}
+	return MatchDistributionRules(targets, rules)
+}
+
+// Returns the targets matching any of the distribution rules, like Distribution resolves them.
+// The site name and the city name of a rule may include wildcards. An empty field matches all the targets.
+func MatchDistributionRules(targets []DistributionTarget, rules []*distribution.DistributionCommonParams) ([]DistributionTarget, error) {
+	var matched []DistributionTarget
+	for _, target := range targets {
+		for _, rule := range rules {
+			isMatch, err := target.matchesRule(rule)
+			if err != nil {
+				return nil, err
+			}
+			if isMatch {
+				matched = append(matched, target)
+				break
+			}
+		}
+	}
+	return matched, nil
+}
+
+func (t *DistributionTarget) matchesRule(rule *distribution.DistributionCommonParams) (bool, error) {
+	for _, field := range [][2]string{{rule.SiteName, t.Name}, {rule.CityName, t.City.Name}} {
+		if field[0] == "" {
+			continue
+		}
+		isMatch, err := stringutils.MatchWildcardPattern(field[0], field[1])
+		if err != nil || !isMatch {
+			return false, errorutils.CheckError(err)
+		}
+	}
+	if len(rule.CountryCodes) == 0 {
+		return true, nil
+	}
+	for _, countryCode := range rule.CountryCodes {
+		if countryCode == "*" || strings.EqualFold(countryCode, t.City.CountryCode) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type DistributionTarget struct {
+	distribution.TargetArtifactory
+	Url     string       `json:"url,omitempty"`
+	Status  TargetStatus `json:"status,omitempty"`
+	Version string       `json:"version,omitempty"`
+	City    TargetCity   `json:"city,omitempty"`
+}
+
+type TargetCity struct {
+	Name        string  `json:"name,omitempty"`
+	CountryCode string  `json:"country_code,omitempty"`
+	Latitude    float64 `json:"latitude,omitempty"`
+	Longitude   float64 `json:"longitude,omitempty"`
+}
+
+func (t *DistributionTarget) IsOnline() bool {
+	return t.Status == TargetOnline
+}
+
+// Returns a distribution rule matching only this target.
+func (t *DistributionTarget) ToDistributionRule() *distribution.DistributionCommonParams {
+	rule := &distribution.DistributionCommonParams{SiteName: t.Name, CityName: t.City.Name}
+	if t.City.CountryCode != "" {
+		rule.CountryCodes = []string{t.City.CountryCode}
+	}
+	return rule
+}
+
+// Returns distribution rules matching the targets accepted by the filter, or all the targets if the filter is nil.
+// For example, to distribute only to the online edge nodes:
+// NewDistributionRules(targets, func(target DistributionTarget) bool { return target.IsOnline() })
+func NewDistributionRules(targets []DistributionTarget, filter func(target DistributionTarget) bool) []*distribution.DistributionCommonParams {
+	var rules []*distribution.DistributionCommonParams
+	for i := range targets {
+		if filter == nil || filter(targets[i]) {
+			rules = append(rules, targets[i].ToDistributionRule())
+		}
+	}
+	return rules
+}
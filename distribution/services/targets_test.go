@@ -0,0 +1,72 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/distribution"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTargets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/api/v1/distribution_targets", r.URL.Path)
+		_, _ = w.Write([]byte(`[
+			{"service_id":"jfrt@1","name":"edge-1","type":"edge","status":"ONLINE","version":"7.77.3","city":{"name":"Tel-Aviv","country_code":"IL"}},
+			{"service_id":"jfrt@2","name":"edge-2","type":"edge","status":"OFFLINE","version":"7.71.1","city":{"name":"Paris","country_code":"FR"}}
+		]`))
+	}))
+	defer server.Close()
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	assert.NoError(t, err)
+	targetsService := NewDistributionTargetsService(client)
+	targetsService.DistDetails = auth.NewArtifactoryDetails()
+	targetsService.DistDetails.SetUrl(server.URL + "/")
+
+	targets, err := targetsService.GetTargets()
+	assert.NoError(t, err)
+	assert.Len(t, targets, 2)
+	assert.Equal(t, "jfrt@1", targets[0].ServiceId)
+	assert.Equal(t, "7.77.3", targets[0].Version)
+	assert.True(t, targets[0].IsOnline())
+	assert.False(t, targets[1].IsOnline())
+
+	rules := NewDistributionRules(targets, func(target DistributionTarget) bool { return target.IsOnline() })
+	assert.Equal(t, []*distribution.DistributionCommonParams{{SiteName: "edge-1", CityName: "Tel-Aviv", CountryCodes: []string{"IL"}}}, rules)
+	assert.Len(t, NewDistributionRules(targets, nil), 2)
+}
+
+func TestMatchDistributionRules(t *testing.T) {
+	targets := []DistributionTarget{
+		{TargetArtifactory: distribution.TargetArtifactory{Name: "edge-il-1"}, City: TargetCity{Name: "Tel-Aviv", CountryCode: "IL"}},
+		{TargetArtifactory: distribution.TargetArtifactory{Name: "edge-il-2"}, City: TargetCity{Name: "Haifa", CountryCode: "IL"}},
+		{TargetArtifactory: distribution.TargetArtifactory{Name: "edge-fr-1"}, City: TargetCity{Name: "Paris", CountryCode: "FR"}},
+	}
+	testCases := []struct {
+		name     string
+		rules    []*distribution.DistributionCommonParams
+		expected []string
+	}{
+		{"all", []*distribution.DistributionCommonParams{{SiteName: "*", CityName: "*", CountryCodes: []string{"*"}}}, []string{"edge-il-1", "edge-il-2", "edge-fr-1"}},
+		{"site pattern", []*distribution.DistributionCommonParams{{SiteName: "edge-il-*"}}, []string{"edge-il-1", "edge-il-2"}},
+		{"city", []*distribution.DistributionCommonParams{{CityName: "Paris"}}, []string{"edge-fr-1"}},
+		{"country", []*distribution.DistributionCommonParams{{SiteName: "*", CountryCodes: []string{"il"}}}, []string{"edge-il-1", "edge-il-2"}},
+		{"all fields must match", []*distribution.DistributionCommonParams{{SiteName: "edge-il-*", CityName: "Paris"}}, nil},
+		{"any rule", []*distribution.DistributionCommonParams{{CityName: "Haifa"}, {CountryCodes: []string{"FR"}}}, []string{"edge-il-2", "edge-fr-1"}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			matched, err := MatchDistributionRules(targets, testCase.rules)
+			assert.NoError(t, err)
+			var names []string
+			for _, target := range matched {
+				names = append(names, target.Name)
+			}
+			assert.Equal(t, testCase.expected, names)
+		})
+	}
+}
@@ -33,6 +33,37 @@ func (ur *UpdateReleaseBundleService) UpdateReleaseBundle(createBundleParams Upd
 	return ur.execUpdateReleaseBundle(createBundleParams.Name, createBundleParams.Version, createBundleParams.GpgPassphrase, releaseBundleBody)
 }
 
+// Updates the description and the release notes of a release bundle, keeping its content.
+// Only a release bundle which isn't signed yet can be updated.
+func (ur *UpdateReleaseBundleService) UpdateReleaseNotes(params UpdateReleaseNotesParams) error {
+	getBundleService := NewGetReleaseBundleService(ur.client)
+	getBundleService.DistDetails = ur.DistDetails
+	releaseBundle, err := getBundleService.GetReleaseBundle(params.Name, params.Version)
+	if err != nil {
+		return err
+	}
+	if releaseBundle.State != ReleaseBundleOpen {
+		return errorutils.CheckErrorf("the release notes of release bundle %s/%s can't be updated since it's in state %s", params.Name, params.Version, releaseBundle.State)
+	}
+	signImmediately := false
+	releaseBundleBody := &distributionServiceUtils.ReleaseBundleBody{
+		DryRun:            ur.DryRun,
+		SignImmediately:   &signImmediately,
+		StoringRepository: releaseBundle.StoringRepository,
+		Description:       releaseBundle.Description,
+		ReleaseNotes:      releaseBundle.ReleaseNotes,
+		BundleSpec:        releaseBundle.Spec,
+	}
+	if params.Description != "" {
+		releaseBundleBody.Description = params.Description
+	}
+	if params.ReleaseNotes != "" {
+		releaseBundleBody.ReleaseNotes = &distributionServiceUtils.ReleaseNotes{Syntax: params.ReleaseNotesSyntax, Content: params.ReleaseNotes}
+	}
+	_, err = ur.execUpdateReleaseBundle(params.Name, params.Version, "", releaseBundleBody)
+	return err
+}
+
 // In case of an immediate sign- release bundle detailed summary (containing sha256) will be returned.
 // In other cases summary will be nil.
 func (ur *UpdateReleaseBundleService) execUpdateReleaseBundle(name, version, gpgPassphrase string, releaseBundle *distributionServiceUtils.ReleaseBundleBody) (*utils.Sha256Summary, error) {
@@ -85,3 +116,17 @@ func NewUpdateReleaseBundleParams(name, version string) UpdateReleaseBundleParam
 		},
 	}
 }
+
+type UpdateReleaseNotesParams struct {
+	Name    string
+	Version string
+	// Empty to keep the current description.
+	Description string
+	// Empty to keep the current release notes.
+	ReleaseNotes       string
+	ReleaseNotesSyntax distributionServiceUtils.ReleaseNotesSyntax
+}
+
+func NewUpdateReleaseNotesParams(name, version string) UpdateReleaseNotesParams {
+	return UpdateReleaseNotesParams{Name: name, Version: version, ReleaseNotesSyntax: distributionServiceUtils.PlainText}
+}
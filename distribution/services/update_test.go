@@ -0,0 +1,49 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/auth"
+	distributionServiceUtils "github.com/jfrog/jfrog-client-go/distribution/services/utils"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateReleaseNotes(t *testing.T) {
+	state := ReleaseBundleOpen
+	var updated *distributionServiceUtils.ReleaseBundleBody
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/release_bundle/rb/1.0", r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"name":"rb","version":"1.0","state":"` + string(state) + `","storing_repository":"release-bundles",` +
+				`"description":"Release 1.0","release_notes":{"syntax":"plain_text","content":"Initial notes"},` +
+				`"spec":{"queries":[{"aql":"items.find({\"repo\":\"generic\"})"}]}}`))
+		case http.MethodPut:
+			updated = &distributionServiceUtils.ReleaseBundleBody{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(updated))
+		}
+	}))
+	defer server.Close()
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	assert.NoError(t, err)
+	updateBundleService := NewUpdateReleaseBundleService(client)
+	updateBundleService.DistDetails = auth.NewArtifactoryDetails()
+	updateBundleService.DistDetails.SetUrl(server.URL + "/")
+
+	params := NewUpdateReleaseNotesParams("rb", "1.0")
+	params.ReleaseNotes = "# Approved by QA"
+	params.ReleaseNotesSyntax = distributionServiceUtils.Markdown
+	assert.NoError(t, updateBundleService.UpdateReleaseNotes(params))
+	// The content and the description are kept
+	assert.Equal(t, "Release 1.0", updated.Description)
+	assert.Equal(t, "release-bundles", updated.StoringRepository)
+	assert.Equal(t, `items.find({"repo":"generic"})`, updated.BundleSpec.Queries[0].Aql)
+	assert.Equal(t, &distributionServiceUtils.ReleaseNotes{Syntax: distributionServiceUtils.Markdown, Content: "# Approved by QA"}, updated.ReleaseNotes)
+
+	state = ReleaseBundleSigned
+	assert.ErrorContains(t, updateBundleService.UpdateReleaseNotes(params), "state SIGNED")
+}
@@ -1,7 +1,15 @@
 package evidence
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -293,3 +301,73 @@ func TestUploadEvidence_URLEncodingFix(t *testing.T) {
 		})
 	}
 }
+
+func TestSignReleaseBundle(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	var capturedPath string
+	var capturedEnvelope evidence.DsseEnvelope
+	mockServer, evdService := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/system/version" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		capturedPath = r.URL.Path
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(body, &capturedEnvelope))
+		w.WriteHeader(http.StatusCreated)
+	})
+	defer mockServer.Close()
+
+	params := evidence.ReleaseBundleSignatureParams{ProjectKey: "proj", Name: "rb", Version: "1.0", ManifestSha256: "abc123"}
+	_, err = evdService.SignReleaseBundle(params, evidence.NewCryptoSigner("kms-key", privateKey))
+	assert.NoError(t, err)
+	assert.Equal(t, "/api/v1/subject/proj-release-bundles-v2/rb/1.0/release-bundle.json.evd", capturedPath)
+
+	// The signature must verify against the DSSE pre-authentication encoding, with the public key only.
+	assert.Equal(t, evidence.InTotoPayloadType, capturedEnvelope.PayloadType)
+	assert.Len(t, capturedEnvelope.Signatures, 1)
+	assert.Equal(t, "kms-key", capturedEnvelope.Signatures[0].KeyId)
+	payload, err := base64.StdEncoding.DecodeString(capturedEnvelope.Payload)
+	assert.NoError(t, err)
+	signature, err := base64.StdEncoding.DecodeString(capturedEnvelope.Signatures[0].Sig)
+	assert.NoError(t, err)
+	digest := sha256.Sum256([]byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(evidence.InTotoPayloadType), evidence.InTotoPayloadType, len(payload), payload)))
+	assert.True(t, ecdsa.VerifyASN1(&privateKey.PublicKey, digest[:], signature))
+
+	var statement struct {
+		Subject []struct {
+			Digest map[string]string `json:"digest"`
+		} `json:"subject"`
+		PredicateType string            `json:"predicateType"`
+		Predicate     map[string]string `json:"predicate"`
+	}
+	assert.NoError(t, json.Unmarshal(payload, &statement))
+	assert.Equal(t, "abc123", statement.Subject[0].Digest["sha256"])
+	assert.Equal(t, evidence.ReleaseBundleSignaturePredicateType, statement.PredicateType)
+	assert.Equal(t, "kms-key", statement.Predicate["key_id"])
+}
+
+func TestCreateDsseEnvelope(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	payload := []byte(`{"_type":"https://in-toto.io/Statement/v1"}`)
+	preAuthEncoding := []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(evidence.InTotoPayloadType), evidence.InTotoPayloadType, len(payload), payload))
+
+	for _, signer := range []evidence.Signer{
+		evidence.NewCryptoSigner("ed25519-key", privateKey),
+		evidence.NewCallbackSigner("ed25519-key", func(toSign []byte) ([]byte, error) { return ed25519.Sign(privateKey, toSign), nil }),
+	} {
+		content, err := evidence.CreateDsseEnvelope(evidence.InTotoPayloadType, payload, signer)
+		assert.NoError(t, err)
+		var envelope evidence.DsseEnvelope
+		assert.NoError(t, json.Unmarshal(content, &envelope))
+		signature, err := base64.StdEncoding.DecodeString(envelope.Signatures[0].Sig)
+		assert.NoError(t, err)
+		assert.True(t, ed25519.Verify(publicKey, preAuthEncoding, signature))
+	}
+
+	_, err = evidence.CreateDsseEnvelope(evidence.InTotoPayloadType, payload, evidence.NewCallbackSigner("vault-key", func([]byte) ([]byte, error) { return nil, nil }))
+	assert.ErrorContains(t, err, "empty signature")
+}
@@ -39,3 +39,13 @@ func (esm *EvidenceServicesManager) UploadEvidence(evidenceDetails services.Evid
 	evidenceService := services.NewEvidenceService(esm.config.GetServiceDetails(), esm.client)
 	return evidenceService.UploadEvidence(evidenceDetails)
 }
+
+func (esm *EvidenceServicesManager) SignAndUploadEvidence(params services.SignedEvidenceParams, signer services.Signer) ([]byte, error) {
+	evidenceService := services.NewEvidenceService(esm.config.GetServiceDetails(), esm.client)
+	return evidenceService.SignAndUploadEvidence(params, signer)
+}
+
+func (esm *EvidenceServicesManager) SignReleaseBundle(params services.ReleaseBundleSignatureParams, signer services.Signer) ([]byte, error) {
+	evidenceService := services.NewEvidenceService(esm.config.GetServiceDetails(), esm.client)
+	return evidenceService.SignReleaseBundle(params, signer)
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	InTotoPayloadType   = "application/vnd.in-toto+json"
+	inTotoStatementType = "https://in-toto.io/Statement/v1"
+	// The predicate type of the detached signature of a Release Bundle, created by SignReleaseBundle.
+	ReleaseBundleSignaturePredicateType = "https://jfrog.com/evidence/release-bundle-signature/v1"
+
+	defaultReleaseBundlesRepository = "release-bundles-v2"
+	releaseBundleManifestName       = "release-bundle.json.evd"
+)
+
+// Signer signs payloads with a private key which stays outside the platform, e.g. in HashiCorp Vault, a cloud KMS or an HSM.
+// The platform verifies the signature with the public key which was uploaded under the key ID.
+type Signer interface {
+	KeyId() string
+	Sign(payload []byte) ([]byte, error)
+}
+
+type callbackSigner struct {
+	keyId string
+	sign  func(payload []byte) ([]byte, error)
+}
+
+// Returns a signer which delegates the signing to the callback, e.g. a call to the transit engine of HashiCorp Vault.
+// The callback receives the payload to sign, and returns the raw signature.
+func NewCallbackSigner(keyId string, sign func(payload []byte) ([]byte, error)) Signer {
+	return &callbackSigner{keyId: keyId, sign: sign}
+}
+
+func (cs *callbackSigner) KeyId() string {
+	return cs.keyId
+}
+
+func (cs *callbackSigner) Sign(payload []byte) ([]byte, error) {
+	return cs.sign(payload)
+}
+
+type cryptoSigner struct {
+	keyId  string
+	signer crypto.Signer
+}
+
+// Returns a signer which signs the sha256 digest of the payload with a crypto.Signer, which is what most cloud KMS and
+// PKCS#11 (HSM) libraries implement. Ed25519 keys sign the payload itself, as they don't support pre-hashed payloads.
+func NewCryptoSigner(keyId string, signer crypto.Signer) Signer {
+	return &cryptoSigner{keyId: keyId, signer: signer}
+}
+
+func (cs *cryptoSigner) KeyId() string {
+	return cs.keyId
+}
+
+func (cs *cryptoSigner) Sign(payload []byte) ([]byte, error) {
+	if _, isEd25519 := cs.signer.Public().(ed25519.PublicKey); isEd25519 {
+		signature, err := cs.signer.Sign(rand.Reader, payload, crypto.Hash(0))
+		return signature, errorutils.CheckError(err)
+	}
+	digest := sha256.Sum256(payload)
+	signature, err := cs.signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	return signature, errorutils.CheckError(err)
+}
+
+type DsseEnvelope struct {
+	PayloadType string          `json:"payloadType"`
+	Payload     string          `json:"payload"`
+	Signatures  []DsseSignature `json:"signatures"`
+}
+
+type DsseSignature struct {
+	KeyId string `json:"keyid"`
+	Sig   string `json:"sig"`
+}
+
+// Creates a DSSE envelope of the payload. The signer signs the pre-authentication encoding of the payload and its type,
+// as defined by the DSSE specification, so the payload is never sent to the platform unsigned.
+func CreateDsseEnvelope(payloadType string, payload []byte, signer Signer) ([]byte, error) {
+	if signer == nil {
+		return nil, errorutils.CheckErrorf("a signer is required to create a DSSE envelope")
+	}
+	signature, err := signer.Sign(dssePreAuthEncoding(payloadType, payload))
+	if err != nil {
+		return nil, err
+	}
+	if len(signature) == 0 {
+		return nil, errorutils.CheckErrorf("the signer of key '%s' returned an empty signature", signer.KeyId())
+	}
+	envelope, err := json.Marshal(DsseEnvelope{
+		PayloadType: payloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures:  []DsseSignature{{KeyId: signer.KeyId(), Sig: base64.StdEncoding.EncodeToString(signature)}},
+	})
+	return envelope, errorutils.CheckError(err)
+}
+
+func dssePreAuthEncoding(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+type inTotoStatement struct {
+	Type          string          `json:"_type"`
+	Subject       []inTotoSubject `json:"subject"`
+	PredicateType string          `json:"predicateType"`
+	Predicate     any             `json:"predicate"`
+}
+
+type inTotoSubject struct {
+	Name   string            `json:"name,omitempty"`
+	Digest map[string]string `json:"digest"`
+}
+
+type SignedEvidenceParams struct {
+	// The path of the subject in Artifactory, in the form of "repo/path/to/file".
+	SubjectUri    string
+	SubjectSha256 string
+	PredicateType string
+	Predicate     any
+	ProviderId    string
+}
+
+// Creates an in-toto statement about the subject, signs it with the signer, and uploads the envelope as evidence.
+// Only the detached signature is uploaded, so the private key never has to be uploaded to the platform.
+func (es *EvidenceService) SignAndUploadEvidence(params SignedEvidenceParams, signer Signer) ([]byte, error) {
+	if params.SubjectUri == "" || params.SubjectSha256 == "" || params.PredicateType == "" {
+		return nil, errorutils.CheckErrorf("a subject URI, a subject sha256 and a predicate type are required to sign evidence")
+	}
+	statement, err := json.Marshal(inTotoStatement{
+		Type:          inTotoStatementType,
+		Subject:       []inTotoSubject{{Name: path.Base(params.SubjectUri), Digest: map[string]string{"sha256": params.SubjectSha256}}},
+		PredicateType: params.PredicateType,
+		Predicate:     params.Predicate,
+	})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	envelope, err := CreateDsseEnvelope(InTotoPayloadType, statement, signer)
+	if err != nil {
+		return nil, err
+	}
+	return es.UploadEvidence(EvidenceDetails{SubjectUri: params.SubjectUri, DSSEFileRaw: envelope, ProviderId: params.ProviderId})
+}
+
+// Returns the path of the manifest of a Release Bundle v2, which is the subject its evidence is attached to.
+func GetReleaseBundleManifestPath(projectKey, name, version string) string {
+	repository := defaultReleaseBundlesRepository
+	if projectKey != "" && projectKey != "default" {
+		repository = projectKey + "-" + defaultReleaseBundlesRepository
+	}
+	return path.Join(repository, name, version, releaseBundleManifestName)
+}
+
+type ReleaseBundleSignatureParams struct {
+	ProjectKey string
+	Name       string
+	Version    string
+	// The sha256 checksum of the Release Bundle manifest, as returned by Artifactory for its path.
+	ManifestSha256 string
+	ProviderId     string
+}
+
+type releaseBundleSignaturePredicate struct {
+	Name       string `json:"release_bundle_name"`
+	Version    string `json:"release_bundle_version"`
+	ProjectKey string `json:"project_key,omitempty"`
+	KeyId      string `json:"key_id"`
+}
+
+// Signs the manifest of a Release Bundle v2 with an external signer, and attaches the detached signature to it as evidence.
+// Use it to sign with a key kept in HashiCorp Vault, a cloud KMS or an HSM, instead of a signing key uploaded to the
+// platform. Release Bundles v1 are signed by Distribution, which supports only signing keys uploaded to it.
+func (es *EvidenceService) SignReleaseBundle(params ReleaseBundleSignatureParams, signer Signer) ([]byte, error) {
+	if params.Name == "" || params.Version == "" {
+		return nil, errorutils.CheckErrorf("a Release Bundle name and version are required to sign it")
+	}
+	if signer == nil {
+		return nil, errorutils.CheckErrorf("a signer is required to sign a Release Bundle")
+	}
+	return es.SignAndUploadEvidence(SignedEvidenceParams{
+		SubjectUri:    GetReleaseBundleManifestPath(params.ProjectKey, params.Name, params.Version),
+		SubjectSha256: params.ManifestSha256,
+		PredicateType: ReleaseBundleSignaturePredicateType,
+		Predicate: releaseBundleSignaturePredicate{
+			Name:       params.Name,
+			Version:    params.Version,
+			ProjectKey: params.ProjectKey,
+			KeyId:      signer.KeyId(),
+		},
+		ProviderId: params.ProviderId,
+	}, signer)
+}
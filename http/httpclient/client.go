@@ -332,6 +332,18 @@ func (jc *HttpClient) doUploadFile(localPath, url string, httpClientsDetails htt
 }
 
 func (jc *HttpClient) UploadFileFromReader(reader io.Reader, url string, httpClientsDetails httputils.HttpClientDetails,
+	size int64) (resp *http.Response, body []byte, err error) {
+	resp, body, err = jc.SendPutFromReader(reader, url, httpClientsDetails, size)
+	if err != nil || resp == nil {
+		return
+	}
+	err = errorutils.CheckResponseStatus(resp, http.StatusCreated, http.StatusOK, http.StatusAccepted)
+	return
+}
+
+// Sends a PUT request with the content of the reader as its body, without retries.
+// The caller is responsible to check the response status.
+func (jc *HttpClient) SendPutFromReader(reader io.Reader, url string, httpClientsDetails httputils.HttpClientDetails,
 	size int64) (resp *http.Response, body []byte, err error) {
 	req, err := jc.newRequest(http.MethodPut, url, reader)
 	if err != nil {
@@ -354,9 +366,6 @@ func (jc *HttpClient) UploadFileFromReader(reader io.Reader, url string, httpCli
 			err = errors.Join(err, errorutils.CheckError(resp.Body.Close()))
 		}
 	}()
-	if err = errorutils.CheckResponseStatus(resp, http.StatusCreated, http.StatusOK, http.StatusAccepted); err != nil {
-		return
-	}
 	body, err = io.ReadAll(resp.Body)
 	err = errorutils.CheckError(err)
 	return
@@ -110,6 +110,15 @@ func (rtc *JfrogHttpClient) UploadFileFromReader(reader io.Reader, url string, h
 	return rtc.httpClient.UploadFileFromReader(reader, url, *httpClientsDetails, size)
 }
 
+func (rtc *JfrogHttpClient) SendPutFromReader(reader io.Reader, url string, httpClientsDetails *httputils.HttpClientDetails,
+	size int64) (resp *http.Response, body []byte, err error) {
+	err = rtc.runPreRequestInterceptors(httpClientsDetails)
+	if err != nil {
+		return
+	}
+	return rtc.httpClient.SendPutFromReader(reader, url, *httpClientsDetails, size)
+}
+
 func (rtc *JfrogHttpClient) ReadRemoteFile(downloadPath string, httpClientsDetails *httputils.HttpClientDetails) (ioReaderCloser io.ReadCloser, resp *http.Response, err error) {
 	err = rtc.runPreRequestInterceptors(httpClientsDetails)
 	if err != nil {
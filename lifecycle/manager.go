@@ -78,16 +78,41 @@ func (lcs *LifecycleServicesManager) CreateReleaseBundlesFromMultipleSources(rbD
 	return resp, errorutils.CheckError(err)
 }
 
+func (lcs *LifecycleServicesManager) CreateReleaseBundleAsync(rbDetails lifecycle.ReleaseBundleDetails, projectKey, signingKeyName string, sources []lifecycle.RbSource) (*lifecycle.RbOperationHandle, error) {
+	rbService := lifecycle.NewReleaseBundlesService(lcs.config.GetServiceDetails(), lcs.client)
+	return rbService.CreateReleaseBundleAsync(rbDetails, projectKey, signingKeyName, sources)
+}
+
 func (lcs *LifecycleServicesManager) GetReleaseBundleSpecification(rbDetails lifecycle.ReleaseBundleDetails) (lifecycle.ReleaseBundleSpecResponse, error) {
 	rbService := lifecycle.NewReleaseBundlesService(lcs.config.GetServiceDetails(), lcs.client)
 	return rbService.GetReleaseBundleSpecification(rbDetails)
 }
 
+func (lcs *LifecycleServicesManager) ListReleaseBundleArtifacts(rbDetails lifecycle.ReleaseBundleDetails, queryParams lifecycle.RbArtifactsQueryParams) (lifecycle.RbArtifactsPage, error) {
+	rbService := lifecycle.NewReleaseBundlesService(lcs.config.GetServiceDetails(), lcs.client)
+	return rbService.ListReleaseBundleArtifacts(rbDetails, queryParams)
+}
+
+func (lcs *LifecycleServicesManager) GetReleaseBundleArtifact(rbDetails lifecycle.ReleaseBundleDetails, projectKey, artifactPath string) (*lifecycle.RbArtifact, error) {
+	rbService := lifecycle.NewReleaseBundlesService(lcs.config.GetServiceDetails(), lcs.client)
+	return rbService.GetReleaseBundleArtifact(rbDetails, projectKey, artifactPath)
+}
+
+func (lcs *LifecycleServicesManager) CompareReleaseBundles(base, target lifecycle.ReleaseBundleDetails, projectKey string) (*lifecycle.RbDiffReport, error) {
+	rbService := lifecycle.NewReleaseBundlesService(lcs.config.GetServiceDetails(), lcs.client)
+	return rbService.CompareReleaseBundles(base, target, projectKey)
+}
+
 func (lcs *LifecycleServicesManager) PromoteReleaseBundle(rbDetails lifecycle.ReleaseBundleDetails, queryParams lifecycle.CommonOptionalQueryParams, signingKeyName string, promotionParams lifecycle.RbPromotionParams) (lifecycle.RbPromotionResp, error) {
 	rbService := lifecycle.NewReleaseBundlesService(lcs.config.GetServiceDetails(), lcs.client)
 	return rbService.Promote(rbDetails, queryParams, signingKeyName, promotionParams)
 }
 
+func (lcs *LifecycleServicesManager) PromoteReleaseBundleAsync(rbDetails lifecycle.ReleaseBundleDetails, projectKey, signingKeyName string, promotionParams lifecycle.RbPromotionParams) (*lifecycle.RbOperationHandle, error) {
+	rbService := lifecycle.NewReleaseBundlesService(lcs.config.GetServiceDetails(), lcs.client)
+	return rbService.PromoteAsync(rbDetails, projectKey, signingKeyName, promotionParams)
+}
+
 func (lcs *LifecycleServicesManager) GetReleaseBundleCreationStatus(rbDetails lifecycle.ReleaseBundleDetails, projectKey string, sync bool) (lifecycle.ReleaseBundleStatusResponse, error) {
 	rbService := lifecycle.NewReleaseBundlesService(lcs.config.GetServiceDetails(), lcs.client)
 	return rbService.GetReleaseBundleCreationStatus(rbDetails, projectKey, sync)
@@ -114,6 +139,14 @@ func (lcs *LifecycleServicesManager) DeleteReleaseBundleVersionPromotion(rbDetai
 }
 
 func (lcs *LifecycleServicesManager) DistributeReleaseBundle(rbDetails lifecycle.ReleaseBundleDetails, distributeParams lifecycle.DistributeReleaseBundleParams) error {
+	return lcs.newDistributeReleaseBundleService(rbDetails, distributeParams).Distribute()
+}
+
+func (lcs *LifecycleServicesManager) DistributeReleaseBundleAsync(rbDetails lifecycle.ReleaseBundleDetails, distributeParams lifecycle.DistributeReleaseBundleParams) (*lifecycle.RbDistributionHandle, error) {
+	return lcs.newDistributeReleaseBundleService(rbDetails, distributeParams).DistributeAsync()
+}
+
+func (lcs *LifecycleServicesManager) newDistributeReleaseBundleService(rbDetails lifecycle.ReleaseBundleDetails, distributeParams lifecycle.DistributeReleaseBundleParams) *lifecycle.DistributeReleaseBundleService {
 	distributeBundleService := lifecycle.NewDistributeReleaseBundleService(lcs.client)
 	distributeBundleService.LcDetails = lcs.config.GetServiceDetails()
 	distributeBundleService.DryRun = lcs.config.IsDryRun()
@@ -122,11 +155,13 @@ func (lcs *LifecycleServicesManager) DistributeReleaseBundle(rbDetails lifecycle
 		Name:              rbDetails.ReleaseBundleName,
 		Version:           rbDetails.ReleaseBundleVersion,
 		DistributionRules: distributeParams.DistributionRules,
+		Scheduling:        distributeParams.Scheduling,
 	}
 	distributeBundleService.AutoCreateRepo = distributeParams.AutoCreateRepo
 	distributeBundleService.Sync = distributeParams.Sync
 	distributeBundleService.MaxWaitMinutes = distributeParams.MaxWaitMinutes
 	distributeBundleService.ProjectKey = distributeParams.ProjectKey
+	distributeBundleService.DistributionDetails = distributeParams.DistributionDetails
 
 	mappings := &distributeBundleService.PathMappings
 	*mappings = []utils.PathMapping{}
@@ -134,7 +169,7 @@ func (lcs *LifecycleServicesManager) DistributeReleaseBundle(rbDetails lifecycle
 		*mappings = append(*mappings,
 			distribution.CreatePathMappingsFromPatternAndTarget(pathMapping.Pattern, pathMapping.Target)...)
 	}
-	return distributeBundleService.Distribute()
+	return distributeBundleService
 }
 
 func (lcs *LifecycleServicesManager) RemoteDeleteReleaseBundle(rbDetails lifecycle.ReleaseBundleDetails, params lifecycle.ReleaseBundleRemoteDeleteParams) error {
@@ -147,6 +182,16 @@ func (lcs *LifecycleServicesManager) ExportReleaseBundle(rbDetails lifecycle.Rel
 	return rbService.ExportReleaseBundle(rbDetails, modifications, queryParams)
 }
 
+func (lcs *LifecycleServicesManager) ExportReleaseBundleArchive(rbDetails lifecycle.ReleaseBundleDetails, modifications lifecycle.Modifications, queryParams lifecycle.CommonOptionalQueryParams, archivePath string) (*lifecycle.ReleaseBundleArchive, error) {
+	rbService := lifecycle.NewReleaseBundlesService(lcs.config.GetServiceDetails(), lcs.client)
+	return rbService.ExportReleaseBundleArchive(rbDetails, modifications, queryParams, archivePath)
+}
+
+func (lcs *LifecycleServicesManager) DownloadExportedReleaseBundle(exportResponse lifecycle.ReleaseBundleExportedStatusResponse, archivePath string) (*lifecycle.ReleaseBundleArchive, error) {
+	rbService := lifecycle.NewReleaseBundlesService(lcs.config.GetServiceDetails(), lcs.client)
+	return rbService.DownloadExportedReleaseBundle(exportResponse, archivePath)
+}
+
 func (lcs *LifecycleServicesManager) IsReleaseBundleExist(rbName, rbVersion, projectKey string) (bool, error) {
 	rbService := lifecycle.NewReleaseBundlesService(lcs.config.GetServiceDetails(), lcs.client)
 	return rbService.ReleaseBundleExists(rbName, rbVersion, projectKey)
@@ -157,6 +202,11 @@ func (lcs *LifecycleServicesManager) AnnotateReleaseBundle(params lifecycle.Anno
 	return rbService.AnnotateReleaseBundle(params)
 }
 
+func (lcs *LifecycleServicesManager) GetReleaseBundleAnnotations(params lifecycle.AnnotateOperationParams) (*lifecycle.RbAnnotations, error) {
+	rbService := lifecycle.NewReleaseBundlesService(lcs.config.GetServiceDetails(), lcs.client)
+	return rbService.GetReleaseBundleAnnotations(params)
+}
+
 func (lcs *LifecycleServicesManager) GetReleaseBundlesStats(serverUrl string) ([]byte, error) {
 	rbService := lifecycle.NewReleaseBundlesStatsService(lcs.config.GetServiceDetails(), lcs.client)
 	return rbService.GetReleaseBundlesStats(serverUrl)
@@ -171,3 +221,48 @@ func (lcs *LifecycleServicesManager) ReleaseBundlesSearchVersions(releaseBundleN
 	rbService := lifecycle.NewReleaseBundlesService(lcs.config.GetServiceDetails(), lcs.client)
 	return rbService.ReleaseBundlesSearchVersions(releaseBundleName, params)
 }
+
+func (lcs *LifecycleServicesManager) GetEnvironments(projectKey string) ([]lifecycle.Environment, error) {
+	environmentsService := lifecycle.NewEnvironmentsService(lcs.config.GetServiceDetails(), lcs.client)
+	return environmentsService.GetAll(projectKey)
+}
+
+func (lcs *LifecycleServicesManager) CreateEnvironment(environment lifecycle.Environment) error {
+	environmentsService := lifecycle.NewEnvironmentsService(lcs.config.GetServiceDetails(), lcs.client)
+	return environmentsService.Create(environment)
+}
+
+func (lcs *LifecycleServicesManager) RenameEnvironment(name, newName string) error {
+	environmentsService := lifecycle.NewEnvironmentsService(lcs.config.GetServiceDetails(), lcs.client)
+	return environmentsService.Rename(name, newName)
+}
+
+func (lcs *LifecycleServicesManager) DeleteEnvironment(name string) error {
+	environmentsService := lifecycle.NewEnvironmentsService(lcs.config.GetServiceDetails(), lcs.client)
+	return environmentsService.Delete(name)
+}
+
+func (lcs *LifecycleServicesManager) ValidatePromotionEnvironment(environment, projectKey string) error {
+	environmentsService := lifecycle.NewEnvironmentsService(lcs.config.GetServiceDetails(), lcs.client)
+	return environmentsService.ValidatePromotionEnvironment(environment, projectKey)
+}
+
+func (lcs *LifecycleServicesManager) GetRepositoryEnvironments(repoKey string) ([]string, error) {
+	environmentsService := lifecycle.NewEnvironmentsService(lcs.config.GetServiceDetails(), lcs.client)
+	return environmentsService.GetRepositoryEnvironments(repoKey)
+}
+
+func (lcs *LifecycleServicesManager) SetRepositoryEnvironments(repoKey string, environments []string) error {
+	environmentsService := lifecycle.NewEnvironmentsService(lcs.config.GetServiceDetails(), lcs.client)
+	return environmentsService.SetRepositoryEnvironments(repoKey, environments)
+}
+
+func (lcs *LifecycleServicesManager) SubscribeToReleaseBundleEvents(params lifecycle.RbWebhookParams) ([]string, error) {
+	webhooksService := lifecycle.NewWebhooksService(lcs.config.GetServiceDetails(), lcs.client)
+	return webhooksService.SubscribeToReleaseBundleEvents(params)
+}
+
+func (lcs *LifecycleServicesManager) UnsubscribeFromReleaseBundleEvents(key string) error {
+	webhooksService := lifecycle.NewWebhooksService(lcs.config.GetServiceDetails(), lcs.client)
+	return webhooksService.UnsubscribeFromReleaseBundleEvents(key)
+}
@@ -53,10 +53,89 @@ type RbAnnotationProps struct {
 	Exist      bool                `json:"exist"`
 }
 
+// Returns params to annotate a Release Bundle.
+// The properties are set on the path of the Release Bundle in Artifactory, e.g. on its manifest, which is the artifact
+// "release-bundle.json.evd" under "<project>-release-bundles-v2/<name>/<version>".
+func NewAnnotateOperationParams(rbDetails ReleaseBundleDetails, artifactoryUrl, path string) AnnotateOperationParams {
+	return AnnotateOperationParams{
+		RbDetails:      rbDetails,
+		PropertyParams: CommonPropParams{Path: path},
+		ArtifactoryUrl: ArtCommonParams{Url: utils.AddTrailingSlashIfNeeded(artifactoryUrl)},
+	}
+}
+
+func (params *AnnotateOperationParams) SetTag(tag string) *AnnotateOperationParams {
+	params.RbTag = RbAnnotationTag{Tag: tag, Exist: true}
+	return params
+}
+
+func (params *AnnotateOperationParams) SetProperties(properties map[string][]string) *AnnotateOperationParams {
+	params.RbProps = RbAnnotationProps{Properties: properties, Exist: len(properties) > 0}
+	return params
+}
+
+func (params *AnnotateOperationParams) DeleteProperties(keys ...string) *AnnotateOperationParams {
+	params.RbDelProps = RbDelProps{Keys: strings.Join(keys, ","), Exist: len(keys) > 0}
+	return params
+}
+
 func (rbs *ReleaseBundlesService) AnnotateReleaseBundle(params AnnotateOperationParams) error {
 	return rbs.annotateReleaseBundle(params)
 }
 
+type RbAnnotations struct {
+	Tag        string
+	Properties map[string][]string
+}
+
+// Returns the tag of the Release Bundle, and the properties of its path in Artifactory if the path is set.
+func (rbs *ReleaseBundlesService) GetReleaseBundleAnnotations(params AnnotateOperationParams) (*RbAnnotations, error) {
+	httpClientsDetails := rbs.GetLifecycleDetails().CreateHttpClientDetails()
+	tagFullUrl, err := utils.BuildUrl(rbs.GetLifecycleDetails().GetUrl(), GetReleaseBundleSetTagApi(params.RbDetails),
+		distribution.GetProjectQueryParam(params.QueryParams.ProjectKey))
+	if err != nil {
+		return nil, err
+	}
+	resp, body, _, err := rbs.client.SendGet(tagFullUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	tag := RbAnnotationTag{}
+	if err = json.Unmarshal(body, &tag); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	annotations := &RbAnnotations{Tag: tag.Tag}
+	if params.PropertyParams.Path == "" {
+		return annotations, nil
+	}
+	annotations.Properties, err = rbs.getProperties(params.ArtifactoryUrl.Url, PropertiesBaseApi+"/"+params.PropertyParams.Path, httpClientsDetails)
+	return annotations, err
+}
+
+func (rbs *ReleaseBundlesService) getProperties(url, path string, httpClientsDetails httputils.HttpClientDetails) (map[string][]string, error) {
+	propsFullUrl, err := utils.BuildUrl(url, path, map[string]string{"properties": ""})
+	if err != nil {
+		return nil, err
+	}
+	resp, body, _, err := rbs.client.SendGet(propsFullUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	// Artifactory returns 404 if the path has no properties
+	if resp.StatusCode == http.StatusNotFound {
+		return map[string][]string{}, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	props := RbAnnotationProps{}
+	err = json.Unmarshal(body, &props)
+	return props.Properties, errorutils.CheckError(err)
+}
+
 func GetReleaseBundleSetTagApi(rbDetails ReleaseBundleDetails) string {
 	return path.Join(releaseBundleBaseApi, records, rbDetails.ReleaseBundleName, rbDetails.ReleaseBundleVersion, Tag)
 }
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnotateReleaseBundle(t *testing.T) {
+	tag := ""
+	properties := map[string][]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/" + releaseBundleBaseApi + "/records/rb/1.0/tag":
+			assert.Equal(t, "proj", r.URL.Query().Get("project"))
+			if r.Method == http.MethodPut {
+				body := RbAnnotationTag{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				tag = body.Tag
+			}
+			_, _ = w.Write([]byte(`{"tag":"` + tag + `"}`))
+		case "/artifactory/api/storage/proj-release-bundles-v2/rb/1.0/release-bundle.json.evd":
+			switch r.Method {
+			case http.MethodPut:
+				assert.Equal(t, "approved.by=security", r.URL.Query().Get("properties"))
+				properties["approved.by"] = []string{"security"}
+			case http.MethodGet:
+				if len(properties) == 0 {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{"properties": properties}))
+			}
+		default:
+			assert.Fail(t, "unexpected request", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	rbs := createTestReleaseBundlesService(t, server.URL)
+
+	params := NewAnnotateOperationParams(ReleaseBundleDetails{ReleaseBundleName: "rb", ReleaseBundleVersion: "1.0"},
+		server.URL+"/artifactory", "proj-release-bundles-v2/rb/1.0/release-bundle.json.evd")
+	params.QueryParams.ProjectKey = "proj"
+	annotations, err := rbs.GetReleaseBundleAnnotations(params)
+	assert.NoError(t, err)
+	assert.Equal(t, &RbAnnotations{Properties: map[string][]string{}}, annotations)
+
+	params.SetTag("approved").SetProperties(map[string][]string{"approved.by": {"security"}})
+	assert.NoError(t, rbs.AnnotateReleaseBundle(params))
+	annotations, err = rbs.GetReleaseBundleAnnotations(params)
+	assert.NoError(t, err)
+	assert.Equal(t, "approved", annotations.Tag)
+	assert.Equal(t, map[string][]string{"approved.by": {"security"}}, annotations.Properties)
+}
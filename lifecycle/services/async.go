@@ -0,0 +1,135 @@
+package services
+
+import (
+	"encoding/json"
+	"path"
+
+	"github.com/jfrog/jfrog-client-go/utils/distribution"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// RbOperationHandle tracks a Release Bundle creation or promotion which was started asynchronously.
+type RbOperationHandle struct {
+	rbs        *ReleaseBundlesService
+	restApi    string
+	operation  string
+	RbDetails  ReleaseBundleDetails
+	ProjectKey string
+	// The timestamp of the promotion. Empty for a creation.
+	CreatedMillis string
+}
+
+// Returns the current status of the operation.
+func (h *RbOperationHandle) Status() (ReleaseBundleStatusResponse, error) {
+	return h.rbs.getReleaseBundleOperationStatus(h.restApi, h.ProjectKey, false, h.operation)
+}
+
+// Waits for the operation to end, and returns its final status.
+// Returns an error if the operation failed or was rejected.
+func (h *RbOperationHandle) Wait() (ReleaseBundleStatusResponse, error) {
+	status, err := h.rbs.getReleaseBundleOperationStatus(h.restApi, h.ProjectKey, true, h.operation)
+	if err != nil {
+		return status, err
+	}
+	if status.Status == Failed || status.Status == Rejected {
+		return status, errorutils.CheckErrorf("Release Bundle %s %s/%s ended with status %s%s", h.operation,
+			h.RbDetails.ReleaseBundleName, h.RbDetails.ReleaseBundleVersion, status.Status, formatMessages(status.Messages))
+	}
+	return status, nil
+}
+
+func formatMessages(messages []Message) string {
+	formatted := ""
+	for _, message := range messages {
+		formatted += "\n" + message.Text
+	}
+	return formatted
+}
+
+// Starts creating a Release Bundle from the sources, and returns a handle to track the creation.
+func (rbs *ReleaseBundlesService) CreateReleaseBundleAsync(rbDetails ReleaseBundleDetails, projectKey, signingKeyName string, sources []RbSource) (*RbOperationHandle, error) {
+	params := CommonOptionalQueryParams{ProjectKey: projectKey, Async: true}
+	if _, err := rbs.CreateReleaseBundleFromMultipleSources(rbDetails, params, signingKeyName, sources); err != nil {
+		return nil, err
+	}
+	return &RbOperationHandle{
+		rbs:        rbs,
+		restApi:    GetReleaseBundleCreationStatusRestApi(rbDetails),
+		operation:  "creation",
+		RbDetails:  rbDetails,
+		ProjectKey: projectKey,
+	}, nil
+}
+
+// Starts promoting a Release Bundle to an environment, and returns a handle to track the promotion.
+func (rbs *ReleaseBundlesService) PromoteAsync(rbDetails ReleaseBundleDetails, projectKey, signingKeyName string, promotionParams RbPromotionParams) (*RbOperationHandle, error) {
+	params := CommonOptionalQueryParams{ProjectKey: projectKey, Async: true}
+	promotion, err := rbs.Promote(rbDetails, params, signingKeyName, promotionParams)
+	if err != nil {
+		return nil, err
+	}
+	if promotion.CreatedMillis == "" {
+		return nil, errorutils.CheckErrorf("the promotion response of Release Bundle %s/%s is missing its creation timestamp", rbDetails.ReleaseBundleName, rbDetails.ReleaseBundleVersion)
+	}
+	createdMillis := promotion.CreatedMillis.String()
+	return &RbOperationHandle{
+		rbs:           rbs,
+		restApi:       path.Join(promotionBaseApi, statusesApi, rbDetails.ReleaseBundleName, rbDetails.ReleaseBundleVersion, createdMillis),
+		operation:     "promotion",
+		RbDetails:     rbDetails,
+		ProjectKey:    projectKey,
+		CreatedMillis: createdMillis,
+	}, nil
+}
+
+// RbDistributionHandle tracks a Release Bundle distribution which was started asynchronously.
+type RbDistributionHandle struct {
+	dr        *DistributeReleaseBundleService
+	TrackerId json.Number
+}
+
+// Returns the current status of the distribution.
+func (h *RbDistributionHandle) Status() (*distribution.DistributionStatusResponse, error) {
+	status, _, err := h.dr.getReleaseBundleDistributionStatus(&h.dr.DistributeParams, h.TrackerId)
+	return status, err
+}
+
+// Waits for the distribution to end. Returns an error if it failed on any of the targets.
+func (h *RbDistributionHandle) Wait() error {
+	return h.dr.waitForDistributionOperationCompletion(&h.dr.DistributeParams, h.TrackerId)
+}
+
+// Returns a tracker of the progress of the distribution on each of its target sites.
+func (h *RbDistributionHandle) Tracker() *distribution.DistributionTracker {
+	return distribution.NewDistributionTracker(h.Status)
+}
+
+// Starts distributing the Release Bundle, and returns a handle to track the distribution.
+// The Sync field is ignored. Returns a nil handle on a dry run.
+func (dr *DistributeReleaseBundleService) DistributeAsync() (*RbDistributionHandle, error) {
+	trackerId, err := distribution.DoDistribute(dr)
+	if err != nil || dr.IsDryRun() {
+		return nil, err
+	}
+	return &RbDistributionHandle{dr: dr, TrackerId: trackerId}, nil
+}
+
+func NewAqlSource(aql string) RbSource {
+	return RbSource{SourceType: Aql, Aql: aql}
+}
+
+func NewArtifactsSource(artifacts ...ArtifactSource) RbSource {
+	return RbSource{SourceType: Artifacts, Artifacts: artifacts}
+}
+
+func NewBuildsSource(builds ...BuildSource) RbSource {
+	return RbSource{SourceType: Builds, Builds: builds}
+}
+
+func NewReleaseBundlesSource(releaseBundles ...ReleaseBundleSource) RbSource {
+	return RbSource{SourceType: ReleaseBundles, ReleaseBundles: releaseBundles}
+}
+
+func NewPackagesSource(packages ...PackageSource) RbSource {
+	return RbSource{SourceType: Packages, Packages: packages}
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestReleaseBundlesService(t *testing.T, serverUrl string) *ReleaseBundlesService {
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	assert.NoError(t, err)
+	lcDetails := auth.NewArtifactoryDetails()
+	lcDetails.SetUrl(serverUrl + "/")
+	return NewReleaseBundlesService(lcDetails, client)
+}
+
+func TestCreateReleaseBundleAsync(t *testing.T) {
+	statuses := []RbStatus{Processing, Completed}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "proj", r.URL.Query().Get("project"))
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/"+releaseBundleBaseApi, r.URL.Path)
+			assert.Equal(t, "true", r.URL.Query().Get(async))
+			body := RbCreationBody{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []RbSource{NewBuildsSource(BuildSource{BuildName: "build", BuildNumber: "1"}), NewAqlSource("items.find()")}, body.Sources)
+			w.WriteHeader(http.StatusAccepted)
+		case http.MethodGet:
+			assert.Equal(t, "/"+releaseBundleBaseApi+"/statuses/rb/1.0", r.URL.Path)
+			_, _ = fmt.Fprintf(w, `{"status":"%s"}`, statuses[0])
+			statuses = statuses[1:]
+		}
+	}))
+	defer server.Close()
+	defer setSyncSleepInterval(10 * time.Millisecond)()
+	rbs := createTestReleaseBundlesService(t, server.URL)
+	sources := []RbSource{NewBuildsSource(BuildSource{BuildName: "build", BuildNumber: "1"}), NewAqlSource("items.find()")}
+	handle, err := rbs.CreateReleaseBundleAsync(ReleaseBundleDetails{ReleaseBundleName: "rb", ReleaseBundleVersion: "1.0"}, "proj", "key", sources)
+	assert.NoError(t, err)
+	status, err := handle.Status()
+	assert.NoError(t, err)
+	assert.Equal(t, Processing, status.Status)
+	status, err = handle.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, Completed, status.Status)
+}
+
+func TestPromoteAsyncFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/"+promotionBaseApi+"/records/rb/1.0", r.URL.Path)
+			_, _ = w.Write([]byte(`{"environment":"PROD","created_millis":1700000000000}`))
+		case http.MethodGet:
+			assert.Equal(t, "/"+promotionBaseApi+"/statuses/rb/1.0/1700000000000", r.URL.Path)
+			_, _ = w.Write([]byte(`{"status":"FAILED","messages":[{"text":"no permission to PROD"}]}`))
+		}
+	}))
+	defer server.Close()
+	rbs := createTestReleaseBundlesService(t, server.URL)
+	handle, err := rbs.PromoteAsync(ReleaseBundleDetails{ReleaseBundleName: "rb", ReleaseBundleVersion: "1.0"}, "", "key", RbPromotionParams{Environment: "PROD"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1700000000000", handle.CreatedMillis)
+	_, err = handle.Wait()
+	assert.ErrorContains(t, err, "no permission to PROD")
+}
+
+func setSyncSleepInterval(interval time.Duration) func() {
+	previous := SyncSleepInterval
+	SyncSleepInterval = interval
+	return func() { SyncSleepInterval = previous }
+}
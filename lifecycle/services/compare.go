@@ -0,0 +1,116 @@
+package services
+
+import (
+	"slices"
+	"sort"
+)
+
+// The differences between two Release Bundle versions. Artifacts are matched by their paths.
+type RbDiffReport struct {
+	Base    ReleaseBundleDetails `json:"base"`
+	Target  ReleaseBundleDetails `json:"target"`
+	Added   []RbArtifact         `json:"added,omitempty"`
+	Removed []RbArtifact         `json:"removed,omitempty"`
+	Changed []RbArtifactChange   `json:"changed,omitempty"`
+}
+
+func (report *RbDiffReport) IsEmpty() bool {
+	return len(report.Added) == 0 && len(report.Removed) == 0 && len(report.Changed) == 0
+}
+
+// An artifact which exists in both versions, with a different checksum or different properties.
+type RbArtifactChange struct {
+	Path              string             `json:"path"`
+	BaseChecksum      string             `json:"base_checksum,omitempty"`
+	TargetChecksum    string             `json:"target_checksum,omitempty"`
+	ChangedProperties []RbPropertyChange `json:"changed_properties,omitempty"`
+}
+
+func (change *RbArtifactChange) IsChecksumChanged() bool {
+	return change.BaseChecksum != change.TargetChecksum
+}
+
+// A property which was added, removed or whose values changed. The values of an added property are empty in the base,
+// and the values of a removed property are empty in the target.
+type RbPropertyChange struct {
+	Key          string   `json:"key"`
+	BaseValues   []string `json:"base_values,omitempty"`
+	TargetValues []string `json:"target_values,omitempty"`
+}
+
+// Compares the artifacts of two versions of Release Bundles, which are usually two versions of the same Release Bundle.
+func (rbs *ReleaseBundlesService) CompareReleaseBundles(base, target ReleaseBundleDetails, projectKey string) (*RbDiffReport, error) {
+	baseArtifacts, err := rbs.getReleaseBundleArtifacts(base, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	targetArtifacts, err := rbs.getReleaseBundleArtifacts(target, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	report := DiffReleaseBundleArtifacts(baseArtifacts, targetArtifacts)
+	report.Base, report.Target = base, target
+	return report, nil
+}
+
+// Returns the differences between two lists of artifacts, ordered by path.
+func DiffReleaseBundleArtifacts(baseArtifacts, targetArtifacts []RbArtifact) *RbDiffReport {
+	report := &RbDiffReport{}
+	baseByPath := make(map[string]RbArtifact, len(baseArtifacts))
+	for _, artifact := range baseArtifacts {
+		baseByPath[artifact.Path] = artifact
+	}
+	for _, targetArtifact := range targetArtifacts {
+		baseArtifact, exists := baseByPath[targetArtifact.Path]
+		if !exists {
+			report.Added = append(report.Added, targetArtifact)
+			continue
+		}
+		delete(baseByPath, targetArtifact.Path)
+		change := RbArtifactChange{
+			Path:              targetArtifact.Path,
+			BaseChecksum:      baseArtifact.Checksum,
+			TargetChecksum:    targetArtifact.Checksum,
+			ChangedProperties: diffProperties(baseArtifact.Properties, targetArtifact.Properties),
+		}
+		if change.IsChecksumChanged() || len(change.ChangedProperties) > 0 {
+			report.Changed = append(report.Changed, change)
+		}
+	}
+	for _, artifact := range baseByPath {
+		report.Removed = append(report.Removed, artifact)
+	}
+	sort.Slice(report.Added, func(i, j int) bool { return report.Added[i].Path < report.Added[j].Path })
+	sort.Slice(report.Removed, func(i, j int) bool { return report.Removed[i].Path < report.Removed[j].Path })
+	sort.Slice(report.Changed, func(i, j int) bool { return report.Changed[i].Path < report.Changed[j].Path })
+	return report
+}
+
+// The values of a property are compared regardless of their order.
+func diffProperties(baseProperties, targetProperties []RbArtifactProperty) []RbPropertyChange {
+	baseValues, targetValues := propertiesToMap(baseProperties), propertiesToMap(targetProperties)
+	var changes []RbPropertyChange
+	for key, values := range targetValues {
+		if !slices.Equal(baseValues[key], values) {
+			changes = append(changes, RbPropertyChange{Key: key, BaseValues: baseValues[key], TargetValues: values})
+		}
+	}
+	for key, values := range baseValues {
+		if _, exists := targetValues[key]; !exists {
+			changes = append(changes, RbPropertyChange{Key: key, BaseValues: values})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+func propertiesToMap(properties []RbArtifactProperty) map[string][]string {
+	propertiesMap := make(map[string][]string, len(properties))
+	for _, property := range properties {
+		propertiesMap[property.Key] = append(propertiesMap[property.Key], property.Values...)
+	}
+	for _, values := range propertiesMap {
+		slices.Sort(values)
+	}
+	return propertiesMap
+}
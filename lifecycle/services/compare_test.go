@@ -0,0 +1,65 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffReleaseBundleArtifacts(t *testing.T) {
+	base := []RbArtifact{
+		{Path: "generic-local/unchanged.zip", Checksum: "1", Properties: []RbArtifactProperty{{Key: "env", Values: []string{"qa", "dev"}}}},
+		{Path: "generic-local/removed.zip", Checksum: "2"},
+		{Path: "generic-local/rebuilt.zip", Checksum: "3"},
+		{Path: "generic-local/relabeled.zip", Checksum: "4", Properties: []RbArtifactProperty{{Key: "env", Values: []string{"qa"}}, {Key: "old", Values: []string{"x"}}}},
+	}
+	target := []RbArtifact{
+		{Path: "generic-local/unchanged.zip", Checksum: "1", Properties: []RbArtifactProperty{{Key: "env", Values: []string{"dev", "qa"}}}},
+		{Path: "generic-local/rebuilt.zip", Checksum: "33"},
+		{Path: "generic-local/relabeled.zip", Checksum: "4", Properties: []RbArtifactProperty{{Key: "env", Values: []string{"prod"}}, {Key: "new", Values: []string{"y"}}}},
+		{Path: "generic-local/added.zip", Checksum: "5"},
+	}
+
+	report := DiffReleaseBundleArtifacts(base, target)
+	assert.False(t, report.IsEmpty())
+	assert.Equal(t, []RbArtifact{{Path: "generic-local/added.zip", Checksum: "5"}}, report.Added)
+	assert.Equal(t, []RbArtifact{{Path: "generic-local/removed.zip", Checksum: "2"}}, report.Removed)
+	assert.Equal(t, []RbArtifactChange{
+		{Path: "generic-local/rebuilt.zip", BaseChecksum: "3", TargetChecksum: "33"},
+		{Path: "generic-local/relabeled.zip", BaseChecksum: "4", TargetChecksum: "4", ChangedProperties: []RbPropertyChange{
+			{Key: "env", BaseValues: []string{"qa"}, TargetValues: []string{"prod"}},
+			{Key: "new", TargetValues: []string{"y"}},
+			{Key: "old", BaseValues: []string{"x"}},
+		}},
+	}, report.Changed)
+	assert.True(t, report.Changed[0].IsChecksumChanged())
+	assert.False(t, report.Changed[1].IsChecksumChanged())
+
+	assert.True(t, DiffReleaseBundleArtifacts(base, base).IsEmpty())
+}
+
+func TestCompareReleaseBundles(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/" + releaseBundleBaseApi + "/records/rb/1.0":
+			_, _ = w.Write([]byte(`{"artifacts":[{"path":"generic-local/a.zip","checksum":"1"}]}`))
+		case "/" + releaseBundleBaseApi + "/records/rb/2.0":
+			_, _ = w.Write([]byte(`{"artifacts":[{"path":"generic-local/a.zip","checksum":"2"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	rbs := createTestReleaseBundlesService(t, server.URL)
+
+	base, target := ReleaseBundleDetails{"rb", "1.0"}, ReleaseBundleDetails{"rb", "2.0"}
+	report, err := rbs.CompareReleaseBundles(base, target, "")
+	assert.NoError(t, err)
+	assert.Equal(t, base, report.Base)
+	assert.Equal(t, []RbArtifactChange{{Path: "generic-local/a.zip", BaseChecksum: "1", TargetChecksum: "2"}}, report.Changed)
+
+	_, err = rbs.CompareReleaseBundles(base, ReleaseBundleDetails{"rb", "3.0"}, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/distribution"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The number of artifacts in a page, if no limit is set.
+const defaultArtifactsPageLimit = 100
+
+type RbArtifactsQueryParams struct {
+	ProjectKey string
+	Offset     int
+	Limit      int
+}
+
+type RbArtifactsPage struct {
+	Artifacts []RbArtifact `json:"artifacts"`
+	Total     int          `json:"total"`
+	Offset    int          `json:"offset"`
+	Limit     int          `json:"limit"`
+}
+
+// Returns true if there are more artifacts after this page.
+func (page *RbArtifactsPage) HasMore() bool {
+	return page.Offset+len(page.Artifacts) < page.Total
+}
+
+// Returns a page of the artifacts of a Release Bundle version, ordered by their paths.
+// The records API returns all the artifacts, so the pages are cut client-side. Being ordered by path, they are stable
+// across calls, as the content of a Release Bundle version never changes.
+func (rbs *ReleaseBundlesService) ListReleaseBundleArtifacts(rbDetails ReleaseBundleDetails, queryParams RbArtifactsQueryParams) (RbArtifactsPage, error) {
+	if queryParams.Offset < 0 || queryParams.Limit < 0 {
+		return RbArtifactsPage{}, errorutils.CheckErrorf("the offset and the limit can't be negative, got offset %d and limit %d", queryParams.Offset, queryParams.Limit)
+	}
+	artifacts, err := rbs.getReleaseBundleArtifacts(rbDetails, queryParams.ProjectKey)
+	if err != nil {
+		return RbArtifactsPage{}, err
+	}
+	limit := queryParams.Limit
+	if limit == 0 {
+		limit = defaultArtifactsPageLimit
+	}
+	start := min(queryParams.Offset, len(artifacts))
+	end := min(start+limit, len(artifacts))
+	return RbArtifactsPage{Artifacts: artifacts[start:end], Total: len(artifacts), Offset: queryParams.Offset, Limit: limit}, nil
+}
+
+// Returns the metadata of the artifact in the given path of a Release Bundle version, including its checksum, size,
+// source repository and properties. Returns an error if the Release Bundle doesn't include the artifact.
+func (rbs *ReleaseBundlesService) GetReleaseBundleArtifact(rbDetails ReleaseBundleDetails, projectKey, artifactPath string) (*RbArtifact, error) {
+	artifacts, err := rbs.getReleaseBundleArtifacts(rbDetails, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	artifactPath = strings.TrimPrefix(artifactPath, "/")
+	index := sort.Search(len(artifacts), func(i int) bool { return artifacts[i].Path >= artifactPath })
+	if index == len(artifacts) || artifacts[index].Path != artifactPath {
+		return nil, errorutils.CheckErrorf("the Release Bundle %s/%s doesn't include the artifact '%s'", rbDetails.ReleaseBundleName, rbDetails.ReleaseBundleVersion, artifactPath)
+	}
+	return &artifacts[index], nil
+}
+
+func (rbs *ReleaseBundlesService) getReleaseBundleArtifacts(rbDetails ReleaseBundleDetails, projectKey string) ([]RbArtifact, error) {
+	requestFullUrl, err := utils.BuildUrl(rbs.GetLifecycleDetails().GetUrl(), GetReleaseBundleSpecificationRestApi(rbDetails), distribution.GetProjectQueryParam(projectKey))
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := rbs.GetLifecycleDetails().CreateHttpClientDetails()
+	resp, body, _, err := rbs.client.SendGet(requestFullUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Lifecycle response:", resp.Status)
+	var specResp ReleaseBundleSpecResponse
+	if err = json.Unmarshal(body, &specResp); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	sort.Slice(specResp.Artifacts, func(i, j int) bool { return specResp.Artifacts[i].Path < specResp.Artifacts[j].Path })
+	return specResp.Artifacts, nil
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListReleaseBundleArtifacts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+releaseBundleBaseApi+"/records/rb/1.0", r.URL.Path)
+		assert.Equal(t, "proj", r.URL.Query().Get("project"))
+		_, _ = w.Write([]byte(`{"artifacts":[
+			{"path":"generic-local/c.zip","checksum":"c3","source_repository_key":"generic-local","size":3},
+			{"path":"generic-local/a.zip","checksum":"a1","source_repository_key":"generic-local","size":1,"properties":[{"key":"env","values":["qa"]}]},
+			{"path":"docker-local/b/manifest.json","checksum":"b2","source_repository_key":"docker-local","package_type":"docker","size":2}
+		]}`))
+	}))
+	defer server.Close()
+	rbs := createTestReleaseBundlesService(t, server.URL)
+	rbDetails := ReleaseBundleDetails{ReleaseBundleName: "rb", ReleaseBundleVersion: "1.0"}
+
+	page, err := rbs.ListReleaseBundleArtifacts(rbDetails, RbArtifactsQueryParams{ProjectKey: "proj", Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, page.Total)
+	assert.Equal(t, []string{"docker-local/b/manifest.json", "generic-local/a.zip"}, []string{page.Artifacts[0].Path, page.Artifacts[1].Path})
+	assert.True(t, page.HasMore())
+
+	page, err = rbs.ListReleaseBundleArtifacts(rbDetails, RbArtifactsQueryParams{ProjectKey: "proj", Offset: 2, Limit: 2})
+	assert.NoError(t, err)
+	assert.Len(t, page.Artifacts, 1)
+	assert.Equal(t, "c3", page.Artifacts[0].Checksum)
+	assert.False(t, page.HasMore())
+
+	page, err = rbs.ListReleaseBundleArtifacts(rbDetails, RbArtifactsQueryParams{ProjectKey: "proj", Offset: 5})
+	assert.NoError(t, err)
+	assert.Empty(t, page.Artifacts)
+
+	artifact, err := rbs.GetReleaseBundleArtifact(rbDetails, "proj", "/generic-local/a.zip")
+	assert.NoError(t, err)
+	assert.Equal(t, "generic-local", artifact.SourceRepositoryKey)
+	assert.Equal(t, []RbArtifactProperty{{Key: "env", Values: []string{"qa"}}}, artifact.Properties)
+
+	_, err = rbs.GetReleaseBundleArtifact(rbDetails, "proj", "generic-local/missing.zip")
+	assert.ErrorContains(t, err, "doesn't include the artifact")
+}
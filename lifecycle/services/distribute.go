@@ -29,6 +29,8 @@ type DistributeReleaseBundleService struct {
 	MaxWaitMinutes   int
 	DistributeParams distribution.DistributionParams
 	ProjectKey       string
+	// Used to get the Distribution version, to validate the scheduling options against it.
+	DistributionDetails auth.ServiceDetails
 	Modifications
 }
 
@@ -39,6 +41,11 @@ type DistributeReleaseBundleParams struct {
 	DistributionRules []*distribution.DistributionCommonParams
 	PathMappings      []PathMapping
 	ProjectKey        string
+	// Optional.
+	Scheduling *distribution.DistributionScheduling
+	// The details of the Distribution service of the platform. Required if Scheduling is set, to validate the
+	// scheduling options against the Distribution version.
+	DistributionDetails auth.ServiceDetails
 }
 
 func (dr *DistributeReleaseBundleService) GetHttpClient() *jfroghttpclient.JfrogHttpClient {
@@ -77,6 +84,15 @@ func (dr *DistributeReleaseBundleService) GetProjectKey() string {
 	return dr.ProjectKey
 }
 
+// Called only if scheduling options are set. Since the options may be silently ignored by an older Distribution,
+// they aren't sent unless the Distribution version can be validated.
+func (dr *DistributeReleaseBundleService) GetDistributionVersion() (string, error) {
+	if dr.DistributionDetails == nil {
+		return "", errorutils.CheckErrorf("the Distribution details are required to validate the scheduling options against the Distribution version")
+	}
+	return dr.DistributionDetails.GetVersion()
+}
+
 func NewDistributeReleaseBundleService(client *jfroghttpclient.JfrogHttpClient) *DistributeReleaseBundleService {
 	return &DistributeReleaseBundleService{client: client}
 }
@@ -92,10 +108,12 @@ func (dr *DistributeReleaseBundleService) Distribute() error {
 }
 
 func (dr *DistributeReleaseBundleService) createDistributeBody() ReleaseBundleDistributeBody {
-	return ReleaseBundleDistributeBody{
+	body := ReleaseBundleDistributeBody{
 		ReleaseBundleDistributeV1Body: distribution.CreateDistributeV1Body(dr.DistributeParams.DistributionRules, dr.DryRun, dr.AutoCreateRepo),
 		Modifications:                 dr.Modifications,
 	}
+	body.Scheduling = dr.DistributeParams.Scheduling.ToBody()
+	return body
 }
 
 type ReleaseBundleDistributeBody struct {
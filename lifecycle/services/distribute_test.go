@@ -0,0 +1,50 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	distributionAuth "github.com/jfrog/jfrog-client-go/distribution/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/distribution"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistributeSchedulingValidatesDistributionVersion(t *testing.T) {
+	distributed := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		distributed = true
+		_, _ = w.Write([]byte(`{"id":"1"}`))
+	}))
+	defer server.Close()
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	assert.NoError(t, err)
+	distributeService := createTestDistributeService(t, client, server.URL)
+	distributeService.DistributeParams.Scheduling = &distribution.DistributionScheduling{StartTime: time.Now().Add(time.Hour), MaxBandwidthKbps: 1024}
+
+	// The scheduling options aren't sent unless the Distribution version is known.
+	assert.ErrorContains(t, distributeService.Distribute(), "Distribution details are required")
+	assert.False(t, distributed)
+
+	distributionDetails := distributionAuth.NewDistributionDetails()
+	distributionDetails.Version = "2.20.0"
+	distributeService.DistributionDetails = distributionDetails
+	var unsupportedErr *distribution.UnsupportedSchedulingOptionError
+	assert.ErrorAs(t, distributeService.Distribute(), &unsupportedErr)
+	assert.Equal(t, distribution.MinDistributionVersionBandwidthLimit, unsupportedErr.MinVersion)
+	assert.False(t, distributed)
+
+	distributionDetails.Version = "2.21.0"
+	assert.NoError(t, distributeService.Distribute())
+	assert.True(t, distributed)
+}
+
+func createTestDistributeService(t *testing.T, client *jfroghttpclient.JfrogHttpClient, serverUrl string) *DistributeReleaseBundleService {
+	distributeService := NewDistributeReleaseBundleService(client)
+	distributeService.LcDetails = createTestReleaseBundlesService(t, serverUrl).GetLifecycleDetails()
+	distributeService.DistributeParams = distribution.DistributionParams{Name: "rb", Version: "1.0",
+		DistributionRules: []*distribution.DistributionCommonParams{{SiteName: "*"}}}
+	return distributeService
+}
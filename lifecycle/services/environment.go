@@ -0,0 +1,201 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/distribution"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	environmentsApi = "api/v1/environments"
+	repositoriesApi = "api/repositories"
+)
+
+// The environments which exist by default. Custom environments can be created as well.
+const (
+	DevEnvironment  = "DEV"
+	QaEnvironment   = "QA"
+	ProdEnvironment = "PROD"
+)
+
+// Manages the environments which Release Bundles are promoted to, and the repositories assigned to each environment.
+// The environments are managed by Access, and the repositories by Artifactory, so their URLs are derived from the
+// Lifecycle URL, e.g. https://acme.jfrog.io/lifecycle/ -> https://acme.jfrog.io/access/.
+type EnvironmentsService struct {
+	client    *jfroghttpclient.JfrogHttpClient
+	lcDetails *auth.ServiceDetails
+}
+
+func NewEnvironmentsService(lcDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *EnvironmentsService {
+	return &EnvironmentsService{lcDetails: &lcDetails, client: client}
+}
+
+func (es *EnvironmentsService) GetLifecycleDetails() auth.ServiceDetails {
+	return *es.lcDetails
+}
+
+type Environment struct {
+	Name string `json:"name"`
+	// Empty for a global environment.
+	ProjectKey string `json:"project_key,omitempty"`
+}
+
+func (es *EnvironmentsService) getPlatformServiceUrl(service string) (string, error) {
+	return getPlatformServiceUrl(es.GetLifecycleDetails(), service)
+}
+
+// Returns the platform URL of the service with the given path, e.g. https://acme.jfrog.io/lifecycle/ -> https://acme.jfrog.io/access/.
+func getPlatformServiceUrl(lcDetails auth.ServiceDetails, service string) (string, error) {
+	lifecycleUrl := strings.TrimSuffix(lcDetails.GetUrl(), "/")
+	if !strings.HasSuffix(lifecycleUrl, "/lifecycle") {
+		return "", errorutils.CheckErrorf("couldn't derive the %s URL from the Lifecycle URL %s, which is expected to end with /lifecycle/", service, lcDetails.GetUrl())
+	}
+	return strings.TrimSuffix(lifecycleUrl, "lifecycle") + service + "/", nil
+}
+
+// Returns the global environments, and the environments of the project if projectKey is set.
+func (es *EnvironmentsService) GetAll(projectKey string) ([]Environment, error) {
+	accessUrl, err := es.getPlatformServiceUrl("access")
+	if err != nil {
+		return nil, err
+	}
+	requestFullUrl, err := utils.BuildUrl(accessUrl, environmentsApi, distribution.GetProjectQueryParam(projectKey))
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := es.GetLifecycleDetails().CreateHttpClientDetails()
+	resp, body, _, err := es.client.SendGet(requestFullUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Access response:", resp.Status)
+	var environments []Environment
+	err = json.Unmarshal(body, &environments)
+	return environments, errorutils.CheckError(err)
+}
+
+// Creates an environment. If ProjectKey is set, the environment is created in the project.
+func (es *EnvironmentsService) Create(environment Environment) error {
+	if environment.Name == "" {
+		return errorutils.CheckErrorf("an environment name is required")
+	}
+	content, err := json.Marshal(environment)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	return es.sendEnvironmentRequest(http.MethodPost, environmentsApi, content, http.StatusCreated, http.StatusOK)
+}
+
+func (es *EnvironmentsService) Rename(name, newName string) error {
+	content, err := json.Marshal(map[string]string{"new_name": newName})
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	return es.sendEnvironmentRequest(http.MethodPost, environmentsApi+"/"+url.PathEscape(name)+"/rename", content, http.StatusOK, http.StatusNoContent)
+}
+
+// Deletes an environment. Release Bundles which were promoted to it aren't deleted.
+func (es *EnvironmentsService) Delete(name string) error {
+	return es.sendEnvironmentRequest(http.MethodDelete, environmentsApi+"/"+url.PathEscape(name), nil, http.StatusOK, http.StatusNoContent)
+}
+
+func (es *EnvironmentsService) sendEnvironmentRequest(method, api string, content []byte, expectedStatusCodes ...int) error {
+	accessUrl, err := es.getPlatformServiceUrl("access")
+	if err != nil {
+		return err
+	}
+	httpClientsDetails := es.GetLifecycleDetails().CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	resp, body, _, err := es.client.Send(method, accessUrl+api, content, true, true, &httpClientsDetails, "")
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, expectedStatusCodes...); err != nil {
+		return err
+	}
+	log.Debug("Access response:", resp.Status)
+	return nil
+}
+
+// Returns an error if the environment doesn't exist, globally or in the project, so that a promotion to a misspelled
+// environment fails before it starts.
+func (es *EnvironmentsService) ValidatePromotionEnvironment(environment, projectKey string) error {
+	environments, err := es.GetAll(projectKey)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, existing := range environments {
+		if existing.Name == environment {
+			return nil
+		}
+		names = append(names, existing.Name)
+	}
+	return errorutils.CheckErrorf("the promotion target environment '%s' doesn't exist. Available environments: %s", environment, strings.Join(names, ", "))
+}
+
+// Returns the environments the repository is assigned to.
+func (es *EnvironmentsService) GetRepositoryEnvironments(repoKey string) ([]string, error) {
+	artifactoryUrl, err := es.getPlatformServiceUrl("artifactory")
+	if err != nil {
+		return nil, err
+	}
+	httpClientsDetails := es.GetLifecycleDetails().CreateHttpClientDetails()
+	resp, body, _, err := es.client.SendGet(artifactoryUrl+repositoriesApi+"/"+url.PathEscape(repoKey), true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	repository := repositoryEnvironments{}
+	err = json.Unmarshal(body, &repository)
+	return repository.Environments, errorutils.CheckError(err)
+}
+
+// Assigns the repository to the environments, replacing its current assignments.
+// Release Bundles promoted to an environment are copied to the repositories assigned to it.
+func (es *EnvironmentsService) SetRepositoryEnvironments(repoKey string, environments []string) error {
+	artifactoryUrl, err := es.getPlatformServiceUrl("artifactory")
+	if err != nil {
+		return err
+	}
+	if environments == nil {
+		environments = []string{}
+	}
+	content, err := json.Marshal(repositoryEnvironments{Key: repoKey, Environments: environments})
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpClientsDetails := es.GetLifecycleDetails().CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	log.Info(fmt.Sprintf("Assigning repository %s to the environments %s...", repoKey, strings.Join(environments, ", ")))
+	resp, body, err := es.client.SendPost(artifactoryUrl+repositoriesApi+"/"+url.PathEscape(repoKey), content, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	log.Debug("Artifactory response:", resp.Status)
+	return nil
+}
+
+type repositoryEnvironments struct {
+	Key string `json:"key,omitempty"`
+	// Not omitted when empty, so that all the assignments of the repository can be removed.
+	Environments []string `json:"environments"`
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironments(t *testing.T) {
+	environments := []Environment{{Name: DevEnvironment}, {Name: ProdEnvironment}}
+	repoEnvironments := []string{DevEnvironment}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /access/api/v1/environments":
+			assert.Equal(t, "proj", r.URL.Query().Get("project"))
+			assert.NoError(t, json.NewEncoder(w).Encode(environments))
+		case "POST /access/api/v1/environments":
+			environment := Environment{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&environment))
+			environments = append(environments, environment)
+			w.WriteHeader(http.StatusCreated)
+		case "DELETE /access/api/v1/environments/QA":
+			environments = environments[:len(environments)-1]
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /artifactory/api/repositories/generic-local":
+			assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{"key": "generic-local", "rclass": "local", "environments": repoEnvironments}))
+		case "POST /artifactory/api/repositories/generic-local":
+			body := repositoryEnvironments{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			repoEnvironments = body.Environments
+		default:
+			assert.Fail(t, "unexpected request", r.Method+" "+r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	rbs := createTestReleaseBundlesService(t, server.URL+"/lifecycle")
+	es := NewEnvironmentsService(rbs.GetLifecycleDetails(), rbs.client)
+
+	assert.ErrorContains(t, es.ValidatePromotionEnvironment(QaEnvironment, "proj"), "Available environments: DEV, PROD")
+	assert.NoError(t, es.Create(Environment{Name: QaEnvironment, ProjectKey: "proj"}))
+	assert.NoError(t, es.ValidatePromotionEnvironment(QaEnvironment, "proj"))
+	assert.Equal(t, "proj", environments[2].ProjectKey)
+	assert.NoError(t, es.Delete(QaEnvironment))
+	assert.Error(t, es.ValidatePromotionEnvironment(QaEnvironment, "proj"))
+
+	assert.NoError(t, es.SetRepositoryEnvironments("generic-local", []string{DevEnvironment, QaEnvironment}))
+	assigned, err := es.GetRepositoryEnvironments("generic-local")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{DevEnvironment, QaEnvironment}, assigned)
+}
+
+func TestEnvironmentsServiceUnexpectedUrl(t *testing.T) {
+	rbs := createTestReleaseBundlesService(t, "https://acme.jfrog.io/rb")
+	_, err := NewEnvironmentsService(rbs.GetLifecycleDetails(), rbs.client).GetAll("")
+	assert.ErrorContains(t, err, "expected to end with /lifecycle/")
+}
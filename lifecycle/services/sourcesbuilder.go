@@ -0,0 +1,171 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The maximum number of builds a build number range can add, to catch a mistyped range before it's sent.
+const maxBuildNumberRange = 1000
+
+// RbSourcesBuilder composes the sources of a Release Bundle, validates them client-side, and renders the creation
+// payload. Sources of the same type are merged into a single source, as expected by the creation API.
+// Usage:
+// sources, err := NewRbSourcesBuilder().AddBuildNumberRange("my-build", 10, 12, "", false).AddArtifacts("generic-local/app.zip").Build()
+type RbSourcesBuilder struct {
+	aql            []string
+	artifacts      []ArtifactSource
+	builds         []BuildSource
+	releaseBundles []ReleaseBundleSource
+	packages       []PackageSource
+	errs           []error
+}
+
+func NewRbSourcesBuilder() *RbSourcesBuilder {
+	return &RbSourcesBuilder{}
+}
+
+func (b *RbSourcesBuilder) addError(format string, args ...any) {
+	b.errs = append(b.errs, fmt.Errorf(format, args...))
+}
+
+func (b *RbSourcesBuilder) AddAql(aql string) *RbSourcesBuilder {
+	if strings.TrimSpace(aql) == "" {
+		b.addError("an AQL source can't be empty")
+		return b
+	}
+	b.aql = append(b.aql, aql)
+	return b
+}
+
+// Adds artifacts by their paths in Artifactory, in the form of "repo/path/to/file". Patterns aren't supported.
+func (b *RbSourcesBuilder) AddArtifacts(paths ...string) *RbSourcesBuilder {
+	for _, artifactPath := range paths {
+		b.AddArtifactWithChecksum(artifactPath, "")
+	}
+	return b
+}
+
+// Adds an artifact by its path, which is validated by Artifactory against its sha256 checksum.
+func (b *RbSourcesBuilder) AddArtifactWithChecksum(artifactPath, sha256 string) *RbSourcesBuilder {
+	switch {
+	case strings.ContainsAny(artifactPath, "*?"):
+		b.addError("the artifact path '%s' can't contain wildcards, use an AQL source instead", artifactPath)
+	case !strings.Contains(strings.Trim(artifactPath, "/"), "/"):
+		b.addError("the artifact path '%s' must be in the form of 'repo/path/to/file'", artifactPath)
+	default:
+		b.artifacts = append(b.artifacts, ArtifactSource{Path: strings.TrimPrefix(artifactPath, "/"), Sha256: sha256})
+	}
+	return b
+}
+
+func (b *RbSourcesBuilder) AddBuild(build BuildSource) *RbSourcesBuilder {
+	if build.BuildName == "" || build.BuildNumber == "" {
+		b.addError("a build source requires both a build name and a build number, got '%s/%s'", build.BuildName, build.BuildNumber)
+		return b
+	}
+	b.builds = append(b.builds, build)
+	return b
+}
+
+// Adds the builds with the numbers in the range from-to, inclusive.
+func (b *RbSourcesBuilder) AddBuildNumberRange(buildName string, from, to int, buildRepository string, includeDependencies bool) *RbSourcesBuilder {
+	if from > to {
+		b.addError("the build number range %d-%d of build '%s' is empty", from, to, buildName)
+		return b
+	}
+	if to-from >= maxBuildNumberRange {
+		b.addError("the build number range %d-%d of build '%s' includes more than %d builds", from, to, buildName, maxBuildNumberRange)
+		return b
+	}
+	for number := from; number <= to; number++ {
+		b.AddBuild(BuildSource{BuildName: buildName, BuildNumber: strconv.Itoa(number), BuildRepository: buildRepository, IncludeDependencies: includeDependencies})
+	}
+	return b
+}
+
+func (b *RbSourcesBuilder) AddReleaseBundle(name, version, projectKey string) *RbSourcesBuilder {
+	if name == "" || version == "" {
+		b.addError("a Release Bundle source requires both a name and a version, got '%s/%s'", name, version)
+		return b
+	}
+	b.releaseBundles = append(b.releaseBundles, ReleaseBundleSource{ReleaseBundleName: name, ReleaseBundleVersion: version, ProjectKey: projectKey})
+	return b
+}
+
+func (b *RbSourcesBuilder) AddPackage(pkg PackageSource) *RbSourcesBuilder {
+	if pkg.PackageName == "" || pkg.PackageVersion == "" || pkg.PackageType == "" || pkg.RepositoryKey == "" {
+		b.addError("a package source requires a name, a version, a type and a repository, got %+v", pkg)
+		return b
+	}
+	b.packages = append(b.packages, pkg)
+	return b
+}
+
+// Validates the sources, and returns them merged by type.
+func (b *RbSourcesBuilder) Build() ([]RbSource, error) {
+	errs := append([]error{}, b.errs...)
+	if len(b.aql) > 1 {
+		errs = append(errs, errors.New("only a single AQL source is allowed, combine the queries into one instead"))
+	}
+	errs = append(errs, findDuplicates("artifact", b.artifacts, func(artifact ArtifactSource) string { return artifact.Path })...)
+	errs = append(errs, findDuplicates("build", b.builds, func(build BuildSource) string { return build.BuildName + "/" + build.BuildNumber })...)
+	errs = append(errs, findDuplicates("Release Bundle", b.releaseBundles, func(rb ReleaseBundleSource) string {
+		return rb.ProjectKey + "/" + rb.ReleaseBundleName + "/" + rb.ReleaseBundleVersion
+	})...)
+	errs = append(errs, findDuplicates("package", b.packages, func(pkg PackageSource) string {
+		return pkg.RepositoryKey + "/" + pkg.PackageName + "/" + pkg.PackageVersion
+	})...)
+	if len(errs) > 0 {
+		return nil, errorutils.CheckError(errors.Join(errs...))
+	}
+
+	var sources []RbSource
+	if len(b.aql) > 0 {
+		sources = append(sources, NewAqlSource(b.aql[0]))
+	}
+	if len(b.artifacts) > 0 {
+		sources = append(sources, NewArtifactsSource(b.artifacts...))
+	}
+	if len(b.builds) > 0 {
+		sources = append(sources, NewBuildsSource(b.builds...))
+	}
+	if len(b.releaseBundles) > 0 {
+		sources = append(sources, NewReleaseBundlesSource(b.releaseBundles...))
+	}
+	if len(b.packages) > 0 {
+		sources = append(sources, NewPackagesSource(b.packages...))
+	}
+	if len(sources) == 0 {
+		return nil, errorutils.CheckErrorf("at least one source is required to create a Release Bundle")
+	}
+	return sources, nil
+}
+
+// Validates the sources, and returns the exact payload the Release Bundle would be created with.
+func (b *RbSourcesBuilder) RenderCreationPayload(rbDetails ReleaseBundleDetails) ([]byte, error) {
+	sources, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.MarshalIndent(RbCreationBody{ReleaseBundleDetails: rbDetails, Sources: sources}, "", "  ")
+	return content, errorutils.CheckError(err)
+}
+
+func findDuplicates[T any](sourceType string, items []T, key func(T) string) []error {
+	var errs []error
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		itemKey := key(item)
+		if seen[itemKey] {
+			errs = append(errs, fmt.Errorf("the %s '%s' was added more than once", sourceType, itemKey))
+		}
+		seen[itemKey] = true
+	}
+	return errs
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRbSourcesBuilder(t *testing.T) {
+	builder := NewRbSourcesBuilder().
+		AddBuildNumberRange("app", 10, 12, "", true).
+		AddArtifacts("generic-local/app.zip", "/generic-local/docs.zip").
+		AddReleaseBundle("base", "1.0", "proj").
+		AddAql(`items.find({"repo":"docker-local"})`)
+	sources, err := builder.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, []RbSource{
+		NewAqlSource(`items.find({"repo":"docker-local"})`),
+		NewArtifactsSource(ArtifactSource{Path: "generic-local/app.zip"}, ArtifactSource{Path: "generic-local/docs.zip"}),
+		NewBuildsSource(
+			BuildSource{BuildName: "app", BuildNumber: "10", IncludeDependencies: true},
+			BuildSource{BuildName: "app", BuildNumber: "11", IncludeDependencies: true},
+			BuildSource{BuildName: "app", BuildNumber: "12", IncludeDependencies: true},
+		),
+		NewReleaseBundlesSource(ReleaseBundleSource{ReleaseBundleName: "base", ReleaseBundleVersion: "1.0", ProjectKey: "proj"}),
+	}, sources)
+
+	payload, err := NewRbSourcesBuilder().AddArtifactWithChecksum("generic-local/app.zip", "abc").
+		RenderCreationPayload(ReleaseBundleDetails{ReleaseBundleName: "rb", ReleaseBundleVersion: "1.0"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"release_bundle_name":"rb","release_bundle_version":"1.0",
+		"sources":[{"source_type":"artifacts","artifacts":[{"path":"generic-local/app.zip","sha256":"abc"}]}]}`, string(payload))
+}
+
+func TestRbSourcesBuilderValidation(t *testing.T) {
+	_, err := NewRbSourcesBuilder().Build()
+	assert.ErrorContains(t, err, "at least one source is required")
+
+	_, err = NewRbSourcesBuilder().
+		AddAql("items.find()").
+		AddAql("items.find()").
+		AddArtifacts("generic-local/*.zip", "app.zip").
+		AddBuildNumberRange("app", 5, 1, "", false).
+		AddBuildNumberRange("app", 1, 2000, "", false).
+		AddBuild(BuildSource{BuildName: "app", BuildNumber: "1"}).
+		AddBuild(BuildSource{BuildName: "app", BuildNumber: "1"}).
+		AddBuild(BuildSource{BuildName: "app"}).
+		AddReleaseBundle("base", "", "").
+		AddPackage(PackageSource{PackageName: "lodash"}).
+		Build()
+	for _, expected := range []string{
+		"only a single AQL source",
+		"'generic-local/*.zip' can't contain wildcards",
+		"'app.zip' must be in the form of 'repo/path/to/file'",
+		"range 5-1 of build 'app' is empty",
+		"includes more than 1000 builds",
+		"the build 'app/1' was added more than once",
+		"requires both a build name and a build number",
+		"Release Bundle source requires both a name and a version",
+		"package source requires",
+	} {
+		assert.ErrorContains(t, err, expected)
+	}
+}
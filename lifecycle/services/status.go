@@ -206,20 +206,24 @@ type ReleaseBundleStatusResponse struct {
 }
 
 type ReleaseBundleSpecResponse struct {
-	CreatedBy     string    `json:"created_by,omitempty"`
-	Created       time.Time `json:"created"`
-	CreatedMillis int       `json:"created_millis,omitempty"`
-	Artifacts     []struct {
-		Path                string `json:"path,omitempty"`
-		Checksum            string `json:"checksum,omitempty"`
-		SourceRepositoryKey string `json:"source_repository_key,omitempty"`
-		PackageType         string `json:"package_type,omitempty"`
-		Size                int    `json:"size,omitempty"`
-		Properties          []struct {
-			Key    string   `json:"key"`
-			Values []string `json:"values"`
-		} `json:"properties,omitempty"`
-	} `json:"artifacts,omitempty"`
+	CreatedBy     string       `json:"created_by,omitempty"`
+	Created       time.Time    `json:"created"`
+	CreatedMillis int          `json:"created_millis,omitempty"`
+	Artifacts     []RbArtifact `json:"artifacts,omitempty"`
+}
+
+type RbArtifact struct {
+	Path                string               `json:"path,omitempty"`
+	Checksum            string               `json:"checksum,omitempty"`
+	SourceRepositoryKey string               `json:"source_repository_key,omitempty"`
+	PackageType         string               `json:"package_type,omitempty"`
+	Size                int                  `json:"size,omitempty"`
+	Properties          []RbArtifactProperty `json:"properties,omitempty"`
+}
+
+type RbArtifactProperty struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
 }
 
 type Message struct {
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The archive of an exported Release Bundle, downloaded for a transfer to a disconnected instance.
+type ReleaseBundleArchive struct {
+	Path             string
+	ChecksumManifest string
+	Sha256           string
+	Size             int64
+}
+
+// Exports the Release Bundle, with all its files, and downloads the archive to archivePath, along with its checksum manifest.
+// The archive can then be moved to a disconnected instance and imported there with ImportReleaseBundleArchive of the
+// Artifactory services manager.
+func (rbs *ReleaseBundlesService) ExportReleaseBundleArchive(rbDetails ReleaseBundleDetails, modifications Modifications, queryParams CommonOptionalQueryParams, archivePath string) (*ReleaseBundleArchive, error) {
+	exportResponse, err := rbs.ExportReleaseBundle(rbDetails, modifications, queryParams)
+	if err != nil {
+		return nil, err
+	}
+	if exportResponse.Status != ExportCompleted {
+		return nil, errorutils.CheckErrorf("export of Release Bundle %s/%s ended with status %s", rbDetails.ReleaseBundleName, rbDetails.ReleaseBundleVersion, exportResponse.Status)
+	}
+	return rbs.DownloadExportedReleaseBundle(exportResponse, archivePath)
+}
+
+// Downloads the archive of an exported Release Bundle to archivePath, and writes its checksum manifest next to it.
+// If the archive was partially downloaded before, the download is resumed from where it stopped, provided that the
+// server returns the checksum of the archive, to verify the resumed download with.
+func (rbs *ReleaseBundlesService) DownloadExportedReleaseBundle(exportResponse ReleaseBundleExportedStatusResponse, archivePath string) (*ReleaseBundleArchive, error) {
+	if exportResponse.DownloadUrl == "" {
+		return nil, errorutils.CheckErrorf("the exported Release Bundle has no download URL")
+	}
+	httpClientDetails := rbs.GetLifecycleDetails().CreateHttpClientDetails()
+	remoteDetails, _, err := rbs.client.GetRemoteFileDetails(exportResponse.DownloadUrl, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	// Without a checksum from the server, a partially or previously downloaded archive can't be verified, so the
+	// archive is downloaded in full.
+	canResume := remoteDetails.Checksum.Sha256 != ""
+	if !canResume {
+		log.Warn("The server didn't provide the checksum of the archive, so it's downloaded in full.")
+	}
+	if err = rbs.downloadArchive(exportResponse.DownloadUrl, archivePath, remoteDetails.Size, canResume); err != nil {
+		return nil, err
+	}
+	localDetails, err := fileutils.GetFileDetails(archivePath, true)
+	if err != nil {
+		return nil, err
+	}
+	if canResume && remoteDetails.Checksum.Sha256 != localDetails.Checksum.Sha256 {
+		// Remove the corrupted archive, so that the next attempt downloads it from scratch
+		return nil, errors.Join(errorutils.CheckErrorf("checksum mismatch of the downloaded archive %s: expected sha256 %s but got %s",
+			archivePath, remoteDetails.Checksum.Sha256, localDetails.Checksum.Sha256), errorutils.CheckError(os.Remove(archivePath)))
+	}
+	if remoteDetails.Size > 0 && remoteDetails.Size != localDetails.Size {
+		return nil, errors.Join(errorutils.CheckErrorf("size mismatch of the downloaded archive %s: expected %d bytes but got %d",
+			archivePath, remoteDetails.Size, localDetails.Size), errorutils.CheckError(os.Remove(archivePath)))
+	}
+	if err = fileutils.WriteChecksumManifest(archivePath, localDetails.Checksum.Sha256); err != nil {
+		return nil, err
+	}
+	log.Info(fmt.Sprintf("Downloaded the exported Release Bundle to %s", archivePath))
+	return &ReleaseBundleArchive{
+		Path:             archivePath,
+		ChecksumManifest: archivePath + fileutils.ChecksumManifestSuffix,
+		Sha256:           localDetails.Checksum.Sha256,
+		Size:             localDetails.Size,
+	}, nil
+}
+
+// If canResume is false, an existing archive is downloaded again from scratch.
+func (rbs *ReleaseBundlesService) downloadArchive(downloadUrl, archivePath string, remoteSize int64, canResume bool) (err error) {
+	var offset int64
+	if fileInfo, statErr := os.Stat(archivePath); statErr == nil && canResume {
+		offset = fileInfo.Size()
+	}
+	if remoteSize > 0 && offset == remoteSize {
+		log.Info(fmt.Sprintf("%s was already downloaded", archivePath))
+		return nil
+	}
+	if remoteSize > 0 && offset > remoteSize {
+		offset = 0
+	}
+	httpClientDetails := rbs.GetLifecycleDetails().CreateHttpClientDetails()
+	if offset > 0 {
+		log.Info(fmt.Sprintf("Resuming the download of %s from byte %d...", archivePath, offset))
+		httpClientDetails.AddHeader("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	} else {
+		log.Info(fmt.Sprintf("Downloading the exported Release Bundle to %s...", archivePath))
+	}
+	resp, _, _, err := rbs.client.Send(http.MethodGet, downloadUrl, nil, true, false, &httpClientDetails, "")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(resp.Body.Close()))
+	}()
+	flags := os.O_CREATE | os.O_WRONLY
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		flags |= os.O_APPEND
+	case http.StatusOK:
+		// The server doesn't support resuming, so the whole archive is downloaded again
+		flags |= os.O_TRUNC
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusPartialContent)
+	}
+	if err = fileutils.CreateDirIfNotExist(filepath.Dir(archivePath)); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(archivePath, flags, 0644)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(file.Close()))
+	}()
+	_, err = io.Copy(file, resp.Body)
+	return errorutils.CheckError(err)
+}
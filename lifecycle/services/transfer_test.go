@@ -0,0 +1,81 @@
+package services
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloadExportedReleaseBundleResume(t *testing.T) {
+	content := bytes.Repeat([]byte("release-bundle-archive"), 1000)
+	sum := sha256.Sum256(content)
+	sha256Hex := hex.EncodeToString(sum[:])
+	var ranges []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/artifactory/api/archive/rb.zip", r.URL.Path)
+		ranges = append(ranges, r.Header.Get("Range"))
+		w.Header().Set("X-Checksum-Sha256", sha256Hex)
+		http.ServeContent(w, r, "rb.zip", time.Time{}, bytes.NewReader(content))
+	}))
+	defer server.Close()
+	rbs := createTestReleaseBundlesService(t, server.URL)
+
+	// Simulate an interrupted download
+	archivePath := filepath.Join(t.TempDir(), "rb.zip")
+	assert.NoError(t, os.WriteFile(archivePath, content[:5000], 0644))
+
+	exportResponse := ReleaseBundleExportedStatusResponse{Status: ExportCompleted, DownloadUrl: server.URL + "/artifactory/api/archive/rb.zip"}
+	archive, err := rbs.DownloadExportedReleaseBundle(exportResponse, archivePath)
+	assert.NoError(t, err)
+	assert.Equal(t, sha256Hex, archive.Sha256)
+	assert.Equal(t, int64(len(content)), archive.Size)
+	// HEAD, and then the rest of the archive
+	assert.Equal(t, []string{"", "bytes=5000-"}, ranges)
+
+	downloaded, err := os.ReadFile(archivePath)
+	assert.NoError(t, err)
+	assert.Equal(t, content, downloaded)
+	manifest, err := os.ReadFile(archivePath + fileutils.ChecksumManifestSuffix)
+	assert.NoError(t, err)
+	assert.Equal(t, sha256Hex+"  rb.zip\n", string(manifest))
+
+	// Already downloaded
+	_, err = rbs.DownloadExportedReleaseBundle(exportResponse, archivePath)
+	assert.NoError(t, err)
+	assert.Len(t, ranges, 3)
+}
+
+func TestDownloadExportedReleaseBundleWithoutChecksum(t *testing.T) {
+	content := bytes.Repeat([]byte("release-bundle-archive"), 1000)
+	var ranges []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		http.ServeContent(w, r, "rb.zip", time.Time{}, bytes.NewReader(content))
+	}))
+	defer server.Close()
+	rbs := createTestReleaseBundlesService(t, server.URL)
+
+	// A corrupted archive of the same size isn't mistaken for a downloaded one.
+	archivePath := filepath.Join(t.TempDir(), "rb.zip")
+	assert.NoError(t, os.WriteFile(archivePath, bytes.Repeat([]byte("x"), len(content)), 0644))
+
+	exportResponse := ReleaseBundleExportedStatusResponse{Status: ExportCompleted, DownloadUrl: server.URL + "/artifactory/api/archive/rb.zip"}
+	archive, err := rbs.DownloadExportedReleaseBundle(exportResponse, archivePath)
+	assert.NoError(t, err)
+	// HEAD, and then the whole archive
+	assert.Equal(t, []string{"", ""}, ranges)
+	sum := sha256.Sum256(content)
+	assert.Equal(t, hex.EncodeToString(sum[:]), archive.Sha256)
+	downloaded, err := os.ReadFile(archivePath)
+	assert.NoError(t, err)
+	assert.Equal(t, content, downloaded)
+}
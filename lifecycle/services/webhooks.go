@@ -0,0 +1,291 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	subscriptionsApi = "api/v1/subscriptions"
+	// The header of the HMAC-SHA256 signature of the webhook request body, in hex.
+	WebhookSignatureHeader = "X-JFrog-Event-Auth"
+)
+
+type RbLifecycleEvent string
+
+const (
+	RbCreatedEvent     RbLifecycleEvent = "created"
+	RbSignedEvent      RbLifecycleEvent = "signed"
+	RbPromotedEvent    RbLifecycleEvent = "promoted"
+	RbDistributedEvent RbLifecycleEvent = "distributed"
+	RbFailedEvent      RbLifecycleEvent = "failed"
+)
+
+// The events of a single domain of the platform events, which a subscription is limited to.
+type eventDomain struct {
+	name       string
+	eventTypes map[RbLifecycleEvent][]string
+}
+
+// Release Bundles v2 are signed on creation, so only Release Bundles v1 send signed events.
+var rbEventDomains = []eventDomain{
+	{name: "release_bundle_v2", eventTypes: map[RbLifecycleEvent][]string{
+		RbCreatedEvent:  {"release_bundle_v2_completed"},
+		RbPromotedEvent: {"release_bundle_v2_promotion_completed"},
+		RbFailedEvent:   {"release_bundle_v2_failed", "release_bundle_v2_promotion_failed"},
+	}},
+	{name: "release_bundle", eventTypes: map[RbLifecycleEvent][]string{
+		RbCreatedEvent: {"created"},
+		RbSignedEvent:  {"signed"},
+	}},
+	{name: "distribution", eventTypes: map[RbLifecycleEvent][]string{
+		RbDistributedEvent: {"distribute_completed"},
+		RbFailedEvent:      {"distribute_failed"},
+	}},
+}
+
+var allRbLifecycleEvents = []RbLifecycleEvent{RbCreatedEvent, RbSignedEvent, RbPromotedEvent, RbDistributedEvent, RbFailedEvent}
+
+// Registers webhooks for the lifecycle events of Release Bundles. The platform splits the events between domains, and
+// a webhook is limited to a single domain, so a webhook is registered for each domain of the requested events.
+type WebhooksService struct {
+	client    *jfroghttpclient.JfrogHttpClient
+	lcDetails *auth.ServiceDetails
+}
+
+func NewWebhooksService(lcDetails auth.ServiceDetails, client *jfroghttpclient.JfrogHttpClient) *WebhooksService {
+	return &WebhooksService{lcDetails: &lcDetails, client: client}
+}
+
+func (ws *WebhooksService) GetLifecycleDetails() auth.ServiceDetails {
+	return *ws.lcDetails
+}
+
+type RbWebhookParams struct {
+	// The webhook of each domain is registered with the key as a prefix, e.g. "my-key-distribution".
+	Key         string
+	Description string
+	// The URL the events are posted to.
+	Url string
+	// Signs the events, so they can be verified by VerifyWebhookSignature.
+	Secret string
+	// Custom headers added to each request, for example an authorization token.
+	Headers map[string]string
+	// The events to notify about. All the events if empty.
+	Events []RbLifecycleEvent
+	// Limits the events to these Release Bundles. All the Release Bundles if empty.
+	ReleaseBundleNames []string
+}
+
+func (wp *RbWebhookParams) validate() error {
+	if wp.Key == "" || wp.Url == "" {
+		return errorutils.CheckErrorf("a webhook key and a URL are required")
+	}
+	if wp.Secret == "" {
+		return errorutils.CheckErrorf("a secret is required for webhook '%s', so that its events can be verified", wp.Key)
+	}
+	for _, event := range wp.Events {
+		if !slices.Contains(allRbLifecycleEvents, event) {
+			return errorutils.CheckErrorf("unknown Release Bundle lifecycle event '%s'", event)
+		}
+	}
+	return nil
+}
+
+type subscription struct {
+	Key         string                `json:"key"`
+	Description string                `json:"description,omitempty"`
+	Enabled     bool                  `json:"enabled"`
+	EventFilter subscriptionFilter    `json:"event_filter"`
+	Handlers    []subscriptionHandler `json:"handlers"`
+}
+
+type subscriptionFilter struct {
+	Domain     string               `json:"domain"`
+	EventTypes []string             `json:"event_types"`
+	Criteria   subscriptionCriteria `json:"criteria"`
+}
+
+type subscriptionCriteria struct {
+	AnyReleaseBundle       bool     `json:"anyReleaseBundle"`
+	SelectedReleaseBundles []string `json:"selectedReleaseBundles"`
+}
+
+type subscriptionHandler struct {
+	HandlerType         string         `json:"handler_type"`
+	Url                 string         `json:"url"`
+	Secret              string         `json:"secret"`
+	UseSecretForSigning bool           `json:"use_secret_for_signing"`
+	CustomHttpHeaders   []customHeader `json:"custom_http_headers,omitempty"`
+}
+
+type customHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Registers the webhooks of the events, and returns their keys.
+func (ws *WebhooksService) SubscribeToReleaseBundleEvents(params RbWebhookParams) ([]string, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+	events := params.Events
+	if len(events) == 0 {
+		events = allRbLifecycleEvents
+	}
+	var keys []string
+	for _, domain := range rbEventDomains {
+		eventTypes := domain.getEventTypes(events)
+		if len(eventTypes) == 0 {
+			continue
+		}
+		newSubscription := params.toSubscription(domain.name, eventTypes)
+		if err := ws.createSubscription(newSubscription); err != nil {
+			return keys, err
+		}
+		keys = append(keys, newSubscription.Key)
+	}
+	return keys, nil
+}
+
+func (domain *eventDomain) getEventTypes(events []RbLifecycleEvent) []string {
+	var eventTypes []string
+	for _, event := range events {
+		eventTypes = append(eventTypes, domain.eventTypes[event]...)
+	}
+	return eventTypes
+}
+
+func (wp *RbWebhookParams) toSubscription(domain string, eventTypes []string) subscription {
+	handler := subscriptionHandler{HandlerType: "webhook", Url: wp.Url, Secret: wp.Secret, UseSecretForSigning: true}
+	for name, value := range wp.Headers {
+		handler.CustomHttpHeaders = append(handler.CustomHttpHeaders, customHeader{Name: name, Value: value})
+	}
+	slices.SortFunc(handler.CustomHttpHeaders, func(a, b customHeader) int { return strings.Compare(a.Name, b.Name) })
+	releaseBundleNames := wp.ReleaseBundleNames
+	if releaseBundleNames == nil {
+		releaseBundleNames = []string{}
+	}
+	return subscription{
+		Key:         wp.Key + "-" + domain,
+		Description: wp.Description,
+		Enabled:     true,
+		EventFilter: subscriptionFilter{
+			Domain:     domain,
+			EventTypes: eventTypes,
+			Criteria:   subscriptionCriteria{AnyReleaseBundle: len(wp.ReleaseBundleNames) == 0, SelectedReleaseBundles: releaseBundleNames},
+		},
+		Handlers: []subscriptionHandler{handler},
+	}
+}
+
+func (ws *WebhooksService) createSubscription(newSubscription subscription) error {
+	eventUrl, err := getPlatformServiceUrl(ws.GetLifecycleDetails(), "event")
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(newSubscription)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpClientsDetails := ws.GetLifecycleDetails().CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	log.Info(fmt.Sprintf("Registering webhook %s...", newSubscription.Key))
+	resp, body, err := ws.client.SendPost(eventUrl+subscriptionsApi, content, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated); err != nil {
+		return err
+	}
+	log.Debug("Event service response:", resp.Status)
+	return nil
+}
+
+// Deletes the webhooks which were registered with the key. Webhooks which don't exist are ignored.
+func (ws *WebhooksService) UnsubscribeFromReleaseBundleEvents(key string) error {
+	eventUrl, err := getPlatformServiceUrl(ws.GetLifecycleDetails(), "event")
+	if err != nil {
+		return err
+	}
+	httpClientsDetails := ws.GetLifecycleDetails().CreateHttpClientDetails()
+	for _, domain := range rbEventDomains {
+		resp, body, err := ws.client.SendDelete(eventUrl+subscriptionsApi+"/"+url.PathEscape(key+"-"+domain.name), nil, &httpClientsDetails)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent); err != nil {
+			return err
+		}
+		log.Debug("Event service response:", resp.Status)
+	}
+	return nil
+}
+
+// Verifies that the body of a webhook request was signed with the secret of the webhook.
+// signature is the value of the WebhookSignatureHeader header.
+func VerifyWebhookSignature(secret string, body []byte, signature string) error {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || signature == "" {
+		return errorutils.CheckErrorf("the webhook signature is missing or malformed")
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return errorutils.CheckErrorf("the webhook signature doesn't match its body")
+	}
+	return nil
+}
+
+type RbWebhookEvent struct {
+	SubscriptionKey string          `json:"subscription_key,omitempty"`
+	Domain          string          `json:"domain"`
+	EventType       string          `json:"event_type"`
+	JpdOrigin       string          `json:"jpd_origin,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+}
+
+// Returns the lifecycle event the platform event was registered for, or an empty event if it's unknown.
+func (e *RbWebhookEvent) LifecycleEvent() RbLifecycleEvent {
+	for _, domain := range rbEventDomains {
+		if domain.name != e.Domain {
+			continue
+		}
+		for event, eventTypes := range domain.eventTypes {
+			if slices.Contains(eventTypes, e.EventType) {
+				return event
+			}
+		}
+	}
+	return ""
+}
+
+// Reads an incoming webhook request, and returns its event if its signature is valid.
+// Use it in the handler of the webhook URL.
+func VerifyWebhookRequest(r *http.Request, secret string) (*RbWebhookEvent, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if err = VerifyWebhookSignature(secret, body, r.Header.Get(WebhookSignatureHeader)); err != nil {
+		return nil, err
+	}
+	event := &RbWebhookEvent{}
+	return event, errorutils.CheckError(json.Unmarshal(body, event))
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscribeToReleaseBundleEvents(t *testing.T) {
+	var created []subscription
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/event/"+subscriptionsApi, r.URL.Path)
+			newSubscription := subscription{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&newSubscription))
+			created = append(created, newSubscription)
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			if r.URL.Path == "/event/"+subscriptionsApi+"/orchestrator-release_bundle" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	assert.NoError(t, err)
+	lcDetails := auth.NewArtifactoryDetails()
+	lcDetails.SetUrl(server.URL + "/lifecycle/")
+	webhooksService := NewWebhooksService(lcDetails, client)
+
+	params := RbWebhookParams{
+		Key:                "orchestrator",
+		Url:                "https://orchestrator.example.com/events",
+		Secret:             "secret",
+		Headers:            map[string]string{"X-Team": "release"},
+		Events:             []RbLifecycleEvent{RbPromotedEvent, RbFailedEvent},
+		ReleaseBundleNames: []string{"my-app"},
+	}
+	keys, err := webhooksService.SubscribeToReleaseBundleEvents(params)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"orchestrator-release_bundle_v2", "orchestrator-distribution"}, keys)
+	assert.Equal(t, []string{"release_bundle_v2_promotion_completed", "release_bundle_v2_failed", "release_bundle_v2_promotion_failed"}, created[0].EventFilter.EventTypes)
+	assert.Equal(t, []string{"distribute_failed"}, created[1].EventFilter.EventTypes)
+	assert.Equal(t, subscriptionCriteria{SelectedReleaseBundles: []string{"my-app"}}, created[0].EventFilter.Criteria)
+	assert.Equal(t, []customHeader{{Name: "X-Team", Value: "release"}}, created[0].Handlers[0].CustomHttpHeaders)
+	assert.True(t, created[0].Handlers[0].UseSecretForSigning)
+
+	_, err = webhooksService.SubscribeToReleaseBundleEvents(RbWebhookParams{Key: "no-secret", Url: "https://example.com"})
+	assert.ErrorContains(t, err, "a secret is required")
+	_, err = webhooksService.SubscribeToReleaseBundleEvents(RbWebhookParams{Key: "key", Url: "https://example.com", Secret: "secret", Events: []RbLifecycleEvent{"archived"}})
+	assert.ErrorContains(t, err, "unknown Release Bundle lifecycle event")
+
+	assert.NoError(t, webhooksService.UnsubscribeFromReleaseBundleEvents("orchestrator"))
+	assert.Len(t, deleted, len(rbEventDomains))
+}
+
+func TestVerifyWebhookRequest(t *testing.T) {
+	body := []byte(`{"subscription_key":"orchestrator-distribution","domain":"distribution","event_type":"distribute_completed","data":{"release_bundle_name":"my-app"}}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(body)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	request := httptest.NewRequest(http.MethodPost, "/events", bytes.NewReader(body))
+	request.Header.Set(WebhookSignatureHeader, signature)
+	event, err := VerifyWebhookRequest(request, "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, RbDistributedEvent, event.LifecycleEvent())
+	assert.JSONEq(t, `{"release_bundle_name":"my-app"}`, string(event.Data))
+
+	assert.ErrorContains(t, VerifyWebhookSignature("other-secret", body, signature), "doesn't match")
+	assert.ErrorContains(t, VerifyWebhookSignature("secret", body, ""), "missing or malformed")
+	assert.Equal(t, RbLifecycleEvent(""), (&RbWebhookEvent{Domain: "artifact", EventType: "deployed"}).LifecycleEvent())
+}
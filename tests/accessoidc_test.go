@@ -0,0 +1,91 @@
+//go:build itest
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/access/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessOidcIntegrations(t *testing.T) {
+	initAccessTest(t)
+	integrationName := getUniqueField("oidc")
+	integration := services.OidcIntegration{
+		Name:         integrationName,
+		ProviderType: services.GitHubOidcProvider,
+		IssuerUrl:    "https://token.actions.githubusercontent.com",
+		Description:  "jfrog-client-go test",
+		Audience:     "jfrog-github",
+	}
+	require.NoError(t, testsAccessOidcService.CreateIntegration(integration))
+	defer func() {
+		assert.NoError(t, testsAccessOidcService.DeleteIntegration(integrationName))
+		deleted, err := testsAccessOidcService.GetIntegration(integrationName)
+		assert.NoError(t, err)
+		assert.Nil(t, deleted)
+	}()
+
+	created, err := testsAccessOidcService.GetIntegration(integrationName)
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.Equal(t, integration.IssuerUrl, created.IssuerUrl)
+	assert.Equal(t, integration.Audience, created.Audience)
+
+	integration.Description = "jfrog-client-go updated test"
+	require.NoError(t, testsAccessOidcService.UpdateIntegration(integration))
+	updated, err := testsAccessOidcService.GetIntegration(integrationName)
+	require.NoError(t, err)
+	assert.Equal(t, integration.Description, updated.Description)
+
+	integrations, err := testsAccessOidcService.GetIntegrations()
+	require.NoError(t, err)
+	assert.Contains(t, getOidcIntegrationNames(integrations), integrationName)
+
+	t.Run("identityMappings", func(t *testing.T) {
+		testAccessOidcIdentityMappings(t, integrationName)
+	})
+}
+
+func testAccessOidcIdentityMappings(t *testing.T, integrationName string) {
+	mapping := services.OidcIdentityMapping{
+		Name:     "main-branch",
+		Priority: 1,
+		Claims:   map[string]any{"repository": "jfrog/jfrog-client-go", "ref": "refs/heads/main"},
+		TokenSpec: services.OidcTokenSpec{
+			Scope: services.NewGroupsTokenScope("readers"),
+		},
+	}
+	require.NoError(t, testsAccessOidcService.CreateIdentityMapping(integrationName, mapping))
+
+	created, err := testsAccessOidcService.GetIdentityMapping(integrationName, mapping.Name)
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.Equal(t, mapping.Claims, created.Claims)
+	assert.Equal(t, mapping.TokenSpec.Scope, created.TokenSpec.Scope)
+
+	mapping.Priority = 2
+	require.NoError(t, testsAccessOidcService.UpdateIdentityMapping(integrationName, mapping))
+	mappings, err := testsAccessOidcService.GetIdentityMappings(integrationName)
+	require.NoError(t, err)
+	require.Len(t, mappings, 1)
+	assert.Equal(t, 2, mappings[0].Priority)
+
+	// A mapping without claims matches any identity, so it's rejected
+	assert.Error(t, testsAccessOidcService.CreateIdentityMapping(integrationName, services.OidcIdentityMapping{Name: "any", TokenSpec: mapping.TokenSpec}))
+
+	require.NoError(t, testsAccessOidcService.DeleteIdentityMapping(integrationName, mapping.Name))
+	deleted, err := testsAccessOidcService.GetIdentityMapping(integrationName, mapping.Name)
+	require.NoError(t, err)
+	assert.Nil(t, deleted)
+}
+
+func getOidcIntegrationNames(integrations []services.OidcIntegration) []string {
+	var names []string
+	for _, integration := range integrations {
+		names = append(names, integration.Name)
+	}
+	return names
+}
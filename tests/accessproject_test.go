@@ -27,6 +27,113 @@ func TestAccessProjectGroups(t *testing.T) {
 	t.Run("groups-add-get-delete", testAccessProjectAddGetDeleteGroups)
 }
 
+func TestAccessProjectRolesAndMemberships(t *testing.T) {
+	initAccessTest(t)
+	t.Run("roles-create-update-delete", testAccessProjectCreateUpdateDeleteRole)
+	t.Run("memberships", testAccessProjectMemberships)
+}
+
+func TestAccessProjectRepos(t *testing.T) {
+	initAccessTest(t)
+	sourceProjectKey := createRandomProject(t).ProjectDetails.ProjectKey
+	targetProjectKey := createRandomProject(t).ProjectDetails.ProjectKey
+	repoKey := GenerateRepoKeyForRepoServiceTest()
+	glp := rtservices.NewGenericLocalRepositoryParams()
+	glp.Key = repoKey
+	require.NoError(t, testsCreateLocalRepositoryService.Generic(glp))
+	deleteRepoOnTestDone(t, repoKey)
+	require.NoError(t, testsAccessProjectService.AssignRepo(repoKey, sourceProjectKey, true))
+
+	require.NoError(t, testsAccessProjectService.ShareRepo(repoKey, targetProjectKey, true))
+	status, err := testsAccessProjectService.GetRepoProjectStatus(repoKey)
+	require.NoError(t, err)
+	assert.Equal(t, sourceProjectKey, status.AssignedTo)
+	assert.Contains(t, status.SharedWithProjects, targetProjectKey)
+
+	movedFrom, err := testsAccessProjectService.MoveRepo(repoKey, targetProjectKey)
+	require.NoError(t, err)
+	assert.Equal(t, sourceProjectKey, movedFrom)
+	status, err = testsAccessProjectService.GetRepoProjectStatus(repoKey)
+	require.NoError(t, err)
+	assert.Equal(t, targetProjectKey, status.AssignedTo)
+	assert.NotContains(t, status.SharedWithProjects, targetProjectKey)
+
+	repos, err := testsAccessProjectService.ListRepos(targetProjectKey)
+	require.NoError(t, err)
+	var repoKeys []string
+	for _, repo := range repos {
+		repoKeys = append(repoKeys, repo.Key)
+	}
+	assert.Contains(t, repoKeys, repoKey)
+	assert.NoError(t, testsAccessProjectService.UnassignRepo(repoKey))
+}
+
+func testAccessProjectCreateUpdateDeleteRole(t *testing.T) {
+	projectKey := createRandomProject(t).ProjectDetails.ProjectKey
+	role := services.ProjectRole{
+		Name:         "deployers",
+		Environments: []string{"DEV"},
+		Actions:      []string{"READ_REPOSITORY", "DEPLOY_CACHE_REPOSITORY"},
+	}
+	require.NoError(t, testsAccessProjectService.CreateRole(projectKey, role))
+
+	role.Environments = []string{"DEV", "PROD"}
+	require.NoError(t, testsAccessProjectService.UpdateRole(projectKey, role))
+	createdRole, err := testsAccessProjectService.GetRole(projectKey, role.Name)
+	require.NoError(t, err)
+	require.NotNil(t, createdRole)
+	assert.Equal(t, services.CustomRole, createdRole.Type)
+	assert.ElementsMatch(t, role.Environments, createdRole.Environments)
+
+	roles, err := testsAccessProjectService.GetRoles(projectKey)
+	require.NoError(t, err)
+	assert.Contains(t, getRoleNames(roles), role.Name)
+
+	require.NoError(t, testsAccessProjectService.DeleteRole(projectKey, role.Name))
+	deletedRole, err := testsAccessProjectService.GetRole(projectKey, role.Name)
+	assert.NoError(t, err)
+	assert.Nil(t, deletedRole)
+}
+
+func getRoleNames(roles []services.ProjectRole) []string {
+	var names []string
+	for _, role := range roles {
+		names = append(names, role.Name)
+	}
+	return names
+}
+
+func testAccessProjectMemberships(t *testing.T) {
+	projectKey := createRandomProject(t).ProjectDetails.ProjectKey
+	username := createRandomUser(t)
+	defer deleteUserAndAssert(t, username)
+	testGroup := getTestProjectGroupParams("a-test-membership-group")
+	createGroup(t, testGroup.Name, false, false)
+	require.NoError(t, testsAccessProjectService.UpdateGroup(projectKey, testGroup.Name, testGroup))
+
+	projectUser := services.ProjectUser{Name: username, Roles: []string{"Developer"}}
+	require.NoError(t, testsAccessProjectService.UpdateUser(projectKey, username, projectUser))
+	user, err := testsAccessProjectService.GetUser(projectKey, username)
+	require.NoError(t, err)
+	assert.Equal(t, &projectUser, user)
+
+	params := services.NewListProjectMembershipsParams(projectKey)
+	params.Limit = 1
+	firstPage, err := testsAccessProjectService.ListMemberships(params)
+	require.NoError(t, err)
+	assert.Equal(t, []services.ProjectMembership{{Name: username, Type: services.UserMember, Roles: projectUser.Roles}}, firstPage.Members)
+	params.Cursor = firstPage.NextCursor
+	secondPage, err := testsAccessProjectService.ListMemberships(params)
+	require.NoError(t, err)
+	assert.Equal(t, []services.ProjectMembership{{Name: testGroup.Name, Type: services.GroupMember, Roles: testGroup.Roles}}, secondPage.Members)
+	assert.Empty(t, secondPage.NextCursor)
+
+	require.NoError(t, testsAccessProjectService.DeleteExistingUser(projectKey, username))
+	users, err := testsAccessProjectService.GetUsers(projectKey)
+	require.NoError(t, err)
+	assert.Empty(t, users)
+}
+
 func testAccessProjectAddGetDeleteGroups(t *testing.T) {
 	projectKey := createRandomProject(t).ProjectDetails.ProjectKey
 
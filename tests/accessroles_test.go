@@ -0,0 +1,56 @@
+//go:build itest
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/access/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessGlobalRoles(t *testing.T) {
+	initAccessTest(t)
+	role := services.GlobalRole{
+		Name:         getUniqueField("global-role"),
+		Description:  "jfrog-client-go test",
+		Environments: []string{"DEV"},
+		Actions:      []string{"READ_REPOSITORY"},
+	}
+	require.NoError(t, testsAccessRolesService.CreateRole(role))
+	defer func() {
+		assert.NoError(t, testsAccessRolesService.DeleteRole(role.Name))
+		deleted, err := testsAccessRolesService.GetRole(role.Name)
+		assert.NoError(t, err)
+		assert.Nil(t, deleted)
+	}()
+
+	created, err := testsAccessRolesService.GetRole(role.Name)
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.Equal(t, services.CustomGlobalRole, created.Type)
+	assert.ElementsMatch(t, role.Actions, created.Actions)
+
+	role.Actions = append(role.Actions, "ANNOTATE_REPOSITORY")
+	require.NoError(t, testsAccessRolesService.UpdateRole(role))
+	updated, err := testsAccessRolesService.GetRole(role.Name)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, role.Actions, updated.Actions)
+
+	// A predefined role can't be created
+	assert.Error(t, testsAccessRolesService.CreateRole(services.GlobalRole{Name: "other", Type: services.PredefinedGlobalRole, Environments: role.Environments, Actions: role.Actions}))
+
+	// Assign to a user
+	username := createRandomUser(t)
+	defer deleteUserAndAssert(t, username)
+	require.NoError(t, testsAccessRolesService.AssignRole(role.Name, services.UserMember, username))
+	assignments, err := testsAccessRolesService.GetRoleAssignments(role.Name)
+	require.NoError(t, err)
+	assert.Contains(t, assignments, services.RoleAssignment{Name: username, Type: services.UserMember})
+
+	require.NoError(t, testsAccessRolesService.UnassignRole(role.Name, services.UserMember, username))
+	assignments, err = testsAccessRolesService.GetRoleAssignments(role.Name)
+	require.NoError(t, err)
+	assert.NotContains(t, assignments, services.RoleAssignment{Name: username, Type: services.UserMember})
+}
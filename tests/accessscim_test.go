@@ -0,0 +1,64 @@
+//go:build itest
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/access/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessScim(t *testing.T) {
+	initAccessTest(t)
+	username := getUniqueField("scim-user")
+	created, err := testsAccessScimService.CreateUser(services.NewScimUser(username, username+"@jfrog.com", true))
+	require.NoError(t, err)
+	assert.Equal(t, username, created.UserName)
+	defer func() {
+		assert.NoError(t, testsAccessScimService.DeleteUser(username))
+		deleted, err := testsAccessScimService.GetUser(username)
+		assert.NoError(t, err)
+		assert.Nil(t, deleted)
+	}()
+
+	// Find the user by filter
+	users, err := testsAccessScimService.ListUsers(services.ScimListParams{Filter: services.NewScimEqualsFilter("userName", username)})
+	require.NoError(t, err)
+	require.Len(t, users.Resources, 1)
+	assert.Equal(t, username, users.Resources[0].UserName)
+
+	// Deactivate and reactivate
+	require.NoError(t, testsAccessScimService.DeactivateUser(username))
+	assertScimUserActive(t, username, false)
+	require.NoError(t, testsAccessScimService.ActivateUser(username))
+	assertScimUserActive(t, username, true)
+
+	// Sync a group
+	groupName := getUniqueField("scim-group")
+	_, err = testsAccessScimService.CreateGroup(services.NewScimGroup(groupName))
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, testsAccessScimService.DeleteGroup(groupName))
+	}()
+	require.NoError(t, testsAccessScimService.SyncGroupMembers(groupName, []string{username}))
+	group, err := testsAccessScimService.GetGroup(groupName)
+	require.NoError(t, err)
+	require.NotNil(t, group)
+	require.Len(t, group.Members, 1)
+	assert.Equal(t, username, group.Members[0].Value)
+
+	require.NoError(t, testsAccessScimService.SyncGroupMembers(groupName, nil))
+	group, err = testsAccessScimService.GetGroup(groupName)
+	require.NoError(t, err)
+	assert.Empty(t, group.Members)
+}
+
+func assertScimUserActive(t *testing.T, username string, expected bool) {
+	user, err := testsAccessScimService.GetUser(username)
+	require.NoError(t, err)
+	require.NotNil(t, user)
+	require.NotNil(t, user.Active)
+	assert.Equal(t, expected, *user.Active)
+}
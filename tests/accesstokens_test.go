@@ -4,10 +4,12 @@ package tests
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	accessAuth "github.com/jfrog/jfrog-client-go/access/auth"
 	"github.com/jfrog/jfrog-client-go/access/services"
@@ -26,6 +28,7 @@ func TestAccessTokens(t *testing.T) {
 	t.Run("createAccessTokenWithReference", testAccessTokenWithReference)
 	t.Run("refreshToken", testRefreshTokenTest)
 	t.Run("exchangeOIDCToken", testExchangeOidcToken)
+	t.Run("listAndRevokeTokens", testListAndRevokeTokens)
 }
 
 // This test uses a mock response because the subject_token (TokenID) is not available in the test environment
@@ -158,6 +161,41 @@ func testRefreshTokenTest(t *testing.T) {
 	assert.Empty(t, token.ReferenceToken)
 }
 
+func testListAndRevokeTokens(t *testing.T) {
+	description := fmt.Sprintf("jfrog-client-go-test-%d", time.Now().UnixNano())
+	tokenParams := createRefreshableAccessTokenParams(3600)
+	tokenParams.Description = description
+	token, err := testsAccessTokensService.CreateAccessToken(tokenParams)
+	require.NoError(t, err)
+	require.NotEmpty(t, token.TokenId)
+
+	// List by description
+	listParams := services.ListTokensParams{DescriptionContains: description}
+	tokens, err := testsAccessTokensService.GetTokens(listParams)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, token.TokenId, tokens[0].TokenId)
+	assert.False(t, tokens[0].ExpiryTime().IsZero())
+
+	// Get token metadata
+	tokenInfo, err := testsAccessTokensService.GetToken(token.TokenId)
+	require.NoError(t, err)
+	require.NotNil(t, tokenInfo)
+	assert.Equal(t, description, tokenInfo.Description)
+
+	// Revoking without criteria is not allowed
+	_, err = testsAccessTokensService.RevokeTokens(services.ListTokensParams{})
+	assert.Error(t, err)
+
+	// Revoke by criteria
+	revoked, err := testsAccessTokensService.RevokeTokens(listParams)
+	require.NoError(t, err)
+	assert.Equal(t, []string{token.TokenId}, revoked)
+	tokenInfo, err = testsAccessTokensService.GetToken(token.TokenId)
+	require.NoError(t, err)
+	assert.Nil(t, tokenInfo)
+}
+
 func createRefreshableAccessTokenParams(expiredIn uint) services.CreateTokenParams {
 	tokenParams := services.CreateTokenParams{}
 	tokenParams.ExpiresIn = &expiredIn
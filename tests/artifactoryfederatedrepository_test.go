@@ -38,6 +38,7 @@ func TestArtifactoryFederatedRepository(t *testing.T) {
 	t.Run("federatedGoTest", federatedGoTest)
 	t.Run("federatedGradleTest", federatedGradleTest)
 	t.Run("federatedHelmTest", federatedHelmTest)
+	t.Run("federatedHuggingFaceMlTest", federatedHuggingFaceMlTest)
 	t.Run("federatedIvyTest", federatedIvyTest)
 	t.Run("federatedMavenTest", federatedMavenTest)
 	t.Run("federatedNpmTest", federatedNpmTest)
@@ -438,6 +439,27 @@ func federatedHelmTest(t *testing.T) {
 	}
 }
 
+func federatedHuggingFaceMlTest(t *testing.T) {
+	repoKey := GenerateRepoKeyForRepoServiceTest()
+	hfp := services.NewHuggingFaceMlFederatedRepositoryParams()
+	hfp.Key = repoKey
+	setFederatedRepositoryBaseParams(&hfp.FederatedRepositoryBaseParams, false)
+
+	err := testsCreateFederatedRepositoryService.HuggingFaceMl(hfp)
+	if !assert.NoError(t, err, "Failed to create "+repoKey) {
+		return
+	}
+	deleteRepoOnTestDone(t, repoKey)
+	validateRepoConfig(t, repoKey, hfp)
+
+	setFederatedRepositoryBaseParams(&hfp.FederatedRepositoryBaseParams, true)
+
+	err = testsUpdateFederatedRepositoryService.HuggingFaceMl(hfp)
+	if assert.NoError(t, err, "Failed to update "+repoKey) {
+		validateRepoConfig(t, repoKey, hfp)
+	}
+}
+
 func federatedIvyTest(t *testing.T) {
 	repoKey := GenerateRepoKeyForRepoServiceTest()
 	ifp := services.NewIvyFederatedRepositoryParams()
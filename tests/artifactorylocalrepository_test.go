@@ -30,6 +30,7 @@ func TestArtifactoryLocalRepository(t *testing.T) {
 	t.Run("localGoTest", localGoTest)
 	t.Run("localGradleTest", localGradleTest)
 	t.Run("localHelmTest", localHelmTest)
+	t.Run("localHuggingFaceMlTest", localHuggingFaceMlTest)
 	t.Run("localIvyTest", localIvyTest)
 	t.Run("localMavenTest", localMavenTest)
 	t.Run("localNpmTest", localNpmTest)
@@ -424,6 +425,27 @@ func localHelmTest(t *testing.T) {
 	}
 }
 
+func localHuggingFaceMlTest(t *testing.T) {
+	repoKey := GenerateRepoKeyForRepoServiceTest()
+	hlp := services.NewHuggingFaceMlLocalRepositoryParams()
+	hlp.Key = repoKey
+	setLocalRepositoryBaseParams(&hlp.LocalRepositoryBaseParams, false)
+
+	err := testsCreateLocalRepositoryService.HuggingFaceMl(hlp)
+	if !assert.NoError(t, err, "Failed to create "+repoKey) {
+		return
+	}
+	deleteRepoOnTestDone(t, repoKey)
+	validateRepoConfig(t, repoKey, hlp)
+
+	setLocalRepositoryBaseParams(&hlp.LocalRepositoryBaseParams, true)
+
+	err = testsUpdateLocalRepositoryService.HuggingFaceMl(hlp)
+	if assert.NoError(t, err, "Failed to update "+repoKey) {
+		validateRepoConfig(t, repoKey, hlp)
+	}
+}
+
 func localIvyTest(t *testing.T) {
 	repoKey := GenerateRepoKeyForRepoServiceTest()
 	ilp := services.NewIvyLocalRepositoryParams()
@@ -33,6 +33,7 @@ func TestArtifactoryRemoteRepository(t *testing.T) {
 	t.Run("remoteGoTest", remoteGoTest)
 	t.Run("remoteGradleTest", remoteGradleTest)
 	t.Run("remoteHelmTest", remoteHelmTest)
+	t.Run("remoteHuggingFaceMlTest", remoteHuggingFaceMlTest)
 	t.Run("remoteIvyTest", remoteIvyTest)
 	t.Run("remoteMavenTest", remoteMavenTest)
 	t.Run("remoteNpmTest", remoteNpmTest)
@@ -543,6 +544,28 @@ func remoteHelmTest(t *testing.T) {
 	}
 }
 
+func remoteHuggingFaceMlTest(t *testing.T) {
+	repoKey := GenerateRepoKeyForRepoServiceTest()
+	hrp := services.NewHuggingFaceMlRemoteRepositoryParams()
+	hrp.Key = repoKey
+	hrp.Url = "https://huggingface.co"
+	setRemoteRepositoryBaseParams(&hrp.RemoteRepositoryBaseParams, false)
+
+	err := testsCreateRemoteRepositoryService.HuggingFaceMl(hrp)
+	if !assert.NoError(t, err, "Failed to create "+repoKey) {
+		return
+	}
+	deleteRepoOnTestDone(t, repoKey)
+	validateRepoConfig(t, repoKey, hrp)
+
+	setRemoteRepositoryBaseParams(&hrp.RemoteRepositoryBaseParams, true)
+
+	err = testsUpdateRemoteRepositoryService.HuggingFaceMl(hrp)
+	if assert.NoError(t, err, "Failed to update "+repoKey) {
+		validateRepoConfig(t, repoKey, hrp)
+	}
+}
+
 func remoteIvyTest(t *testing.T) {
 	repoKey := GenerateRepoKeyForRepoServiceTest()
 	irp := services.NewIvyRemoteRepositoryParams()
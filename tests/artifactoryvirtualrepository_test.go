@@ -29,6 +29,7 @@ func TestArtifactoryVirtualRepository(t *testing.T) {
 	t.Run("virtualGoTest", virtualGoTest)
 	t.Run("virtualGradleTest", virtualGradleTest)
 	t.Run("virtualHelmTest", virtualHelmTest)
+	t.Run("virtualHuggingFaceMlTest", virtualHuggingFaceMlTest)
 	t.Run("virtualIvyTest", virtualIvyTest)
 	t.Run("virtualMavenTest", virtualMavenTest)
 	t.Run("virtualNpmTest", virtualNpmTest)
@@ -428,6 +429,27 @@ func virtualHelmTest(t *testing.T) {
 	}
 }
 
+func virtualHuggingFaceMlTest(t *testing.T) {
+	repoKey := GenerateRepoKeyForRepoServiceTest()
+	hvp := services.NewHuggingFaceMlVirtualRepositoryParams()
+	hvp.Key = repoKey
+	setVirtualRepositoryBaseParams(&hvp.VirtualRepositoryBaseParams, false)
+
+	err := testsCreateVirtualRepositoryService.HuggingFaceMl(hvp)
+	if !assert.NoError(t, err, "Failed to create "+repoKey) {
+		return
+	}
+	deleteRepoOnTestDone(t, repoKey)
+	validateRepoConfig(t, repoKey, hvp)
+
+	setVirtualRepositoryBaseParams(&hvp.VirtualRepositoryBaseParams, true)
+
+	err = testsUpdateVirtualRepositoryService.HuggingFaceMl(hvp)
+	if assert.NoError(t, err, "Failed to update "+repoKey) {
+		validateRepoConfig(t, repoKey, hvp)
+	}
+}
+
 func virtualIvyTest(t *testing.T) {
 	repoKey := GenerateRepoKeyForRepoServiceTest()
 	ivp := services.NewIvyVirtualRepositoryParams()
@@ -87,6 +87,9 @@ func setupIntegrationTests() {
 		createAccessProjectManager()
 		createAccessInviteManager()
 		createAccessTokensManager()
+		createAccessOidcManager()
+		createAccessScimManager()
+		createAccessRolesManager()
 	}
 }
 
@@ -137,6 +137,9 @@ var (
 	testsAccessProjectService *accessServices.ProjectService
 	testsAccessInviteService  *accessServices.InviteService
 	testsAccessTokensService  *accessServices.TokenService
+	testsAccessOidcService    *accessServices.OidcService
+	testsAccessScimService    *accessServices.ScimService
+	testsAccessRolesService   *accessServices.RolesService
 
 	timestamp    = time.Now().Unix()
 	timestampStr = strconv.FormatInt(timestamp, 10)
@@ -1171,6 +1174,30 @@ func createAccessTokensManager() {
 	testsAccessTokensService.ServiceDetails = accessDetails
 }
 
+func createAccessOidcManager() {
+	accessDetails := GetAccessDetails()
+	client, err := createJfrogHttpClient(&accessDetails)
+	failOnHttpClientCreation(err)
+	testsAccessOidcService = accessServices.NewOidcService(client)
+	testsAccessOidcService.ServiceDetails = accessDetails
+}
+
+func createAccessScimManager() {
+	accessDetails := GetAccessDetails()
+	client, err := createJfrogHttpClient(&accessDetails)
+	failOnHttpClientCreation(err)
+	testsAccessScimService = accessServices.NewScimService(client)
+	testsAccessScimService.ServiceDetails = accessDetails
+}
+
+func createAccessRolesManager() {
+	accessDetails := GetAccessDetails()
+	client, err := createJfrogHttpClient(&accessDetails)
+	failOnHttpClientCreation(err)
+	testsAccessRolesService = accessServices.NewRolesService(client)
+	testsAccessRolesService.ServiceDetails = accessDetails
+}
+
 func createAccessPingManager() {
 	accessDetails := GetAccessDetails()
 	client, err := createJfrogHttpClient(&accessDetails)
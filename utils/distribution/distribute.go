@@ -38,11 +38,33 @@ func CreateDistributeV1Body(distCommonParams []*DistributionCommonParams, dryRun
 	return body
 }
 
+// Implemented by executors which can tell the version of the Distribution server, to validate the scheduling options against it.
+type distributionVersionGetter interface {
+	GetDistributionVersion() (string, error)
+}
+
 func DoDistribute(dr DistributeReleaseBundleExecutor) (trackerId json.Number, err error) {
 	distributeParams := dr.GetDistributionParams()
+	if err = validateScheduling(dr, distributeParams.Scheduling); err != nil {
+		return "", err
+	}
 	return execDistribute(dr, distributeParams.Name, distributeParams.Version)
 }
 
+func validateScheduling(dr DistributeReleaseBundleExecutor, scheduling *DistributionScheduling) error {
+	if scheduling == nil {
+		return nil
+	}
+	serverVersion := ""
+	if versionGetter, ok := dr.(distributionVersionGetter); ok {
+		var err error
+		if serverVersion, err = versionGetter.GetDistributionVersion(); err != nil {
+			return err
+		}
+	}
+	return scheduling.Validate(serverVersion, dr.IsDryRun())
+}
+
 func execDistribute(dr DistributeReleaseBundleExecutor, name, version string) (json.Number, error) {
 	content, err := json.Marshal(dr.GetDistributeBody())
 	if err != nil {
@@ -91,12 +113,15 @@ type DistributionParams struct {
 	DistributionRules []*DistributionCommonParams
 	Name              string
 	Version           string
+	// Optional. Schedules the distribution, and limits the resources it uses.
+	Scheduling *DistributionScheduling
 }
 
 type ReleaseBundleDistributeV1Body struct {
-	DryRun            bool                    `json:"dry_run"`
-	DistributionRules []DistributionRulesBody `json:"distribution_rules"`
-	AutoCreateRepo    bool                    `json:"auto_create_missing_repositories,omitempty"`
+	DryRun            bool                        `json:"dry_run"`
+	DistributionRules []DistributionRulesBody     `json:"distribution_rules"`
+	AutoCreateRepo    bool                        `json:"auto_create_missing_repositories,omitempty"`
+	Scheduling        *DistributionSchedulingBody `json:"scheduling,omitempty"`
 }
 
 type DistributionRulesBody struct {
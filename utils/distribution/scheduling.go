@@ -0,0 +1,118 @@
+package distribution
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jfrog/gofrog/version"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The minimum Distribution versions which support each of the scheduling options.
+const (
+	MinDistributionVersionSchedule         = "2.19.0"
+	MinDistributionVersionBandwidthLimit   = "2.21.0"
+	MinDistributionVersionParallelismLimit = "2.21.0"
+)
+
+const (
+	schedulingOptionSchedule               = "scheduled distribution window"
+	schedulingOptionBandwidthLimit         = "bandwidth limit"
+	schedulingOptionParallelTransfersLimit = "parallel transfers limit"
+	schedulingTimeLayout                   = time.RFC3339
+)
+
+// Controls when a distribution runs, and the resources it uses. All the fields are optional.
+type DistributionScheduling struct {
+	// The distribution starts at this time, instead of immediately.
+	StartTime time.Time
+	// The distribution fails on the targets it didn't complete on by this time.
+	EndTime time.Time
+	// The maximum bandwidth of the transfers to each target, in kilobits per second.
+	MaxBandwidthKbps int
+	// The maximum number of files transferred in parallel to each target.
+	MaxParallelTransfers int
+}
+
+// Returned when the server version doesn't support a scheduling option.
+type UnsupportedSchedulingOptionError struct {
+	Option        string
+	ServerVersion string
+	MinVersion    string
+}
+
+func (e *UnsupportedSchedulingOptionError) Error() string {
+	return fmt.Sprintf("the %s isn't supported by Distribution %s. Distribution %s or above is required", e.Option, e.ServerVersion, e.MinVersion)
+}
+
+func (s *DistributionScheduling) hasWindow() bool {
+	return !s.StartTime.IsZero() || !s.EndTime.IsZero()
+}
+
+// Validates the combination of the options, and if serverVersion isn't empty, that the server supports them.
+func (s *DistributionScheduling) Validate(serverVersion string, dryRun bool) error {
+	if s == nil {
+		return nil
+	}
+	var errs []error
+	if s.MaxBandwidthKbps < 0 || s.MaxParallelTransfers < 0 {
+		errs = append(errs, fmt.Errorf("the bandwidth and parallel transfers limits can't be negative, got %d and %d", s.MaxBandwidthKbps, s.MaxParallelTransfers))
+	}
+	if s.hasWindow() {
+		if dryRun {
+			errs = append(errs, errors.New("a dry run can't be scheduled, as it runs immediately"))
+		}
+		if !s.StartTime.IsZero() && !s.EndTime.IsZero() && !s.EndTime.After(s.StartTime) {
+			errs = append(errs, fmt.Errorf("the distribution window end time %s must be after its start time %s", s.EndTime.Format(schedulingTimeLayout), s.StartTime.Format(schedulingTimeLayout)))
+		}
+		if !s.EndTime.IsZero() && s.EndTime.Before(time.Now()) {
+			errs = append(errs, fmt.Errorf("the distribution window end time %s has already passed", s.EndTime.Format(schedulingTimeLayout)))
+		}
+	}
+	if serverVersion != "" {
+		errs = append(errs, s.validateServerVersion(serverVersion)...)
+	}
+	return errorutils.CheckError(errors.Join(errs...))
+}
+
+func (s *DistributionScheduling) validateServerVersion(serverVersion string) []error {
+	var errs []error
+	serverVer := version.NewVersion(serverVersion)
+	for _, option := range []struct {
+		name       string
+		isSet      bool
+		minVersion string
+	}{
+		{schedulingOptionSchedule, s.hasWindow(), MinDistributionVersionSchedule},
+		{schedulingOptionBandwidthLimit, s.MaxBandwidthKbps > 0, MinDistributionVersionBandwidthLimit},
+		{schedulingOptionParallelTransfersLimit, s.MaxParallelTransfers > 0, MinDistributionVersionParallelismLimit},
+	} {
+		if option.isSet && !serverVer.AtLeast(option.minVersion) {
+			errs = append(errs, &UnsupportedSchedulingOptionError{Option: option.name, ServerVersion: serverVersion, MinVersion: option.minVersion})
+		}
+	}
+	return errs
+}
+
+// Returns the body of the options, or nil if none is set.
+func (s *DistributionScheduling) ToBody() *DistributionSchedulingBody {
+	if s == nil || (!s.hasWindow() && s.MaxBandwidthKbps == 0 && s.MaxParallelTransfers == 0) {
+		return nil
+	}
+	body := &DistributionSchedulingBody{MaxBandwidthKbps: s.MaxBandwidthKbps, MaxParallelTransfers: s.MaxParallelTransfers}
+	if !s.StartTime.IsZero() {
+		body.StartTime = s.StartTime.UTC().Format(schedulingTimeLayout)
+	}
+	if !s.EndTime.IsZero() {
+		body.EndTime = s.EndTime.UTC().Format(schedulingTimeLayout)
+	}
+	return body
+}
+
+type DistributionSchedulingBody struct {
+	StartTime            string `json:"start_time,omitempty"`
+	EndTime              string `json:"end_time,omitempty"`
+	MaxBandwidthKbps     int    `json:"max_bandwidth_kbps,omitempty"`
+	MaxParallelTransfers int    `json:"max_parallel_transfers,omitempty"`
+}
@@ -0,0 +1,57 @@
+package distribution
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistributionSchedulingValidate(t *testing.T) {
+	start := time.Now().Add(time.Hour)
+	testCases := []struct {
+		name          string
+		scheduling    *DistributionScheduling
+		serverVersion string
+		dryRun        bool
+		expectedError string
+	}{
+		{"nil", nil, "2.0.0", false, ""},
+		{"supported", &DistributionScheduling{StartTime: start, EndTime: start.Add(time.Hour), MaxBandwidthKbps: 1024, MaxParallelTransfers: 4}, "2.21.0", false, ""},
+		{"unknown version", &DistributionScheduling{StartTime: start, MaxBandwidthKbps: 1024}, "", false, ""},
+		{"negative limit", &DistributionScheduling{MaxParallelTransfers: -1}, "", false, "can't be negative"},
+		{"dry run", &DistributionScheduling{StartTime: start}, "", true, "a dry run can't be scheduled"},
+		{"dry run with limits", &DistributionScheduling{MaxBandwidthKbps: 1024}, "", true, ""},
+		{"end before start", &DistributionScheduling{StartTime: start, EndTime: start.Add(-time.Minute)}, "", false, "must be after its start time"},
+		{"end passed", &DistributionScheduling{EndTime: time.Now().Add(-time.Minute)}, "", false, "has already passed"},
+		{"old version", &DistributionScheduling{StartTime: start, MaxBandwidthKbps: 1024}, "2.20.0", false, "the bandwidth limit isn't supported by Distribution 2.20.0"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.scheduling.Validate(testCase.serverVersion, testCase.dryRun)
+			if testCase.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestDistributionSchedulingUnsupportedOptions(t *testing.T) {
+	scheduling := &DistributionScheduling{StartTime: time.Now().Add(time.Hour), MaxParallelTransfers: 2}
+	err := scheduling.Validate("2.18.0", false)
+	var unsupportedErr *UnsupportedSchedulingOptionError
+	assert.True(t, errors.As(err, &unsupportedErr))
+	assert.Equal(t, MinDistributionVersionSchedule, unsupportedErr.MinVersion)
+	assert.ErrorContains(t, err, "parallel transfers limit")
+}
+
+func TestDistributionSchedulingToBody(t *testing.T) {
+	assert.Nil(t, (*DistributionScheduling)(nil).ToBody())
+	assert.Nil(t, (&DistributionScheduling{}).ToBody())
+	start := time.Date(2030, 1, 2, 3, 4, 5, 0, time.FixedZone("IST", 2*60*60))
+	body := (&DistributionScheduling{StartTime: start, MaxBandwidthKbps: 512}).ToBody()
+	assert.Equal(t, &DistributionSchedulingBody{StartTime: "2030-01-02T01:04:05Z", MaxBandwidthKbps: 512}, body)
+}
@@ -0,0 +1,198 @@
+package distribution
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+)
+
+const (
+	defaultTrackerTimeout         = 60 * time.Minute
+	defaultTrackerPollingInterval = 10 * time.Second
+)
+
+// The distribution progress of a target site.
+type SiteProgress struct {
+	Name             string
+	ServiceId        string
+	Status           DistributionStatus
+	TotalFiles       int64
+	DistributedFiles int64
+	TotalBytes       int64
+	DistributedBytes int64
+	FilesInProgress  []string
+	FileErrors       []string
+	Error            string
+}
+
+// Returns the percentage of the bytes distributed to the site, or of the files if the size is unknown.
+func (sp *SiteProgress) Percent() float64 {
+	switch {
+	case sp.Status == Completed:
+		return 100
+	case sp.TotalBytes > 0:
+		return float64(sp.DistributedBytes) * 100 / float64(sp.TotalBytes)
+	case sp.TotalFiles > 0:
+		return float64(sp.DistributedFiles) * 100 / float64(sp.TotalFiles)
+	}
+	return 0
+}
+
+type DistributionProgress struct {
+	Name    string
+	Version string
+	Status  DistributionStatus
+	Sites   []SiteProgress
+}
+
+func (dp *DistributionProgress) IsDone() bool {
+	return dp.Status == Completed || dp.Status == Failed
+}
+
+// Returns the sites the distribution failed on, including sites with file errors.
+func (dp *DistributionProgress) FailedSites() []SiteProgress {
+	var failed []SiteProgress
+	for _, site := range dp.Sites {
+		if site.Status == Failed || site.Error != "" || len(site.FileErrors) > 0 {
+			failed = append(failed, site)
+		}
+	}
+	return failed
+}
+
+func NewDistributionProgress(status *DistributionStatusResponse) DistributionProgress {
+	progress := DistributionProgress{Name: status.Name, Version: status.Version, Status: status.Status}
+	for _, site := range status.Sites {
+		progress.Sites = append(progress.Sites, SiteProgress{
+			Name:             site.TargetArtifactory.Name,
+			ServiceId:        site.TargetArtifactory.ServiceId,
+			Status:           site.Status,
+			TotalFiles:       toInt64(site.TotalFiles),
+			DistributedFiles: toInt64(site.DistributedFiles),
+			TotalBytes:       toInt64(site.TotalBytes),
+			DistributedBytes: toInt64(site.DistributedBytes),
+			FilesInProgress:  site.FilesInProgress,
+			FileErrors:       site.FileErrors,
+			Error:            site.Error,
+		})
+	}
+	return progress
+}
+
+func toInt64(number json.Number) int64 {
+	value, err := number.Int64()
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+type DistributionUpdate struct {
+	Progress DistributionProgress
+	// Set on the last update if the distribution failed or the tracking timed out.
+	Err error
+}
+
+// DistributionTracker polls the status of a distribution until it's completed or failed, and reports the progress
+// of each target site.
+type DistributionTracker struct {
+	// Returns the current status of the distribution.
+	GetStatus func() (*DistributionStatusResponse, error)
+	// Default: 60 minutes
+	Timeout time.Duration
+	// Default: 10 seconds
+	PollingInterval time.Duration
+	// Called whenever the progress changes, including the final progress. Optional.
+	OnProgress func(progress DistributionProgress)
+}
+
+func NewDistributionTracker(getStatus func() (*DistributionStatusResponse, error)) *DistributionTracker {
+	return &DistributionTracker{GetStatus: getStatus}
+}
+
+// Polls the status until the distribution ends, and returns its final progress.
+// Returns an error if the distribution failed on any of the sites, or if it didn't end within the timeout.
+func (dt *DistributionTracker) Track() (*DistributionProgress, error) {
+	var current *DistributionProgress
+	pollingAction := func() (shouldStop bool, responseBody []byte, err error) {
+		status, err := dt.GetStatus()
+		if err != nil {
+			return true, nil, err
+		}
+		progress := NewDistributionProgress(status)
+		if current == nil || !reflect.DeepEqual(*current, progress) {
+			current = &progress
+			if dt.OnProgress != nil {
+				dt.OnProgress(progress)
+			}
+		}
+		return progress.IsDone(), nil, nil
+	}
+	timeout := dt.Timeout
+	if timeout <= 0 {
+		timeout = defaultTrackerTimeout
+	}
+	pollingInterval := dt.PollingInterval
+	if pollingInterval <= 0 {
+		pollingInterval = defaultTrackerPollingInterval
+	}
+	pollingExecutor := &httputils.PollingExecutor{
+		Timeout:         timeout,
+		PollingInterval: pollingInterval,
+		PollingAction:   pollingAction,
+		MsgPrefix:       "Tracking distribution...",
+	}
+	if _, err := pollingExecutor.Execute(); err != nil {
+		return current, err
+	}
+	if failedSites := current.FailedSites(); current.Status == Failed || len(failedSites) > 0 {
+		return current, errorutils.CheckErrorf("distribution of %s/%s failed:%s", current.Name, current.Version, formatFailedSites(failedSites))
+	}
+	return current, nil
+}
+
+func formatFailedSites(sites []SiteProgress) string {
+	var builder strings.Builder
+	for _, site := range sites {
+		builder.WriteString(fmt.Sprintf("\nsite %s: %s", site.Name, site.Status))
+		if site.Error != "" {
+			builder.WriteString(", " + site.Error)
+		}
+		for _, fileError := range site.FileErrors {
+			builder.WriteString(", " + fileError)
+		}
+	}
+	return builder.String()
+}
+
+// Tracks the distribution in the background, and sends an update whenever the progress changes.
+// The channel must be drained, and is closed once the distribution ends. The last update has an error if Track would
+// have returned one.
+// OnProgress is called as well, if set.
+func (dt *DistributionTracker) Updates() <-chan DistributionUpdate {
+	updates := make(chan DistributionUpdate)
+	go func() {
+		defer close(updates)
+		tracker := *dt
+		tracker.OnProgress = func(progress DistributionProgress) {
+			if dt.OnProgress != nil {
+				dt.OnProgress(progress)
+			}
+			if !progress.IsDone() {
+				updates <- DistributionUpdate{Progress: progress}
+			}
+		}
+		progress, err := tracker.Track()
+		final := DistributionUpdate{Err: err}
+		if progress != nil {
+			final.Progress = *progress
+		}
+		updates <- final
+	}()
+	return updates
+}
@@ -0,0 +1,78 @@
+package distribution
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTracker(statuses []DistributionStatusResponse) *DistributionTracker {
+	tracker := NewDistributionTracker(func() (*DistributionStatusResponse, error) {
+		status := statuses[0]
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+		return &status, nil
+	})
+	tracker.PollingInterval = 10 * time.Millisecond
+	return tracker
+}
+
+func siteStatus(name string, status DistributionStatus, distributedBytes string, fileErrors ...string) DistributionSiteStatus {
+	return DistributionSiteStatus{
+		Status:            status,
+		TargetArtifactory: TargetArtifactory{Name: name},
+		TotalBytes:        "200",
+		DistributedBytes:  json.Number(distributedBytes),
+		FileErrors:        fileErrors,
+	}
+}
+
+func TestTrackDistribution(t *testing.T) {
+	inProgress := DistributionStatusResponse{Name: "rb", Version: "1", Status: InProgress, Sites: []DistributionSiteStatus{siteStatus("edge-1", InProgress, "50")}}
+	tracker := newTestTracker([]DistributionStatusResponse{
+		inProgress,
+		// Unchanged progress isn't reported
+		inProgress,
+		{Name: "rb", Version: "1", Status: Completed, Sites: []DistributionSiteStatus{siteStatus("edge-1", Completed, "200")}},
+	})
+	var percents []float64
+	tracker.OnProgress = func(progress DistributionProgress) {
+		percents = append(percents, progress.Sites[0].Percent())
+	}
+	progress, err := tracker.Track()
+	assert.NoError(t, err)
+	assert.Equal(t, Completed, progress.Status)
+	assert.Equal(t, []float64{25, 100}, percents)
+}
+
+func TestTrackDistributionUpdates(t *testing.T) {
+	tracker := newTestTracker([]DistributionStatusResponse{
+		{Name: "rb", Version: "1", Status: InQueue},
+		{Name: "rb", Version: "1", Status: Failed, Sites: []DistributionSiteStatus{
+			siteStatus("edge-1", Completed, "200"),
+			siteStatus("edge-2", Failed, "100", "failed to deploy a.zip"),
+		}},
+	})
+	var updates []DistributionUpdate
+	for update := range tracker.Updates() {
+		updates = append(updates, update)
+	}
+	assert.Len(t, updates, 2)
+	assert.NoError(t, updates[0].Err)
+	assert.Equal(t, InQueue, updates[0].Progress.Status)
+	assert.ErrorContains(t, updates[1].Err, "site edge-2: Failed, failed to deploy a.zip")
+	failedSites := updates[1].Progress.FailedSites()
+	assert.Len(t, failedSites, 1)
+	assert.Equal(t, int64(100), failedSites[0].DistributedBytes)
+}
+
+func TestTrackDistributionTimeout(t *testing.T) {
+	tracker := newTestTracker([]DistributionStatusResponse{{Name: "rb", Version: "1", Status: InProgress}})
+	tracker.Timeout = 30 * time.Millisecond
+	progress, err := tracker.Track()
+	assert.Error(t, err)
+	assert.Equal(t, InProgress, progress.Status)
+}
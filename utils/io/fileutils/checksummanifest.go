@@ -0,0 +1,39 @@
+package fileutils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The checksum manifest of a file is written next to it, with this suffix, in the format of sha256sum.
+const ChecksumManifestSuffix = ".sha256"
+
+// Writes the checksum manifest of the file, so that it can be validated after the file is transferred.
+func WriteChecksumManifest(filePath, sha256 string) error {
+	manifest := fmt.Sprintf("%s  %s\n", sha256, filepath.Base(filePath))
+	return errorutils.CheckError(os.WriteFile(filePath+ChecksumManifestSuffix, []byte(manifest), 0644))
+}
+
+// Validates the file against the checksum manifest next to it, and returns its details.
+func ValidateChecksumManifest(filePath string) (*FileDetails, error) {
+	manifest, err := os.ReadFile(filePath + ChecksumManifestSuffix)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("couldn't read the checksum manifest of %s: %s", filePath, err.Error())
+	}
+	fields := strings.Fields(string(manifest))
+	if len(fields) == 0 {
+		return nil, errorutils.CheckErrorf("the checksum manifest of %s is empty", filePath)
+	}
+	details, err := GetFileDetails(filePath, true)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(fields[0], details.Checksum.Sha256) {
+		return nil, errorutils.CheckErrorf("checksum mismatch of %s: the manifest expects sha256 %s but got %s", filePath, fields[0], details.Checksum.Sha256)
+	}
+	return details, nil
+}
@@ -0,0 +1,28 @@
+package fileutils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecksumManifest(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "rb.zip")
+	assert.NoError(t, os.WriteFile(filePath, []byte("release-bundle-archive"), 0644))
+
+	_, err := ValidateChecksumManifest(filePath)
+	assert.ErrorContains(t, err, "couldn't read the checksum manifest")
+
+	details, err := GetFileDetails(filePath, true)
+	assert.NoError(t, err)
+	assert.NoError(t, WriteChecksumManifest(filePath, details.Checksum.Sha256))
+	validated, err := ValidateChecksumManifest(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, details.Checksum.Sha256, validated.Checksum.Sha256)
+
+	assert.NoError(t, os.WriteFile(filePath, []byte("corrupted"), 0644))
+	_, err = ValidateChecksumManifest(filePath)
+	assert.ErrorContains(t, err, "checksum mismatch")
+}
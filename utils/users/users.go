@@ -0,0 +1,103 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type UserParams struct {
+	UserDetails     User
+	ReplaceIfExists bool
+	ClearGroups     bool
+}
+
+func NewUserParams() UserParams {
+	return UserParams{}
+}
+
+// application/vnd.org.jfrog.artifactory.security.User+json
+type User struct {
+	Name                     string    `json:"name,omitempty" csv:"username,omitempty"`
+	Email                    string    `json:"email,omitempty" csv:"email,omitempty"`
+	Password                 string    `json:"password,omitempty" csv:"password,omitempty"`
+	Admin                    *bool     `json:"admin,omitempty" csv:"admin,omitempty"`
+	ProfileUpdatable         *bool     `json:"profileUpdatable,omitempty" csv:"profileUpdatable,omitempty"`
+	DisableUIAccess          *bool     `json:"disableUIAccess,omitempty" csv:"disableUIAccess,omitempty"`
+	InternalPasswordDisabled *bool     `json:"internalPasswordDisabled,omitempty" csv:"internalPasswordDisabled,omitempty"`
+	LastLoggedIn             string    `json:"lastLoggedIn,omitempty" csv:"lastLoggedIn,omitempty"`
+	Realm                    string    `json:"realm,omitempty" csv:"realm,omitempty"`
+	Groups                   *[]string `json:"groups,omitempty" csv:"groups,omitempty"`
+	ShouldInvite             *bool     `json:"shouldInvite,omitempty" csv:"shouldInvite,omitempty"`
+	Source                   string    `json:"source,omitempty" csv:"source,omitempty"`
+	WatchManager             *bool     `json:"watchManager,omitempty" csv:"watchManager,omitempty"`
+	ReportsManager           *bool     `json:"reportsManager,omitempty" csv:"reportsManager,omitempty"`
+	PolicyManager            *bool     `json:"policyManager,omitempty" csv:"policyManager,omitempty"`
+	ProjectAdmin             *bool     `json:"projectAdmin,omitempty" csv:"projectAdmin,omitempty"`
+}
+
+// The user operations which are common to the Artifactory security API (the UserService in the artifactory/services
+// package) and the Access users API (the UsersService in the access/services package), so that callers can work with
+// either of them.
+type UsersClient interface {
+	ListUsers(params ListUsersParams) (*UsersPage, error)
+	// Returns nil if the user doesn't exist.
+	GetUserDetails(name string) (*User, error)
+	CreateUser(params UserParams) error
+	UpdateUser(params UserParams) error
+	DeleteUser(name string) error
+}
+
+type ListUsersParams struct {
+	// The maximum number of users in a page. If 0, all the users are returned.
+	Limit int
+	// The cursor returned with the previous page. Empty for the first page.
+	Cursor string
+	// Only users whose name contains this value, case-insensitive.
+	NameContains string
+	// Only users of this realm, e.g. "internal" or "ldap".
+	Realm string
+}
+
+func NewListUsersParams() ListUsersParams {
+	return ListUsersParams{}
+}
+
+// Returns true if the user matches the filters of the params.
+func (lup *ListUsersParams) Matches(user User) bool {
+	if lup.NameContains != "" && !strings.Contains(strings.ToLower(user.Name), strings.ToLower(lup.NameContains)) {
+		return false
+	}
+	return lup.Realm == "" || strings.EqualFold(lup.Realm, user.Realm)
+}
+
+type UsersPage struct {
+	Users []User
+	// The cursor of the next page, or empty if this is the last page.
+	NextCursor string
+}
+
+type ImportUsersParams struct {
+	Users []User
+	// If true, existing users are updated. Otherwise, the import of existing users fails.
+	ReplaceIfExists bool
+}
+
+func NewImportUsersParams(users []User) ImportUsersParams {
+	return ImportUsersParams{Users: users}
+}
+
+// Creates the given users using either the security API or the Access users API. All the users are processed even if
+// some fail. The returned error aggregates the errors of all the failed users.
+func ImportUsers(usersClient UsersClient, params ImportUsersParams) error {
+	var errs []error
+	for _, user := range params.Users {
+		userParams := NewUserParams()
+		userParams.UserDetails = user
+		userParams.ReplaceIfExists = params.ReplaceIfExists
+		if err := usersClient.CreateUser(userParams); err != nil {
+			errs = append(errs, fmt.Errorf("user '%s': %w", user.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
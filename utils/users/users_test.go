@@ -0,0 +1,43 @@
+package users
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListUsersParamsMatches(t *testing.T) {
+	params := ListUsersParams{NameContains: "Dev", Realm: "ldap"}
+	assert.True(t, params.Matches(User{Name: "frontend-developer", Realm: "LDAP"}))
+	assert.False(t, params.Matches(User{Name: "frontend-developer", Realm: "internal"}))
+	assert.False(t, params.Matches(User{Name: "admin", Realm: "ldap"}))
+	emptyParams := NewListUsersParams()
+	assert.True(t, emptyParams.Matches(User{Name: "admin"}))
+}
+
+type createUserRecorder struct {
+	UsersClient
+	created []UserParams
+}
+
+func (cur *createUserRecorder) CreateUser(params UserParams) error {
+	if params.UserDetails.Name == "existing" {
+		return errors.New("user 'existing' already exists")
+	}
+	cur.created = append(cur.created, params)
+	return nil
+}
+
+func TestImportUsers(t *testing.T) {
+	recorder := &createUserRecorder{}
+	params := NewImportUsersParams([]User{{Name: "first"}, {Name: "existing"}, {Name: "second"}})
+	params.ReplaceIfExists = true
+	err := ImportUsers(recorder, params)
+	assert.ErrorContains(t, err, "user 'existing'")
+	if assert.Len(t, recorder.created, 2) {
+		assert.Equal(t, "first", recorder.created[0].UserDetails.Name)
+		assert.Equal(t, "second", recorder.created[1].UserDetails.Name)
+		assert.True(t, recorder.created[1].ReplaceIfExists)
+	}
+}
@@ -673,3 +673,22 @@ func CalculateBackoff(attempt int, initialDelay, maxDelay time.Duration) time.Du
 	}
 	return currentDelay
 }
+
+// Returns a single page of the items, for APIs which don't support pagination. The cursor is the offset of the page.
+func PaginateByOffset[T any](items []T, limit int, cursor string) (page []T, nextCursor string, err error) {
+	offset := 0
+	if cursor != "" {
+		if offset, err = strconv.Atoi(cursor); err != nil || offset < 0 {
+			return nil, "", errorutils.CheckErrorf("invalid page cursor '%s'", cursor)
+		}
+	}
+	if offset > len(items) {
+		offset = len(items)
+	}
+	page = items[offset:]
+	if limit > 0 && len(page) > limit {
+		page = page[:limit]
+		nextCursor = strconv.Itoa(offset + limit)
+	}
+	return page, nextCursor, nil
+}
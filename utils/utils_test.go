@@ -431,3 +431,23 @@ func TestCalculateBackoff(t *testing.T) {
 		})
 	}
 }
+
+func TestPaginateByOffset(t *testing.T) {
+	items := []int{1, 2, 3}
+	page, nextCursor, err := PaginateByOffset(items, 2, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, page)
+	assert.Equal(t, "2", nextCursor)
+
+	page, nextCursor, err = PaginateByOffset(items, 2, nextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3}, page)
+	assert.Empty(t, nextCursor)
+
+	page, _, err = PaginateByOffset(items, 2, "5")
+	assert.NoError(t, err)
+	assert.Empty(t, page)
+
+	_, _, err = PaginateByOffset(items, 2, "-1")
+	assert.Error(t, err)
+}
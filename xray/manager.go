@@ -1,9 +1,13 @@
 package xray
 
 import (
+	"io"
+	"time"
+
 	"github.com/CycloneDX/cyclonedx-go"
 	"github.com/jfrog/jfrog-client-go/config"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	ioutils "github.com/jfrog/jfrog-client-go/utils/io"
 	"github.com/jfrog/jfrog-client-go/xray/services"
 	xrayUtils "github.com/jfrog/jfrog-client-go/xray/services/utils"
 	"github.com/jfrog/jfrog-client-go/xray/services/xsc"
@@ -58,6 +62,34 @@ func (sm *XrayServicesManager) GetVersion() (string, error) {
 	return versionService.GetVersion()
 }
 
+// Ping returns an error if Xray doesn't respond to a ping
+func (sm *XrayServicesManager) Ping() error {
+	systemService := services.NewSystemService(sm.client)
+	systemService.XrayDetails = sm.config.GetServiceDetails()
+	return systemService.Ping()
+}
+
+// IsReady returns true if Xray and all its services are ready to serve requests
+func (sm *XrayServicesManager) IsReady() (bool, error) {
+	systemService := services.NewSystemService(sm.client)
+	systemService.XrayDetails = sm.config.GetServiceDetails()
+	return systemService.IsReady()
+}
+
+// GetMetrics returns all the metrics Xray exposes
+func (sm *XrayServicesManager) GetMetrics() ([]services.Metric, error) {
+	systemService := services.NewSystemService(sm.client)
+	systemService.XrayDetails = sm.config.GetServiceDetails()
+	return systemService.GetMetrics()
+}
+
+// GetSystemMetrics returns the indexed resources counts, queues backlog and database sync status of Xray
+func (sm *XrayServicesManager) GetSystemMetrics() (*services.SystemMetrics, error) {
+	systemService := services.NewSystemService(sm.client)
+	systemService.XrayDetails = sm.config.GetServiceDetails()
+	return systemService.GetSystemMetrics()
+}
+
 // CreateWatch will create a new Xray watch
 func (sm *XrayServicesManager) CreateWatch(params xrayUtils.WatchParams) error {
 	watchService := services.NewWatchService(sm.client)
@@ -151,6 +183,97 @@ func (sm *XrayServicesManager) AddBuildsToIndexing(buildNames []string) error {
 	return binMgrService.AddBuildsToIndexing(buildNames)
 }
 
+// GetIndexedRepositories will return the repositories of a binary manager, split by whether Xray indexes them
+func (sm *XrayServicesManager) GetIndexedRepositories(binMgrId string) (*services.IndexedRepositoriesConfig, error) {
+	binMgrService := services.NewBinMgrService(sm.client)
+	binMgrService.XrayDetails = sm.config.GetServiceDetails()
+	return binMgrService.GetIndexedRepositories(binMgrId)
+}
+
+// UpdateIndexedRepositories will set the repositories Xray indexes
+func (sm *XrayServicesManager) UpdateIndexedRepositories(binMgrId string, config services.IndexedRepositoriesConfig) error {
+	binMgrService := services.NewBinMgrService(sm.client)
+	binMgrService.XrayDetails = sm.config.GetServiceDetails()
+	return binMgrService.UpdateIndexedRepositories(binMgrId, config)
+}
+
+// AddRepositoriesToIndexing will add repositories to Xray indexing configuration
+func (sm *XrayServicesManager) AddRepositoriesToIndexing(binMgrId string, repoNames []string) error {
+	binMgrService := services.NewBinMgrService(sm.client)
+	binMgrService.XrayDetails = sm.config.GetServiceDetails()
+	return binMgrService.AddRepositoriesToIndexing(binMgrId, repoNames)
+}
+
+// RemoveRepositoriesFromIndexing will remove repositories from Xray indexing configuration
+func (sm *XrayServicesManager) RemoveRepositoriesFromIndexing(binMgrId string, repoNames []string) error {
+	binMgrService := services.NewBinMgrService(sm.client)
+	binMgrService.XrayDetails = sm.config.GetServiceDetails()
+	return binMgrService.RemoveRepositoriesFromIndexing(binMgrId, repoNames)
+}
+
+// GetIndexedBuilds will return the builds, split by whether Xray indexes them
+func (sm *XrayServicesManager) GetIndexedBuilds() (*services.IndexedBuildsConfig, error) {
+	binMgrService := services.NewBinMgrService(sm.client)
+	binMgrService.XrayDetails = sm.config.GetServiceDetails()
+	return binMgrService.GetIndexedBuilds()
+}
+
+// UpdateIndexedBuilds will set the builds Xray indexes
+func (sm *XrayServicesManager) UpdateIndexedBuilds(buildNames []string) error {
+	binMgrService := services.NewBinMgrService(sm.client)
+	binMgrService.XrayDetails = sm.config.GetServiceDetails()
+	return binMgrService.UpdateIndexedBuilds(buildNames)
+}
+
+// RemoveBuildsFromIndexing will remove builds from Xray indexing configuration
+func (sm *XrayServicesManager) RemoveBuildsFromIndexing(buildNames []string) error {
+	binMgrService := services.NewBinMgrService(sm.client)
+	binMgrService.XrayDetails = sm.config.GetServiceDetails()
+	return binMgrService.RemoveBuildsFromIndexing(buildNames)
+}
+
+// GetIndexedReleaseBundles will return the release bundles, split by whether Xray indexes them
+func (sm *XrayServicesManager) GetIndexedReleaseBundles() (*services.IndexedReleaseBundlesConfig, error) {
+	binMgrService := services.NewBinMgrService(sm.client)
+	binMgrService.XrayDetails = sm.config.GetServiceDetails()
+	return binMgrService.GetIndexedReleaseBundles()
+}
+
+// UpdateIndexedReleaseBundles will set the release bundles Xray indexes
+func (sm *XrayServicesManager) UpdateIndexedReleaseBundles(releaseBundleNames []string) error {
+	binMgrService := services.NewBinMgrService(sm.client)
+	binMgrService.XrayDetails = sm.config.GetServiceDetails()
+	return binMgrService.UpdateIndexedReleaseBundles(releaseBundleNames)
+}
+
+// AddReleaseBundlesToIndexing will add release bundles to Xray indexing configuration
+func (sm *XrayServicesManager) AddReleaseBundlesToIndexing(releaseBundleNames []string) error {
+	binMgrService := services.NewBinMgrService(sm.client)
+	binMgrService.XrayDetails = sm.config.GetServiceDetails()
+	return binMgrService.AddReleaseBundlesToIndexing(releaseBundleNames)
+}
+
+// RemoveReleaseBundlesFromIndexing will remove release bundles from Xray indexing configuration
+func (sm *XrayServicesManager) RemoveReleaseBundlesFromIndexing(releaseBundleNames []string) error {
+	binMgrService := services.NewBinMgrService(sm.client)
+	binMgrService.XrayDetails = sm.config.GetServiceDetails()
+	return binMgrService.RemoveReleaseBundlesFromIndexing(releaseBundleNames)
+}
+
+// GetRepositoryConfig will return the Xray scanning configuration of a repository
+func (sm *XrayServicesManager) GetRepositoryConfig(repoName string) (*services.RepositoryConfig, error) {
+	binMgrService := services.NewBinMgrService(sm.client)
+	binMgrService.XrayDetails = sm.config.GetServiceDetails()
+	return binMgrService.GetRepositoryConfig(repoName)
+}
+
+// UpdateRepositoryConfig will set the Xray scanning configuration of a repository, such as its retention
+func (sm *XrayServicesManager) UpdateRepositoryConfig(config services.RepositoryConfig) error {
+	binMgrService := services.NewBinMgrService(sm.client)
+	binMgrService.XrayDetails = sm.config.GetServiceDetails()
+	return binMgrService.UpdateRepositoryConfig(config)
+}
+
 func (sm *XrayServicesManager) IsTokenValidationEnabled() (isEnabled bool, err error) {
 	jasConfigService := services.NewJasConfigService(sm.client)
 	jasConfigService.XrayDetails = sm.config.GetServiceDetails()
@@ -189,6 +312,14 @@ func (sm *XrayServicesManager) GetImportGraphResults(scanID string) (*services.S
 	return enrichService.GetImportGraphResults(scanID)
 }
 
+// ScanBinary requests a graph scan of a locally indexed binary or archive, and waits for the scan results
+func (sm *XrayServicesManager) ScanBinary(params services.BinaryScanParams) (*services.ScanResponse, error) {
+	scanService := services.NewScanService(sm.client)
+	scanService.XrayDetails = sm.config.GetServiceDetails()
+	scanService.ScopeProjectKey = sm.scopeProjectKey
+	return scanService.ScanBinary(params)
+}
+
 // BuildScan scans a published build-info with Xray.
 // 'scanResponse' - Xray scan output of the requested build scan.
 // 'noFailBuildPolicy' - Indicates that the Xray API returned a "No Xray Fail build...." error
@@ -199,6 +330,15 @@ func (sm *XrayServicesManager) BuildScan(params services.XrayBuildParams, includ
 	return buildScanService.ScanBuild(params, includeVulnerabilities, triggerRetries)
 }
 
+// BuildScanAndWait scans a published build-info with Xray, and waits for the results with an exponential backoff.
+// The returned summary groups the violations by type and severity.
+func (sm *XrayServicesManager) BuildScanAndWait(params services.BuildScanParams) (*services.BuildScanSummary, error) {
+	buildScanService := services.NewBuildScanService(sm.client)
+	buildScanService.XrayDetails = sm.config.GetServiceDetails()
+	buildScanService.ScopeProjectKey = sm.scopeProjectKey
+	return buildScanService.ScanBuildAndWait(params)
+}
+
 // GenerateVulnerabilitiesReport returns a Xray report response of the requested report
 func (sm *XrayServicesManager) GenerateVulnerabilitiesReport(params services.VulnerabilitiesReportRequestParams) (resp *services.ReportResponse, err error) {
 	reportService := services.NewReportService(sm.client)
@@ -220,6 +360,20 @@ func (sm *XrayServicesManager) GenerateViolationsReport(params services.Violatio
 	return reportService.Violations(params)
 }
 
+// GenerateOperationalRiskReport returns a Xray report response of the requested report
+func (sm *XrayServicesManager) GenerateOperationalRiskReport(params services.OperationalRiskReportRequestParams) (resp *services.ReportResponse, err error) {
+	reportService := services.NewReportService(sm.client)
+	reportService.XrayDetails = sm.config.GetServiceDetails()
+	return reportService.OperationalRisk(params)
+}
+
+// WaitForReport waits for a Xray report to complete, and returns its details
+func (sm *XrayServicesManager) WaitForReport(reportId string, timeout, pollingInterval time.Duration) (details *services.ReportDetails, err error) {
+	reportService := services.NewReportService(sm.client)
+	reportService.XrayDetails = sm.config.GetServiceDetails()
+	return reportService.WaitForReport(reportId, timeout, pollingInterval)
+}
+
 // ReportDetails returns a Xray details response for the requested report
 func (sm *XrayServicesManager) ReportDetails(reportId string) (details *services.ReportDetails, err error) {
 	reportService := services.NewReportService(sm.client)
@@ -234,6 +388,27 @@ func (sm *XrayServicesManager) ReportContent(params services.ReportContentReques
 	return reportService.Content(params)
 }
 
+// ReportAllContent reads all the pages of the requested report, and passes each row to the handler
+func (sm *XrayServicesManager) ReportAllContent(params services.ReportContentRequestParams, handler func(services.Row) error) error {
+	reportService := services.NewReportService(sm.client)
+	reportService.XrayDetails = sm.config.GetServiceDetails()
+	return reportService.AllContent(params, handler)
+}
+
+// ExportReport exports a Xray report in PDF, CSV or JSON format, and writes it to the writer
+func (sm *XrayServicesManager) ExportReport(params services.ReportExportParams, writer io.Writer) error {
+	reportService := services.NewReportService(sm.client)
+	reportService.XrayDetails = sm.config.GetServiceDetails()
+	return reportService.Export(params, writer)
+}
+
+// ExportReportToFile exports a Xray report in PDF, CSV or JSON format to a local file
+func (sm *XrayServicesManager) ExportReportToFile(params services.ReportExportParams, filePath string) error {
+	reportService := services.NewReportService(sm.client)
+	reportService.XrayDetails = sm.config.GetServiceDetails()
+	return reportService.ExportToFile(params, filePath)
+}
+
 // DeleteReport deletes a Xray report
 func (sm *XrayServicesManager) DeleteReport(reportId string) error {
 	reportService := services.NewReportService(sm.client)
@@ -241,6 +416,13 @@ func (sm *XrayServicesManager) DeleteReport(reportId string) error {
 	return reportService.Delete(reportId)
 }
 
+// GetLicenseCompliance aggregates the licenses of all the artifacts of builds or release bundles, and checks them against a license policy
+func (sm *XrayServicesManager) GetLicenseCompliance(params services.LicenseComplianceParams) (*services.LicenseComplianceResult, error) {
+	reportService := services.NewReportService(sm.client)
+	reportService.XrayDetails = sm.config.GetServiceDetails()
+	return reportService.GetLicenseCompliance(params)
+}
+
 // ArtifactSummary returns Xray artifact summaries for the requested checksums and/or paths
 func (sm *XrayServicesManager) ArtifactSummary(params services.ArtifactSummaryParams) (*services.ArtifactSummaryResponse, error) {
 	summaryService := services.NewSummaryService(sm.client)
@@ -248,6 +430,27 @@ func (sm *XrayServicesManager) ArtifactSummary(params services.ArtifactSummaryPa
 	return summaryService.GetArtifactSummary(params)
 }
 
+// ArtifactSummaryInBatches returns Xray artifact summaries for many checksums and/or paths, looked up in concurrent batches
+func (sm *XrayServicesManager) ArtifactSummaryInBatches(params services.BatchArtifactSummaryParams) (*services.ArtifactSummaryResponse, error) {
+	summaryService := services.NewSummaryService(sm.client)
+	summaryService.XrayDetails = sm.config.GetServiceDetails()
+	return summaryService.GetArtifactSummaryInBatches(params)
+}
+
+// ComponentSummary returns the issues and licenses of the requested components, looked up in batches
+func (sm *XrayServicesManager) ComponentSummary(componentIds []string) (*services.ArtifactSummaryResponse, error) {
+	summaryService := services.NewSummaryService(sm.client)
+	summaryService.XrayDetails = sm.config.GetServiceDetails()
+	return summaryService.GetComponentSummary(componentIds)
+}
+
+// CveSummary returns the severities, fixed versions and applicability of the requested CVEs, looked up in batches
+func (sm *XrayServicesManager) CveSummary(cveIds []string) (*services.CveSummaryResponse, error) {
+	summaryService := services.NewSummaryService(sm.client)
+	summaryService.XrayDetails = sm.config.GetServiceDetails()
+	return summaryService.GetCveSummary(cveIds)
+}
+
 // IsEntitled returns true if the user is entitled for the requested feature ID
 func (sm *XrayServicesManager) IsEntitled(featureId string) (bool, error) {
 	entitlementsService := services.NewEntitlementsService(sm.client)
@@ -256,6 +459,14 @@ func (sm *XrayServicesManager) IsEntitled(featureId string) (bool, error) {
 	return entitlementsService.IsEntitled(featureId)
 }
 
+// GetPermissions returns the Xray actions the current credentials can perform
+func (sm *XrayServicesManager) GetPermissions() (*services.XrayPermissions, error) {
+	permissionsService := services.NewPermissionsService(sm.client)
+	permissionsService.XrayDetails = sm.config.GetServiceDetails()
+	permissionsService.ScopeProjectKey = sm.scopeProjectKey
+	return permissionsService.GetPermissions()
+}
+
 // Xsc returns the Xsc service inside Xray
 func (sm *XrayServicesManager) Xsc() *xsc.XscInnerService {
 	xscService := xsc.NewXscService(sm.client)
@@ -271,6 +482,22 @@ func (sm *XrayServicesManager) GetArtifactStatus(repo, path string) (*services.A
 	return artifactService.GetStatus(repo, path)
 }
 
+// GetBuildStatus returns the scan status of a build
+func (sm *XrayServicesManager) GetBuildStatus(buildName, buildNumber, project string) (*services.ArtifactStatusResponse, error) {
+	artifactService := services.NewArtifactService(sm.client)
+	artifactService.XrayDetails = sm.config.GetServiceDetails()
+	artifactService.ScopeProjectKey = sm.scopeProjectKey
+	return artifactService.GetBuildStatus(buildName, buildNumber, project)
+}
+
+// WatchScans polls the scan status of artifacts and builds until their scans complete, and calls the callbacks on each state transition
+func (sm *XrayServicesManager) WatchScans(params services.ScanWatcherParams) (map[services.ScanTarget]services.ScanState, error) {
+	artifactService := services.NewArtifactService(sm.client)
+	artifactService.XrayDetails = sm.config.GetServiceDetails()
+	artifactService.ScopeProjectKey = sm.scopeProjectKey
+	return artifactService.WatchScans(params)
+}
+
 func (sm *XrayServicesManager) GetViolations(params xrayUtils.ViolationsRequest) (*services.ViolationsResponse, error) {
 	violationsService := services.NewViolationsService(sm.client)
 	violationsService.XrayDetails = sm.config.GetServiceDetails()
@@ -278,6 +505,14 @@ func (sm *XrayServicesManager) GetViolations(params xrayUtils.ViolationsRequest)
 	return violationsService.GetViolations(params)
 }
 
+// GetViolationsSince passes the violations created or updated since a timestamp to the handler, and returns the checkpoint to start the next sync from
+func (sm *XrayServicesManager) GetViolationsSince(params services.ViolationsSyncParams, handler func(violation services.XrayViolation) error) (time.Time, error) {
+	violationsService := services.NewViolationsService(sm.client)
+	violationsService.XrayDetails = sm.config.GetServiceDetails()
+	violationsService.ScopeProjectKey = sm.scopeProjectKey
+	return violationsService.GetViolationsSince(params, handler)
+}
+
 func (sm *XrayServicesManager) DownloadIndexer(localDirPath, localFileName string) (string, error) {
 	indexerService := services.NewIndexerService(sm.client)
 	indexerService.XrayDetails = sm.config.GetServiceDetails()
@@ -291,3 +526,211 @@ func (sm *XrayServicesManager) RemediationByCve(bom *cyclonedx.BOM) (xrayUtils.C
 	remediationService.ScopeProjectKey = sm.scopeProjectKey
 	return remediationService.RemediationByCve(bom)
 }
+
+func (sm *XrayServicesManager) newSbomService() *services.SbomService {
+	sbomService := services.NewSbomService(sm.client)
+	sbomService.XrayDetails = sm.config.GetServiceDetails()
+	sbomService.ScopeProjectKey = sm.scopeProjectKey
+	return sbomService
+}
+
+// ExportSbom writes the SBOM of an artifact, build or release bundle to the writer
+func (sm *XrayServicesManager) ExportSbom(params services.SbomExportParams, writer io.Writer) error {
+	return sm.newSbomService().Export(params, writer)
+}
+
+// ExportSbomToFile downloads the SBOM of an artifact, build or release bundle to a local file
+func (sm *XrayServicesManager) ExportSbomToFile(params services.SbomExportParams, filePath string) error {
+	return sm.newSbomService().ExportToFile(params, filePath)
+}
+
+// ExportCycloneDxSbom returns the decoded CycloneDX SBOM of an artifact, build or release bundle
+func (sm *XrayServicesManager) ExportCycloneDxSbom(params services.SbomExportParams) (*cyclonedx.BOM, error) {
+	return sm.newSbomService().ExportCycloneDx(params)
+}
+
+// ImportSbom submits an external CycloneDX or SPDX SBOM to Xray for scanning, and returns the scan ID
+func (sm *XrayServicesManager) ImportSbom(params services.SbomImportParams) (scanId string, err error) {
+	return sm.newSbomService().Import(params)
+}
+
+// GetImportedSbomResults waits for the scan of an imported SBOM, and returns its enriched components
+func (sm *XrayServicesManager) GetImportedSbomResults(scanId string, timeout, pollingInterval time.Duration) (*services.ScanResponse, error) {
+	return sm.newSbomService().GetImportResults(scanId, timeout, pollingInterval)
+}
+
+// ImportSbomAndWait submits an external SBOM to Xray for scanning, and waits for the scan results
+func (sm *XrayServicesManager) ImportSbomAndWait(params services.SbomImportParams) (*services.ScanResponse, error) {
+	return sm.newSbomService().ImportAndWait(params)
+}
+
+func (sm *XrayServicesManager) newComponentGraphService() *services.ComponentGraphService {
+	componentGraphService := services.NewComponentGraphService(sm.client)
+	componentGraphService.XrayDetails = sm.config.GetServiceDetails()
+	componentGraphService.ScopeProjectKey = sm.scopeProjectKey
+	return componentGraphService
+}
+
+// GetArtifactDependencyGraph returns the full dependency graph of an artifact
+func (sm *XrayServicesManager) GetArtifactDependencyGraph(artifactPath string) (*services.ArtifactDependencyGraph, error) {
+	return sm.newComponentGraphService().GetArtifactDependencyGraph(artifactPath)
+}
+
+// GetArtifactDependencies returns a page of the direct, or also transitive, dependencies of an artifact
+func (sm *XrayServicesManager) GetArtifactDependencies(params services.ArtifactDependenciesParams) (*services.ArtifactDependenciesPage, error) {
+	return sm.newComponentGraphService().GetArtifactDependencies(params)
+}
+
+// GetComponentArtifacts returns the artifacts which contain a component
+func (sm *XrayServicesManager) GetComponentArtifacts(params services.ComponentArtifactsParams) (*services.ComponentArtifactsResponse, error) {
+	return sm.newComponentGraphService().GetComponentArtifacts(params)
+}
+
+func (sm *XrayServicesManager) newCurationService() *services.CurationService {
+	curationService := services.NewCurationService(sm.client)
+	curationService.XrayDetails = sm.config.GetServiceDetails()
+	curationService.ScopeProjectKey = sm.scopeProjectKey
+	return curationService
+}
+
+// GetCurationAuditEvents returns the packages that were blocked or approved by Curation
+func (sm *XrayServicesManager) GetCurationAuditEvents(params services.CurationAuditParams) (*services.CurationAuditResponse, error) {
+	return sm.newCurationService().GetAuditEvents(params)
+}
+
+// GetCurationPackagesStatus returns whether packages would be blocked by Curation, and by which policies
+func (sm *XrayServicesManager) GetCurationPackagesStatus(packages []services.CurationPackage) (*services.CurationPackagesStatusResponse, error) {
+	return sm.newCurationService().GetPackagesStatus(packages)
+}
+
+// GetCurationConditions returns all the Curation conditions
+func (sm *XrayServicesManager) GetCurationConditions() ([]services.CurationCondition, error) {
+	return sm.newCurationService().GetConditions()
+}
+
+// GetCurationCondition returns a Curation condition by its ID
+func (sm *XrayServicesManager) GetCurationCondition(conditionId string) (*services.CurationCondition, error) {
+	return sm.newCurationService().GetCondition(conditionId)
+}
+
+// GetCurationPolicies returns all the Curation policies
+func (sm *XrayServicesManager) GetCurationPolicies() ([]services.CurationPolicy, error) {
+	return sm.newCurationService().GetPolicies()
+}
+
+// GetCurationPolicy returns a Curation policy by its ID
+func (sm *XrayServicesManager) GetCurationPolicy(policyId string) (*services.CurationPolicy, error) {
+	return sm.newCurationService().GetPolicy(policyId)
+}
+
+func (sm *XrayServicesManager) newWebhookService() *services.WebhookService {
+	webhookService := services.NewWebhookService(sm.client)
+	webhookService.XrayDetails = sm.config.GetServiceDetails()
+	return webhookService
+}
+
+// CreateWebhook creates a new Xray webhook
+func (sm *XrayServicesManager) CreateWebhook(params services.WebhookParams) error {
+	return sm.newWebhookService().Create(params)
+}
+
+// GetWebhook returns an Xray webhook by name
+func (sm *XrayServicesManager) GetWebhook(name string) (*services.WebhookParams, error) {
+	return sm.newWebhookService().Get(name)
+}
+
+// GetAllWebhooks returns all the Xray webhooks
+func (sm *XrayServicesManager) GetAllWebhooks() ([]services.WebhookParams, error) {
+	return sm.newWebhookService().GetAll()
+}
+
+// UpdateWebhook replaces an existing Xray webhook by name
+func (sm *XrayServicesManager) UpdateWebhook(params services.WebhookParams) error {
+	return sm.newWebhookService().Update(params)
+}
+
+// DeleteWebhook deletes an Xray webhook by name
+func (sm *XrayServicesManager) DeleteWebhook(name string) error {
+	return sm.newWebhookService().Delete(name)
+}
+
+// TestWebhook sends a test event to an Xray webhook
+func (sm *XrayServicesManager) TestWebhook(name string) error {
+	return sm.newWebhookService().Test(name)
+}
+
+func (sm *XrayServicesManager) newExposuresService() *services.ExposuresService {
+	exposuresService := services.NewExposuresService(sm.client)
+	exposuresService.XrayDetails = sm.config.GetServiceDetails()
+	exposuresService.ScopeProjectKey = sm.scopeProjectKey
+	return exposuresService
+}
+
+// GetExposures returns a page of the exposures found in a container image
+func (sm *XrayServicesManager) GetExposures(params services.ExposuresParams) (*services.ExposuresResponse, error) {
+	return sm.newExposuresService().GetExposures(params)
+}
+
+// GetAllExposures returns all the exposures found in a container image
+func (sm *XrayServicesManager) GetAllExposures(params services.ExposuresParams) (*services.ExposuresResponse, error) {
+	return sm.newExposuresService().GetAllExposures(params)
+}
+
+func (sm *XrayServicesManager) newRetentionService() *services.RetentionService {
+	retentionService := services.NewRetentionService(sm.client)
+	retentionService.XrayDetails = sm.config.GetServiceDetails()
+	return retentionService
+}
+
+// GetRetentionConfig returns how long Xray keeps its scan results, violations and reports
+func (sm *XrayServicesManager) GetRetentionConfig() (*services.RetentionConfig, error) {
+	return sm.newRetentionService().GetConfig()
+}
+
+// UpdateRetentionConfig updates how long Xray keeps its scan results, violations and reports
+func (sm *XrayServicesManager) UpdateRetentionConfig(config services.RetentionConfig) error {
+	return sm.newRetentionService().UpdateConfig(config)
+}
+
+// StartCleanup starts a job which deletes old Xray data
+func (sm *XrayServicesManager) StartCleanup(params services.CleanupParams) (*services.CleanupJob, error) {
+	return sm.newRetentionService().StartCleanup(params)
+}
+
+// GetCleanupJob returns the status of a cleanup job
+func (sm *XrayServicesManager) GetCleanupJob(jobId string) (*services.CleanupJob, error) {
+	return sm.newRetentionService().GetCleanupJob(jobId)
+}
+
+// WaitForCleanup waits for a cleanup job to complete
+func (sm *XrayServicesManager) WaitForCleanup(jobId string, timeout, pollingInterval time.Duration) (*services.CleanupJob, error) {
+	return sm.newRetentionService().WaitForCleanup(jobId, timeout, pollingInterval)
+}
+
+func (sm *XrayServicesManager) newDbSyncService() *services.DbSyncService {
+	dbSyncService := services.NewDbSyncService(sm.client)
+	dbSyncService.XrayDetails = sm.config.GetServiceDetails()
+	return dbSyncService
+}
+
+// GetDbSyncBundles returns the bundles of the vulnerability database updates
+func (sm *XrayServicesManager) GetDbSyncBundles(params services.DbSyncBundlesParams) ([]services.DbSyncBundle, error) {
+	return sm.newDbSyncService().GetBundles(params)
+}
+
+// ExportDbSyncBundles downloads the bundles of the vulnerability database updates, to import them to an offline Xray instance
+func (sm *XrayServicesManager) ExportDbSyncBundles(params services.DbSyncBundlesParams, localDirPath string, progress ioutils.ProgressMgr) ([]string, error) {
+	return sm.newDbSyncService().ExportBundles(params, localDirPath, progress)
+}
+
+// ImportDbSyncBundle uploads a bundle of vulnerability database updates to an offline Xray instance
+func (sm *XrayServicesManager) ImportDbSyncBundle(bundlePath, expectedSha256 string, progress ioutils.ProgressMgr) error {
+	return sm.newDbSyncService().ImportBundle(bundlePath, expectedSha256, progress)
+}
+
+// EvaluateWatch evaluates whether an artifact would violate the policies of a watch, before it's deployed or promoted
+func (sm *XrayServicesManager) EvaluateWatch(watchName, artifactPathOrChecksum string) (*services.DryRunResult, error) {
+	dryRunService := services.NewDryRunService(sm.client)
+	dryRunService.XrayDetails = sm.config.GetServiceDetails()
+	return dryRunService.EvaluateWatch(watchName, artifactPathOrChecksum)
+}
@@ -12,7 +12,8 @@ import (
 )
 
 const (
-	statusAPI = "api/v1/artifact/status"
+	statusAPI      = "api/v1/artifact/status"
+	buildStatusAPI = "api/v1/build/status"
 )
 
 // ArtifactStatus represents the status of an artifact in Xray
@@ -65,11 +66,47 @@ func (as *ArtifactService) GetStatus(repo, path string) (response *ArtifactStatu
 	return
 }
 
+// GetBuildStatus returns the scan status of a build. The project is optional.
+func (as *ArtifactService) GetBuildStatus(buildName, buildNumber, project string) (response *ArtifactStatusResponse, err error) {
+	httpClientsDetails := as.XrayDetails.CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+
+	requestBody, err := json.Marshal(BuildStatusRequest{BuildName: buildName, BuildNumber: buildNumber})
+	if errorutils.CheckError(err) != nil {
+		return
+	}
+
+	url := as.XrayDetails.GetUrl() + buildStatusAPI
+	if project != "" {
+		url += "?" + projectKeyQueryParam + project
+	}
+	resp, body, err := as.client.SendPost(clientutils.AppendScopedProjectKeyParam(url, as.ScopeProjectKey), requestBody, &httpClientsDetails)
+	if err != nil {
+		return
+	}
+
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		err = fmt.Errorf("got unexpected server response while attempting to get build status for %s/%s:\n%s", buildName, buildNumber, err.Error())
+		return
+	}
+
+	response = &ArtifactStatusResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		err = errorutils.CheckErrorf("couldn't parse JFrog Xray build status response: %s", err.Error())
+	}
+	return
+}
+
 type ArtifactStatusRequest struct {
 	Repository string `json:"repo"`
 	Path       string `json:"path"`
 }
 
+type BuildStatusRequest struct {
+	BuildName   string `json:"name"`
+	BuildNumber string `json:"number"`
+}
+
 type ArtifactStatusResponse struct {
 	Overall ArtifactScanStatus     `json:"overall"`
 	Details ArtifactDetailedStatus `json:"details"`
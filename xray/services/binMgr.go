@@ -3,6 +3,8 @@ package services
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
+	"slices"
 
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
 
@@ -13,7 +15,11 @@ import (
 )
 
 const (
-	binMgrAPIURL = "api/v1/binMgr"
+	binMgrAPIURL      = "api/v1/binMgr"
+	reposConfigAPIURL = "api/v1/repos_config"
+
+	// The ID of the binary manager of the Artifactory instance Xray is connected to.
+	DefaultBinMgrId = "default"
 )
 
 // BinMgrService defines the http client and Xray details
@@ -68,6 +74,228 @@ func (xbms *BinMgrService) AddBuildsToIndexing(buildNames []string) error {
 	return nil
 }
 
+// GetIndexedRepositories returns the repositories of a binary manager, split by whether Xray indexes them
+func (xbms *BinMgrService) GetIndexedRepositories(binMgrId string) (*IndexedRepositoriesConfig, error) {
+	config := &IndexedRepositoriesConfig{}
+	if err := xbms.getConfig(xbms.getBinMgrReposURL(binMgrId), config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// UpdateIndexedRepositories sets the repositories Xray indexes
+func (xbms *BinMgrService) UpdateIndexedRepositories(binMgrId string, config IndexedRepositoriesConfig) error {
+	log.Info("Updating the indexed repositories of binary manager", binMgrId+"...")
+	return xbms.putConfig(xbms.getBinMgrReposURL(binMgrId), config)
+}
+
+// AddRepositoriesToIndexing configures Xray to index repositories. Returns an error if any of the repositories isn't
+// found in the binary manager. Repositories which are already indexed are ignored.
+func (xbms *BinMgrService) AddRepositoriesToIndexing(binMgrId string, repoNames []string) error {
+	return xbms.moveRepositories(binMgrId, repoNames, true)
+}
+
+// RemoveRepositoriesFromIndexing configures Xray to stop indexing repositories. Returns an error if any of the
+// repositories isn't found in the binary manager. Repositories which aren't indexed are ignored.
+func (xbms *BinMgrService) RemoveRepositoriesFromIndexing(binMgrId string, repoNames []string) error {
+	return xbms.moveRepositories(binMgrId, repoNames, false)
+}
+
+func (xbms *BinMgrService) moveRepositories(binMgrId string, repoNames []string, index bool) error {
+	config, err := xbms.GetIndexedRepositories(binMgrId)
+	if err != nil {
+		return err
+	}
+	from, to := &config.NonIndexedRepos, &config.IndexedRepos
+	if !index {
+		from, to = to, from
+	}
+	if err = moveIndexedResources(repoNames, func(repo IndexedRepository) string { return repo.Name }, from, to, "repository"); err != nil {
+		return err
+	}
+	return xbms.UpdateIndexedRepositories(binMgrId, *config)
+}
+
+// GetIndexedBuilds returns the builds, split by whether Xray indexes them
+func (xbms *BinMgrService) GetIndexedBuilds() (*IndexedBuildsConfig, error) {
+	config := &IndexedBuildsConfig{}
+	if err := xbms.getConfig(xbms.getBinMgrURL()+"/builds", config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// UpdateIndexedBuilds sets the builds Xray indexes. Builds which aren't listed stop being indexed.
+func (xbms *BinMgrService) UpdateIndexedBuilds(buildNames []string) error {
+	log.Info("Updating the indexed builds...")
+	return xbms.putConfig(xbms.getBinMgrURL()+"/builds", IndexedBuildsConfig{IndexedBuilds: buildNames})
+}
+
+// RemoveBuildsFromIndexing configures Xray to stop indexing builds. Returns an error if any of the builds isn't found.
+// Builds which aren't indexed are ignored.
+func (xbms *BinMgrService) RemoveBuildsFromIndexing(buildNames []string) error {
+	config, err := xbms.GetIndexedBuilds()
+	if err != nil {
+		return err
+	}
+	if err = moveIndexedResources(buildNames, func(name string) string { return name }, &config.IndexedBuilds, &config.NonIndexedBuilds, "build"); err != nil {
+		return err
+	}
+	return xbms.UpdateIndexedBuilds(config.IndexedBuilds)
+}
+
+// GetIndexedReleaseBundles returns the release bundles, split by whether Xray indexes them
+func (xbms *BinMgrService) GetIndexedReleaseBundles() (*IndexedReleaseBundlesConfig, error) {
+	config := &IndexedReleaseBundlesConfig{}
+	if err := xbms.getConfig(xbms.getBinMgrURL()+"/release_bundles", config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// UpdateIndexedReleaseBundles sets the release bundles Xray indexes. Release bundles which aren't listed stop being indexed.
+func (xbms *BinMgrService) UpdateIndexedReleaseBundles(releaseBundleNames []string) error {
+	log.Info("Updating the indexed release bundles...")
+	return xbms.putConfig(xbms.getBinMgrURL()+"/release_bundles", IndexedReleaseBundlesConfig{IndexedReleaseBundles: releaseBundleNames})
+}
+
+// AddReleaseBundlesToIndexing configures Xray to index release bundles. Returns an error if any of the release bundles
+// isn't found. Release bundles which are already indexed are ignored.
+func (xbms *BinMgrService) AddReleaseBundlesToIndexing(releaseBundleNames []string) error {
+	return xbms.moveReleaseBundles(releaseBundleNames, true)
+}
+
+// RemoveReleaseBundlesFromIndexing configures Xray to stop indexing release bundles. Returns an error if any of the
+// release bundles isn't found. Release bundles which aren't indexed are ignored.
+func (xbms *BinMgrService) RemoveReleaseBundlesFromIndexing(releaseBundleNames []string) error {
+	return xbms.moveReleaseBundles(releaseBundleNames, false)
+}
+
+func (xbms *BinMgrService) moveReleaseBundles(releaseBundleNames []string, index bool) error {
+	config, err := xbms.GetIndexedReleaseBundles()
+	if err != nil {
+		return err
+	}
+	from, to := &config.NonIndexedReleaseBundles, &config.IndexedReleaseBundles
+	if !index {
+		from, to = to, from
+	}
+	if err = moveIndexedResources(releaseBundleNames, func(name string) string { return name }, from, to, "release bundle"); err != nil {
+		return err
+	}
+	return xbms.UpdateIndexedReleaseBundles(config.IndexedReleaseBundles)
+}
+
+// GetRepositoryConfig returns the scanning configuration of a repository, such as the retention of its scan results
+func (xbms *BinMgrService) GetRepositoryConfig(repoName string) (*RepositoryConfig, error) {
+	config := &RepositoryConfig{}
+	if err := xbms.getConfig(clientutils.AddTrailingSlashIfNeeded(xbms.XrayDetails.GetUrl())+reposConfigAPIURL+"/"+url.PathEscape(repoName), config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// UpdateRepositoryConfig sets the scanning configuration of a repository
+func (xbms *BinMgrService) UpdateRepositoryConfig(config RepositoryConfig) error {
+	if config.RepoName == "" {
+		return errorutils.CheckErrorf("a repository name is required to update its Xray configuration")
+	}
+	log.Info("Updating the Xray configuration of repository", config.RepoName+"...")
+	return xbms.putConfig(clientutils.AddTrailingSlashIfNeeded(xbms.XrayDetails.GetUrl())+reposConfigAPIURL, config)
+}
+
+// Moves the resources with the requested names between the indexed and the non-indexed lists.
+func moveIndexedResources[T any](names []string, nameOf func(T) string, from, to *[]T, resourceType string) error {
+	for _, name := range names {
+		isNamed := func(resource T) bool { return nameOf(resource) == name }
+		if slices.ContainsFunc(*to, isNamed) {
+			continue
+		}
+		i := slices.IndexFunc(*from, isNamed)
+		if i < 0 {
+			return errorutils.CheckErrorf("%s '%s' wasn't found in the Xray indexing configuration", resourceType, name)
+		}
+		*to = append(*to, (*from)[i])
+		*from = slices.Delete(*from, i, i+1)
+	}
+	return nil
+}
+
+func (xbms *BinMgrService) getBinMgrReposURL(binMgrId string) string {
+	if binMgrId == "" {
+		binMgrId = DefaultBinMgrId
+	}
+	return xbms.getBinMgrURL() + "/" + url.PathEscape(binMgrId) + "/repos"
+}
+
+func (xbms *BinMgrService) getConfig(configUrl string, config any) error {
+	httpClientsDetails := xbms.XrayDetails.CreateHttpClientDetails()
+	resp, body, _, err := xbms.client.SendGet(configUrl, true, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	log.Debug("Xray response:", resp.Status)
+	return errorutils.CheckError(json.Unmarshal(body, config))
+}
+
+func (xbms *BinMgrService) putConfig(configUrl string, config any) error {
+	content, err := json.Marshal(config)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpClientsDetails := xbms.XrayDetails.CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	resp, body, err := xbms.client.SendPut(configUrl, content, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated, http.StatusNoContent); err != nil {
+		return err
+	}
+	log.Debug("Xray response:", resp.Status)
+	return nil
+}
+
 type addBuildsToIndexBody struct {
 	BuildNames []string `json:"names"`
 }
+
+type IndexedRepositoriesConfig struct {
+	BinMgrId        string              `json:"bin_mgr_id,omitempty"`
+	IndexedRepos    []IndexedRepository `json:"indexed_repos"`
+	NonIndexedRepos []IndexedRepository `json:"non_indexed_repos"`
+}
+
+type IndexedRepository struct {
+	Name string `json:"name"`
+	// Valid values: local, remote, federated
+	Type    string `json:"type,omitempty"`
+	PkgType string `json:"pkg_type,omitempty"`
+}
+
+type IndexedBuildsConfig struct {
+	BinMgrId         string   `json:"bin_mgr_id,omitempty"`
+	IndexedBuilds    []string `json:"indexed_builds"`
+	NonIndexedBuilds []string `json:"non_indexed_builds,omitempty"`
+}
+
+type IndexedReleaseBundlesConfig struct {
+	BinMgrId                 string   `json:"bin_mgr_id,omitempty"`
+	IndexedReleaseBundles    []string `json:"indexed_release_bundles"`
+	NonIndexedReleaseBundles []string `json:"non_indexed_release_bundles,omitempty"`
+}
+
+type RepositoryConfig struct {
+	RepoName string               `json:"repo_name"`
+	Config   RepositoryScanConfig `json:"repo_config"`
+}
+
+type RepositoryScanConfig struct {
+	// The number of days the scan results of the repository's artifacts are kept.
+	RetentionInDays int `json:"retention_in_days,omitempty"`
+	// Enables the contextual analysis of the vulnerabilities found in the repository.
+	VulnContextualAnalysis *bool `json:"vuln_contextual_analysis,omitempty"`
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestBinMgrService(t *testing.T, serverUrl string) *BinMgrService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	binMgrService := NewBinMgrService(client)
+	binMgrService.XrayDetails = xrayDetails
+	return binMgrService
+}
+
+func TestAddAndRemoveRepositoriesToIndexing(t *testing.T) {
+	config := IndexedRepositoriesConfig{
+		BinMgrId:        DefaultBinMgrId,
+		IndexedRepos:    []IndexedRepository{{Name: "libs-release-local", Type: "local", PkgType: "maven"}},
+		NonIndexedRepos: []IndexedRepository{{Name: "npm-remote", Type: "remote", PkgType: "npm"}, {Name: "generic-local", Type: "local", PkgType: "generic"}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+binMgrAPIURL+"/default/repos", r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			content, err := json.Marshal(config)
+			assert.NoError(t, err)
+			_, _ = w.Write(content)
+		case http.MethodPut:
+			config = IndexedRepositoriesConfig{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&config))
+		}
+	}))
+	defer server.Close()
+	binMgrService := createTestBinMgrService(t, server.URL)
+
+	assert.NoError(t, binMgrService.AddRepositoriesToIndexing("", []string{"npm-remote", "libs-release-local"}))
+	assert.Equal(t, []IndexedRepository{{Name: "libs-release-local", Type: "local", PkgType: "maven"}, {Name: "npm-remote", Type: "remote", PkgType: "npm"}}, config.IndexedRepos)
+	assert.Equal(t, []IndexedRepository{{Name: "generic-local", Type: "local", PkgType: "generic"}}, config.NonIndexedRepos)
+
+	assert.NoError(t, binMgrService.RemoveRepositoriesFromIndexing(DefaultBinMgrId, []string{"libs-release-local"}))
+	assert.Equal(t, []IndexedRepository{{Name: "npm-remote", Type: "remote", PkgType: "npm"}}, config.IndexedRepos)
+	assert.Len(t, config.NonIndexedRepos, 2)
+
+	assert.ErrorContains(t, binMgrService.AddRepositoriesToIndexing(DefaultBinMgrId, []string{"missing"}), "repository 'missing' wasn't found")
+}
+
+func TestRemoveBuildsFromIndexing(t *testing.T) {
+	var updated IndexedBuildsConfig
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+binMgrAPIURL+"/builds", r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"bin_mgr_id":"default","indexed_builds":["build1","build2"],"non_indexed_builds":["build3"]}`))
+		case http.MethodPut:
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+		}
+	}))
+	defer server.Close()
+	binMgrService := createTestBinMgrService(t, server.URL)
+	assert.NoError(t, binMgrService.RemoveBuildsFromIndexing([]string{"build1", "build3"}))
+	assert.Equal(t, []string{"build2"}, updated.IndexedBuilds)
+}
+
+func TestUpdateRepositoryConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/"+reposConfigAPIURL, r.URL.Path)
+		config := RepositoryConfig{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&config))
+		assert.Equal(t, RepositoryConfig{RepoName: "libs-release-local", Config: RepositoryScanConfig{RetentionInDays: 90}}, config)
+	}))
+	defer server.Close()
+	binMgrService := createTestBinMgrService(t, server.URL)
+	assert.NoError(t, binMgrService.UpdateRepositoryConfig(RepositoryConfig{RepoName: "libs-release-local", Config: RepositoryScanConfig{RetentionInDays: 90}}))
+	assert.ErrorContains(t, binMgrService.UpdateRepositoryConfig(RepositoryConfig{}), "a repository name is required")
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	xrayUtils "github.com/jfrog/jfrog-client-go/xray/services/utils"
+)
+
+// The parameters of an on-demand scan of a local binary or archive, to check it before it's deployed.
+type BinaryScanParams struct {
+	// The graph of the local binary or archive, as produced by indexing it locally with the JFrog Xray indexer app.
+	BinaryGraph *xrayUtils.BinaryGraphNode
+	// The path in Artifactory that the binary is intended to be deployed to, e.g. "libs-release-local/org/acme/app.jar".
+	// The watches of the repository are applied on the scan.
+	RepoPath string
+	// The watches of the project are applied on the scan. Takes precedence over RepoPath.
+	ProjectKey string
+	// The watches to apply on the scan. Ignored if RepoPath or ProjectKey are set.
+	Watches                []string
+	IncludeVulnerabilities bool
+	IncludeLicenses        bool
+}
+
+func NewBinaryScanParams(binaryGraph *xrayUtils.BinaryGraphNode) BinaryScanParams {
+	return BinaryScanParams{BinaryGraph: binaryGraph}
+}
+
+// Requests a graph scan of a locally indexed binary or archive, and waits for the scan results.
+func (ss *ScanService) ScanBinary(params BinaryScanParams) (*ScanResponse, error) {
+	if params.BinaryGraph == nil {
+		return nil, errorutils.CheckErrorf("a binary graph is required to scan a binary")
+	}
+	scanId, err := ss.ScanGraph(XrayGraphScanParams{
+		RepoPath:               params.RepoPath,
+		ProjectKey:             params.ProjectKey,
+		Watches:                params.Watches,
+		ScanType:               Binary,
+		BinaryGraph:            params.BinaryGraph,
+		IncludeVulnerabilities: params.IncludeVulnerabilities,
+		IncludeLicenses:        params.IncludeLicenses,
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("Requested a scan of", params.BinaryGraph.Path, "with scan ID", scanId)
+	return ss.GetScanGraphResults(scanId, "", params.IncludeVulnerabilities, params.IncludeLicenses, false)
+}
+
+// Returns true if any of the violations is set to fail builds, or is blocking by any of its policies.
+func (sr *ScanResponse) HasBlockingViolations() bool {
+	for _, violation := range sr.Violations {
+		if violation.FailBuild {
+			return true
+		}
+		for _, policy := range violation.Policies {
+			if policy.IsBlocking {
+				return true
+			}
+		}
+	}
+	return false
+}
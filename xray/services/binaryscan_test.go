@@ -0,0 +1,48 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	xrayUtils "github.com/jfrog/jfrog-client-go/xray/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanBinary(t *testing.T) {
+	binaryGraph := &xrayUtils.BinaryGraphNode{Path: "app.jar", Sha256: "abc", Nodes: []*xrayUtils.BinaryGraphNode{{Id: "gav://org.acme:lib:1.0.0"}}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/"+scanGraphAPI, r.URL.Path)
+			assert.Equal(t, "libs-local/app.jar", r.URL.Query().Get("repo_path"))
+			assert.Equal(t, string(Binary), r.URL.Query().Get("scan_type"))
+			graph := &xrayUtils.BinaryGraphNode{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(graph))
+			assert.Equal(t, binaryGraph, graph)
+			_, _ = w.Write([]byte(`{"scan_id":"scan-1"}`))
+		case http.MethodGet:
+			assert.Equal(t, "/"+scanGraphAPI+"/scan-1", r.URL.Path)
+			assert.Equal(t, "true", r.URL.Query().Get("include_vulnerabilities"))
+			_, _ = w.Write([]byte(`{"scan_id":"scan-1","violations":[{"issue_id":"XRAY-1","policies":[{"policy":"gate","is_blocking":true}]}],"vulnerabilities":[{"issue_id":"XRAY-1","severity":"High"}]}`))
+		}
+	}))
+	defer server.Close()
+	client, xrayDetails := newTestXrayClient(t, server.URL)
+	scanService := NewScanService(client)
+	scanService.XrayDetails = xrayDetails
+
+	params := NewBinaryScanParams(binaryGraph)
+	params.RepoPath = "libs-local/app.jar"
+	params.IncludeVulnerabilities = true
+	results, err := scanService.ScanBinary(params)
+	assert.NoError(t, err)
+	if assert.Len(t, results.Vulnerabilities, 1) {
+		assert.Equal(t, "High", results.Vulnerabilities[0].Severity)
+	}
+	assert.True(t, results.HasBlockingViolations())
+
+	_, err = scanService.ScanBinary(NewBinaryScanParams(nil))
+	assert.ErrorContains(t, err, "a binary graph is required")
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/jfrog/gofrog/version"
 	"github.com/jfrog/jfrog-client-go/auth"
@@ -15,6 +16,7 @@ import (
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
+	xrayUtils "github.com/jfrog/jfrog-client-go/xray/services/utils"
 )
 
 const (
@@ -26,8 +28,11 @@ const (
 
 	projectKeyQueryParam                  = "projectKey="
 	includeVulnerabilitiesQueryParam      = "include_vulnerabilities="
+	includeLicensesQueryParam             = "include_licenses="
 	buildScanResultsPostApiMinXrayVersion = "3.77.0"
 	buildScanResultsPostApi               = "scanResult"
+
+	defaultBuildScanMaxPollingInterval = time.Minute
 )
 
 var (
@@ -55,7 +60,7 @@ func (bs *BuildScanService) ScanBuild(params XrayBuildParams, includeVulnerabili
 			return
 		}
 	}
-	getResultsReqFunc, err := bs.prepareGetResultsRequest(params, includeVulnerabilities)
+	getResultsReqFunc, err := bs.prepareGetResultsRequest(params, includeVulnerabilities, false)
 	if err != nil {
 		return
 	}
@@ -63,6 +68,43 @@ func (bs *BuildScanService) ScanBuild(params XrayBuildParams, includeVulnerabili
 	return
 }
 
+// Triggers the scan of a build and waits for its results, polling Xray with an exponential backoff.
+// If params.FailBuild is set and Xray marked the build to fail, the summary is returned along with a BuildScanFailBuildError.
+func (bs *BuildScanService) ScanBuildAndWait(params BuildScanParams) (*BuildScanSummary, error) {
+	includeIssues := params.IncludeVulnerabilities || params.IncludeLicenses
+	noFailBuildPolicy := false
+	if err := bs.triggerScan(params.XrayBuildParams, params.TriggerRetries); err != nil {
+		// Without a "Fail build" policy there are no violations, but the vulnerabilities and licenses may still be requested.
+		if !includeIssues || !strings.Contains(err.Error(), XrayScanBuildNoFailBuildPolicy) {
+			return nil, err
+		}
+		noFailBuildPolicy = true
+	}
+	getResultsReqFunc, err := bs.prepareGetResultsRequest(params.XrayBuildParams, params.IncludeVulnerabilities, params.IncludeLicenses)
+	if err != nil {
+		return nil, err
+	}
+	timeout := valueOrDefault(params.Timeout, defaultMaxWaitMinutes)
+	interval := valueOrDefault(params.PollingInterval, defaultSyncSleepInterval)
+	maxInterval := max(valueOrDefault(params.MaxPollingInterval, defaultBuildScanMaxPollingInterval), interval)
+	log.Info("Waiting for Build Scan to complete...")
+	body, err := pollWithBackoff(buildScanPollingAction(getResultsReqFunc), timeout, interval, maxInterval,
+		fmt.Sprintf("Get Build Scan results for Build: %s/%s...", params.BuildName, params.BuildNumber))
+	if err != nil {
+		return nil, err
+	}
+	scanResponse, err := parseBuildScanResults(body)
+	if err != nil {
+		return nil, err
+	}
+	summary := NewBuildScanSummary(scanResponse)
+	summary.NoFailBuildPolicy = noFailBuildPolicy
+	if params.FailBuild && scanResponse.FailBuild {
+		return summary, errorutils.CheckError(&BuildScanFailBuildError{BuildName: params.BuildName, BuildNumber: params.BuildNumber, MoreDetailsUrl: scanResponse.MoreDetailsUrl})
+	}
+	return summary, nil
+}
+
 func isArtifactoryBuildNotFoundError(resp *http.Response, body []byte) error {
 	if resp.StatusCode != http.StatusNotFound {
 		return nil
@@ -124,7 +166,7 @@ func (bs *BuildScanService) triggerScan(params XrayBuildParams, retries int) err
 
 // prepareGetResultsRequest creates a function that requests for the scan results from Xray.
 // Starting from Xray version 3.77.0, there's a new POST API that supports special characters in the build-name and build-number fields.
-func (bs *BuildScanService) prepareGetResultsRequest(params XrayBuildParams, includeVulnerabilities bool) (getResultsReqFunc func() (*http.Response, []byte, error), err error) {
+func (bs *BuildScanService) prepareGetResultsRequest(params XrayBuildParams, includeVulnerabilities, includeLicenses bool) (getResultsReqFunc func() (*http.Response, []byte, error), err error) {
 	paramsBytes, err := json.Marshal(params)
 	if errorutils.CheckError(err) != nil {
 		return
@@ -137,6 +179,9 @@ func (bs *BuildScanService) prepareGetResultsRequest(params XrayBuildParams, inc
 	if includeVulnerabilities {
 		queryParams = append(queryParams, includeVulnerabilitiesQueryParam+"true")
 	}
+	if includeLicenses {
+		queryParams = append(queryParams, includeLicensesQueryParam+"true")
+	}
 	httpClientsDetails := bs.XrayDetails.CreateHttpClientDetails()
 	httpClientsDetails.SetContentTypeApplicationJson()
 	if version.NewVersion(xrayVer).AtLeast(buildScanResultsPostApiMinXrayVersion) {
@@ -149,7 +194,22 @@ func (bs *BuildScanService) prepareGetResultsRequest(params XrayBuildParams, inc
 
 func (bs *BuildScanService) getBuildScanResults(reqFunc func() (*http.Response, []byte, error), params XrayBuildParams) (*BuildScanResponse, error) {
 	log.Info("Waiting for Build Scan to complete...")
-	pollingAction := func() (shouldStop bool, responseBody []byte, err error) {
+	pollingExecutor := &httputils.PollingExecutor{
+		Timeout:         defaultMaxWaitMinutes,
+		PollingInterval: defaultSyncSleepInterval,
+		PollingAction:   buildScanPollingAction(reqFunc),
+		MsgPrefix:       fmt.Sprintf("Get Build Scan results for Build: %s/%s...", params.BuildName, params.BuildNumber),
+	}
+
+	body, err := pollingExecutor.Execute()
+	if err != nil {
+		return nil, err
+	}
+	return parseBuildScanResults(body)
+}
+
+func buildScanPollingAction(reqFunc func() (*http.Response, []byte, error)) httputils.PollingAction {
+	return func() (shouldStop bool, responseBody []byte, err error) {
 		resp, body, err := reqFunc()
 		if err != nil {
 			return true, nil, err
@@ -163,25 +223,34 @@ func (bs *BuildScanService) getBuildScanResults(reqFunc func() (*http.Response,
 		}
 		return false, nil, nil
 	}
-	pollingExecutor := &httputils.PollingExecutor{
-		Timeout:         defaultMaxWaitMinutes,
-		PollingInterval: defaultSyncSleepInterval,
-		PollingAction:   pollingAction,
-		MsgPrefix:       fmt.Sprintf("Get Build Scan results for Build: %s/%s...", params.BuildName, params.BuildNumber),
-	}
+}
 
-	body, err := pollingExecutor.Execute()
-	if err != nil {
-		return nil, err
+// Runs the polling action until it stops, doubling the interval between the attempts up to maxInterval, with jitter.
+func pollWithBackoff(pollingAction httputils.PollingAction, timeout, interval, maxInterval time.Duration, msgPrefix string) ([]byte, error) {
+	deadline := time.Now().Add(timeout)
+	for attempt := 0; ; attempt++ {
+		shouldStop, body, err := pollingAction()
+		if shouldStop || err != nil {
+			return body, err
+		}
+		delay := utils.CalculateBackoff(attempt, interval, maxInterval)
+		if time.Now().Add(delay).After(deadline) {
+			return nil, errorutils.CheckErrorf("%s timed out after %v and %d attempts", msgPrefix, timeout, attempt+1)
+		}
+		log.Debug(fmt.Sprintf("%s(Attempt %d) retrying in %v", msgPrefix, attempt+1, delay))
+		time.Sleep(delay)
 	}
+}
+
+func parseBuildScanResults(body []byte) (*BuildScanResponse, error) {
 	buildScanResponse := BuildScanResponse{}
-	if err = json.Unmarshal(body, &buildScanResponse); err != nil {
+	if err := json.Unmarshal(body, &buildScanResponse); err != nil {
 		return nil, errorutils.CheckError(err)
 	}
 	if buildScanResponse.Status == xrayScanStatusFailed {
 		return nil, errorutils.CheckErrorf("Xray build scan failed")
 	}
-	return &buildScanResponse, err
+	return &buildScanResponse, nil
 }
 
 func (bs *BuildScanService) getResultsGetRequestFunc(params XrayBuildParams, httpClientsDetails *httputils.HttpClientDetails, queryParams []string) func() (*http.Response, []byte, error) {
@@ -229,5 +298,69 @@ type BuildScanResponse struct {
 	FailBuild       bool            `json:"fail_build,omitempty"`
 	Violations      []Violation     `json:"violations,omitempty"`
 	Vulnerabilities []Vulnerability `json:"vulnerabilities,omitempty"`
+	Licenses        []License       `json:"licenses,omitempty"`
 	Info            string          `json:"info,omitempty"`
 }
+
+type BuildScanParams struct {
+	XrayBuildParams
+	IncludeVulnerabilities bool
+	IncludeLicenses        bool
+	// Return a BuildScanFailBuildError if Xray marked the build to fail, i.e. a "Fail build" policy rule was violated.
+	FailBuild bool
+	// The number of attempts to trigger the scan, while the build is indexed. Defaults to 1.
+	TriggerRetries int
+	// The time to wait for the scan to complete. Defaults to 45 minutes.
+	Timeout time.Duration
+	// The time to wait before the first results check. Doubled after every check, up to MaxPollingInterval. Defaults to 5 seconds.
+	PollingInterval time.Duration
+	// Defaults to 1 minute.
+	MaxPollingInterval time.Duration
+}
+
+func NewBuildScanParams(buildName, buildNumber, project string) BuildScanParams {
+	return BuildScanParams{XrayBuildParams: XrayBuildParams{BuildName: buildName, BuildNumber: buildNumber, Project: project}}
+}
+
+// The results of a build scan, with its violations grouped by type.
+type BuildScanSummary struct {
+	*BuildScanResponse
+	// True if no "Fail build" policy rule is defined on the build, so no violations were checked.
+	NoFailBuildPolicy         bool
+	SecurityViolations        []Violation
+	LicenseViolations         []Violation
+	OperationalRiskViolations []Violation
+	// The number of violations of each severity.
+	ViolationsBySeverity map[string]int
+}
+
+func NewBuildScanSummary(scanResponse *BuildScanResponse) *BuildScanSummary {
+	summary := &BuildScanSummary{BuildScanResponse: scanResponse, ViolationsBySeverity: make(map[string]int)}
+	for _, violation := range scanResponse.Violations {
+		switch xrayUtils.PolicyType(strings.ToLower(violation.ViolationType)) {
+		case xrayUtils.Security:
+			summary.SecurityViolations = append(summary.SecurityViolations, violation)
+		case xrayUtils.License:
+			summary.LicenseViolations = append(summary.LicenseViolations, violation)
+		case xrayUtils.OperationalRisk:
+			summary.OperationalRiskViolations = append(summary.OperationalRiskViolations, violation)
+		}
+		summary.ViolationsBySeverity[violation.Severity]++
+	}
+	return summary
+}
+
+// Returned by ScanBuildAndWait if Xray marked the scanned build to fail.
+type BuildScanFailBuildError struct {
+	BuildName      string
+	BuildNumber    string
+	MoreDetailsUrl string
+}
+
+func (e *BuildScanFailBuildError) Error() string {
+	message := fmt.Sprintf("Xray marked build %s/%s to fail, as it violates a \"Fail build\" policy rule", e.BuildName, e.BuildNumber)
+	if e.MoreDetailsUrl != "" {
+		message += ". More details: " + e.MoreDetailsUrl
+	}
+	return message
+}
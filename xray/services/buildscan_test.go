@@ -0,0 +1,107 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testBuildScanResults = `{"status":"completed","fail_build":true,"more_details_url":"https://xray/build","violations":[
+	{"type":"security","severity":"High","issue_id":"XRAY-1"},
+	{"type":"security","severity":"Critical","issue_id":"XRAY-2"},
+	{"type":"license","severity":"High","license_key":"GPL-3.0"},
+	{"type":"operational_risk","severity":"Low"}]}`
+
+func createTestBuildScanService(t *testing.T, serverUrl string) *BuildScanService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	buildScanService := NewBuildScanService(client)
+	buildScanService.XrayDetails = xrayDetails
+	return buildScanService
+}
+
+func createBuildScanTestServer(t *testing.T, triggerInfo string, pendingResults int) (*httptest.Server, *int) {
+	resultRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/" + BuildScanAPI:
+			_, _ = w.Write([]byte(`{"info":"` + triggerInfo + `"}`))
+		case "/" + BuildScanAPI + "/" + buildScanResultsPostApi:
+			assert.Equal(t, "include_vulnerabilities=true&include_licenses=true", r.URL.RawQuery)
+			if resultRequests++; resultRequests <= pendingResults {
+				w.WriteHeader(http.StatusAccepted)
+				return
+			}
+			_, _ = w.Write([]byte(testBuildScanResults))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server, &resultRequests
+}
+
+func TestScanBuildAndWait(t *testing.T) {
+	server, resultRequests := createBuildScanTestServer(t, "Scan of Build: build number: 1 was successfully triggered", 2)
+	defer server.Close()
+	params := NewBuildScanParams("build", "1", "")
+	params.IncludeVulnerabilities = true
+	params.IncludeLicenses = true
+	params.PollingInterval = time.Millisecond
+
+	summary, err := createTestBuildScanService(t, server.URL).ScanBuildAndWait(params)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *resultRequests)
+	assert.False(t, summary.NoFailBuildPolicy)
+	assert.Len(t, summary.SecurityViolations, 2)
+	assert.Len(t, summary.LicenseViolations, 1)
+	assert.Len(t, summary.OperationalRiskViolations, 1)
+	assert.Equal(t, map[string]int{"Critical": 1, "High": 2, "Low": 1}, summary.ViolationsBySeverity)
+
+	params.FailBuild = true
+	*resultRequests = 0
+	summary, err = createTestBuildScanService(t, server.URL).ScanBuildAndWait(params)
+	var failBuildErr *BuildScanFailBuildError
+	if assert.True(t, errors.As(err, &failBuildErr)) {
+		assert.Equal(t, "https://xray/build", failBuildErr.MoreDetailsUrl)
+	}
+	assert.NotNil(t, summary)
+}
+
+func TestScanBuildAndWaitNoFailBuildPolicy(t *testing.T) {
+	server, _ := createBuildScanTestServer(t, XrayScanBuildNoFailBuildPolicy, 0)
+	defer server.Close()
+	params := NewBuildScanParams("build", "1", "")
+	params.IncludeVulnerabilities = true
+	params.IncludeLicenses = true
+
+	summary, err := createTestBuildScanService(t, server.URL).ScanBuildAndWait(params)
+	assert.NoError(t, err)
+	assert.True(t, summary.NoFailBuildPolicy)
+
+	params.IncludeVulnerabilities = false
+	params.IncludeLicenses = false
+	_, err = createTestBuildScanService(t, server.URL).ScanBuildAndWait(params)
+	assert.ErrorContains(t, err, XrayScanBuildNoFailBuildPolicy)
+}
+
+func TestPollWithBackoff(t *testing.T) {
+	var attempts []time.Time
+	pollingAction := func() (bool, []byte, error) {
+		attempts = append(attempts, time.Now())
+		return len(attempts) == 4, []byte("done"), nil
+	}
+	body, err := pollWithBackoff(pollingAction, time.Minute, 5*time.Millisecond, 12*time.Millisecond, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "done", string(body))
+	if assert.Len(t, attempts, 4) {
+		// The intervals are 5, 10 and then capped at 12 milliseconds, with a jitter of up to 20%.
+		assert.GreaterOrEqual(t, attempts[2].Sub(attempts[1]), 8*time.Millisecond)
+		assert.GreaterOrEqual(t, attempts[3].Sub(attempts[2]), 9*time.Millisecond)
+	}
+
+	_, err = pollWithBackoff(func() (bool, []byte, error) { return false, nil, nil }, 10*time.Millisecond, 4*time.Millisecond, time.Second, "poll")
+	assert.ErrorContains(t, err, "poll timed out")
+}
@@ -0,0 +1,208 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/xray/services/utils"
+)
+
+const (
+	artifactDependencyGraphAPI = "api/v1/dependencyGraph/artifact"
+	componentArtifactsAPI      = "api/v1/component/artifacts"
+)
+
+type ComponentGraphService struct {
+	client          *jfroghttpclient.JfrogHttpClient
+	XrayDetails     auth.ServiceDetails
+	ScopeProjectKey string
+}
+
+func NewComponentGraphService(client *jfroghttpclient.JfrogHttpClient) *ComponentGraphService {
+	return &ComponentGraphService{client: client}
+}
+
+type ArtifactDependencyGraph struct {
+	Artifact   GraphArtifact    `json:"artifact"`
+	Components []GraphComponent `json:"components,omitempty"`
+}
+
+type GraphArtifact struct {
+	Name        string `json:"name,omitempty"`
+	Path        string `json:"path,omitempty"`
+	PackageType string `json:"pkg_type,omitempty"`
+	Sha256      string `json:"sha256,omitempty"`
+	ComponentId string `json:"component_id,omitempty"`
+}
+
+type GraphComponent struct {
+	ComponentId   string           `json:"component_id,omitempty"`
+	ComponentName string           `json:"component_name,omitempty"`
+	PackageType   string           `json:"package_type,omitempty"`
+	Version       string           `json:"version,omitempty"`
+	Created       string           `json:"created,omitempty"`
+	Components    []GraphComponent `json:"components,omitempty"`
+}
+
+type ArtifactDependenciesParams struct {
+	// The path of the artifact, including the Artifactory ID, e.g. "default/libs-release-local/org/acme/app/1.0/app-1.0.jar".
+	ArtifactPath string
+	// Includes the transitive dependencies. Otherwise, only the direct dependencies are returned.
+	Transitive bool
+	Offset     int
+	// 0 for no limit.
+	Limit int
+}
+
+func NewArtifactDependenciesParams(artifactPath string) ArtifactDependenciesParams {
+	return ArtifactDependenciesParams{ArtifactPath: artifactPath}
+}
+
+type ArtifactDependenciesPage struct {
+	Dependencies []GraphDependency
+	TotalCount   int
+	HasMore      bool
+}
+
+type GraphDependency struct {
+	ComponentId string
+	PackageType string
+	Version     string
+	// 1 for direct dependencies.
+	Depth int
+	// The component ID of the component which depends on this dependency. Empty for direct dependencies.
+	Parent string
+}
+
+type ComponentArtifactsParams struct {
+	// The component ID, e.g. "gav://org.acme:lib:1.0".
+	ComponentId string
+	Pagination  *utils.PaginationOptions
+}
+
+func NewComponentArtifactsParams(componentId string) ComponentArtifactsParams {
+	return ComponentArtifactsParams{ComponentId: componentId}
+}
+
+type componentArtifactsBody struct {
+	ComponentId string                   `json:"component_id"`
+	Pagination  *utils.PaginationOptions `json:"pagination,omitempty"`
+}
+
+type ComponentArtifactsResponse struct {
+	TotalCount int             `json:"total_count,omitempty"`
+	Artifacts  []GraphArtifact `json:"artifacts,omitempty"`
+}
+
+// Returns the full dependency graph of an artifact.
+func (cgs *ComponentGraphService) GetArtifactDependencyGraph(artifactPath string) (*ArtifactDependencyGraph, error) {
+	requestBody, err := json.Marshal(map[string]string{"path": artifactPath})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	body, err := cgs.sendPost(artifactDependencyGraphAPI, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	graph := &ArtifactDependencyGraph{}
+	if err = json.Unmarshal(body, graph); err != nil {
+		return nil, errorutils.CheckErrorf("couldn't parse JFrog Xray server dependency graph response: %s", err.Error())
+	}
+	return graph, nil
+}
+
+// Returns a page of the direct, or also transitive, dependencies of an artifact. Dependencies are listed breadth first,
+// and each dependency is listed once, at its shallowest depth.
+func (cgs *ComponentGraphService) GetArtifactDependencies(params ArtifactDependenciesParams) (*ArtifactDependenciesPage, error) {
+	graph, err := cgs.GetArtifactDependencyGraph(params.ArtifactPath)
+	if err != nil {
+		return nil, err
+	}
+	dependencies := FlattenDependencyGraph(graph.Components, params.Transitive)
+	page := &ArtifactDependenciesPage{TotalCount: len(dependencies)}
+	if params.Offset >= len(dependencies) {
+		return page, nil
+	}
+	end := len(dependencies)
+	if params.Limit > 0 && params.Offset+params.Limit < end {
+		end = params.Offset + params.Limit
+	}
+	page.Dependencies = dependencies[params.Offset:end]
+	page.HasMore = end < len(dependencies)
+	return page, nil
+}
+
+// Lists the components of a dependency graph breadth first. Each component is listed once, at its shallowest depth.
+// If transitive is false, only the top level components are listed.
+func FlattenDependencyGraph(components []GraphComponent, transitive bool) []GraphDependency {
+	type graphLevelComponent struct {
+		component *GraphComponent
+		parent    string
+		depth     int
+	}
+	var dependencies []GraphDependency
+	visited := make(map[string]bool)
+	queue := make([]graphLevelComponent, 0, len(components))
+	for i := range components {
+		queue = append(queue, graphLevelComponent{component: &components[i], depth: 1})
+	}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if visited[current.component.ComponentId] {
+			continue
+		}
+		visited[current.component.ComponentId] = true
+		dependencies = append(dependencies, GraphDependency{
+			ComponentId: current.component.ComponentId,
+			PackageType: current.component.PackageType,
+			Version:     current.component.Version,
+			Depth:       current.depth,
+			Parent:      current.parent,
+		})
+		if !transitive {
+			continue
+		}
+		for i := range current.component.Components {
+			queue = append(queue, graphLevelComponent{component: &current.component.Components[i], parent: current.component.ComponentId, depth: current.depth + 1})
+		}
+	}
+	return dependencies
+}
+
+// Returns the artifacts which contain a component, directly or transitively.
+func (cgs *ComponentGraphService) GetComponentArtifacts(params ComponentArtifactsParams) (*ComponentArtifactsResponse, error) {
+	if params.ComponentId == "" {
+		return nil, errorutils.CheckErrorf("a component ID is required")
+	}
+	requestBody, err := json.Marshal(componentArtifactsBody{ComponentId: params.ComponentId, Pagination: params.Pagination})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	body, err := cgs.sendPost(componentArtifactsAPI, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	response := &ComponentArtifactsResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		return nil, errorutils.CheckErrorf("couldn't parse JFrog Xray server component artifacts response: %s", err.Error())
+	}
+	return response, nil
+}
+
+func (cgs *ComponentGraphService) sendPost(api string, requestBody []byte) ([]byte, error) {
+	httpClientsDetails := cgs.XrayDetails.CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	resp, body, err := cgs.client.SendPost(clientutils.AppendScopedProjectKeyParam(cgs.XrayDetails.GetUrl()+api, cgs.ScopeProjectKey), requestBody, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
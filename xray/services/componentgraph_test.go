@@ -0,0 +1,85 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/xray/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDependencyGraph = `{
+  "artifact": {"name": "app-1.0.jar", "path": "default/libs-release-local/app-1.0.jar", "pkg_type": "Maven", "component_id": "gav://org.acme:app:1.0"},
+  "components": [
+    {"component_id": "gav://org.acme:a:1.0", "components": [
+      {"component_id": "gav://org.acme:c:1.0", "components": [{"component_id": "gav://org.acme:d:1.0"}]}
+    ]},
+    {"component_id": "gav://org.acme:b:1.0", "components": [{"component_id": "gav://org.acme:c:1.0"}]}
+  ]
+}`
+
+func createTestComponentGraphService(t *testing.T, serverUrl string) *ComponentGraphService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	componentGraphService := NewComponentGraphService(client)
+	componentGraphService.XrayDetails = xrayDetails
+	return componentGraphService
+}
+
+func TestGetArtifactDependencies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+artifactDependencyGraphAPI, r.URL.Path)
+		body := map[string]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "default/libs-release-local/app-1.0.jar", body["path"])
+		_, _ = w.Write([]byte(testDependencyGraph))
+	}))
+	defer server.Close()
+	componentGraphService := createTestComponentGraphService(t, server.URL)
+	params := NewArtifactDependenciesParams("default/libs-release-local/app-1.0.jar")
+
+	page, err := componentGraphService.GetArtifactDependencies(params)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, page.TotalCount)
+	assert.Equal(t, []GraphDependency{{ComponentId: "gav://org.acme:a:1.0", Depth: 1}, {ComponentId: "gav://org.acme:b:1.0", Depth: 1}}, page.Dependencies)
+
+	params.Transitive = true
+	params.Offset = 1
+	params.Limit = 2
+	page, err = componentGraphService.GetArtifactDependencies(params)
+	assert.NoError(t, err)
+	// The shared dependency "c" is listed once.
+	assert.Equal(t, 4, page.TotalCount)
+	assert.True(t, page.HasMore)
+	assert.Equal(t, []GraphDependency{
+		{ComponentId: "gav://org.acme:b:1.0", Depth: 1},
+		{ComponentId: "gav://org.acme:c:1.0", Depth: 2, Parent: "gav://org.acme:a:1.0"},
+	}, page.Dependencies)
+
+	params.Offset = 10
+	page, err = componentGraphService.GetArtifactDependencies(params)
+	assert.NoError(t, err)
+	assert.Empty(t, page.Dependencies)
+	assert.False(t, page.HasMore)
+}
+
+func TestGetComponentArtifacts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+componentArtifactsAPI, r.URL.Path)
+		body := componentArtifactsBody{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "gav://org.acme:c:1.0", body.ComponentId)
+		assert.Equal(t, 10, body.Pagination.Limit)
+		_, _ = w.Write([]byte(`{"total_count": 12, "artifacts": [{"name": "app-1.0.jar", "path": "default/libs-release-local/app-1.0.jar"}]}`))
+	}))
+	defer server.Close()
+	params := NewComponentArtifactsParams("gav://org.acme:c:1.0")
+	params.Pagination = &utils.PaginationOptions{Limit: 10, Offset: 2}
+	response, err := createTestComponentGraphService(t, server.URL).GetComponentArtifacts(params)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, response.TotalCount)
+	if assert.Len(t, response.Artifacts, 1) {
+		assert.Equal(t, "app-1.0.jar", response.Artifacts[0].Name)
+	}
+}
@@ -0,0 +1,303 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	curationAuditAPI         = "api/v1/curation/audit/packages"
+	curationPackageStatusAPI = "api/v1/curation/packages/status"
+	curationConditionsAPI    = "api/v1/curation/conditions"
+	curationPoliciesAPI      = "api/v1/curation/policies"
+)
+
+// The actions Curation takes on a requested package.
+const (
+	CurationActionBlocked  = "blocked"
+	CurationActionApproved = "approved"
+	// The package would have been blocked by a policy in dry run mode.
+	CurationActionDryRun = "dry_run"
+)
+
+// The actions of a Curation policy.
+const (
+	CurationPolicyActionBlock  = "block"
+	CurationPolicyActionDryRun = "dry_run"
+)
+
+type CurationService struct {
+	client          *jfroghttpclient.JfrogHttpClient
+	XrayDetails     auth.ServiceDetails
+	ScopeProjectKey string
+}
+
+func NewCurationService(client *jfroghttpclient.JfrogHttpClient) *CurationService {
+	return &CurationService{client: client}
+}
+
+type CurationAuditParams struct {
+	// One of the CurationAction* values. All the events are returned if empty.
+	Action      string
+	PackageType string
+	// The curated remote repository the package was requested from.
+	RepoKey string
+	// Zero values aren't sent.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// Default if not provided: 100
+	Limit  int
+	Offset int
+	// Valid values: created_at, package_name, repo_key (Default: created_at)
+	OrderBy string
+	// Valid values: asc, desc (Default: desc)
+	Direction string
+}
+
+func NewCurationAuditParams() CurationAuditParams {
+	return CurationAuditParams{}
+}
+
+func (cp *CurationAuditParams) queryParams() string {
+	values := url.Values{}
+	addIfNotEmpty := func(key, value string) {
+		if value != "" {
+			values.Set(key, value)
+		}
+	}
+	addIfNotEmpty("action", cp.Action)
+	addIfNotEmpty("package_type", cp.PackageType)
+	addIfNotEmpty("repo_key", cp.RepoKey)
+	if !cp.CreatedAfter.IsZero() {
+		values.Set("created_at_start", cp.CreatedAfter.UTC().Format(time.RFC3339))
+	}
+	if !cp.CreatedBefore.IsZero() {
+		values.Set("created_at_end", cp.CreatedBefore.UTC().Format(time.RFC3339))
+	}
+	if cp.Limit > 0 {
+		values.Set("limit", strconv.Itoa(cp.Limit))
+	}
+	if cp.Offset > 0 {
+		values.Set("offset", strconv.Itoa(cp.Offset))
+	}
+	addIfNotEmpty("order_by", cp.OrderBy)
+	addIfNotEmpty("direction", cp.Direction)
+	if len(values) == 0 {
+		return ""
+	}
+	return "?" + values.Encode()
+}
+
+type CurationAuditResponse struct {
+	Events     []CurationAuditEvent `json:"data,omitempty"`
+	TotalCount int                  `json:"total_count,omitempty"`
+}
+
+type CurationAuditEvent struct {
+	Id             string `json:"id,omitempty"`
+	CreatedAt      string `json:"created_at,omitempty"`
+	Action         string `json:"action,omitempty"`
+	PackageType    string `json:"package_type,omitempty"`
+	PackageName    string `json:"package_name,omitempty"`
+	PackageVersion string `json:"package_version,omitempty"`
+	RepoKey        string `json:"repo_key,omitempty"`
+	Username       string `json:"username,omitempty"`
+	// The policies which blocked, or would have blocked, the package.
+	Policies []CurationPolicyViolation `json:"policies,omitempty"`
+}
+
+type CurationPolicyViolation struct {
+	Policy      string `json:"policy,omitempty"`
+	Condition   string `json:"condition,omitempty"`
+	Explanation string `json:"explanation,omitempty"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+type CurationPackage struct {
+	PackageType string `json:"package_type"`
+	Name        string `json:"package_name"`
+	Version     string `json:"package_version"`
+	// The curated remote repository. The package is checked against the policies of all the curated repositories if empty.
+	RepoKey string `json:"repo_key,omitempty"`
+}
+
+type curationPackagesStatusBody struct {
+	Packages []CurationPackage `json:"packages"`
+}
+
+type CurationPackagesStatusResponse struct {
+	Packages []CurationPackageStatus `json:"packages,omitempty"`
+}
+
+type CurationPackageStatus struct {
+	CurationPackage
+	// One of the CurationAction* values.
+	Action   string                    `json:"action,omitempty"`
+	Policies []CurationPolicyViolation `json:"policies,omitempty"`
+}
+
+// Returns true if the package is blocked by any policy.
+func (cps *CurationPackageStatus) IsBlocked() bool {
+	return cps.Action == CurationActionBlocked
+}
+
+type CurationCondition struct {
+	Id                  string                    `json:"id,omitempty"`
+	Name                string                    `json:"name,omitempty"`
+	ConditionTemplateId string                    `json:"condition_template_id,omitempty"`
+	ParamValues         []CurationConditionParam  `json:"param_values,omitempty"`
+	IsCustom            bool                      `json:"is_custom,omitempty"`
+	Policies            []CurationConditionPolicy `json:"policies,omitempty"`
+}
+
+type CurationConditionParam struct {
+	ParamId string `json:"param_id,omitempty"`
+	Value   any    `json:"value,omitempty"`
+}
+
+// A policy which uses the condition.
+type CurationConditionPolicy struct {
+	Id   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type CurationPolicy struct {
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Enabled     bool   `json:"enabled,omitempty"`
+	ConditionId string `json:"condition_id,omitempty"`
+	// One of the CurationPolicyAction* values.
+	PolicyAction string `json:"policy_action,omitempty"`
+	// Valid values: all_repos, specific_repos, pkg_types
+	Scope           string           `json:"scope,omitempty"`
+	RepoInclude     []string         `json:"repo_include,omitempty"`
+	RepoExclude     []string         `json:"repo_exclude,omitempty"`
+	PkgTypesInclude []string         `json:"pkg_types_include,omitempty"`
+	Waivers         []CurationWaiver `json:"waivers,omitempty"`
+	// Valid values: manual, auto_approved
+	WaiverRequestConfig string   `json:"waiver_request_config,omitempty"`
+	NotifyEmails        []string `json:"notify_emails,omitempty"`
+}
+
+// Allows packages which the policy blocks.
+type CurationWaiver struct {
+	PkgType       string   `json:"pkg_type,omitempty"`
+	PkgName       string   `json:"pkg_name,omitempty"`
+	AllVersions   bool     `json:"all_versions,omitempty"`
+	PkgVersions   []string `json:"pkg_versions,omitempty"`
+	Justification string   `json:"justification,omitempty"`
+}
+
+// Returns the Curation audit events of the requested packages, i.e. the packages that were blocked or approved.
+func (cs *CurationService) GetAuditEvents(params CurationAuditParams) (*CurationAuditResponse, error) {
+	body, err := cs.sendGet(curationAuditAPI + params.queryParams())
+	if err != nil {
+		return nil, err
+	}
+	response := &CurationAuditResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		return nil, errorutils.CheckErrorf("couldn't parse JFrog Xray server Curation audit response: %s", err.Error())
+	}
+	return response, nil
+}
+
+// Returns the Curation status of packages, i.e. whether they would be blocked if requested, and by which policies.
+func (cs *CurationService) GetPackagesStatus(packages []CurationPackage) (*CurationPackagesStatusResponse, error) {
+	for _, pkg := range packages {
+		if pkg.PackageType == "" || pkg.Name == "" || pkg.Version == "" {
+			return nil, errorutils.CheckErrorf("a package type, name and version are required to get the Curation status of a package")
+		}
+	}
+	requestBody, err := json.Marshal(curationPackagesStatusBody{Packages: packages})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	httpClientsDetails := cs.XrayDetails.CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	resp, body, err := cs.client.SendPost(cs.getUrl(curationPackageStatusAPI), requestBody, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Xray response:", resp.Status)
+	response := &CurationPackagesStatusResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		return nil, errorutils.CheckErrorf("couldn't parse JFrog Xray server Curation packages status response: %s", err.Error())
+	}
+	return response, nil
+}
+
+// Returns all the Curation conditions.
+func (cs *CurationService) GetConditions() ([]CurationCondition, error) {
+	var conditions []CurationCondition
+	if err := cs.getResource(curationConditionsAPI, &conditions); err != nil {
+		return nil, err
+	}
+	return conditions, nil
+}
+
+// Returns a Curation condition by its ID.
+func (cs *CurationService) GetCondition(conditionId string) (*CurationCondition, error) {
+	condition := &CurationCondition{}
+	if err := cs.getResource(curationConditionsAPI+"/"+url.PathEscape(conditionId), condition); err != nil {
+		return nil, err
+	}
+	return condition, nil
+}
+
+// Returns all the Curation policies.
+func (cs *CurationService) GetPolicies() ([]CurationPolicy, error) {
+	var policies []CurationPolicy
+	if err := cs.getResource(curationPoliciesAPI, &policies); err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// Returns a Curation policy by its ID.
+func (cs *CurationService) GetPolicy(policyId string) (*CurationPolicy, error) {
+	policy := &CurationPolicy{}
+	if err := cs.getResource(curationPoliciesAPI+"/"+url.PathEscape(policyId), policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (cs *CurationService) getResource(api string, result any) error {
+	body, err := cs.sendGet(api)
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(body, result); err != nil {
+		return errorutils.CheckErrorf("couldn't parse JFrog Xray server response of %s: %s", api, err.Error())
+	}
+	return nil
+}
+
+func (cs *CurationService) sendGet(api string) ([]byte, error) {
+	httpClientsDetails := cs.XrayDetails.CreateHttpClientDetails()
+	resp, body, _, err := cs.client.SendGet(cs.getUrl(api), true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Xray response:", resp.Status)
+	return body, nil
+}
+
+func (cs *CurationService) getUrl(api string) string {
+	return clientutils.AppendScopedProjectKeyParam(clientutils.AddTrailingSlashIfNeeded(cs.XrayDetails.GetUrl())+api, cs.ScopeProjectKey)
+}
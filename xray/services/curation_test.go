@@ -0,0 +1,90 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestCurationService(t *testing.T, serverUrl string) *CurationService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	curationService := NewCurationService(client)
+	curationService.XrayDetails = xrayDetails
+	return curationService
+}
+
+func TestCurationAuditQueryParams(t *testing.T) {
+	params := NewCurationAuditParams()
+	assert.Empty(t, params.queryParams())
+
+	params.Action = CurationActionBlocked
+	params.CreatedAfter = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	params.Limit = 10
+	assert.Equal(t, "?action=blocked&created_at_start=2024-01-02T03%3A04%3A05Z&limit=10", params.queryParams())
+}
+
+func TestGetCurationAuditEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+curationAuditAPI, r.URL.Path)
+		assert.Equal(t, "npm", r.URL.Query().Get("package_type"))
+		_, _ = w.Write([]byte(`{"total_count":1,"data":[{"id":"1","action":"blocked","package_type":"npm","package_name":"lodash","package_version":"4.17.20",
+			"policies":[{"policy":"block-critical","condition":"CVE with CVSS score of 9 or above"}]}]}`))
+	}))
+	defer server.Close()
+	response, err := createTestCurationService(t, server.URL).GetAuditEvents(CurationAuditParams{PackageType: "npm"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, response.TotalCount)
+	if assert.Len(t, response.Events, 1) {
+		assert.Equal(t, "lodash", response.Events[0].PackageName)
+		assert.Equal(t, "block-critical", response.Events[0].Policies[0].Policy)
+	}
+}
+
+func TestGetCurationPackagesStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+curationPackageStatusAPI, r.URL.Path)
+		body := curationPackagesStatusBody{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Len(t, body.Packages, 1)
+		_, _ = w.Write([]byte(`{"packages":[{"package_type":"npm","package_name":"lodash","package_version":"4.17.20","action":"blocked"}]}`))
+	}))
+	defer server.Close()
+	curationService := createTestCurationService(t, server.URL)
+	response, err := curationService.GetPackagesStatus([]CurationPackage{{PackageType: "npm", Name: "lodash", Version: "4.17.20"}})
+	assert.NoError(t, err)
+	if assert.Len(t, response.Packages, 1) {
+		assert.True(t, response.Packages[0].IsBlocked())
+	}
+
+	_, err = curationService.GetPackagesStatus([]CurationPackage{{PackageType: "npm", Name: "lodash"}})
+	assert.ErrorContains(t, err, "a package type, name and version are required")
+}
+
+func TestGetCurationPolicies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/" + curationPoliciesAPI:
+			_, _ = w.Write([]byte(`[{"id":"1","name":"block-critical","policy_action":"block","condition_id":"2"}]`))
+		case "/" + curationConditionsAPI + "/2":
+			_, _ = w.Write([]byte(`{"id":"2","name":"CVE with CVSS score of 9 or above","condition_template_id":"1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	curationService := createTestCurationService(t, server.URL)
+	policies, err := curationService.GetPolicies()
+	assert.NoError(t, err)
+	assert.Equal(t, []CurationPolicy{{Id: "1", Name: "block-critical", PolicyAction: CurationPolicyActionBlock, ConditionId: "2"}}, policies)
+
+	condition, err := curationService.GetCondition(policies[0].ConditionId)
+	assert.NoError(t, err)
+	assert.Equal(t, "CVE with CVSS score of 9 or above", condition.Name)
+
+	_, err = curationService.GetPolicy("3")
+	assert.ErrorContains(t, err, "404")
+}
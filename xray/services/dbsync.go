@@ -0,0 +1,159 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/httpclient"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	ioutils "github.com/jfrog/jfrog-client-go/utils/io"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	dbSyncBundlesAPI = "api/v1/updates/bundles"
+	dbSyncImportAPI  = "api/v1/updates/offline"
+)
+
+// DbSyncService exports the vulnerability database updates of an Xray instance, and imports them to an offline
+// (air-gapped) Xray instance
+type DbSyncService struct {
+	client      *jfroghttpclient.JfrogHttpClient
+	XrayDetails auth.ServiceDetails
+}
+
+func NewDbSyncService(client *jfroghttpclient.JfrogHttpClient) *DbSyncService {
+	return &DbSyncService{client: client}
+}
+
+type DbSyncBundlesParams struct {
+	// Only the updates since this time are exported, usually the time of the previous export.
+	// The bundles of the full database are exported if zero.
+	Since time.Time
+}
+
+type DbSyncBundle struct {
+	Name string `json:"name"`
+	// An absolute URL, or a URL relative to Xray.
+	Url    string `json:"url"`
+	Sha256 string `json:"sha256"`
+	Size   int64  `json:"size,omitempty"`
+}
+
+type dbSyncBundlesResponse struct {
+	Bundles []DbSyncBundle `json:"bundles"`
+}
+
+// Returns the bundles of the vulnerability database updates.
+func (ds *DbSyncService) GetBundles(params DbSyncBundlesParams) ([]DbSyncBundle, error) {
+	requestUrl := ds.XrayDetails.GetUrl() + dbSyncBundlesAPI
+	if !params.Since.IsZero() {
+		requestUrl += "?since=" + url.QueryEscape(params.Since.UTC().Format(time.RFC3339))
+	}
+	httpClientsDetails := ds.XrayDetails.CreateHttpClientDetails()
+	resp, body, _, err := ds.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Xray response:", resp.Status)
+	bundles := dbSyncBundlesResponse{}
+	if err = json.Unmarshal(body, &bundles); err != nil {
+		return nil, errorutils.CheckErrorf("couldn't parse JFrog Xray server DB sync bundles response: %s", err.Error())
+	}
+	return bundles.Bundles, nil
+}
+
+// Downloads the bundles of the vulnerability database updates to a local directory, and returns their paths.
+// The SHA256 checksum of each bundle is validated after it's downloaded. The progress is optional.
+func (ds *DbSyncService) ExportBundles(params DbSyncBundlesParams, localDirPath string, progress ioutils.ProgressMgr) ([]string, error) {
+	bundles, err := ds.GetBundles(params)
+	if err != nil {
+		return nil, err
+	}
+	if progress != nil {
+		progress.IncGeneralProgressTotalBy(int64(len(bundles)))
+	}
+	paths := make([]string, 0, len(bundles))
+	for _, bundle := range bundles {
+		if err = ds.downloadBundle(bundle, localDirPath, progress); err != nil {
+			return paths, err
+		}
+		paths = append(paths, filepath.Join(localDirPath, bundle.Name))
+		if progress != nil {
+			progress.IncrementGeneralProgress()
+		}
+	}
+	return paths, nil
+}
+
+func (ds *DbSyncService) downloadBundle(bundle DbSyncBundle, localDirPath string, progress ioutils.ProgressMgr) error {
+	if bundle.Name == "" || bundle.Sha256 == "" {
+		return errorutils.CheckErrorf("the DB sync bundle '%s' is missing a name or a checksum", bundle.Url)
+	}
+	// The name is used as the local file name, so it must not point outside of the local directory.
+	if bundle.Name == "." || bundle.Name == ".." || strings.ContainsAny(bundle.Name, `/\`) {
+		return errorutils.CheckErrorf("the name of the DB sync bundle '%s' is not a valid file name", bundle.Name)
+	}
+	downloadUrl := bundle.Url
+	// The credentials of Xray are sent only to Xray, and not to the storage the bundles may be served from.
+	httpClientsDetails := httputils.HttpClientDetails{Headers: map[string]string{}}
+	if !strings.Contains(downloadUrl, "://") {
+		downloadUrl = ds.XrayDetails.GetUrl() + strings.TrimPrefix(downloadUrl, "/")
+	}
+	if strings.HasPrefix(downloadUrl, ds.XrayDetails.GetUrl()) {
+		httpClientsDetails = ds.XrayDetails.CreateHttpClientDetails()
+	}
+	log.Info(fmt.Sprintf("Downloading the DB sync bundle %s...", bundle.Name))
+	downloadFileDetails := &httpclient.DownloadFileDetails{
+		FileName:       bundle.Name,
+		DownloadPath:   downloadUrl,
+		RelativePath:   bundle.Name,
+		LocalPath:      localDirPath,
+		LocalFileName:  bundle.Name,
+		ExpectedSha256: bundle.Sha256,
+		Size:           bundle.Size,
+	}
+	resp, err := ds.client.DownloadFileWithProgress(downloadFileDetails, "", &httpClientsDetails, false, false, progress)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatus(resp, http.StatusOK)
+}
+
+// Uploads a bundle of vulnerability database updates to an offline Xray instance. If expectedSha256 isn't empty,
+// the checksum of the bundle is validated before it's uploaded. Xray validates the checksum as well, and applies the
+// updates in the background. The progress is optional.
+func (ds *DbSyncService) ImportBundle(bundlePath, expectedSha256 string, progress ioutils.ProgressMgr) error {
+	details, err := fileutils.GetFileDetails(bundlePath, true)
+	if err != nil {
+		return err
+	}
+	if expectedSha256 != "" && !strings.EqualFold(expectedSha256, details.Checksum.Sha256) {
+		return errorutils.CheckErrorf("checksum mismatch for the DB sync bundle %s, expected SHA256 %s but got %s", bundlePath, expectedSha256, details.Checksum.Sha256)
+	}
+	httpClientsDetails := ds.XrayDetails.CreateHttpClientDetails()
+	httpClientsDetails.Headers["X-Checksum-Sha256"] = details.Checksum.Sha256
+	importUrl := ds.XrayDetails.GetUrl() + dbSyncImportAPI + "/" + url.PathEscape(filepath.Base(bundlePath))
+	log.Info(fmt.Sprintf("Importing the DB sync bundle %s...", bundlePath))
+	resp, body, err := ds.client.UploadFile(bundlePath, importUrl, "", &httpClientsDetails, progress)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated, http.StatusAccepted); err != nil {
+		return err
+	}
+	log.Debug("Xray response:", resp.Status)
+	return nil
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDbSyncBundle = "vulnerabilities database update"
+
+func createTestDbSyncService(t *testing.T, serverUrl string) *DbSyncService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	dbSyncService := NewDbSyncService(client)
+	dbSyncService.XrayDetails = xrayDetails
+	return dbSyncService
+}
+
+func testDbSyncBundleSha256() string {
+	checksum := sha256.Sum256([]byte(testDbSyncBundle))
+	return hex.EncodeToString(checksum[:])
+}
+
+func TestExportDbSyncBundles(t *testing.T) {
+	sha := testDbSyncBundleSha256()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/" + dbSyncBundlesAPI:
+			assert.Equal(t, "2024-01-01T00:00:00Z", r.URL.Query().Get("since"))
+			_, _ = fmt.Fprintf(w, `{"bundles":[{"name":"update.zip","url":"/bundles/update.zip","sha256":"%s"},{"name":"corrupted.zip","url":"bundles/update.zip","sha256":"0000"}]}`, sha)
+		case "/bundles/update.zip":
+			_, _ = w.Write([]byte(testDbSyncBundle))
+		}
+	}))
+	defer server.Close()
+	localDir := t.TempDir()
+	paths, err := createTestDbSyncService(t, server.URL).ExportBundles(DbSyncBundlesParams{Since: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}, localDir, nil)
+	// The checksum of the second bundle doesn't match.
+	assert.ErrorContains(t, err, "checksum mismatch")
+	assert.Equal(t, []string{filepath.Join(localDir, "update.zip")}, paths)
+	content, err := os.ReadFile(paths[0])
+	assert.NoError(t, err)
+	assert.Equal(t, testDbSyncBundle, string(content))
+}
+
+func TestImportDbSyncBundle(t *testing.T) {
+	sha := testDbSyncBundleSha256()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/"+dbSyncImportAPI+"/update.zip", r.URL.Path)
+		assert.Equal(t, sha, r.Header.Get("X-Checksum-Sha256"))
+		content, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, testDbSyncBundle, string(content))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	bundlePath := filepath.Join(t.TempDir(), "update.zip")
+	assert.NoError(t, os.WriteFile(bundlePath, []byte(testDbSyncBundle), 0o600))
+	dbSyncService := createTestDbSyncService(t, server.URL)
+	assert.NoError(t, dbSyncService.ImportBundle(bundlePath, sha, nil))
+	assert.ErrorContains(t, dbSyncService.ImportBundle(bundlePath, "0000", nil), "checksum mismatch")
+}
+
+func TestExportDbSyncBundlesInvalidName(t *testing.T) {
+	for _, name := range []string{"../update.zip", "nested/update.zip", `..\update.zip`, ".."} {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/"+dbSyncBundlesAPI, r.URL.Path, "the bundle must not be downloaded")
+				_, _ = fmt.Fprintf(w, `{"bundles":[{"name":%q,"url":"/bundles/update.zip","sha256":"%s"}]}`, name, testDbSyncBundleSha256())
+			}))
+			defer server.Close()
+			localDir := filepath.Join(t.TempDir(), "bundles")
+			paths, err := createTestDbSyncService(t, server.URL).ExportBundles(DbSyncBundlesParams{}, localDir, nil)
+			assert.ErrorContains(t, err, "not a valid file name")
+			assert.Empty(t, paths)
+		})
+	}
+}
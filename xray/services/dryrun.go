@@ -0,0 +1,261 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/xray/services/utils"
+)
+
+// The type of the security issues in the artifact summary.
+const securityIssueType = "security"
+
+// The rank of each severity. A higher rank is more severe.
+var severityRanks = map[string]int{
+	strings.ToLower(string(utils.Critical)):    5,
+	strings.ToLower(string(utils.High)):        4,
+	strings.ToLower(string(utils.Medium)):      3,
+	strings.ToLower(string(utils.Low)):         2,
+	strings.ToLower(string(utils.Information)): 1,
+	strings.ToLower(string(utils.Normal)):      1,
+}
+
+// DryRunService evaluates whether artifacts would violate the policies of a watch, using the data Xray has on their
+// components, without waiting for Xray to generate violations
+type DryRunService struct {
+	client      *jfroghttpclient.JfrogHttpClient
+	XrayDetails auth.ServiceDetails
+}
+
+func NewDryRunService(client *jfroghttpclient.JfrogHttpClient) *DryRunService {
+	return &DryRunService{client: client}
+}
+
+type DryRunViolation struct {
+	Policy string
+	Rule   string
+	Type   utils.PolicyType
+	// The issue of a security violation.
+	IssueId  string
+	Severity string
+	// The license of a license violation.
+	License    string
+	Components []string
+	Actions    *utils.PolicyAction
+}
+
+// Returns true if the rule's actions block the download of the artifact.
+func (dv *DryRunViolation) BlocksDownload() bool {
+	return dv.Actions != nil && dv.Actions.BlockDownload.Active != nil && *dv.Actions.BlockDownload.Active
+}
+
+// Returns true if the rule's actions fail builds.
+func (dv *DryRunViolation) FailsBuild() bool {
+	return dv.Actions != nil && dv.Actions.FailBuild != nil && *dv.Actions.FailBuild
+}
+
+type DryRunResult struct {
+	Violations []DryRunViolation
+	// The criteria which can't be evaluated by the client, formatted as "<policy>/<rule>: <criteria>". Rules with such
+	// criteria may generate violations which aren't reported, so the result is a lower bound of the actual violations.
+	UnevaluatedCriteria []string
+}
+
+func (dr *DryRunResult) WouldViolate() bool {
+	return len(dr.Violations) > 0
+}
+
+func (dr *DryRunResult) WouldBlockDownload() bool {
+	return slices.ContainsFunc(dr.Violations, func(violation DryRunViolation) bool { return violation.BlocksDownload() })
+}
+
+func (dr *DryRunResult) WouldFailBuild() bool {
+	return slices.ContainsFunc(dr.Violations, func(violation DryRunViolation) bool { return violation.FailsBuild() })
+}
+
+// Evaluates whether an artifact would violate the policies of a watch. The artifact is identified by its path, e.g.
+// "default/libs-release-local/app.jar", or by its sha256 checksum, and must be indexed by Xray.
+// The resources of the watch aren't evaluated, so the artifact is assumed to be included in the watch.
+func (drs *DryRunService) EvaluateWatch(watchName, artifactPathOrChecksum string) (*DryRunResult, error) {
+	watchService := NewWatchService(drs.client)
+	watchService.XrayDetails = drs.XrayDetails
+	watch, err := watchService.Get(watchName)
+	if err != nil {
+		return nil, err
+	}
+	policyService := NewPolicyService(drs.client)
+	policyService.XrayDetails = drs.XrayDetails
+	policies := make([]utils.PolicyParams, 0, len(watch.Policies))
+	for _, assignedPolicy := range watch.Policies {
+		policy, err := policyService.Get(assignedPolicy.Name)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, *policy)
+	}
+
+	summaryService := NewSummaryService(drs.client)
+	summaryService.XrayDetails = drs.XrayDetails
+	params := ArtifactSummaryParams{Paths: []string{artifactPathOrChecksum}}
+	if !strings.Contains(artifactPathOrChecksum, "/") {
+		params = ArtifactSummaryParams{Checksums: []string{artifactPathOrChecksum}}
+	}
+	summary, err := summaryService.GetArtifactSummary(params)
+	if err != nil {
+		return nil, err
+	}
+	artifact := summary.FindArtifact(artifactPathOrChecksum)
+	if artifact == nil {
+		return nil, errorutils.CheckErrorf("artifact %s wasn't found in Xray", artifactPathOrChecksum)
+	}
+	return EvaluatePolicies(*artifact, policies), nil
+}
+
+// Evaluates the policies against the issues and licenses of an artifact. As in Xray, each issue or license is matched
+// against the rules of each policy by their priority, and only the first matching rule generates a violation.
+// The security rules are evaluated by their minimal severity and CVSS range, and the license rules by their allowed,
+// banned and unknown licenses. Other criteria are reported in the UnevaluatedCriteria of the result.
+func EvaluatePolicies(artifact Artifact, policies []utils.PolicyParams) *DryRunResult {
+	result := &DryRunResult{}
+	for _, policy := range policies {
+		rules := slices.Clone(policy.Rules)
+		sort.SliceStable(rules, func(i, j int) bool { return rules[i].Priority < rules[j].Priority })
+		for _, rule := range rules {
+			for _, criteria := range unevaluatedCriteria(policy.Type, rule.Criteria) {
+				result.UnevaluatedCriteria = append(result.UnevaluatedCriteria, fmt.Sprintf("%s/%s: %s", policy.Name, rule.Name, criteria))
+			}
+		}
+		switch policy.Type {
+		case utils.Security:
+			result.Violations = append(result.Violations, evaluateSecurityRules(policy.Name, rules, artifact.Issues)...)
+		case utils.License:
+			result.Violations = append(result.Violations, evaluateLicenseRules(policy.Name, rules, artifact.Licenses)...)
+		}
+	}
+	return result
+}
+
+func unevaluatedCriteria(policyType utils.PolicyType, criteria utils.PolicyCriteria) []string {
+	if policyType == utils.OperationalRisk {
+		return []string{"operational risk"}
+	}
+	var unevaluated []string
+	if criteria.Exposures != nil {
+		unevaluated = append(unevaluated, "exposures")
+	}
+	if criteria.Sast != nil {
+		unevaluated = append(unevaluated, "sast")
+	}
+	if criteria.MaliciousPackage {
+		unevaluated = append(unevaluated, "malicious package")
+	}
+	if criteria.SkipNotApplicableCVEs {
+		unevaluated = append(unevaluated, "applicable CVEs only")
+	}
+	return unevaluated
+}
+
+func evaluateSecurityRules(policyName string, rules []utils.PolicyRule, issues []Issue) []DryRunViolation {
+	var violations []DryRunViolation
+	for _, issue := range issues {
+		if !strings.EqualFold(issue.IssueType, securityIssueType) {
+			continue
+		}
+		for _, rule := range rules {
+			if !matchesSecurityCriteria(rule.Criteria, issue) {
+				continue
+			}
+			violation := DryRunViolation{Policy: policyName, Rule: rule.Name, Type: utils.Security, IssueId: issue.IssueId, Severity: issue.Severity, Actions: rule.Actions}
+			for _, component := range issue.Components {
+				violation.Components = append(violation.Components, component.ComponentId)
+			}
+			violations = append(violations, violation)
+			break
+		}
+	}
+	return violations
+}
+
+func matchesSecurityCriteria(criteria utils.PolicyCriteria, issue Issue) bool {
+	switch {
+	case criteria.MinSeverity != "":
+		return severityRanks[strings.ToLower(issue.Severity)] >= severityRanks[strings.ToLower(string(criteria.MinSeverity))]
+	case criteria.CvssRange != nil:
+		score, found := maxCvssScore(issue.Cves)
+		return found && score >= criteria.CvssRange.From && score <= criteria.CvssRange.To
+	}
+	return false
+}
+
+// Returns the highest CVSS v3 score of the CVEs, or the highest CVSS v2 score if none of them has a v3 score.
+func maxCvssScore(cves []SummaryCve) (score float64, found bool) {
+	for _, useV3 := range []bool{true, false} {
+		for _, cve := range cves {
+			vector := cve.CvssV2Score
+			if useV3 {
+				vector = cve.CvssV3Score
+			}
+			// The scores may be followed by their vectors, e.g. "9.8/CVSS:3.1/AV:N/...".
+			value, err := strconv.ParseFloat(strings.SplitN(vector, "/", 2)[0], 64)
+			if err == nil && (!found || value > score) {
+				score, found = value, true
+			}
+		}
+		if found {
+			return
+		}
+	}
+	return
+}
+
+func evaluateLicenseRules(policyName string, rules []utils.PolicyRule, licenses []SummaryLicense) []DryRunViolation {
+	// The licenses of each component, to allow components with multiple licenses if any of them is allowed.
+	componentLicenses := map[string][]string{}
+	for _, license := range licenses {
+		for _, component := range license.Components {
+			componentLicenses[component] = append(componentLicenses[component], license.Name)
+		}
+	}
+	var violations []DryRunViolation
+	for _, license := range licenses {
+		for _, rule := range rules {
+			if !violatesLicenseCriteria(rule.Criteria, license.Name) {
+				continue
+			}
+			var components []string
+			for _, component := range license.Components {
+				permissive := rule.Criteria.MultiLicensePermissive != nil && *rule.Criteria.MultiLicensePermissive
+				if permissive && slices.ContainsFunc(componentLicenses[component], func(other string) bool {
+					return !violatesLicenseCriteria(rule.Criteria, other)
+				}) {
+					continue
+				}
+				components = append(components, component)
+			}
+			if len(components) > 0 {
+				violations = append(violations, DryRunViolation{Policy: policyName, Rule: rule.Name, Type: utils.License, License: license.Name, Components: components, Actions: rule.Actions})
+			}
+			break
+		}
+	}
+	return violations
+}
+
+func violatesLicenseCriteria(criteria utils.PolicyCriteria, license string) bool {
+	if license == "" || strings.EqualFold(license, unknownLicense) {
+		return criteria.AllowUnknown != nil && !*criteria.AllowUnknown
+	}
+	matches := func(licenses []string) bool {
+		return slices.ContainsFunc(licenses, func(other string) bool { return strings.EqualFold(other, license) })
+	}
+	if len(criteria.AllowedLicenses) > 0 {
+		return !matches(criteria.AllowedLicenses)
+	}
+	return matches(criteria.BannedLicenses)
+}
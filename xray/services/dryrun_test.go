@@ -0,0 +1,89 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/xray/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateSecurityPolicy(t *testing.T) {
+	artifact := Artifact{Issues: []Issue{
+		{IssueId: "XRAY-1", IssueType: "security", Severity: "Critical", Components: []SummaryComponent{{ComponentId: "gav://a:b:1"}}},
+		{IssueId: "XRAY-2", IssueType: "security", Severity: "Medium", Cves: []SummaryCve{{CvssV2Score: "7.5/AV:N", CvssV3Score: "5.3/CVSS:3.1/AV:N"}}},
+		{IssueId: "XRAY-3", IssueType: "security", Severity: "Low", Cves: []SummaryCve{{CvssV2Score: "8.0/AV:N"}}},
+		{IssueId: "XRAY-4", IssueType: "license", Severity: "High"},
+	}}
+	policy := utils.PolicyParams{Name: "sec", Type: utils.Security, Rules: []utils.PolicyRule{
+		{Name: "cvss", Priority: 2, Criteria: utils.PolicyCriteria{CvssRange: &utils.PolicyCvssRange{From: 5, To: 10}}},
+		{Name: "high", Priority: 1, Criteria: utils.PolicyCriteria{MinSeverity: utils.High}, Actions: &utils.PolicyAction{FailBuild: clientutils.Pointer(true)}},
+	}}
+	result := EvaluatePolicies(artifact, []utils.PolicyParams{policy})
+	assert.Equal(t, []DryRunViolation{
+		{Policy: "sec", Rule: "high", Type: utils.Security, IssueId: "XRAY-1", Severity: "Critical", Components: []string{"gav://a:b:1"}, Actions: policy.Rules[1].Actions},
+		{Policy: "sec", Rule: "cvss", Type: utils.Security, IssueId: "XRAY-2", Severity: "Medium"},
+		{Policy: "sec", Rule: "cvss", Type: utils.Security, IssueId: "XRAY-3", Severity: "Low"},
+	}, result.Violations)
+	assert.True(t, result.WouldViolate())
+	assert.True(t, result.WouldFailBuild())
+	assert.False(t, result.WouldBlockDownload())
+	assert.Empty(t, result.UnevaluatedCriteria)
+}
+
+func TestEvaluateLicensePolicy(t *testing.T) {
+	artifact := Artifact{Licenses: []SummaryLicense{
+		{Name: "MIT", Components: []string{"npm://a:1", "npm://b:1"}},
+		{Name: "GPL-3.0", Components: []string{"npm://b:1", "npm://c:1"}},
+		{Name: "Unknown", Components: []string{"npm://d:1"}},
+	}}
+	policies := []utils.PolicyParams{
+		{Name: "allowed", Type: utils.License, Rules: []utils.PolicyRule{{Name: "mit-only", Criteria: utils.PolicyCriteria{
+			AllowedLicenses: []string{"mit"}, AllowUnknown: clientutils.Pointer(false), MultiLicensePermissive: clientutils.Pointer(true),
+		}}}},
+		{Name: "banned", Type: utils.License, Rules: []utils.PolicyRule{{Name: "no-gpl", Criteria: utils.PolicyCriteria{
+			BannedLicenses: []string{"GPL-3.0"}, MaliciousPackage: true,
+		}}}},
+	}
+	result := EvaluatePolicies(artifact, policies)
+	assert.Equal(t, []DryRunViolation{
+		{Policy: "allowed", Rule: "mit-only", Type: utils.License, License: "GPL-3.0", Components: []string{"npm://c:1"}},
+		{Policy: "allowed", Rule: "mit-only", Type: utils.License, License: "Unknown", Components: []string{"npm://d:1"}},
+		{Policy: "banned", Rule: "no-gpl", Type: utils.License, License: "GPL-3.0", Components: []string{"npm://b:1", "npm://c:1"}},
+	}, result.Violations)
+	assert.Equal(t, []string{"banned/no-gpl: malicious package"}, result.UnevaluatedCriteria)
+	assert.False(t, result.WouldFailBuild())
+}
+
+func TestEvaluateWatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/" + watchAPIURL + "/watch-1":
+			_, _ = w.Write([]byte(`{"general_data":{"name":"watch-1","active":true},"assigned_policies":[{"name":"sec","type":"security"}]}`))
+		case "/" + policyAPIURL + "/sec":
+			_, _ = w.Write([]byte(`{"name":"sec","type":"security","rules":[{"name":"high","priority":1,"criteria":{"min_severity":"High"},"actions":{"block_download":{"active":true}}}]}`))
+		case "/" + summaryAPI + "artifact":
+			params := ArtifactSummaryParams{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&params))
+			assert.Equal(t, []string{"default/libs/app.jar"}, params.Paths)
+			_, _ = w.Write([]byte(`{"artifacts":[{"general":{"path":"default/libs/app.jar"},"issues":[{"issue_id":"XRAY-1","issue_type":"security","severity":"High"}]}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client, xrayDetails := newTestXrayClient(t, server.URL)
+	dryRunService := NewDryRunService(client)
+	dryRunService.XrayDetails = xrayDetails
+
+	result, err := dryRunService.EvaluateWatch("watch-1", "default/libs/app.jar")
+	assert.NoError(t, err)
+	assert.Len(t, result.Violations, 1)
+	assert.True(t, result.WouldBlockDownload())
+
+	_, err = dryRunService.EvaluateWatch("missing", "default/libs/app.jar")
+	assert.Error(t, err)
+}
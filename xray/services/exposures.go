@@ -0,0 +1,165 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/jfrog/jfrog-client-go/xray/services/utils"
+)
+
+const (
+	exposuresResultsAPI = "api/v1/exposures/results"
+
+	defaultExposuresPageSize = 100
+)
+
+type ExposureCategory string
+
+const (
+	ExposureCategorySecrets      ExposureCategory = "secrets"
+	ExposureCategoryServices     ExposureCategory = "services"
+	ExposureCategoryApplications ExposureCategory = "applications"
+	ExposureCategoryIac          ExposureCategory = "iac"
+)
+
+// ExposuresService returns the exposures found by the Advanced Security scans of container images
+type ExposuresService struct {
+	client          *jfroghttpclient.JfrogHttpClient
+	XrayDetails     auth.ServiceDetails
+	ScopeProjectKey string
+}
+
+func NewExposuresService(client *jfroghttpclient.JfrogHttpClient) *ExposuresService {
+	return &ExposuresService{client: client}
+}
+
+type ExposuresParams struct {
+	// The repository the image is stored in.
+	Repository string
+	// The digest of the image, e.g. "sha256:...".
+	ImageDigest string
+	// All the categories are returned if empty.
+	Categories []ExposureCategory
+	// All the severities are returned if empty.
+	Severities []utils.Severity
+	// Default if not provided: 100
+	PageSize int
+	// Starts at 1. Default if not provided: 1
+	PageNumber int
+}
+
+func NewExposuresParams(repository, imageDigest string) ExposuresParams {
+	return ExposuresParams{Repository: repository, ImageDigest: imageDigest}
+}
+
+func (ep *ExposuresParams) queryParams() string {
+	values := url.Values{}
+	values.Set("repo", ep.Repository)
+	values.Set("image_digest", ep.ImageDigest)
+	for _, category := range ep.Categories {
+		values.Add("category", string(category))
+	}
+	for _, severity := range ep.Severities {
+		values.Add("severity", string(severity))
+	}
+	pageSize := ep.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultExposuresPageSize
+	}
+	values.Set("num_of_rows", strconv.Itoa(pageSize))
+	values.Set("page_num", strconv.Itoa(max(ep.PageNumber, 1)))
+	return "?" + values.Encode()
+}
+
+type ExposuresResponse struct {
+	ImageDigest string            `json:"image_digest,omitempty"`
+	TotalCount  int               `json:"total_count,omitempty"`
+	Findings    []ExposureFinding `json:"data,omitempty"`
+}
+
+type ExposureFinding struct {
+	Id       string           `json:"id,omitempty"`
+	Category ExposureCategory `json:"category,omitempty"`
+	Severity utils.Severity   `json:"severity,omitempty"`
+	// The ID of the rule that detected the exposure, e.g. "EXP-1234".
+	RuleId      string `json:"rule_id,omitempty"`
+	RuleName    string `json:"rule_name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Remediation string `json:"fix_suggestion,omitempty"`
+	// Whether a secret found in the image is still active, if it could be validated.
+	TokenValidation string             `json:"token_validation,omitempty"`
+	Locations       []ExposureLocation `json:"locations,omitempty"`
+}
+
+type ExposureLocation struct {
+	// The digest of the image layer the exposure was found in.
+	LayerDigest string `json:"layer_digest,omitempty"`
+	FilePath    string `json:"file_path,omitempty"`
+	Line        int    `json:"line,omitempty"`
+	Snippet     string `json:"snippet,omitempty"`
+}
+
+// Returns the findings of the given severities and categories. A filter is ignored if empty.
+// Severities are compared case-insensitively.
+func (er *ExposuresResponse) Filter(severities []utils.Severity, categories []ExposureCategory) []ExposureFinding {
+	var filtered []ExposureFinding
+	for _, finding := range er.Findings {
+		if len(categories) > 0 && !slices.Contains(categories, finding.Category) {
+			continue
+		}
+		if len(severities) > 0 && !slices.ContainsFunc(severities, func(severity utils.Severity) bool {
+			return strings.EqualFold(string(severity), string(finding.Severity))
+		}) {
+			continue
+		}
+		filtered = append(filtered, finding)
+	}
+	return filtered
+}
+
+// Returns a page of the exposures found in a container image.
+func (es *ExposuresService) GetExposures(params ExposuresParams) (*ExposuresResponse, error) {
+	if params.Repository == "" || params.ImageDigest == "" {
+		return nil, errorutils.CheckErrorf("a repository and an image digest are required to get the exposures of an image")
+	}
+	httpClientsDetails := es.XrayDetails.CreateHttpClientDetails()
+	requestUrl := clientutils.AppendScopedProjectKeyParam(es.XrayDetails.GetUrl()+exposuresResultsAPI+params.queryParams(), es.ScopeProjectKey)
+	resp, body, _, err := es.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Xray response:", resp.Status)
+	exposures := &ExposuresResponse{}
+	if err = json.Unmarshal(body, exposures); err != nil {
+		return nil, errorutils.CheckErrorf("couldn't parse JFrog Xray server exposures response: %s", err.Error())
+	}
+	return exposures, nil
+}
+
+// Returns all the exposures found in a container image, fetching all the pages.
+func (es *ExposuresService) GetAllExposures(params ExposuresParams) (*ExposuresResponse, error) {
+	all := &ExposuresResponse{ImageDigest: params.ImageDigest}
+	for params.PageNumber = max(params.PageNumber, 1); ; params.PageNumber++ {
+		page, err := es.GetExposures(params)
+		if err != nil {
+			return nil, err
+		}
+		all.TotalCount = page.TotalCount
+		all.Findings = append(all.Findings, page.Findings...)
+		if len(page.Findings) == 0 || len(all.Findings) >= page.TotalCount {
+			return all, nil
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/xray/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestExposuresService(t *testing.T, serverUrl string) *ExposuresService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	exposuresService := NewExposuresService(client)
+	exposuresService.XrayDetails = xrayDetails
+	return exposuresService
+}
+
+func TestGetAllExposures(t *testing.T) {
+	findings := []ExposureFinding{
+		{Id: "1", Category: ExposureCategorySecrets, Severity: utils.High, Locations: []ExposureLocation{{LayerDigest: "sha256:layer", FilePath: "/app/.env", Line: 3}}},
+		{Id: "2", Category: ExposureCategoryServices, Severity: utils.Medium},
+		{Id: "3", Category: ExposureCategoryApplications, Severity: "critical"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+exposuresResultsAPI, r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "docker-local", query.Get("repo"))
+		assert.Equal(t, "sha256:image", query.Get("image_digest"))
+		assert.Equal(t, []string{"secrets", "services", "applications"}, query["category"])
+		assert.Equal(t, "2", query.Get("num_of_rows"))
+		page := findings[2:]
+		if query.Get("page_num") == "1" {
+			page = findings[:2]
+		}
+		content, err := json.Marshal(ExposuresResponse{ImageDigest: "sha256:image", TotalCount: len(findings), Findings: page})
+		assert.NoError(t, err)
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	params := NewExposuresParams("docker-local", "sha256:image")
+	params.Categories = []ExposureCategory{ExposureCategorySecrets, ExposureCategoryServices, ExposureCategoryApplications}
+	params.PageSize = 2
+	exposures, err := createTestExposuresService(t, server.URL).GetAllExposures(params)
+	assert.NoError(t, err)
+	assert.Equal(t, &ExposuresResponse{ImageDigest: "sha256:image", TotalCount: 3, Findings: findings}, exposures)
+
+	filtered := exposures.Filter([]utils.Severity{utils.Critical, utils.High}, nil)
+	assert.Equal(t, []ExposureFinding{findings[0], findings[2]}, filtered)
+	filtered = exposures.Filter([]utils.Severity{utils.Critical, utils.High}, []ExposureCategory{ExposureCategorySecrets})
+	assert.Equal(t, []ExposureFinding{findings[0]}, filtered)
+}
+
+func TestGetExposuresMissingDigest(t *testing.T) {
+	_, err := createTestExposuresService(t, "http://localhost").GetExposures(NewExposuresParams("docker-local", ""))
+	assert.ErrorContains(t, err, "an image digest are required")
+}
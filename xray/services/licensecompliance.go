@@ -0,0 +1,273 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The license Xray reports for components without a detected license.
+	unknownLicense = "Unknown"
+
+	LicenseViolationBanned     = "banned"
+	LicenseViolationNotAllowed = "not_allowed"
+	LicenseViolationUnknown    = "unknown"
+)
+
+// Common SPDX license identifiers, by their lowercase form.
+var spdxLicenseIds = toLowercaseIndex([]string{
+	"0BSD", "AFL-3.0", "AGPL-1.0", "AGPL-3.0", "AGPL-3.0-only", "AGPL-3.0-or-later", "Apache-1.0", "Apache-1.1",
+	"Apache-2.0", "APSL-2.0", "Artistic-1.0", "Artistic-2.0", "BSD-1-Clause", "BSD-2-Clause", "BSD-3-Clause",
+	"BSD-4-Clause", "BSL-1.0", "CC-BY-3.0", "CC-BY-4.0", "CC-BY-SA-3.0", "CC-BY-SA-4.0", "CC0-1.0", "CDDL-1.0",
+	"CDDL-1.1", "CPL-1.0", "EPL-1.0", "EPL-2.0", "EUPL-1.1", "EUPL-1.2", "GPL-1.0", "GPL-2.0", "GPL-2.0-only",
+	"GPL-2.0-or-later", "GPL-3.0", "GPL-3.0-only", "GPL-3.0-or-later", "ISC", "LGPL-2.0", "LGPL-2.1",
+	"LGPL-2.1-only", "LGPL-2.1-or-later", "LGPL-3.0", "LGPL-3.0-only", "LGPL-3.0-or-later", "MIT", "MIT-0",
+	"MPL-1.0", "MPL-1.1", "MPL-2.0", "MS-PL", "MS-RL", "OFL-1.1", "OpenSSL", "PHP-3.01", "PostgreSQL",
+	"Python-2.0", "Ruby", "SSPL-1.0", "Unicode-DFS-2016", "Unlicense", "UPL-1.0", "W3C", "WTFPL", "Zlib", "ZPL-2.1",
+})
+
+// Common names of licenses that aren't SPDX identifiers, by their lowercase form.
+var licenseAliases = map[string]string{
+	"apache 2":                    "Apache-2.0",
+	"apache 2.0":                  "Apache-2.0",
+	"apache-2":                    "Apache-2.0",
+	"apache license 2.0":          "Apache-2.0",
+	"apache license, version 2.0": "Apache-2.0",
+	"asl 2.0":                     "Apache-2.0",
+	"mit license":                 "MIT",
+	"the mit license":             "MIT",
+	"bsd":                         "BSD-3-Clause",
+	"new bsd license":             "BSD-3-Clause",
+	"bsd 3-clause":                "BSD-3-Clause",
+	"simplified bsd license":      "BSD-2-Clause",
+	"bsd 2-clause":                "BSD-2-Clause",
+	"gplv2":                       "GPL-2.0",
+	"gpl2":                        "GPL-2.0",
+	"gplv3":                       "GPL-3.0",
+	"gpl3":                        "GPL-3.0",
+	"lgplv2.1":                    "LGPL-2.1",
+	"lgplv3":                      "LGPL-3.0",
+	"agplv3":                      "AGPL-3.0",
+	"mpl 2.0":                     "MPL-2.0",
+	"mozilla public license 2.0":  "MPL-2.0",
+	"eclipse public license 1.0":  "EPL-1.0",
+	"eclipse public license 2.0":  "EPL-2.0",
+	"public domain":               "Unlicense",
+}
+
+func toLowercaseIndex(ids []string) map[string]string {
+	index := make(map[string]string, len(ids))
+	for _, id := range ids {
+		index[strings.ToLower(id)] = id
+	}
+	return index
+}
+
+// Returns the SPDX identifier of a license, which is matched case-insensitively against the common SPDX identifiers
+// and names of licenses. If the license isn't recognized, it's returned trimmed and known is false.
+func NormalizeSpdxLicense(license string) (id string, known bool) {
+	id = strings.TrimSpace(license)
+	lower := strings.ToLower(id)
+	if spdxId, exists := spdxLicenseIds[lower]; exists {
+		return spdxId, true
+	}
+	if spdxId, exists := licenseAliases[lower]; exists {
+		return spdxId, true
+	}
+	return id, false
+}
+
+// LicensePolicy defines the licenses allowed in a build or a release bundle. Licenses are normalized before they're
+// matched, so both SPDX identifiers and common license names can be used.
+type LicensePolicy struct {
+	// If not empty, any other license is a violation.
+	Allowed []string
+	Banned  []string
+	// Report components with an unknown or unrecognized license as violations.
+	FailOnUnknown bool
+}
+
+// A license of a component in an artifact.
+type LicenseUsage struct {
+	// The normalized SPDX identifier of the license, or the license as reported by Xray if it isn't recognized.
+	License string
+	// The license as reported by Xray.
+	OriginalLicense string
+	Component       string
+	Artifact        string
+}
+
+type LicenseSummary struct {
+	License    string
+	Components []string
+	Artifacts  []string
+}
+
+type LicenseComplianceViolation struct {
+	LicenseUsage
+	// One of the LicenseViolation* values.
+	Reason string
+}
+
+type LicenseComplianceResult struct {
+	// The licenses found, sorted by license.
+	Licenses []LicenseSummary
+	// The usages of licenses Xray couldn't detect or which aren't recognized SPDX licenses.
+	Unknown    []LicenseUsage
+	Violations []LicenseComplianceViolation
+}
+
+func (lcr *LicenseComplianceResult) IsCompliant() bool {
+	return len(lcr.Violations) == 0
+}
+
+// Aggregates the license usages found across the artifacts, and checks them against the policy.
+func AggregateLicenses(usages []LicenseUsage, policy LicensePolicy) *LicenseComplianceResult {
+	allowed := normalizeLicenses(policy.Allowed)
+	banned := normalizeLicenses(policy.Banned)
+	result := &LicenseComplianceResult{}
+	summaries := map[string]*LicenseSummary{}
+	for _, usage := range usages {
+		license, known := NormalizeSpdxLicense(usage.OriginalLicense)
+		if usage.OriginalLicense == "" || strings.EqualFold(usage.OriginalLicense, unknownLicense) {
+			license, known = unknownLicense, false
+		}
+		usage.License = license
+		summary, exists := summaries[license]
+		if !exists {
+			summary = &LicenseSummary{License: license}
+			summaries[license] = summary
+		}
+		summary.Components = appendIfMissing(summary.Components, usage.Component)
+		summary.Artifacts = appendIfMissing(summary.Artifacts, usage.Artifact)
+
+		reason := ""
+		switch {
+		case slices.Contains(banned, license):
+			reason = LicenseViolationBanned
+		case !known:
+			result.Unknown = append(result.Unknown, usage)
+			if policy.FailOnUnknown {
+				reason = LicenseViolationUnknown
+			}
+		case len(allowed) > 0 && !slices.Contains(allowed, license):
+			reason = LicenseViolationNotAllowed
+		}
+		if reason != "" {
+			result.Violations = append(result.Violations, LicenseComplianceViolation{LicenseUsage: usage, Reason: reason})
+		}
+	}
+	for _, summary := range summaries {
+		sort.Strings(summary.Components)
+		sort.Strings(summary.Artifacts)
+		result.Licenses = append(result.Licenses, *summary)
+	}
+	sort.Slice(result.Licenses, func(i, j int) bool { return result.Licenses[i].License < result.Licenses[j].License })
+	return result
+}
+
+func normalizeLicenses(licenses []string) []string {
+	normalized := make([]string, 0, len(licenses))
+	for _, license := range licenses {
+		id, _ := NormalizeSpdxLicense(license)
+		normalized = append(normalized, id)
+	}
+	return normalized
+}
+
+func appendIfMissing(values []string, value string) []string {
+	if value == "" || slices.Contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
+
+// Returns the license usages of the licenses in the results of an Xray scan, such as a build scan.
+func LicenseUsagesFromScan(licenses []License, artifact string) []LicenseUsage {
+	var usages []LicenseUsage
+	for _, license := range licenses {
+		componentIds := make([]string, 0, len(license.Components))
+		for componentId := range license.Components {
+			componentIds = append(componentIds, componentId)
+		}
+		sort.Strings(componentIds)
+		for _, componentId := range componentIds {
+			usages = append(usages, LicenseUsage{OriginalLicense: license.Key, Component: componentId, Artifact: artifact})
+		}
+	}
+	return usages
+}
+
+// Returns the license usage of a row of a licenses report.
+func LicenseUsageFromReportRow(row Row) LicenseUsage {
+	license := row.License
+	if row.Unknown != nil && *row.Unknown {
+		license = unknownLicense
+	}
+	return LicenseUsage{OriginalLicense: license, Component: row.Component, Artifact: row.Artifact}
+}
+
+type LicenseComplianceParams struct {
+	// The builds or release bundles to check. The repositories can be checked as well.
+	Resources Resource
+	Policy    LicensePolicy
+	// The time to wait for Xray to generate the licenses report. Defaults to 45 minutes.
+	Timeout time.Duration
+	// Defaults to 5 seconds.
+	PollingInterval time.Duration
+}
+
+// Checks the licenses of the latest build numbers of a build.
+func NewBuildLicenseComplianceParams(buildName string, latestBuildNumbers int, policy LicensePolicy) LicenseComplianceParams {
+	return LicenseComplianceParams{
+		Resources: Resource{Builds: &BuildsResource{Names: []string{buildName}, NumberOfLatestVersions: max(latestBuildNumbers, 1)}},
+		Policy:    policy,
+	}
+}
+
+// Checks the licenses of the latest versions of a release bundle v2.
+func NewReleaseBundleV2LicenseComplianceParams(releaseBundleName string, latestVersions int, policy LicensePolicy) LicenseComplianceParams {
+	return LicenseComplianceParams{
+		Resources: Resource{ReleaseBundlesV2: &ReleaseBundlesResource{Names: []string{releaseBundleName}, NumberOfLatestVersions: max(latestVersions, 1)}},
+		Policy:    policy,
+	}
+}
+
+// Aggregates the licenses of all the artifacts of the resources, using an Xray licenses report, and checks them
+// against the policy. The report is deleted once its content is read.
+func (rs *ReportService) GetLicenseCompliance(params LicenseComplianceParams) (result *LicenseComplianceResult, err error) {
+	report, err := rs.Licenses(LicensesReportRequestParams{
+		Name:      fmt.Sprintf("license-compliance-%d", time.Now().UnixMilli()),
+		Resources: params.Resources,
+	})
+	if err != nil {
+		return nil, err
+	}
+	reportId := fmt.Sprint(report.ReportId)
+	defer func() {
+		if deleteErr := rs.Delete(reportId); deleteErr != nil {
+			log.Warn(fmt.Sprintf("Failed to delete Xray report %s: %s", reportId, deleteErr.Error()))
+		}
+	}()
+	timeout := valueOrDefault(params.Timeout, defaultMaxWaitMinutes)
+	pollingInterval := valueOrDefault(params.PollingInterval, defaultSyncSleepInterval)
+	if _, err = rs.WaitForReport(reportId, timeout, pollingInterval); err != nil {
+		return nil, err
+	}
+	var usages []LicenseUsage
+	err = rs.AllContent(ReportContentRequestParams{ReportType: Licenses, ReportId: reportId}, func(row Row) error {
+		usages = append(usages, LicenseUsageFromReportRow(row))
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Join(errorutils.CheckErrorf("failed to read the licenses of Xray report %s", reportId), err)
+	}
+	return AggregateLicenses(usages, params.Policy), nil
+}
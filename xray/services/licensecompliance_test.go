@@ -0,0 +1,84 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSpdxLicense(t *testing.T) {
+	testCases := []struct {
+		license  string
+		expected string
+		known    bool
+	}{
+		{"apache-2.0", "Apache-2.0", true},
+		{" The MIT License ", "MIT", true},
+		{"GPLv3", "GPL-3.0", true},
+		{"My Custom License", "My Custom License", false},
+	}
+	for _, testCase := range testCases {
+		id, known := NormalizeSpdxLicense(testCase.license)
+		assert.Equal(t, testCase.expected, id)
+		assert.Equal(t, testCase.known, known)
+	}
+}
+
+func TestAggregateLicenses(t *testing.T) {
+	usages := []LicenseUsage{
+		{OriginalLicense: "Apache 2.0", Component: "gav://a:a:1", Artifact: "app.jar"},
+		{OriginalLicense: "apache-2.0", Component: "gav://b:b:1", Artifact: "lib.jar"},
+		{OriginalLicense: "GPLv3", Component: "gav://c:c:1", Artifact: "app.jar"},
+		{OriginalLicense: "Unknown", Component: "gav://d:d:1", Artifact: "app.jar"},
+		{OriginalLicense: "BSD-3-Clause", Component: "gav://e:e:1", Artifact: "app.jar"},
+	}
+	result := AggregateLicenses(usages, LicensePolicy{Allowed: []string{"Apache 2.0", "GPL-3.0"}, Banned: []string{"gplv3"}, FailOnUnknown: true})
+	assert.Equal(t, []LicenseSummary{
+		{License: "Apache-2.0", Components: []string{"gav://a:a:1", "gav://b:b:1"}, Artifacts: []string{"app.jar", "lib.jar"}},
+		{License: "BSD-3-Clause", Components: []string{"gav://e:e:1"}, Artifacts: []string{"app.jar"}},
+		{License: "GPL-3.0", Components: []string{"gav://c:c:1"}, Artifacts: []string{"app.jar"}},
+		{License: "Unknown", Components: []string{"gav://d:d:1"}, Artifacts: []string{"app.jar"}},
+	}, result.Licenses)
+	assert.Len(t, result.Unknown, 1)
+	var reasons []string
+	for _, violation := range result.Violations {
+		reasons = append(reasons, violation.License+":"+violation.Reason)
+	}
+	assert.Equal(t, []string{"GPL-3.0:banned", "Unknown:unknown", "BSD-3-Clause:not_allowed"}, reasons)
+	assert.False(t, result.IsCompliant())
+
+	assert.True(t, AggregateLicenses(usages[:2], LicensePolicy{Allowed: []string{"Apache-2.0"}}).IsCompliant())
+}
+
+func TestGetLicenseCompliance(t *testing.T) {
+	deleted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, ReportsAPI+"/"+Licenses):
+			request := LicensesReportRequestParams{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			assert.Equal(t, &ReleaseBundlesResource{Names: []string{"rb"}, NumberOfLatestVersions: 1}, request.Resources.ReleaseBundlesV2)
+			_, _ = w.Write([]byte(`{"report_id":3,"status":"pending"}`))
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, ReportsAPI+"/3"):
+			_, _ = w.Write([]byte(`{"id":3,"status":"completed"}`))
+		case r.Method == http.MethodPost:
+			_, _ = w.Write([]byte(`{"total_rows":2,"rows":[{"license":"MIT","component":"npm://a:1","artifact":"rb"},{"license":"GPL-2.0","component":"npm://b:1","artifact":"rb"}]}`))
+		case r.Method == http.MethodDelete:
+			deleted = true
+		}
+	}))
+	defer server.Close()
+	params := NewReleaseBundleV2LicenseComplianceParams("rb", 0, LicensePolicy{Banned: []string{"GPL-2.0"}})
+	params.PollingInterval = time.Millisecond
+	result, err := createTestReportService(t, server.URL).GetLicenseCompliance(params)
+	assert.NoError(t, err)
+	if assert.Len(t, result.Violations, 1) {
+		assert.Equal(t, "npm://b:1", result.Violations[0].Component)
+	}
+	assert.True(t, deleted)
+}
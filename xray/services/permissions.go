@@ -0,0 +1,101 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// PermissionsService inspects which Xray actions the current credentials can perform
+type PermissionsService struct {
+	client          *jfroghttpclient.JfrogHttpClient
+	XrayDetails     auth.ServiceDetails
+	ScopeProjectKey string
+}
+
+func NewPermissionsService(client *jfroghttpclient.JfrogHttpClient) *PermissionsService {
+	return &PermissionsService{client: client}
+}
+
+// The Xray actions the current credentials can perform.
+type XrayPermissions struct {
+	ReadReports       bool
+	ManageReports     bool
+	ReadIgnoreRules   bool
+	ManageIgnoreRules bool
+	ReadPolicies      bool
+	ManagePolicies    bool
+	ReadWatches       bool
+	ManageWatches     bool
+}
+
+type permissionProbe struct {
+	method  string
+	api     string
+	content []byte
+	allowed *bool
+}
+
+// Returns the Xray actions the current credentials can perform.
+// Since Xray doesn't expose the permissions of a token, each action is probed with a request which requires its
+// permission and has no side effects: the read actions are probed by listing a single item, and the manage actions by
+// deleting an item which doesn't exist. An action is allowed unless Xray responds with 403 Forbidden.
+// Returns an error if the credentials are rejected altogether.
+func (ps *PermissionsService) GetPermissions() (*XrayPermissions, error) {
+	permissions := &XrayPermissions{}
+	// A name which doesn't match an existing item, used to probe the manage actions.
+	missingItem := fmt.Sprintf("jfrog-client-go-permissions-probe-%d", time.Now().UnixNano())
+	probes := []permissionProbe{
+		{http.MethodPost, ReportsAPI + "?page_num=1&num_of_rows=1", []byte("{}"), &permissions.ReadReports},
+		{http.MethodDelete, ReportsAPI + "/0", nil, &permissions.ManageReports},
+		{http.MethodGet, ignoreRuleAPIURL + "?page_num=1&num_of_rows=1", nil, &permissions.ReadIgnoreRules},
+		{http.MethodDelete, ignoreRuleAPIURL + "/" + missingItem, nil, &permissions.ManageIgnoreRules},
+		{http.MethodGet, policyAPIURL, nil, &permissions.ReadPolicies},
+		{http.MethodDelete, policyAPIURL + "/" + missingItem, nil, &permissions.ManagePolicies},
+		{http.MethodGet, watchAPIURL, nil, &permissions.ReadWatches},
+		{http.MethodDelete, watchAPIURL + "/" + missingItem, nil, &permissions.ManageWatches},
+	}
+	for _, probe := range probes {
+		allowed, err := ps.probe(probe)
+		if err != nil {
+			return nil, err
+		}
+		*probe.allowed = allowed
+	}
+	return permissions, nil
+}
+
+func (ps *PermissionsService) probe(probe permissionProbe) (bool, error) {
+	httpClientsDetails := ps.XrayDetails.CreateHttpClientDetails()
+	requestUrl := clientutils.AppendScopedProjectKeyParam(ps.XrayDetails.GetUrl()+probe.api, ps.ScopeProjectKey)
+	var resp *http.Response
+	var body []byte
+	var err error
+	switch probe.method {
+	case http.MethodGet:
+		resp, body, _, err = ps.client.SendGet(requestUrl, true, &httpClientsDetails)
+	case http.MethodPost:
+		httpClientsDetails.SetContentTypeApplicationJson()
+		resp, body, err = ps.client.SendPost(requestUrl, probe.content, &httpClientsDetails)
+	case http.MethodDelete:
+		resp, body, err = ps.client.SendDelete(requestUrl, probe.content, &httpClientsDetails)
+	}
+	if err != nil {
+		return false, err
+	}
+	log.Debug(fmt.Sprintf("Xray response to %s %s: %s", probe.method, probe.api, resp.Status))
+	switch {
+	case resp.StatusCode == http.StatusForbidden:
+		return false, nil
+	case resp.StatusCode == http.StatusUnauthorized, resp.StatusCode >= http.StatusInternalServerError:
+		return false, errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+	}
+	// Other client errors, such as 404 Not Found for the missing items, mean the request passed the permission check.
+	return true, nil
+}
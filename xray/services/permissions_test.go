@@ -0,0 +1,50 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestPermissionsService(t *testing.T, serverUrl string) *PermissionsService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	permissionsService := NewPermissionsService(client)
+	permissionsService.XrayDetails = xrayDetails
+	return permissionsService
+}
+
+func TestGetPermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "proj", r.URL.Query().Get("projectKey"))
+		switch {
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/"+policyAPIURL):
+			w.WriteHeader(http.StatusForbidden)
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/"+watchAPIURL):
+			w.WriteHeader(http.StatusForbidden)
+		case strings.HasPrefix(r.URL.Path, "/"+ignoreRuleAPIURL):
+			w.WriteHeader(http.StatusForbidden)
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			_, _ = w.Write([]byte("{}"))
+		}
+	}))
+	defer server.Close()
+	permissionsService := createTestPermissionsService(t, server.URL)
+	permissionsService.ScopeProjectKey = "proj"
+	permissions, err := permissionsService.GetPermissions()
+	assert.NoError(t, err)
+	assert.Equal(t, XrayPermissions{ReadReports: true, ManageReports: true, ReadPolicies: true, ReadWatches: true}, *permissions)
+}
+
+func TestGetPermissionsUnauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	_, err := createTestPermissionsService(t, server.URL).GetPermissions()
+	assert.ErrorContains(t, err, "401")
+}
@@ -2,11 +2,19 @@ package services
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
-	"net/http"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 const (
@@ -15,8 +23,26 @@ const (
 	Vulnerabilities = "vulnerabilities"
 	Licenses        = "licenses"
 	Violations      = "violations"
+	OperationalRisk = "operationalRisks"
+
+	// Report statuses
+	ReportStatusPending   = "pending"
+	ReportStatusRunning   = "running"
+	ReportStatusCompleted = "completed"
+	ReportStatusFailed    = "failed"
+	ReportStatusAborted   = "aborted"
+
+	// Export formats
+	ReportExportPdf  ReportExportFormat = "pdf"
+	ReportExportCsv  ReportExportFormat = "csv"
+	ReportExportJson ReportExportFormat = "json"
+
+	// The number of rows fetched per page when reading the whole content of a report
+	defaultReportContentPageSize = 1000
 )
 
+type ReportExportFormat string
+
 // ReportService defines the Http client and Xray details
 type ReportService struct {
 	client      *jfroghttpclient.JfrogHttpClient
@@ -83,6 +109,17 @@ type Row struct {
 	Unknown          *bool  `json:"unknown,omitempty"`
 	Unrecognized     *bool  `json:"unrecognized,omitempty"`
 	Custom           *bool  `json:"custom,omitempty"`
+	// Operational Risk Report field
+	Risk          string   `json:"risk,omitempty"`
+	RiskReason    string   `json:"risk_reason,omitempty"`
+	IsEol         *bool    `json:"is_eol,omitempty"`
+	EolMessage    string   `json:"eol_message,omitempty"`
+	LatestVersion string   `json:"latest_version,omitempty"`
+	NewerVersions *int     `json:"newer_versions,omitempty"`
+	Cadence       *float64 `json:"cadence,omitempty"`
+	Commits       *int64   `json:"commits,omitempty"`
+	Committers    *int     `json:"committers,omitempty"`
+	Released      string   `json:"released,omitempty"`
 	// Common field
 	Path       string   `json:"path,omitempty"`
 	References []string `json:"references,omitempty"`
@@ -121,6 +158,29 @@ type ViolationsReportRequestParams struct {
 	Resources Resource         `json:"resources,omitempty"`
 }
 
+// OperationalRiskReportRequestParams defines a report request
+type OperationalRiskReportRequestParams struct {
+	Name      string                `json:"name,omitempty"`
+	Filters   OperationalRiskFilter `json:"filters,omitempty"`
+	Resources Resource              `json:"resources,omitempty"`
+}
+
+type OperationalRiskFilter struct {
+	Component string `json:"component,omitempty"`
+	Artifact  string `json:"artifact,omitempty"`
+	// Valid values: High, Medium, Low, None
+	Risks    []string      `json:"risks,omitempty"`
+	ScanDate DateTimeRange `json:"scan_date,omitempty"`
+}
+
+// ReportExportParams defines a report export request
+type ReportExportParams struct {
+	ReportId string
+	Format   ReportExportFormat
+	// The name of the exported file. Defaults to the report ID.
+	FileName string
+}
+
 type VulnerabilitiesFilter struct {
 	VulnerableComponent string        `json:"vulnerable_component,omitempty"`
 	ImpactedArtifact    string        `json:"impacted_artifact,omitempty"`
@@ -168,7 +228,25 @@ type CvssScore struct {
 }
 
 type Resource struct {
-	Repositories []Repository `json:"repositories,omitempty"`
+	Repositories     []Repository            `json:"repositories,omitempty"`
+	Builds           *BuildsResource         `json:"builds,omitempty"`
+	ReleaseBundlesV2 *ReleaseBundlesResource `json:"release_bundles_v2,omitempty"`
+}
+
+type BuildsResource struct {
+	Names           []string `json:"names,omitempty"`
+	IncludePatterns []string `json:"include_patterns,omitempty"`
+	ExcludePatterns []string `json:"exclude_patterns,omitempty"`
+	// The number of the latest build numbers of each build to include in the report.
+	NumberOfLatestVersions int `json:"number_of_latest_versions,omitempty"`
+}
+
+type ReleaseBundlesResource struct {
+	Names           []string `json:"names,omitempty"`
+	IncludePatterns []string `json:"include_patterns,omitempty"`
+	ExcludePatterns []string `json:"exclude_patterns,omitempty"`
+	// The number of the latest versions of each release bundle to include in the report.
+	NumberOfLatestVersions int `json:"number_of_latest_versions,omitempty"`
 }
 
 type Repository struct {
@@ -202,6 +280,11 @@ func (rs *ReportService) Violations(req ViolationsReportRequestParams) (*ReportR
 	return rs.requestReport(req, Violations)
 }
 
+// OperationalRisk requests a new Xray scan for operational risks
+func (rs *ReportService) OperationalRisk(req OperationalRiskReportRequestParams) (*ReportResponse, error) {
+	return rs.requestReport(req, OperationalRisk)
+}
+
 // Internal function to requests a new Xray scan for Report of type (vulnerabilities/licenses/voilations)
 func (rs *ReportService) requestReport(req any, reportType string) (*ReportResponse, error) {
 	retVal := ReportResponse{}
@@ -289,3 +372,102 @@ func (rs *ReportService) Delete(reportId string) error {
 
 	return nil
 }
+
+// WaitForReport polls the report's details until it's completed, and returns them.
+// Returns an error if the report failed or was aborted, or if it isn't completed within the timeout.
+// The timeout defaults to 45 minutes, and the polling interval to 5 seconds.
+func (rs *ReportService) WaitForReport(reportId string, timeout, pollingInterval time.Duration) (*ReportDetails, error) {
+	var details *ReportDetails
+	pollingAction := func() (shouldStop bool, responseBody []byte, err error) {
+		details, err = rs.Details(reportId)
+		if err != nil {
+			return true, nil, err
+		}
+		switch details.Status {
+		case ReportStatusCompleted:
+			return true, nil, nil
+		case ReportStatusFailed, ReportStatusAborted:
+			return true, nil, errorutils.CheckErrorf("Xray report %s is %s", reportId, details.Status)
+		}
+		log.Debug(fmt.Sprintf("Xray report %s is %s (%d%%)...", reportId, details.Status, details.Progress))
+		return false, nil, nil
+	}
+	pollingExecutor := &httputils.PollingExecutor{
+		Timeout:         valueOrDefault(timeout, defaultMaxWaitMinutes),
+		PollingInterval: valueOrDefault(pollingInterval, defaultSyncSleepInterval),
+		PollingAction:   pollingAction,
+		MsgPrefix:       fmt.Sprintf("Waiting for Xray report %s...", reportId),
+	}
+	if _, err := pollingExecutor.Execute(); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// AllContent reads the content of the report page by page, starting at request.PageNum, and passes each row to the
+// handler. If request.NumRows isn't set, pages of 1000 rows are read. Reading stops on the first error the handler
+// returns.
+func (rs *ReportService) AllContent(request ReportContentRequestParams, handler func(Row) error) error {
+	if request.PageNum < 1 {
+		request.PageNum = 1
+	}
+	if request.NumRows <= 0 {
+		request.NumRows = defaultReportContentPageSize
+	}
+	for read := 0; ; request.PageNum++ {
+		content, err := rs.Content(request)
+		if err != nil {
+			return err
+		}
+		for _, row := range content.Rows {
+			if err = handler(row); err != nil {
+				return err
+			}
+		}
+		read += len(content.Rows)
+		if len(content.Rows) < request.NumRows || read >= content.TotalRows {
+			return nil
+		}
+	}
+}
+
+// Export downloads the report in the requested format, and writes it to the writer as it's downloaded.
+// Xray exports the report as a zip archive.
+func (rs *ReportService) Export(params ReportExportParams, writer io.Writer) (err error) {
+	if params.Format == "" {
+		return errorutils.CheckErrorf("an export format is required")
+	}
+	fileName := params.FileName
+	if fileName == "" {
+		fileName = params.ReportId
+	}
+	httpClientsDetails := rs.XrayDetails.CreateHttpClientDetails()
+	exportUrl := fmt.Sprintf("%s%s/export/%s?file_name=%s&format=%s", rs.XrayDetails.GetUrl(), ReportsAPI, params.ReportId, url.QueryEscape(fileName), params.Format)
+	// The body is left open to stream the exported report.
+	resp, _, _, err := rs.client.Send(http.MethodGet, exportUrl, nil, true, false, &httpClientsDetails, "")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(resp.Body.Close()))
+	}()
+	if resp.StatusCode != http.StatusOK {
+		body, readErr := io.ReadAll(resp.Body)
+		return errors.Join(errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK), errorutils.CheckError(readErr))
+	}
+	log.Debug("Xray response:", resp.Status)
+	_, err = io.Copy(writer, resp.Body)
+	return errorutils.CheckError(err)
+}
+
+// ExportToFile downloads the report in the requested format to a local file.
+func (rs *ReportService) ExportToFile(params ReportExportParams, filePath string) (err error) {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(file.Close()))
+	}()
+	return rs.Export(params, file)
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestReportService(t *testing.T, serverUrl string) *ReportService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	reportService := NewReportService(client)
+	reportService.XrayDetails = xrayDetails
+	return reportService
+}
+
+func TestOperationalRiskReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasSuffix(r.URL.Path, ReportsAPI+"/"+OperationalRisk))
+		req := OperationalRiskReportRequestParams{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, []string{"High"}, req.Filters.Risks)
+		_, _ = w.Write([]byte(`{"report_id":7,"status":"pending"}`))
+	}))
+	defer server.Close()
+	params := OperationalRiskReportRequestParams{Name: "risks", Filters: OperationalRiskFilter{Risks: []string{"High"}}}
+	resp, err := createTestReportService(t, server.URL).OperationalRisk(params)
+	assert.NoError(t, err)
+	assert.Equal(t, &ReportResponse{ReportId: 7, Status: ReportStatusPending}, resp)
+}
+
+func TestWaitForReport(t *testing.T) {
+	statuses := []string{ReportStatusPending, ReportStatusRunning, ReportStatusCompleted}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"id":7,"status":"%s"}`, statuses[requests])
+		requests++
+	}))
+	defer server.Close()
+	details, err := createTestReportService(t, server.URL).WaitForReport("7", time.Minute, time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, ReportStatusCompleted, details.Status)
+	assert.Equal(t, 3, requests)
+
+	requests = 0
+	statuses = []string{ReportStatusRunning, ReportStatusFailed}
+	_, err = createTestReportService(t, server.URL).WaitForReport("7", time.Minute, time.Millisecond)
+	assert.ErrorContains(t, err, "Xray report 7 is failed")
+
+	// Without a timeout and a polling interval, the defaults are used.
+	requests = 0
+	statuses = []string{ReportStatusCompleted}
+	details, err = createTestReportService(t, server.URL).WaitForReport("7", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, ReportStatusCompleted, details.Status)
+}
+
+func TestReportAllContent(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page_num")
+		pages = append(pages, page)
+		assert.Equal(t, "2", r.URL.Query().Get("num_of_rows"))
+		pageNum, err := strconv.Atoi(page)
+		assert.NoError(t, err)
+		rows := `[{"cves":[{"cve":"CVE-1"}]},{"cves":[{"cve":"CVE-2"}]}]`
+		if pageNum == 3 {
+			rows = `[{"cves":[{"cve":"CVE-5"}]}]`
+		}
+		_, _ = fmt.Fprintf(w, `{"total_rows":5,"rows":%s}`, rows)
+	}))
+	defer server.Close()
+	var rowsRead int
+	err := createTestReportService(t, server.URL).AllContent(ReportContentRequestParams{ReportType: Vulnerabilities, ReportId: "7", NumRows: 2}, func(Row) error {
+		rowsRead++
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, rowsRead)
+	assert.Equal(t, []string{"1", "2", "3"}, pages)
+}
+
+func TestExportReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+ReportsAPI+"/export/7", r.URL.Path)
+		assert.Equal(t, "csv", r.URL.Query().Get("format"))
+		if r.URL.Query().Get("file_name") == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "my report", r.URL.Query().Get("file_name"))
+		_, _ = w.Write([]byte("exported"))
+	}))
+	defer server.Close()
+	reportService := createTestReportService(t, server.URL)
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, reportService.Export(ReportExportParams{ReportId: "7", Format: ReportExportCsv, FileName: "my report"}, buffer))
+	assert.Equal(t, "exported", buffer.String())
+
+	assert.ErrorContains(t, reportService.Export(ReportExportParams{ReportId: "7", Format: ReportExportCsv, FileName: "missing"}, &bytes.Buffer{}), "404")
+	assert.ErrorContains(t, reportService.Export(ReportExportParams{ReportId: "7"}, &bytes.Buffer{}), "an export format is required")
+}
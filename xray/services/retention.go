@@ -0,0 +1,181 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	retentionConfigAPI = "api/v1/configuration/retention"
+	cleanupJobsAPI     = "api/v1/cleanup/jobs"
+
+	CleanupJobStatusPending   = "pending"
+	CleanupJobStatusRunning   = "running"
+	CleanupJobStatusCompleted = "completed"
+	CleanupJobStatusFailed    = "failed"
+)
+
+type CleanupScope string
+
+const (
+	CleanupScanResults CleanupScope = "scan_results"
+	CleanupViolations  CleanupScope = "violations"
+	CleanupReports     CleanupScope = "reports"
+)
+
+// RetentionService manages how long Xray keeps its data, and cleans up old data
+type RetentionService struct {
+	client      *jfroghttpclient.JfrogHttpClient
+	XrayDetails auth.ServiceDetails
+}
+
+func NewRetentionService(client *jfroghttpclient.JfrogHttpClient) *RetentionService {
+	return &RetentionService{client: client}
+}
+
+// The number of days Xray keeps each type of data. 0 keeps the data forever.
+// The retention of the scan results of a specific repository can be configured with UpdateRepositoryConfig.
+type RetentionConfig struct {
+	ScanResultsRetentionDays int `json:"scan_results_retention_days"`
+	// Applies to the violations history, including resolved violations.
+	ViolationsRetentionDays int `json:"violations_retention_days"`
+	ReportsRetentionDays    int `json:"reports_retention_days"`
+}
+
+type CleanupParams struct {
+	// The types of data to clean up. All the types if empty.
+	Scopes []CleanupScope `json:"scopes,omitempty"`
+	// Deletes the data older than the number of days. The retention configuration is used if 0.
+	OlderThanDays int `json:"older_than_days,omitempty"`
+}
+
+type CleanupJob struct {
+	Id string `json:"id"`
+	// One of the CleanupJobStatus* values.
+	Status  string         `json:"status"`
+	Scopes  []CleanupScope `json:"scopes,omitempty"`
+	Started string         `json:"started,omitempty"`
+	Ended   string         `json:"ended,omitempty"`
+	// The number of records deleted so far, by scope.
+	DeletedRecords map[CleanupScope]int64 `json:"deleted_records,omitempty"`
+	Error          string                 `json:"error,omitempty"`
+}
+
+// Returns the retention configuration of Xray.
+func (rs *RetentionService) GetConfig() (*RetentionConfig, error) {
+	config := &RetentionConfig{}
+	if err := rs.sendGet(retentionConfigAPI, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Updates the retention configuration of Xray. Data older than the new retention is deleted by the next cleanup.
+func (rs *RetentionService) UpdateConfig(config RetentionConfig) error {
+	if config.ScanResultsRetentionDays < 0 || config.ViolationsRetentionDays < 0 || config.ReportsRetentionDays < 0 {
+		return errorutils.CheckErrorf("the retention days can't be negative")
+	}
+	content, err := json.Marshal(config)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpClientsDetails := rs.XrayDetails.CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	resp, body, err := rs.client.SendPut(rs.XrayDetails.GetUrl()+retentionConfigAPI, content, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent); err != nil {
+		return err
+	}
+	log.Debug("Xray response:", resp.Status)
+	return nil
+}
+
+// Starts a job which deletes old data, and returns it. Use WaitForCleanup to wait for the job to complete.
+func (rs *RetentionService) StartCleanup(params CleanupParams) (*CleanupJob, error) {
+	content, err := json.Marshal(params)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	httpClientsDetails := rs.XrayDetails.CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	log.Info("Starting an Xray cleanup job...")
+	resp, body, err := rs.client.SendPost(rs.XrayDetails.GetUrl()+cleanupJobsAPI, content, &httpClientsDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated, http.StatusAccepted); err != nil {
+		return nil, err
+	}
+	log.Debug("Xray response:", resp.Status)
+	job := &CleanupJob{}
+	if err = json.Unmarshal(body, job); err != nil {
+		return nil, errorutils.CheckErrorf("couldn't parse JFrog Xray server cleanup response: %s", err.Error())
+	}
+	return job, nil
+}
+
+// Returns the status of a cleanup job.
+func (rs *RetentionService) GetCleanupJob(jobId string) (*CleanupJob, error) {
+	job := &CleanupJob{}
+	if err := rs.sendGet(cleanupJobsAPI+"/"+url.PathEscape(jobId), job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// Polls the status of a cleanup job until it's completed, and returns it.
+// Returns an error if the job failed, or if it isn't completed within the timeout.
+func (rs *RetentionService) WaitForCleanup(jobId string, timeout, pollingInterval time.Duration) (*CleanupJob, error) {
+	var job *CleanupJob
+	pollingAction := func() (shouldStop bool, responseBody []byte, err error) {
+		job, err = rs.GetCleanupJob(jobId)
+		if err != nil {
+			return true, nil, err
+		}
+		switch job.Status {
+		case CleanupJobStatusCompleted:
+			return true, nil, nil
+		case CleanupJobStatusFailed:
+			return true, nil, errorutils.CheckErrorf("Xray cleanup job %s failed: %s", jobId, job.Error)
+		}
+		log.Debug(fmt.Sprintf("Xray cleanup job %s is %s...", jobId, job.Status))
+		return false, nil, nil
+	}
+	pollingExecutor := &httputils.PollingExecutor{
+		Timeout:         valueOrDefault(timeout, defaultMaxWaitMinutes),
+		PollingInterval: valueOrDefault(pollingInterval, defaultSyncSleepInterval),
+		PollingAction:   pollingAction,
+		MsgPrefix:       fmt.Sprintf("Waiting for Xray cleanup job %s...", jobId),
+	}
+	if _, err := pollingExecutor.Execute(); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (rs *RetentionService) sendGet(api string, result any) error {
+	httpClientsDetails := rs.XrayDetails.CreateHttpClientDetails()
+	resp, body, _, err := rs.client.SendGet(rs.XrayDetails.GetUrl()+api, true, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	log.Debug("Xray response:", resp.Status)
+	if err = json.Unmarshal(body, result); err != nil {
+		return errorutils.CheckErrorf("couldn't parse JFrog Xray server response: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestRetentionService(t *testing.T, serverUrl string) *RetentionService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	retentionService := NewRetentionService(client)
+	retentionService.XrayDetails = xrayDetails
+	return retentionService
+}
+
+func TestUpdateRetentionConfig(t *testing.T) {
+	var updated RetentionConfig
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+retentionConfigAPI, r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+	}))
+	defer server.Close()
+	retentionService := createTestRetentionService(t, server.URL)
+	config := RetentionConfig{ScanResultsRetentionDays: 90, ViolationsRetentionDays: 365}
+	assert.NoError(t, retentionService.UpdateConfig(config))
+	assert.Equal(t, config, updated)
+	assert.ErrorContains(t, retentionService.UpdateConfig(RetentionConfig{ReportsRetentionDays: -1}), "can't be negative")
+}
+
+func TestCleanupAndWait(t *testing.T) {
+	statuses := []string{CleanupJobStatusRunning, CleanupJobStatusCompleted}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/"+cleanupJobsAPI, r.URL.Path)
+			params := CleanupParams{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&params))
+			assert.Equal(t, CleanupParams{Scopes: []CleanupScope{CleanupViolations}, OlderThanDays: 30}, params)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"id":"job-1","status":"pending"}`))
+		case http.MethodGet:
+			assert.Equal(t, "/"+cleanupJobsAPI+"/job-1", r.URL.Path)
+			_, _ = fmt.Fprintf(w, `{"id":"job-1","status":"%s","deleted_records":{"violations":12}}`, statuses[0])
+			statuses = statuses[1:]
+		}
+	}))
+	defer server.Close()
+	retentionService := createTestRetentionService(t, server.URL)
+	job, err := retentionService.StartCleanup(CleanupParams{Scopes: []CleanupScope{CleanupViolations}, OlderThanDays: 30})
+	assert.NoError(t, err)
+	assert.Equal(t, CleanupJobStatusPending, job.Status)
+
+	job, err = retentionService.WaitForCleanup(job.Id, time.Minute, time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, map[CleanupScope]int64{CleanupViolations: 12}, job.DeletedRecords)
+}
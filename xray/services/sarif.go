@@ -0,0 +1,362 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/xray/services/utils"
+)
+
+const (
+	SarifVersion   = "2.1.0"
+	SarifSchemaUri = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	defaultSarifToolName       = "JFrog Xray"
+	defaultSarifInformationUri = "https://jfrog.com/xray/"
+
+	SarifLevelError   = "error"
+	SarifLevelWarning = "warning"
+	SarifLevelNote    = "note"
+
+	// The property code scanning tools read the severity of security rules from, a score between 0.0 and 10.0.
+	sarifSecuritySeverityProperty = "security-severity"
+	sarifTagsProperty             = "tags"
+)
+
+// The scores given to security rules without a CVSS v3 score, by severity.
+var severitySecurityScores = map[utils.Severity]string{
+	utils.Critical: "10.0",
+	utils.High:     "8.9",
+	utils.Medium:   "6.9",
+	utils.Low:      "3.9",
+}
+
+type SarifOptions struct {
+	// Defaults to "JFrog Xray".
+	ToolName    string
+	ToolVersion string
+	// Defaults to "https://jfrog.com/xray/".
+	InformationUri string
+	// The URI of the file all the results are reported on, e.g. the descriptor "package.json", relative to the root of
+	// the source repository. Code scanning tools, such as GitHub's, require a file location. If empty, the physical path
+	// of the impacted component is used where known.
+	LocationUri string
+}
+
+type SarifReport struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SarifRun `json:"runs"`
+}
+
+type SarifRun struct {
+	Tool    SarifTool     `json:"tool"`
+	Results []SarifResult `json:"results"`
+}
+
+type SarifTool struct {
+	Driver SarifDriver `json:"driver"`
+}
+
+type SarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationUri string      `json:"informationUri,omitempty"`
+	Rules          []SarifRule `json:"rules"`
+}
+
+type SarifRule struct {
+	Id                   string                  `json:"id"`
+	Name                 string                  `json:"name,omitempty"`
+	ShortDescription     *SarifMessage           `json:"shortDescription,omitempty"`
+	FullDescription      *SarifMessage           `json:"fullDescription,omitempty"`
+	Help                 *SarifMessage           `json:"help,omitempty"`
+	HelpUri              string                  `json:"helpUri,omitempty"`
+	DefaultConfiguration *SarifRuleConfiguration `json:"defaultConfiguration,omitempty"`
+	Properties           map[string]any          `json:"properties,omitempty"`
+}
+
+type SarifRuleConfiguration struct {
+	Level string `json:"level"`
+}
+
+type SarifMessage struct {
+	Text     string `json:"text"`
+	Markdown string `json:"markdown,omitempty"`
+}
+
+type SarifResult struct {
+	RuleId    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   SarifMessage    `json:"message"`
+	Locations []SarifLocation `json:"locations,omitempty"`
+}
+
+type SarifLocation struct {
+	PhysicalLocation *SarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []SarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type SarifPhysicalLocation struct {
+	ArtifactLocation SarifArtifactLocation `json:"artifactLocation"`
+}
+
+type SarifArtifactLocation struct {
+	Uri string `json:"uri"`
+}
+
+type SarifLogicalLocation struct {
+	Name string `json:"name"`
+	Kind string `json:"kind,omitempty"`
+}
+
+// Writes the report as indented JSON.
+func (sr *SarifReport) Write(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return errorutils.CheckError(encoder.Encode(sr))
+}
+
+// An issue found in a component, which is reported as a SARIF result of the issue's rule.
+type sarifIssue struct {
+	ruleId        string
+	ruleName      string
+	issueType     string
+	severity      string
+	summary       string
+	description   string
+	remediation   string
+	helpUri       string
+	cvssV3Score   string
+	cwes          []string
+	component     string
+	fixedVersions []string
+	physicalPath  string
+}
+
+// Converts the results of a scan to SARIF. The violations are reported if the scan found any, and otherwise its
+// vulnerabilities. Each issue is reported once for every impacted component.
+func ConvertScanResponseToSarif(scanResponse *ScanResponse, options SarifOptions) *SarifReport {
+	builder := newSarifBuilder(options)
+	if len(scanResponse.Violations) > 0 {
+		for _, violation := range scanResponse.Violations {
+			issue := sarifIssue{ruleName: violation.IssueId, issueType: violation.ViolationType, severity: violation.Severity, summary: violation.Summary}
+			issue.ruleId = scanIssueRuleId(violation.IssueId, violation.Cves, violation.LicenseKey)
+			setCveDetails(&issue, violation.Cves, violation.ExtendedInformation)
+			builder.addComponentIssues(issue, violation.Components)
+		}
+		return builder.report
+	}
+	for _, vulnerability := range scanResponse.Vulnerabilities {
+		issue := sarifIssue{ruleName: vulnerability.IssueId, issueType: string(utils.Security), severity: vulnerability.Severity, summary: vulnerability.Summary}
+		issue.ruleId = scanIssueRuleId(vulnerability.IssueId, vulnerability.Cves, "")
+		setCveDetails(&issue, vulnerability.Cves, vulnerability.ExtendedInformation)
+		builder.addComponentIssues(issue, vulnerability.Components)
+	}
+	return builder.report
+}
+
+// Converts violations fetched with ViolationsService.GetViolations to SARIF. Each violation is reported once for every
+// infected component. The details of the violations are included if they were requested with IncludeDetails.
+func ConvertViolationsToSarif(violations []XrayViolation, options SarifOptions) *SarifReport {
+	builder := newSarifBuilder(options)
+	for _, violation := range violations {
+		issue := sarifIssue{
+			ruleId:        violation.IssueId,
+			ruleName:      violation.IssueId,
+			issueType:     string(violation.Type),
+			severity:      string(violation.Severity),
+			summary:       violation.Summary,
+			description:   violation.Description,
+			helpUri:       violation.Url,
+			fixedVersions: violation.FixVersions,
+		}
+		for _, cve := range violation.Cves {
+			if cve.Id != "" {
+				issue.ruleId = cve.Id
+				issue.cwes = cve.Cwe
+				break
+			}
+		}
+		if violation.JfrogResearchInformation != nil {
+			issue.remediation = violation.JfrogResearchInformation.Remediation
+		}
+		if len(violation.InfectedFilePaths) > 0 {
+			issue.physicalPath = violation.InfectedFilePaths[0]
+		}
+		components := violation.InfectedComponentIds
+		if len(components) == 0 {
+			components = []string{""}
+		}
+		for _, component := range components {
+			issue.component = component
+			builder.add(issue)
+		}
+	}
+	return builder.report
+}
+
+// Security issues are identified by their first CVE, so that they match the CVEs found by other tools.
+func scanIssueRuleId(issueId string, cves []Cve, licenseKey string) string {
+	for _, cve := range cves {
+		if cve.Id != "" {
+			return cve.Id
+		}
+	}
+	if licenseKey != "" {
+		return licenseKey
+	}
+	return issueId
+}
+
+func setCveDetails(issue *sarifIssue, cves []Cve, extendedInformation *ExtendedInformation) {
+	for _, cve := range cves {
+		if cve.Id != "" {
+			issue.cvssV3Score = cve.CvssV3Score
+			issue.cwes = cve.Cwe
+			break
+		}
+	}
+	if extendedInformation != nil {
+		issue.description = extendedInformation.FullDescription
+		issue.remediation = extendedInformation.Remediation
+	}
+}
+
+type sarifBuilder struct {
+	options     SarifOptions
+	report      *SarifReport
+	ruleIndexes map[string]int
+}
+
+func newSarifBuilder(options SarifOptions) *sarifBuilder {
+	driver := SarifDriver{Name: options.ToolName, Version: options.ToolVersion, InformationUri: options.InformationUri, Rules: []SarifRule{}}
+	if driver.Name == "" {
+		driver.Name = defaultSarifToolName
+	}
+	if driver.InformationUri == "" {
+		driver.InformationUri = defaultSarifInformationUri
+	}
+	return &sarifBuilder{
+		options:     options,
+		report:      &SarifReport{Schema: SarifSchemaUri, Version: SarifVersion, Runs: []SarifRun{{Tool: SarifTool{Driver: driver}, Results: []SarifResult{}}}},
+		ruleIndexes: make(map[string]int),
+	}
+}
+
+// Adds the issue for each of the components, sorted by their IDs.
+func (sb *sarifBuilder) addComponentIssues(issue sarifIssue, components map[string]Component) {
+	if len(components) == 0 {
+		sb.add(issue)
+		return
+	}
+	for _, componentId := range slices.Sorted(maps.Keys(components)) {
+		component := components[componentId]
+		componentIssue := issue
+		componentIssue.component = componentId
+		componentIssue.fixedVersions = component.FixedVersions
+		if len(component.ImpactPaths) > 0 && len(component.ImpactPaths[0]) > 0 {
+			componentIssue.physicalPath = component.ImpactPaths[0][0].FullPath
+		}
+		sb.add(componentIssue)
+	}
+}
+
+func (sb *sarifBuilder) add(issue sarifIssue) {
+	run := &sb.report.Runs[0]
+	level := sarifLevel(issue.severity)
+	ruleIndex, exists := sb.ruleIndexes[issue.ruleId]
+	if !exists {
+		ruleIndex = len(run.Tool.Driver.Rules)
+		sb.ruleIndexes[issue.ruleId] = ruleIndex
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, createSarifRule(issue, level))
+	}
+	message := issue.summary
+	if issue.component != "" {
+		message = fmt.Sprintf("[%s] %s: %s", issue.severity, issue.component, issue.summary)
+	}
+	if len(issue.fixedVersions) > 0 {
+		message += ". Fixed versions: " + strings.Join(issue.fixedVersions, ", ")
+	}
+	result := SarifResult{RuleId: issue.ruleId, RuleIndex: ruleIndex, Level: level, Message: SarifMessage{Text: message}}
+	location := SarifLocation{}
+	if uri := sb.locationUri(issue); uri != "" {
+		location.PhysicalLocation = &SarifPhysicalLocation{ArtifactLocation: SarifArtifactLocation{Uri: uri}}
+	}
+	if issue.component != "" {
+		location.LogicalLocations = []SarifLogicalLocation{{Name: issue.component, Kind: "package"}}
+	}
+	if location.PhysicalLocation != nil || location.LogicalLocations != nil {
+		result.Locations = []SarifLocation{location}
+	}
+	run.Results = append(run.Results, result)
+}
+
+func (sb *sarifBuilder) locationUri(issue sarifIssue) string {
+	if sb.options.LocationUri != "" {
+		return sb.options.LocationUri
+	}
+	return issue.physicalPath
+}
+
+func createSarifRule(issue sarifIssue, level string) SarifRule {
+	rule := SarifRule{
+		Id:                   issue.ruleId,
+		Name:                 issue.ruleName,
+		HelpUri:              issue.helpUri,
+		DefaultConfiguration: &SarifRuleConfiguration{Level: level},
+	}
+	if issue.summary != "" {
+		rule.ShortDescription = &SarifMessage{Text: issue.summary}
+	}
+	description := issue.description
+	if description == "" {
+		description = issue.summary
+	}
+	if description != "" {
+		rule.FullDescription = &SarifMessage{Text: description}
+		help := SarifMessage{Text: description, Markdown: description}
+		if issue.remediation != "" {
+			help.Text += "\n\nRemediation:\n" + issue.remediation
+			help.Markdown += "\n\n**Remediation:**\n" + issue.remediation
+		}
+		rule.Help = &help
+	}
+	tags := []string{}
+	if issue.issueType != "" {
+		tags = append(tags, strings.ToLower(issue.issueType))
+	}
+	tags = append(tags, issue.cwes...)
+	rule.Properties = map[string]any{sarifTagsProperty: tags}
+	if utils.PolicyType(strings.ToLower(issue.issueType)) == utils.Security {
+		if score := securitySeverityScore(issue); score != "" {
+			rule.Properties[sarifSecuritySeverityProperty] = score
+		}
+	}
+	return rule
+}
+
+func securitySeverityScore(issue sarifIssue) string {
+	if score, err := strconv.ParseFloat(issue.cvssV3Score, 64); err == nil {
+		return strconv.FormatFloat(score, 'f', 1, 64)
+	}
+	return severitySecurityScores[utils.Severity(issue.severity)]
+}
+
+func sarifLevel(severity string) string {
+	switch utils.Severity(severity) {
+	case utils.Critical, utils.High:
+		return SarifLevelError
+	case utils.Medium:
+		return SarifLevelWarning
+	default:
+		return SarifLevelNote
+	}
+}
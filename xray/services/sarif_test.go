@@ -0,0 +1,102 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/xray/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertScanResponseToSarif(t *testing.T) {
+	scanResponse := &ScanResponse{
+		Violations: []Violation{
+			{
+				IssueId:       "XRAY-1",
+				ViolationType: "security",
+				Severity:      "Critical",
+				Summary:       "Remote code execution",
+				Cves:          []Cve{{Id: "CVE-2021-44228", CvssV3Score: "10", Cwe: []string{"CWE-502"}}},
+				Components: map[string]Component{
+					"gav://org.apache.logging.log4j:log4j-core:2.14.1": {FixedVersions: []string{"[2.15.0]"}},
+					"gav://org.apache.logging.log4j:log4j-api:2.14.1":  {},
+				},
+				ExtendedInformation: &ExtendedInformation{FullDescription: "Log4Shell", Remediation: "Upgrade"},
+			},
+			{IssueId: "XRAY-2", ViolationType: "license", Severity: "Medium", Summary: "GPL", LicenseKey: "GPL-3.0", Components: map[string]Component{"npm://lib:1.0.0": {}}},
+		},
+		// Ignored, as the violations are reported.
+		Vulnerabilities: []Vulnerability{{IssueId: "XRAY-3"}},
+	}
+	report := ConvertScanResponseToSarif(scanResponse, SarifOptions{LocationUri: "pom.xml"})
+	assert.Equal(t, SarifVersion, report.Version)
+	run := report.Runs[0]
+	assert.Equal(t, "JFrog Xray", run.Tool.Driver.Name)
+	if assert.Len(t, run.Tool.Driver.Rules, 2) {
+		rule := run.Tool.Driver.Rules[0]
+		assert.Equal(t, "CVE-2021-44228", rule.Id)
+		assert.Equal(t, "XRAY-1", rule.Name)
+		assert.Equal(t, "Log4Shell", rule.FullDescription.Text)
+		assert.Contains(t, rule.Help.Markdown, "Upgrade")
+		assert.Equal(t, map[string]any{"tags": []string{"security", "CWE-502"}, "security-severity": "10.0"}, rule.Properties)
+
+		assert.Equal(t, "GPL-3.0", run.Tool.Driver.Rules[1].Id)
+		assert.NotContains(t, run.Tool.Driver.Rules[1].Properties, "security-severity")
+	}
+	if assert.Len(t, run.Results, 3) {
+		// The components are sorted by their IDs.
+		assert.Equal(t, SarifResult{
+			RuleId:  "CVE-2021-44228",
+			Level:   SarifLevelError,
+			Message: SarifMessage{Text: "[Critical] gav://org.apache.logging.log4j:log4j-api:2.14.1: Remote code execution"},
+			Locations: []SarifLocation{{
+				PhysicalLocation: &SarifPhysicalLocation{ArtifactLocation: SarifArtifactLocation{Uri: "pom.xml"}},
+				LogicalLocations: []SarifLogicalLocation{{Name: "gav://org.apache.logging.log4j:log4j-api:2.14.1", Kind: "package"}},
+			}},
+		}, run.Results[0])
+		assert.Contains(t, run.Results[1].Message.Text, "Fixed versions: [2.15.0]")
+		assert.Equal(t, 1, run.Results[2].RuleIndex)
+		assert.Equal(t, SarifLevelWarning, run.Results[2].Level)
+	}
+}
+
+func TestConvertVulnerabilitiesToSarif(t *testing.T) {
+	scanResponse := &ScanResponse{Vulnerabilities: []Vulnerability{{IssueId: "XRAY-3", Severity: "High", Summary: "Prototype pollution", Components: map[string]Component{
+		"npm://lodash:4.17.20": {ImpactPaths: [][]ImpactPathNode{{{ComponentId: "npm://lodash:4.17.20", FullPath: "node_modules/lodash"}}}},
+	}}}}
+	run := ConvertScanResponseToSarif(scanResponse, SarifOptions{}).Runs[0]
+	if assert.Len(t, run.Results, 1) {
+		assert.Equal(t, "XRAY-3", run.Results[0].RuleId)
+		assert.Equal(t, "node_modules/lodash", run.Results[0].Locations[0].PhysicalLocation.ArtifactLocation.Uri)
+	}
+	// Without a CVSS v3 score, the security severity is derived from the severity.
+	assert.Equal(t, "8.9", run.Tool.Driver.Rules[0].Properties["security-severity"])
+}
+
+func TestConvertViolationsToSarif(t *testing.T) {
+	violations := []XrayViolation{{
+		IssueId:              "XRAY-4",
+		Type:                 utils.SecurityViolation,
+		Severity:             utils.Low,
+		Summary:              "Denial of service",
+		Url:                  "https://xray/violations/1",
+		InfectedComponentIds: []string{"pypi://requests:2.0.0"},
+		Cves:                 []CveDetails{{Id: "CVE-2023-1"}},
+	}}
+	report := ConvertViolationsToSarif(violations, SarifOptions{ToolName: "Scanner", ToolVersion: "1.0"})
+	run := report.Runs[0]
+	assert.Equal(t, SarifDriver{Name: "Scanner", Version: "1.0", InformationUri: "https://jfrog.com/xray/", Rules: run.Tool.Driver.Rules}, run.Tool.Driver)
+	if assert.Len(t, run.Results, 1) {
+		assert.Equal(t, "CVE-2023-1", run.Results[0].RuleId)
+		assert.Equal(t, SarifLevelNote, run.Results[0].Level)
+		assert.Nil(t, run.Results[0].Locations[0].PhysicalLocation)
+	}
+	assert.Equal(t, "https://xray/violations/1", run.Tool.Driver.Rules[0].HelpUri)
+
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, report.Write(buffer))
+	content := map[string]any{}
+	assert.NoError(t, json.Unmarshal(buffer.Bytes(), &content))
+	assert.Equal(t, SarifSchemaUri, content["$schema"])
+}
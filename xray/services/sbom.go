@@ -0,0 +1,233 @@
+package services
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	exportComponentDetailsAPI = "api/v1/component/exportDetails"
+
+	buildPackageType         = "build"
+	releaseBundlePackageType = "releaseBundle"
+)
+
+type SbomFormat string
+
+const (
+	CycloneDxJson SbomFormat = "cyclonedx-json"
+	CycloneDxXml  SbomFormat = "cyclonedx-xml"
+	SpdxJson      SbomFormat = "spdx-json"
+	SpdxTagValue  SbomFormat = "spdx-tag:value"
+)
+
+// Returns true for the CycloneDX formats.
+func (sf SbomFormat) IsCycloneDx() bool {
+	return sf == CycloneDxJson || sf == CycloneDxXml
+}
+
+// Xray returns the SBOM in a zip archive, in the entry with the format's extension.
+var sbomFormatExtensions = map[SbomFormat]string{
+	CycloneDxJson: ".cdx.json",
+	CycloneDxXml:  ".cdx.xml",
+	SpdxJson:      ".spdx.json",
+	SpdxTagValue:  ".spdx",
+}
+
+type SbomExportParams struct {
+	// The package type of the artifact, e.g. "docker". Set by the build and release bundle constructors.
+	PackageType string
+	// The component name of the artifact, e.g. "my-image:1.0", or "<name>:<number>" of a build or "<name>:<version>" of a release bundle.
+	ComponentName string
+	// The path of the artifact in Artifactory, e.g. "docker-local/my-image/1.0/manifest.json". Not required for builds and release bundles.
+	Path   string
+	Format SbomFormat
+}
+
+func NewArtifactSbomExportParams(packageType, componentName, path string, format SbomFormat) SbomExportParams {
+	return SbomExportParams{PackageType: packageType, ComponentName: componentName, Path: path, Format: format}
+}
+
+func NewBuildSbomExportParams(buildName, buildNumber string, format SbomFormat) SbomExportParams {
+	return SbomExportParams{PackageType: buildPackageType, ComponentName: buildName + ":" + buildNumber, Format: format}
+}
+
+func NewReleaseBundleSbomExportParams(releaseBundleName, releaseBundleVersion string, format SbomFormat) SbomExportParams {
+	return SbomExportParams{PackageType: releaseBundlePackageType, ComponentName: releaseBundleName + ":" + releaseBundleVersion, Format: format}
+}
+
+type sbomExportBody struct {
+	PackageType     string `json:"package_type"`
+	ComponentName   string `json:"component_name"`
+	Path            string `json:"path,omitempty"`
+	CycloneDx       bool   `json:"cyclonedx,omitempty"`
+	CycloneDxFormat string `json:"cyclonedx_format,omitempty"`
+	Spdx            bool   `json:"spdx,omitempty"`
+	SpdxFormat      string `json:"spdx_format,omitempty"`
+}
+
+func createSbomExportBody(params SbomExportParams) (*sbomExportBody, error) {
+	if params.PackageType == "" || params.ComponentName == "" {
+		return nil, errorutils.CheckErrorf("a package type and a component name are required to export an SBOM")
+	}
+	body := &sbomExportBody{PackageType: params.PackageType, ComponentName: params.ComponentName, Path: params.Path}
+	switch params.Format {
+	case CycloneDxJson, CycloneDxXml:
+		body.CycloneDx = true
+		body.CycloneDxFormat = string(params.Format[len("cyclonedx-"):])
+	case SpdxJson, SpdxTagValue:
+		body.Spdx = true
+		body.SpdxFormat = string(params.Format[len("spdx-"):])
+	default:
+		return nil, errorutils.CheckErrorf("unsupported SBOM format '%s'", params.Format)
+	}
+	return body, nil
+}
+
+type SbomService struct {
+	client          *jfroghttpclient.JfrogHttpClient
+	XrayDetails     auth.ServiceDetails
+	ScopeProjectKey string
+}
+
+func NewSbomService(client *jfroghttpclient.JfrogHttpClient) *SbomService {
+	return &SbomService{client: client}
+}
+
+// Exports the SBOM of an artifact, build or release bundle and writes it to the writer.
+func (ss *SbomService) Export(params SbomExportParams, writer io.Writer) error {
+	return ss.exportSbom(params, func(reader io.Reader) error {
+		_, err := io.Copy(writer, reader)
+		return errorutils.CheckError(err)
+	})
+}
+
+// Exports the SBOM of an artifact, build or release bundle to a local file.
+// The file is removed if the export fails.
+func (ss *SbomService) ExportToFile(params SbomExportParams, filePath string) (err error) {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(file.Close()))
+		if err != nil {
+			err = errors.Join(err, errorutils.CheckError(os.Remove(filePath)))
+		}
+	}()
+	return ss.Export(params, file)
+}
+
+// Exports the SBOM of an artifact, build or release bundle in one of the CycloneDX formats, and decodes it.
+func (ss *SbomService) ExportCycloneDx(params SbomExportParams) (*cyclonedx.BOM, error) {
+	if !params.Format.IsCycloneDx() {
+		return nil, errorutils.CheckErrorf("expected a CycloneDX SBOM format, got '%s'", params.Format)
+	}
+	fileFormat := cyclonedx.BOMFileFormatJSON
+	if params.Format == CycloneDxXml {
+		fileFormat = cyclonedx.BOMFileFormatXML
+	}
+	bom := new(cyclonedx.BOM)
+	err := ss.exportSbom(params, func(reader io.Reader) error {
+		if err := cyclonedx.NewBOMDecoder(reader, fileFormat).Decode(bom); err != nil {
+			return errorutils.CheckErrorf("failed to decode the CycloneDX SBOM of '%s': %s", params.ComponentName, err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bom, nil
+}
+
+// Downloads the zip archive exported by Xray into a temporary file, and passes the SBOM entry to the handler.
+func (ss *SbomService) exportSbom(params SbomExportParams, handler func(reader io.Reader) error) (err error) {
+	body, err := createSbomExportBody(params)
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(body)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	tempFile, err := fileutils.CreateTempFile()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(os.Remove(tempFile.Name())))
+	}()
+	err = ss.downloadSbomArchive(params, content, tempFile)
+	if err = errors.Join(err, errorutils.CheckError(tempFile.Close())); err != nil {
+		return err
+	}
+	archive, err := zip.OpenReader(tempFile.Name())
+	if err != nil {
+		return errorutils.CheckErrorf("failed to open the SBOM archive of '%s': %s", params.ComponentName, err.Error())
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(archive.Close()))
+	}()
+	entry, err := findSbomEntry(archive.File, params)
+	if err != nil {
+		return err
+	}
+	reader, err := entry.Open()
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(reader.Close()))
+	}()
+	return handler(reader)
+}
+
+func (ss *SbomService) downloadSbomArchive(params SbomExportParams, content []byte, writer io.Writer) (err error) {
+	httpClientsDetails := ss.XrayDetails.CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+	url := utils.AppendScopedProjectKeyParam(ss.XrayDetails.GetUrl()+exportComponentDetailsAPI, ss.ScopeProjectKey)
+	log.Info("Exporting the SBOM of", params.ComponentName+"...")
+	resp, err := ss.client.SendPostLeaveBodyOpen(url, content, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(resp.Body.Close()))
+	}()
+	if resp.StatusCode != http.StatusOK {
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		return errorutils.CheckResponseStatusWithBody(resp, respBody, http.StatusOK)
+	}
+	log.Debug("Xray response:", resp.Status)
+	_, err = io.Copy(writer, resp.Body)
+	return errorutils.CheckError(err)
+}
+
+func findSbomEntry(entries []*zip.File, params SbomExportParams) (*zip.File, error) {
+	extension := sbomFormatExtensions[params.Format]
+	var names []string
+	for _, entry := range entries {
+		if strings.HasSuffix(strings.ToLower(entry.Name), extension) {
+			return entry, nil
+		}
+		names = append(names, entry.Name)
+	}
+	return nil, errorutils.CheckErrorf("the SBOM archive of '%s' has no '%s' file. Files in the archive: %s",
+		params.ComponentName, extension, strings.Join(names, ", "))
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestSbomService(t *testing.T, serverUrl string) *SbomService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	sbomService := NewSbomService(client)
+	sbomService.XrayDetails = xrayDetails
+	return sbomService
+}
+
+func TestCreateSbomExportBody(t *testing.T) {
+	body, err := createSbomExportBody(NewBuildSbomExportParams("build", "1", SpdxTagValue))
+	assert.NoError(t, err)
+	assert.Equal(t, &sbomExportBody{PackageType: "build", ComponentName: "build:1", Spdx: true, SpdxFormat: "tag:value"}, body)
+
+	body, err = createSbomExportBody(NewArtifactSbomExportParams("docker", "image:1.0", "docker-local/image/1.0/manifest.json", CycloneDxXml))
+	assert.NoError(t, err)
+	assert.Equal(t, &sbomExportBody{PackageType: "docker", ComponentName: "image:1.0", Path: "docker-local/image/1.0/manifest.json", CycloneDx: true, CycloneDxFormat: "xml"}, body)
+
+	_, err = createSbomExportBody(NewReleaseBundleSbomExportParams("bundle", "1.0", "pdf"))
+	assert.ErrorContains(t, err, "unsupported SBOM format")
+}
+
+func createTestSbomArchive(t *testing.T, entries map[string]string) []byte {
+	buffer := &bytes.Buffer{}
+	archive := zip.NewWriter(buffer)
+	for name, content := range entries {
+		writer, err := archive.Create(name)
+		assert.NoError(t, err)
+		_, err = writer.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, archive.Close())
+	return buffer.Bytes()
+}
+
+func TestExportSbom(t *testing.T) {
+	archive := createTestSbomArchive(t, map[string]string{
+		"bundle_1.0.cdx.json":  `{"bomFormat":"CycloneDX","specVersion":"1.4","components":[{"name":"lib","version":"1.0.0"}]}`,
+		"bundle_1.0.spdx.json": `{"spdxVersion":"SPDX-2.3"}`,
+	})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+exportComponentDetailsAPI, r.URL.Path)
+		body := sbomExportBody{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "bundle:1.0", body.ComponentName)
+		w.Header().Set("Content-Type", "application/zip")
+		_, _ = w.Write(archive)
+	}))
+	defer server.Close()
+	sbomService := createTestSbomService(t, server.URL)
+	params := NewReleaseBundleSbomExportParams("bundle", "1.0", CycloneDxJson)
+
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, sbomService.Export(params, buffer))
+	assert.Contains(t, buffer.String(), `"name":"lib"`)
+
+	bom, err := sbomService.ExportCycloneDx(params)
+	assert.NoError(t, err)
+	if assert.NotNil(t, bom.Components) && assert.Len(t, *bom.Components, 1) {
+		assert.Equal(t, "lib", (*bom.Components)[0].Name)
+	}
+
+	filePath := filepath.Join(t.TempDir(), "sbom.spdx.json")
+	assert.NoError(t, sbomService.ExportToFile(NewReleaseBundleSbomExportParams("bundle", "1.0", SpdxJson), filePath))
+	content, err := os.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"spdxVersion":"SPDX-2.3"}`, string(content))
+
+	// The archive has no CycloneDX XML file, so the partially exported file is removed.
+	filePath = filepath.Join(t.TempDir(), "sbom.cdx.xml")
+	err = sbomService.ExportToFile(NewReleaseBundleSbomExportParams("bundle", "1.0", CycloneDxXml), filePath)
+	assert.ErrorContains(t, err, "has no '.cdx.xml' file")
+	assert.NoFileExists(t, filePath)
+}
+
+func TestExportSbomFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	params := NewBuildSbomExportParams("build", "1", SpdxJson)
+	assert.ErrorContains(t, createTestSbomService(t, server.URL).Export(params, &bytes.Buffer{}), "404")
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	xrayUtils "github.com/jfrog/jfrog-client-go/xray/services/utils"
+)
+
+const importSpdxAPI = "api/v1/scan/import_spdx"
+
+type SbomImportParams struct {
+	// The content of the SBOM.
+	Sbom []byte
+	// The format of the SBOM. Detected from the content if empty.
+	Format SbomFormat
+	// The name the scanned SBOM is reported under in Xray, e.g. the name of the SBOM file.
+	FileName string
+	// The time to wait for the scan results. Defaults to 45 minutes.
+	Timeout time.Duration
+	// The time to wait between the results checks. Defaults to 5 seconds.
+	PollingInterval time.Duration
+}
+
+// Reads an SBOM file to import. The format is detected from the content of the file.
+func NewSbomImportParamsFromFile(filePath string) (SbomImportParams, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return SbomImportParams{}, errorutils.CheckError(err)
+	}
+	return SbomImportParams{Sbom: content, FileName: filepath.Base(filePath)}, nil
+}
+
+// Detects the format of an SBOM by its content.
+func DetectSbomFormat(content []byte) (SbomFormat, error) {
+	trimmed := bytes.TrimSpace(content)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		return CycloneDxXml, nil
+	case bytes.HasPrefix(trimmed, []byte("SPDXVersion:")):
+		return SpdxTagValue, nil
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		sbom := struct {
+			BomFormat   string `json:"bomFormat"`
+			SpdxVersion string `json:"spdxVersion"`
+		}{}
+		if err := json.Unmarshal(trimmed, &sbom); err != nil {
+			return "", errorutils.CheckErrorf("couldn't parse the JSON SBOM: %s", err.Error())
+		}
+		if sbom.BomFormat == "CycloneDX" {
+			return CycloneDxJson, nil
+		}
+		if sbom.SpdxVersion != "" {
+			return SpdxJson, nil
+		}
+	}
+	return "", errorutils.CheckErrorf("couldn't detect the SBOM format, expected a CycloneDX or an SPDX document")
+}
+
+// Submits an external SBOM, such as the SBOM of third-party software, to Xray for scanning, and returns the scan ID.
+// CycloneDX (JSON and XML) and SPDX JSON SBOMs are supported.
+func (ss *SbomService) Import(params SbomImportParams) (scanId string, err error) {
+	if len(params.Sbom) == 0 {
+		return "", errorutils.CheckErrorf("the SBOM to import is empty")
+	}
+	format := params.Format
+	if format == "" {
+		if format, err = DetectSbomFormat(params.Sbom); err != nil {
+			return "", err
+		}
+	}
+	httpClientsDetails := ss.XrayDetails.CreateHttpClientDetails()
+	var api string
+	switch format {
+	case CycloneDxJson:
+		api = importGraph
+		httpClientsDetails.SetContentTypeApplicationJson()
+	case CycloneDxXml:
+		api = importGraphXML
+		httpClientsDetails.Headers["Content-Type"] = "application/xml"
+	case SpdxJson:
+		api = importSpdxAPI
+		httpClientsDetails.SetContentTypeApplicationJson()
+	default:
+		return "", errorutils.CheckErrorf("importing a '%s' SBOM isn't supported", format)
+	}
+	requestUrl := ss.XrayDetails.GetUrl() + api
+	if params.FileName != "" {
+		requestUrl += "?file_name=" + url.QueryEscape(params.FileName)
+	}
+	requestUrl = utils.AppendScopedProjectKeyParam(requestUrl, ss.ScopeProjectKey)
+
+	log.Info(fmt.Sprintf("Importing a %s SBOM to JFrog Xray...", format))
+	resp, body, err := ss.client.SendPost(requestUrl, params.Sbom, &httpClientsDetails)
+	if err != nil {
+		return "", err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated); err != nil {
+		scanErrorJson := ScanErrorJson{}
+		if e := json.Unmarshal(body, &scanErrorJson); e == nil && scanErrorJson.Error != "" {
+			return "", errorutils.CheckError(errors.New(scanErrorJson.Error))
+		}
+		return "", err
+	}
+	log.Debug("Xray response:", resp.Status)
+	scanResponse := RequestScanResponse{}
+	if err = json.Unmarshal(body, &scanResponse); err != nil {
+		return "", errorutils.CheckErrorf("couldn't parse JFrog Xray server SBOM import response: %s", err.Error())
+	}
+	return scanResponse.ScanId, nil
+}
+
+// Waits for the scan of an imported SBOM to complete, and returns the components of the SBOM enriched with their
+// vulnerabilities and licenses.
+func (ss *SbomService) GetImportResults(scanId string, timeout, pollingInterval time.Duration) (*ScanResponse, error) {
+	httpClientsDetails := ss.XrayDetails.CreateHttpClientDetails()
+	endPoint := ss.XrayDetails.GetUrl() + scanGraphAPI + "/" + scanId + includeVulnerabilitiesParam + "&include_licenses=true"
+	endPoint = utils.AppendScopedProjectKeyParam(endPoint, ss.ScopeProjectKey)
+	pollingExecutor := &httputils.PollingExecutor{
+		Timeout:         defaultMaxWaitMinutes,
+		PollingInterval: defaultSyncSleepInterval,
+		PollingAction:   xrayUtils.PollingAction(ss.client, endPoint, httpClientsDetails),
+		MsgPrefix:       "Get imported SBOM scan results...",
+	}
+	if timeout > 0 {
+		pollingExecutor.Timeout = timeout
+	}
+	if pollingInterval > 0 {
+		pollingExecutor.PollingInterval = pollingInterval
+	}
+	body, err := pollingExecutor.Execute()
+	if err != nil {
+		return nil, err
+	}
+	scanResponse := ScanResponse{}
+	if err = json.Unmarshal(body, &scanResponse); err != nil {
+		return nil, errorutils.CheckErrorf("couldn't parse JFrog Xray server response: %s", err.Error())
+	}
+	if scanResponse.ScannedStatus == xrayScanStatusFailed {
+		return nil, errorutils.CheckErrorf("received a failure status from JFrog Xray server:\n%s", errorutils.GenerateErrorString(body))
+	}
+	return &scanResponse, nil
+}
+
+// Imports an external SBOM and waits for its scan results.
+func (ss *SbomService) ImportAndWait(params SbomImportParams) (*ScanResponse, error) {
+	scanId, err := ss.Import(params)
+	if err != nil {
+		return nil, err
+	}
+	return ss.GetImportResults(scanId, params.Timeout, params.PollingInterval)
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectSbomFormat(t *testing.T) {
+	testCases := []struct {
+		content  string
+		expected SbomFormat
+	}{
+		{`{"bomFormat": "CycloneDX", "specVersion": "1.4"}`, CycloneDxJson},
+		{` <?xml version="1.0"?><bom xmlns="http://cyclonedx.org/schema/bom/1.4"/>`, CycloneDxXml},
+		{`{"spdxVersion": "SPDX-2.3", "packages": []}`, SpdxJson},
+		{"SPDXVersion: SPDX-2.3\nDataLicense: CC0-1.0", SpdxTagValue},
+	}
+	for _, testCase := range testCases {
+		format, err := DetectSbomFormat([]byte(testCase.content))
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, format)
+	}
+	_, err := DetectSbomFormat([]byte(`{"name": "not an SBOM"}`))
+	assert.ErrorContains(t, err, "couldn't detect the SBOM format")
+}
+
+func TestImportSbomAndWait(t *testing.T) {
+	sbom := `{"spdxVersion": "SPDX-2.3", "packages": [{"name": "lodash", "versionInfo": "4.17.20"}]}`
+	resultsRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/"+importSpdxAPI, r.URL.Path)
+			assert.Equal(t, "vendor.spdx.json", r.URL.Query().Get("file_name"))
+			assert.Equal(t, "proj", r.URL.Query().Get("projectKey"))
+			content, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, sbom, string(content))
+			_, _ = w.Write([]byte(`{"scan_id": "scan-1"}`))
+		case http.MethodGet:
+			assert.Equal(t, "/"+scanGraphAPI+"/scan-1", r.URL.Path)
+			assert.Equal(t, "true", r.URL.Query().Get("include_licenses"))
+			if resultsRequests++; resultsRequests == 1 {
+				w.WriteHeader(http.StatusAccepted)
+				return
+			}
+			_, _ = w.Write([]byte(`{"scan_id": "scan-1", "vulnerabilities": [{"issue_id": "XRAY-1", "severity": "High"}]}`))
+		}
+	}))
+	defer server.Close()
+	sbomService := createTestSbomService(t, server.URL)
+	sbomService.ScopeProjectKey = "proj"
+
+	results, err := sbomService.ImportAndWait(SbomImportParams{Sbom: []byte(sbom), FileName: "vendor.spdx.json", PollingInterval: time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, "scan-1", results.ScanId)
+	if assert.Len(t, results.Vulnerabilities, 1) {
+		assert.Equal(t, "XRAY-1", results.Vulnerabilities[0].IssueId)
+	}
+
+	_, err = sbomService.Import(SbomImportParams{Sbom: []byte("SPDXVersion: SPDX-2.3")})
+	assert.ErrorContains(t, err, "isn't supported")
+}
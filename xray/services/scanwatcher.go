@@ -0,0 +1,178 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	defaultScanWatcherTimeout            = 30 * time.Minute
+	defaultScanWatcherPollingInterval    = 5 * time.Second
+	defaultScanWatcherMaxPollingInterval = time.Minute
+)
+
+// The simplified scan state of an artifact or a build, as reported to the scan watcher callbacks.
+type ScanState string
+
+const (
+	ScanStateUnknown ScanState = ""
+	// The scan didn't start yet.
+	ScanStateQueued   ScanState = "queued"
+	ScanStateScanning ScanState = "scanning"
+	ScanStateDone     ScanState = "done"
+	ScanStateFailed   ScanState = "failed"
+	// Xray doesn't scan the artifact's package type.
+	ScanStateNotSupported ScanState = "not_supported"
+)
+
+// Returns true if the state doesn't change anymore.
+func (ss ScanState) IsFinal() bool {
+	return ss == ScanStateDone || ss == ScanStateFailed || ss == ScanStateNotSupported
+}
+
+func toScanState(status ArtifactStatus) ScanState {
+	switch status {
+	case ArtifactStatusNotScanned, ArtifactStatusPending:
+		return ScanStateQueued
+	case ArtifactStatusScanning:
+		return ScanStateScanning
+	case ArtifactStatusDone, ArtifactStatusPartial:
+		return ScanStateDone
+	case ArtifactStatusFailed:
+		return ScanStateFailed
+	case ArtifactStatusNotSupported:
+		return ScanStateNotSupported
+	default:
+		return ScanStateUnknown
+	}
+}
+
+// An artifact or a build to watch the scan of.
+type ScanTarget struct {
+	Repository  string
+	Path        string
+	BuildName   string
+	BuildNumber string
+	// The project of the build. Optional.
+	Project string
+}
+
+func NewArtifactScanTarget(repo, path string) ScanTarget {
+	return ScanTarget{Repository: repo, Path: path}
+}
+
+func NewBuildScanTarget(buildName, buildNumber, project string) ScanTarget {
+	return ScanTarget{BuildName: buildName, BuildNumber: buildNumber, Project: project}
+}
+
+func (st ScanTarget) IsBuild() bool {
+	return st.BuildName != ""
+}
+
+func (st ScanTarget) String() string {
+	if st.IsBuild() {
+		return fmt.Sprintf("build %s/%s", st.BuildName, st.BuildNumber)
+	}
+	return fmt.Sprintf("artifact %s/%s", st.Repository, st.Path)
+}
+
+type ScanStateChange struct {
+	Target   ScanTarget
+	Previous ScanState
+	Current  ScanState
+	// The status Xray returned for the target.
+	Status *ArtifactStatusResponse
+}
+
+type ScanWatcherParams struct {
+	Targets []ScanTarget
+	// Called whenever the scan state of a target changes, including when its first state is received.
+	OnStateChange func(change ScanStateChange)
+	// Called once the scan of a target is done, after OnStateChange.
+	OnDone func(target ScanTarget, status *ArtifactStatusResponse)
+	// Called once the scan of a target failed or isn't supported, after OnStateChange.
+	OnFailed func(target ScanTarget, status *ArtifactStatusResponse)
+	// The time to wait for all the scans to complete. Defaults to 30 minutes.
+	Timeout time.Duration
+	// The time to wait before the second status check. The time between the checks doubles, with a random jitter,
+	// up to MaxPollingInterval. Defaults to 5 seconds.
+	PollingInterval time.Duration
+	// Defaults to 1 minute.
+	MaxPollingInterval time.Duration
+}
+
+// Polls the scan status of the targets until all the scans complete or the timeout expires, and calls the callbacks
+// on each state transition. Returns the last known state of each target. An error is returned if the timeout expires,
+// or if a status couldn't be retrieved.
+func (as *ArtifactService) WatchScans(params ScanWatcherParams) (map[ScanTarget]ScanState, error) {
+	timeout := valueOrDefault(params.Timeout, defaultScanWatcherTimeout)
+	interval := valueOrDefault(params.PollingInterval, defaultScanWatcherPollingInterval)
+	maxInterval := max(valueOrDefault(params.MaxPollingInterval, defaultScanWatcherMaxPollingInterval), interval)
+
+	states := make(map[ScanTarget]ScanState, len(params.Targets))
+	pending := make([]ScanTarget, 0, len(params.Targets))
+	for _, target := range params.Targets {
+		if _, exists := states[target]; !exists {
+			states[target] = ScanStateUnknown
+			pending = append(pending, target)
+		}
+	}
+	deadline := time.Now().Add(timeout)
+	for attempt := 0; ; attempt++ {
+		var stillPending []ScanTarget
+		for _, target := range pending {
+			status, err := as.getScanStatus(target)
+			if err != nil {
+				return states, err
+			}
+			current := toScanState(status.Overall.Status)
+			if previous := states[target]; current != previous {
+				states[target] = current
+				params.notify(ScanStateChange{Target: target, Previous: previous, Current: current, Status: status})
+			}
+			if !current.IsFinal() {
+				stillPending = append(stillPending, target)
+			}
+		}
+		if pending = stillPending; len(pending) == 0 {
+			return states, nil
+		}
+		delay := clientutils.CalculateBackoff(attempt, interval, maxInterval)
+		if time.Now().Add(delay).After(deadline) {
+			return states, errorutils.CheckErrorf("timed out after %v waiting for the Xray scans of %d targets to complete, including the %s", timeout, len(pending), pending[0])
+		}
+		log.Debug(fmt.Sprintf("Waiting for the Xray scans of %d targets, checking again in %v", len(pending), delay))
+		time.Sleep(delay)
+	}
+}
+
+func (params *ScanWatcherParams) notify(change ScanStateChange) {
+	log.Debug(fmt.Sprintf("The Xray scan state of the %s changed from '%s' to '%s'", change.Target, change.Previous, change.Current))
+	if params.OnStateChange != nil {
+		params.OnStateChange(change)
+	}
+	switch {
+	case change.Current == ScanStateDone && params.OnDone != nil:
+		params.OnDone(change.Target, change.Status)
+	case (change.Current == ScanStateFailed || change.Current == ScanStateNotSupported) && params.OnFailed != nil:
+		params.OnFailed(change.Target, change.Status)
+	}
+}
+
+func (as *ArtifactService) getScanStatus(target ScanTarget) (*ArtifactStatusResponse, error) {
+	if target.IsBuild() {
+		return as.GetBuildStatus(target.BuildName, target.BuildNumber, target.Project)
+	}
+	return as.GetStatus(target.Repository, target.Path)
+}
+
+func valueOrDefault(value, defaultValue time.Duration) time.Duration {
+	if value > 0 {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestArtifactService(t *testing.T, serverUrl string) *ArtifactService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	artifactService := NewArtifactService(client)
+	artifactService.XrayDetails = xrayDetails
+	return artifactService
+}
+
+func TestWatchScans(t *testing.T) {
+	// The statuses each target goes through, one per request.
+	artifactStatuses := []ArtifactStatus{ArtifactStatusPending, ArtifactStatusPending, ArtifactStatusScanning, ArtifactStatusDone}
+	buildStatuses := []ArtifactStatus{ArtifactStatusScanning, ArtifactStatusFailed}
+	nextStatus := func(statuses *[]ArtifactStatus) ArtifactStatus {
+		status := (*statuses)[0]
+		if len(*statuses) > 1 {
+			*statuses = (*statuses)[1:]
+		}
+		return status
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var status ArtifactStatus
+		switch r.URL.Path {
+		case "/" + statusAPI:
+			status = nextStatus(&artifactStatuses)
+		case "/" + buildStatusAPI:
+			assert.Equal(t, "proj", r.URL.Query().Get("projectKey"))
+			request := BuildStatusRequest{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			assert.Equal(t, BuildStatusRequest{BuildName: "build", BuildNumber: "1"}, request)
+			status = nextStatus(&buildStatuses)
+		}
+		content, err := json.Marshal(ArtifactStatusResponse{Overall: ArtifactScanStatus{Status: status}})
+		assert.NoError(t, err)
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	artifact := NewArtifactScanTarget("docker-local", "image/1.0/manifest.json")
+	build := NewBuildScanTarget("build", "1", "proj")
+	var changes []ScanStateChange
+	var done, failed []ScanTarget
+	states, err := createTestArtifactService(t, server.URL).WatchScans(ScanWatcherParams{
+		Targets:         []ScanTarget{artifact, build, artifact},
+		OnStateChange:   func(change ScanStateChange) { changes = append(changes, change) },
+		OnDone:          func(target ScanTarget, _ *ArtifactStatusResponse) { done = append(done, target) },
+		OnFailed:        func(target ScanTarget, _ *ArtifactStatusResponse) { failed = append(failed, target) },
+		PollingInterval: time.Millisecond,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[ScanTarget]ScanState{artifact: ScanStateDone, build: ScanStateFailed}, states)
+	assert.Equal(t, []ScanTarget{artifact}, done)
+	assert.Equal(t, []ScanTarget{build}, failed)
+
+	var artifactTransitions []ScanState
+	for _, change := range changes {
+		if change.Target == artifact {
+			artifactTransitions = append(artifactTransitions, change.Current)
+		}
+	}
+	// Repeated states aren't reported.
+	assert.Equal(t, []ScanState{ScanStateQueued, ScanStateScanning, ScanStateDone}, artifactTransitions)
+}
+
+func TestWatchScansTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"overall": {"status": "SCANNING"}}`))
+	}))
+	defer server.Close()
+	target := NewArtifactScanTarget("generic-local", "file.zip")
+	states, err := createTestArtifactService(t, server.URL).WatchScans(ScanWatcherParams{
+		Targets:         []ScanTarget{target},
+		Timeout:         50 * time.Millisecond,
+		PollingInterval: 10 * time.Millisecond,
+	})
+	assert.ErrorContains(t, err, "including the artifact generic-local/file.zip")
+	assert.Equal(t, ScanStateScanning, states[target])
+}
@@ -2,16 +2,24 @@ package services
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 
+	"github.com/jfrog/gofrog/parallel"
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
 
 const (
-	summaryAPI = "api/v2/summary/"
+	summaryAPI          = "api/v2/summary/"
+	componentSummaryAPI = "api/v1/summary/component"
+	cveSummaryAPI       = "api/v1/summary/cve"
+
+	// The max number of components or CVEs sent in a single request. Larger lookups are split into batches.
+	SummaryLookupBatchSize = 100
 )
 
 func (ss *SummaryService) getSummaryUrl() string {
@@ -80,16 +88,193 @@ func (ss *SummaryService) GetArtifactSummary(params ArtifactSummaryParams) (*Art
 	return &response, nil
 }
 
+// Returns the summaries of many artifacts, looked up by their paths and checksums in batches of up to params.BatchSize,
+// of which up to params.Threads are sent concurrently. Unlike GetArtifactSummary, artifacts that couldn't be looked up
+// are returned in the response errors. All the batches are sent even if some fail. The returned error aggregates the
+// errors of all the failed batches, and the response holds the summaries of the other batches.
+func (ss *SummaryService) GetArtifactSummaryInBatches(params BatchArtifactSummaryParams) (*ArtifactSummaryResponse, error) {
+	batchSize := params.BatchSize
+	if batchSize <= 0 {
+		batchSize = SummaryLookupBatchSize
+	}
+	threads := params.Threads
+	if threads <= 0 {
+		threads = 1
+	}
+	var batches []ArtifactSummaryParams
+	for checksums := range slices.Chunk(params.Checksums, batchSize) {
+		batches = append(batches, ArtifactSummaryParams{Checksums: checksums})
+	}
+	for paths := range slices.Chunk(params.Paths, batchSize) {
+		batches = append(batches, ArtifactSummaryParams{Paths: paths})
+	}
+	batchResponses := make([]ArtifactSummaryResponse, len(batches))
+	batchErrors := make([]error, len(batches))
+	producerConsumer := parallel.NewBounedRunner(threads, false)
+	go func() {
+		defer producerConsumer.Done()
+		for i := range batches {
+			task := func(int) error {
+				batchErrors[i] = ss.sendLookup(summaryAPI+"artifact", batches[i], &batchResponses[i])
+				return nil
+			}
+			_, _ = producerConsumer.AddTask(task)
+		}
+	}()
+	producerConsumer.Run()
+
+	// The batches are merged in order, so that the artifacts are returned in the order they were requested.
+	response := &ArtifactSummaryResponse{}
+	for i := range batches {
+		response.Artifacts = append(response.Artifacts, batchResponses[i].Artifacts...)
+		response.Errors = append(response.Errors, batchResponses[i].Errors...)
+	}
+	return response, errors.Join(batchErrors...)
+}
+
+// Returns the summaries of components, which list the issues and licenses of each component. Components are identified
+// by their Xray component IDs, e.g. "gav://org.acme:lib:1.0" or "npm://lodash:4.17.20".
+func (ss *SummaryService) GetComponentSummary(componentIds []string) (*ArtifactSummaryResponse, error) {
+	response := &ArtifactSummaryResponse{}
+	for batch := range slices.Chunk(componentIds, SummaryLookupBatchSize) {
+		componentDetails := make([]componentSummaryDetails, 0, len(batch))
+		for _, componentId := range batch {
+			componentDetails = append(componentDetails, componentSummaryDetails{ComponentId: componentId})
+		}
+		batchResponse := ArtifactSummaryResponse{}
+		if err := ss.sendLookup(componentSummaryAPI, componentSummaryBody{ComponentDetails: componentDetails}, &batchResponse); err != nil {
+			return nil, err
+		}
+		response.Artifacts = append(response.Artifacts, batchResponse.Artifacts...)
+		response.Errors = append(response.Errors, batchResponse.Errors...)
+	}
+	return response, nil
+}
+
+// Returns the summaries of CVEs, including their severity by each source, the fixed versions of the affected components
+// and whether JFrog can determine their applicability. CVEs which Xray doesn't know are returned as errors.
+func (ss *SummaryService) GetCveSummary(cveIds []string) (*CveSummaryResponse, error) {
+	response := &CveSummaryResponse{}
+	for batch := range slices.Chunk(cveIds, SummaryLookupBatchSize) {
+		batchResponse := CveSummaryResponse{}
+		if err := ss.sendLookup(cveSummaryAPI, cveSummaryBody{Cves: batch}, &batchResponse); err != nil {
+			return nil, err
+		}
+		response.Cves = append(response.Cves, batchResponse.Cves...)
+		response.Errors = append(response.Errors, batchResponse.Errors...)
+	}
+	return response, nil
+}
+
+func (ss *SummaryService) sendLookup(api string, requestContent, response any) error {
+	httpDetails := ss.XrayDetails.CreateHttpClientDetails()
+	httpDetails.SetContentTypeApplicationJson()
+	requestBody, err := json.Marshal(requestContent)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	resp, body, err := ss.client.SendPost(ss.XrayDetails.GetUrl()+api, requestBody, &httpDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	return errorutils.CheckError(json.Unmarshal(body, response))
+}
+
+type componentSummaryBody struct {
+	ComponentDetails []componentSummaryDetails `json:"component_details"`
+}
+
+type componentSummaryDetails struct {
+	ComponentId string `json:"component_id"`
+}
+
+type cveSummaryBody struct {
+	Cves []string `json:"cves"`
+}
+
+type CveSummaryResponse struct {
+	Cves []CveSummary `json:"cves,omitempty"`
+	// The CVEs which couldn't be looked up, identified by their IDs.
+	Errors []Error `json:"errors,omitempty"`
+}
+
+type CveSummary struct {
+	Id           string   `json:"cve,omitempty"`
+	Summary      string   `json:"summary,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Severity     string   `json:"severity,omitempty"`
+	CvssV2Score  string   `json:"cvss_v2_score,omitempty"`
+	CvssV2Vector string   `json:"cvss_v2_vector,omitempty"`
+	CvssV3Score  string   `json:"cvss_v3_score,omitempty"`
+	CvssV3Vector string   `json:"cvss_v3_vector,omitempty"`
+	Cwe          []string `json:"cwe,omitempty"`
+	Published    string   `json:"published,omitempty"`
+	References   []string `json:"references,omitempty"`
+	// The severity of the CVE by each of its sources, e.g. NVD or JFrog Research.
+	SeveritySources []CveSeveritySource `json:"severity_sources,omitempty"`
+	// The affected components and their fixed versions.
+	Components    []SummaryComponent       `json:"components,omitempty"`
+	Applicability *CveSummaryApplicability `json:"applicability,omitempty"`
+}
+
+type CveSeveritySource struct {
+	Source      string `json:"source,omitempty"`
+	Severity    string `json:"severity,omitempty"`
+	CvssV3Score string `json:"cvss_v3_score,omitempty"`
+}
+
+type CveSummaryApplicability struct {
+	// True if JFrog has a scanner which determines whether the CVE is applicable.
+	ScannerAvailable bool                `json:"scanner_available,omitempty"`
+	Status           ApplicabilityStatus `json:"status,omitempty"`
+	Description      string              `json:"description,omitempty"`
+}
+
+// Returns the fixed versions of a component, or nil if the CVE doesn't affect it.
+func (cs *CveSummary) GetFixedVersions(componentId string) []string {
+	for _, component := range cs.Components {
+		if component.ComponentId == componentId {
+			return component.FixedVersions
+		}
+	}
+	return nil
+}
+
 type ArtifactSummaryParams struct {
 	Checksums []string `json:"checksums,omitempty"`
 	Paths     []string `json:"paths,omitempty"`
 }
 
+type BatchArtifactSummaryParams struct {
+	ArtifactSummaryParams
+	// The max number of paths or checksums sent in a single request. Defaults to SummaryLookupBatchSize.
+	BatchSize int
+	// The number of requests sent concurrently. Defaults to 1.
+	Threads int
+}
+
+func NewBatchArtifactSummaryParams() BatchArtifactSummaryParams {
+	return BatchArtifactSummaryParams{}
+}
+
 type ArtifactSummaryResponse struct {
 	Artifacts []Artifact `json:"artifacts,omitempty"`
 	Errors    []Error    `json:"errors,omitempty"`
 }
 
+// Returns the summary of an artifact by its path or sha256 checksum, or nil if it isn't in the response.
+func (asr *ArtifactSummaryResponse) FindArtifact(pathOrChecksum string) *Artifact {
+	for i := range asr.Artifacts {
+		if asr.Artifacts[i].General.Path == pathOrChecksum || asr.Artifacts[i].General.Sha256 == pathOrChecksum {
+			return &asr.Artifacts[i]
+		}
+	}
+	return nil
+}
+
 type Artifact struct {
 	General  General          `json:"general,omitempty"`
 	Issues   []Issue          `json:"issues,omitempty"`
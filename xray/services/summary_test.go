@@ -0,0 +1,127 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestSummaryService(t *testing.T, serverUrl string) *SummaryService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	summaryService := NewSummaryService(client)
+	summaryService.XrayDetails = xrayDetails
+	return summaryService
+}
+
+func TestGetComponentSummaryInBatches(t *testing.T) {
+	var batchSizes []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+componentSummaryAPI, r.URL.Path)
+		body := componentSummaryBody{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		batchSizes = append(batchSizes, len(body.ComponentDetails))
+		response := ArtifactSummaryResponse{}
+		for _, component := range body.ComponentDetails {
+			response.Artifacts = append(response.Artifacts, Artifact{General: General{ComponentId: component.ComponentId}})
+		}
+		content, err := json.Marshal(response)
+		assert.NoError(t, err)
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+	var componentIds []string
+	for i := range SummaryLookupBatchSize + 1 {
+		componentIds = append(componentIds, fmt.Sprintf("npm://component:%d", i))
+	}
+	response, err := createTestSummaryService(t, server.URL).GetComponentSummary(componentIds)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{SummaryLookupBatchSize, 1}, batchSizes)
+	if assert.Len(t, response.Artifacts, SummaryLookupBatchSize+1) {
+		assert.Equal(t, componentIds[SummaryLookupBatchSize], response.Artifacts[SummaryLookupBatchSize].General.ComponentId)
+	}
+}
+
+func TestGetArtifactSummaryInBatches(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+summaryAPI+"artifact", r.URL.Path)
+		requests.Add(1)
+		body := ArtifactSummaryParams{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.LessOrEqual(t, len(body.Checksums)+len(body.Paths), 2)
+		if slices.Contains(body.Paths, "default/repo/fail") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		response := ArtifactSummaryResponse{}
+		for _, checksum := range body.Checksums {
+			response.Artifacts = append(response.Artifacts, Artifact{General: General{Sha256: checksum}})
+		}
+		for _, path := range body.Paths {
+			if path == "default/repo/missing" {
+				response.Errors = append(response.Errors, Error{Identifier: path, Error: "not found"})
+				continue
+			}
+			response.Artifacts = append(response.Artifacts, Artifact{General: General{Path: path}, Issues: []Issue{{IssueId: "XRAY-1"}}})
+		}
+		content, err := json.Marshal(response)
+		assert.NoError(t, err)
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+	params := NewBatchArtifactSummaryParams()
+	params.Checksums = []string{"a", "b", "c"}
+	params.Paths = []string{"default/repo/1", "default/repo/missing", "default/repo/fail"}
+	params.BatchSize = 2
+	params.Threads = 3
+
+	response, err := createTestSummaryService(t, server.URL).GetArtifactSummaryInBatches(params)
+	assert.ErrorContains(t, err, "400")
+	assert.EqualValues(t, 4, requests.Load())
+	var identifiers []string
+	for _, artifact := range response.Artifacts {
+		identifiers = append(identifiers, artifact.General.Sha256+artifact.General.Path)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "default/repo/1"}, identifiers)
+	assert.Equal(t, []Error{{Identifier: "default/repo/missing", Error: "not found"}}, response.Errors)
+	if artifact := response.FindArtifact("default/repo/1"); assert.NotNil(t, artifact) {
+		assert.Equal(t, "XRAY-1", artifact.Issues[0].IssueId)
+	}
+	assert.Nil(t, response.FindArtifact("default/repo/fail"))
+}
+
+func TestGetCveSummary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+cveSummaryAPI, r.URL.Path)
+		body := cveSummaryBody{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"CVE-2021-44228", "CVE-0000-0000"}, body.Cves)
+		_, _ = w.Write([]byte(`{
+		  "cves": [{
+		    "cve": "CVE-2021-44228",
+		    "severity": "Critical",
+		    "severity_sources": [{"source": "NVD", "severity": "Critical", "cvss_v3_score": "10.0"}],
+		    "components": [{"component_id": "gav://org.apache.logging.log4j:log4j-core:2.14.1", "fixed_versions": ["[2.15.0]"]}],
+		    "applicability": {"scanner_available": true, "status": "applicable"}
+		  }],
+		  "errors": [{"identifier": "CVE-0000-0000", "error": "not found"}]
+		}`))
+	}))
+	defer server.Close()
+	response, err := createTestSummaryService(t, server.URL).GetCveSummary([]string{"CVE-2021-44228", "CVE-0000-0000"})
+	assert.NoError(t, err)
+	if assert.Len(t, response.Cves, 1) {
+		cve := response.Cves[0]
+		assert.Equal(t, "10.0", cve.SeveritySources[0].CvssV3Score)
+		assert.Equal(t, []string{"[2.15.0]"}, cve.GetFixedVersions("gav://org.apache.logging.log4j:log4j-core:2.14.1"))
+		assert.Nil(t, cve.GetFixedVersions("gav://org.acme:other:1.0"))
+		assert.Equal(t, Applicable, cve.Applicability.Status)
+	}
+	assert.Equal(t, []Error{{Identifier: "CVE-0000-0000", Error: "not found"}}, response.Errors)
+}
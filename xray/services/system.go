@@ -0,0 +1,241 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	systemPingAPI      = "api/v1/system/ping"
+	systemReadinessAPI = "api/v1/system/readiness"
+	systemMetricsAPI   = "api/v1/metrics"
+
+	// The names of the metrics Xray exposes in the OpenMetrics format.
+	artifactsCountMetric        = "jfxr_data_artifacts_total"
+	componentsCountMetric       = "jfxr_data_components_total"
+	upTimeMetric                = "jfxr_performance_server_up_time_seconds"
+	queueMessagesMetric         = "queue_messages_total"
+	dbSyncRunningMetric         = "jfxr_db_sync_running_total"
+	dbSyncStartedBeforeMetric   = "jfxr_db_sync_started_before_seconds"
+	dbSyncPersistedBeforeMetric = "jfxr_db_sync_ended_persist_before_seconds"
+	dbSyncAnalyzedBeforeMetric  = "jfxr_db_sync_ended_analyze_before_seconds"
+
+	queueNameLabel = "queue_name"
+)
+
+// SystemService returns the health and the metrics of Xray
+type SystemService struct {
+	client      *jfroghttpclient.JfrogHttpClient
+	XrayDetails auth.ServiceDetails
+}
+
+// NewSystemService creates a new service to monitor Xray
+func NewSystemService(client *jfroghttpclient.JfrogHttpClient) *SystemService {
+	return &SystemService{client: client}
+}
+
+// A single sample of a metric.
+type Metric struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+type SystemMetrics struct {
+	// The number of indexed artifacts.
+	ArtifactsCount int64
+	// The number of indexed components.
+	ComponentsCount int64
+	UpTime          time.Duration
+	// The number of messages waiting in each of the Xray queues, by queue name.
+	QueueMessages map[string]int64
+	DbSync        DbSyncStatus
+}
+
+type DbSyncStatus struct {
+	Running bool
+	// The time since the last database sync started, or 0 if unknown.
+	StartedBefore time.Duration
+	// The time since the last database sync persisted its updates, or 0 if unknown.
+	PersistedBefore time.Duration
+	// The time since the last database sync completed analyzing the updates, or 0 if unknown.
+	AnalyzedBefore time.Duration
+}
+
+// Returns the total number of messages waiting in all the Xray queues.
+// A backlog that keeps growing means that indexing falls behind.
+func (sm *SystemMetrics) TotalQueuedMessages() int64 {
+	var total int64
+	for _, messages := range sm.QueueMessages {
+		total += messages
+	}
+	return total
+}
+
+// Returns an error if Xray doesn't respond to a ping.
+func (ss *SystemService) Ping() error {
+	_, err := ss.sendGet(systemPingAPI)
+	return err
+}
+
+// Returns true if Xray is ready to serve requests. Xray responds with 503 while its services start, or if any of them
+// is unhealthy.
+func (ss *SystemService) IsReady() (bool, error) {
+	httpDetails := ss.XrayDetails.CreateHttpClientDetails()
+	// A 503 response is an answer rather than a failure, so it isn't retried.
+	httpDetails.AddPreRetryInterceptor(func() bool { return false })
+	resp, body, _, err := ss.client.SendGet(ss.XrayDetails.GetUrl()+systemReadinessAPI, true, &httpDetails)
+	if err != nil {
+		return false, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusServiceUnavailable); err != nil {
+		return false, err
+	}
+	log.Debug("Xray response:", resp.Status)
+	return resp.StatusCode == http.StatusOK, nil
+}
+
+// Returns all the metrics Xray exposes.
+func (ss *SystemService) GetMetrics() ([]Metric, error) {
+	body, err := ss.sendGet(systemMetricsAPI)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetrics(body)
+}
+
+// Returns the indexing, queues and database sync metrics of Xray.
+func (ss *SystemService) GetSystemMetrics() (*SystemMetrics, error) {
+	metrics, err := ss.GetMetrics()
+	if err != nil {
+		return nil, err
+	}
+	return NewSystemMetrics(metrics), nil
+}
+
+func NewSystemMetrics(metrics []Metric) *SystemMetrics {
+	systemMetrics := &SystemMetrics{QueueMessages: make(map[string]int64)}
+	for _, metric := range metrics {
+		switch metric.Name {
+		case artifactsCountMetric:
+			systemMetrics.ArtifactsCount = int64(metric.Value)
+		case componentsCountMetric:
+			systemMetrics.ComponentsCount = int64(metric.Value)
+		case upTimeMetric:
+			systemMetrics.UpTime = secondsToDuration(metric.Value)
+		case queueMessagesMetric:
+			systemMetrics.QueueMessages[metric.Labels[queueNameLabel]] += int64(metric.Value)
+		case dbSyncRunningMetric:
+			systemMetrics.DbSync.Running = metric.Value > 0
+		case dbSyncStartedBeforeMetric:
+			systemMetrics.DbSync.StartedBefore = secondsToDuration(metric.Value)
+		case dbSyncPersistedBeforeMetric:
+			systemMetrics.DbSync.PersistedBefore = secondsToDuration(metric.Value)
+		case dbSyncAnalyzedBeforeMetric:
+			systemMetrics.DbSync.AnalyzedBefore = secondsToDuration(metric.Value)
+		}
+	}
+	return systemMetrics
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// Parses metrics in the OpenMetrics (Prometheus) text format. Comments and metadata lines are skipped.
+func ParseMetrics(content []byte) ([]Metric, error) {
+	var metrics []Metric
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		metric, err := parseMetricLine(line)
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics, errorutils.CheckError(scanner.Err())
+}
+
+// Parses a 'name{label="value",...} value [timestamp]' line.
+func parseMetricLine(line string) (Metric, error) {
+	metric := Metric{Labels: make(map[string]string)}
+	rest := line
+	if labelsStart := strings.IndexByte(line, '{'); labelsStart >= 0 {
+		labelsEnd := strings.LastIndexByte(line, '}')
+		if labelsEnd < labelsStart {
+			return metric, errorutils.CheckErrorf("invalid metric line '%s'", line)
+		}
+		metric.Name = line[:labelsStart]
+		if err := parseMetricLabels(line[labelsStart+1:labelsEnd], metric.Labels); err != nil {
+			return metric, errorutils.CheckErrorf("invalid labels in metric line '%s': %s", line, err.Error())
+		}
+		rest = line[labelsEnd+1:]
+	} else {
+		var found bool
+		if metric.Name, rest, found = strings.Cut(line, " "); !found {
+			return metric, errorutils.CheckErrorf("invalid metric line '%s'", line)
+		}
+	}
+	fields := strings.Fields(rest)
+	if metric.Name == "" || len(fields) == 0 {
+		return metric, errorutils.CheckErrorf("invalid metric line '%s'", line)
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return metric, errorutils.CheckErrorf("invalid value in metric line '%s': %s", line, err.Error())
+	}
+	metric.Value = value
+	return metric, nil
+}
+
+func parseMetricLabels(labels string, result map[string]string) error {
+	for labels = strings.TrimSpace(labels); labels != ""; {
+		name, rest, found := strings.Cut(labels, "=")
+		if !found || !strings.HasPrefix(rest, `"`) {
+			return errorutils.CheckErrorf("expected name=\"value\" in '%s'", labels)
+		}
+		// Find the closing quote, skipping escaped characters.
+		end := 1
+		for ; end < len(rest) && rest[end] != '"'; end++ {
+			if rest[end] == '\\' {
+				end++
+			}
+		}
+		if end >= len(rest) {
+			return errorutils.CheckErrorf("unterminated label value in '%s'", labels)
+		}
+		value, err := strconv.Unquote(rest[:end+1])
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		result[strings.TrimSpace(name)] = value
+		labels = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(rest[end+1:]), ","))
+	}
+	return nil
+}
+
+func (ss *SystemService) sendGet(api string) ([]byte, error) {
+	httpDetails := ss.XrayDetails.CreateHttpClientDetails()
+	resp, body, _, err := ss.client.SendGet(ss.XrayDetails.GetUrl()+api, true, &httpDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	log.Debug("Xray response:", resp.Status)
+	return body, nil
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testXrayMetrics = `# HELP jfxr_data_artifacts_total Artifacts of pkg type generic count in Xray
+# TYPE jfxr_data_artifacts_total counter
+jfxr_data_artifacts_total{package_type="generic"} 1500
+jfxr_data_components_total 30000 1645738619452
+jfxr_performance_server_up_time_seconds 3600.5
+queue_messages_total{queue_name="index"} 12
+queue_messages_total{queue_name="persist",host="a,b\"c"} 3
+jfxr_db_sync_running_total 1
+jfxr_db_sync_started_before_seconds 120
+`
+
+func createTestSystemService(t *testing.T, serverUrl string) *SystemService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	systemService := NewSystemService(client)
+	systemService.XrayDetails = xrayDetails
+	return systemService
+}
+
+func TestParseMetrics(t *testing.T) {
+	metrics, err := ParseMetrics([]byte(testXrayMetrics))
+	assert.NoError(t, err)
+	if assert.Len(t, metrics, 7) {
+		assert.Equal(t, Metric{Name: "jfxr_data_artifacts_total", Labels: map[string]string{"package_type": "generic"}, Value: 1500}, metrics[0])
+		assert.Equal(t, Metric{Name: "jfxr_data_components_total", Labels: map[string]string{}, Value: 30000}, metrics[1])
+		assert.Equal(t, map[string]string{"queue_name": "persist", "host": `a,b"c`}, metrics[4].Labels)
+	}
+
+	_, err = ParseMetrics([]byte("metric{label=value} 1"))
+	assert.Error(t, err)
+	_, err = ParseMetrics([]byte("metric one"))
+	assert.Error(t, err)
+}
+
+func TestGetSystemMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+systemMetricsAPI, r.URL.Path)
+		_, _ = w.Write([]byte(testXrayMetrics))
+	}))
+	defer server.Close()
+	systemMetrics, err := createTestSystemService(t, server.URL).GetSystemMetrics()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1500, systemMetrics.ArtifactsCount)
+	assert.EqualValues(t, 30000, systemMetrics.ComponentsCount)
+	assert.Equal(t, 3600500*time.Millisecond, systemMetrics.UpTime)
+	assert.Equal(t, map[string]int64{"index": 12, "persist": 3}, systemMetrics.QueueMessages)
+	assert.EqualValues(t, 15, systemMetrics.TotalQueuedMessages())
+	assert.Equal(t, DbSyncStatus{Running: true, StartedBefore: 2 * time.Minute}, systemMetrics.DbSync)
+}
+
+func TestIsReady(t *testing.T) {
+	status := http.StatusServiceUnavailable
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+systemReadinessAPI, r.URL.Path)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+	systemService := createTestSystemService(t, server.URL)
+	ready, err := systemService.IsReady()
+	assert.NoError(t, err)
+	assert.False(t, ready)
+
+	status = http.StatusOK
+	ready, err = systemService.IsReady()
+	assert.NoError(t, err)
+	assert.True(t, ready)
+}
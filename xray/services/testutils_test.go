@@ -0,0 +1,27 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+// Xray details for tests which run against a mock server. Reports a fixed version, so that version checks pass.
+type testXrayDetails struct {
+	auth.CommonConfigFields
+}
+
+func (txd *testXrayDetails) GetVersion() (string, error) {
+	return "3.100.0", nil
+}
+
+// Returns a client and details to access a mock Xray server.
+func newTestXrayClient(t *testing.T, serverUrl string) (*jfroghttpclient.JfrogHttpClient, auth.ServiceDetails) {
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	assert.NoError(t, err)
+	var xrayDetails auth.ServiceDetails = &testXrayDetails{}
+	xrayDetails.SetUrl(serverUrl + "/")
+	return client, xrayDetails
+}
@@ -2,7 +2,10 @@ package utils
 
 import (
 	"math"
+	"slices"
 	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
 
 type Severity string
@@ -21,10 +24,21 @@ const (
 type PolicyType string
 
 const (
-	Security PolicyType = "security"
-	License  PolicyType = "license"
+	Security        PolicyType = "security"
+	License         PolicyType = "license"
+	OperationalRisk PolicyType = "operational_risk"
+)
+
+type OperationalRiskLevel string
+
+const (
+	HighRisk   OperationalRiskLevel = "High"
+	MediumRisk OperationalRiskLevel = "Medium"
+	LowRisk    OperationalRiskLevel = "Low"
 )
 
+var severities = []Severity{Critical, High, Medium, Low, Normal, Pending, Information, Unknown}
+
 func NewPolicyParams() PolicyParams {
 	return PolicyParams{}
 }
@@ -61,12 +75,30 @@ type PolicyCriteria struct {
 	Exposures             *PolicyExposureCriteria `json:"exposures,omitempty"`
 	Sast                  *PolicySastCriteria     `json:"sast,omitempty"`
 	SkipNotApplicableCVEs bool                    `json:"applicable_cves_only,omitempty"`
+	MaliciousPackage      bool                    `json:"malicious_package,omitempty"`
 
 	// License
 	AllowedLicenses        []string `json:"allowed_licenses,omitempty"`
 	BannedLicenses         []string `json:"banned_licenses,omitempty"`
 	AllowUnknown           *bool    `json:"allow_unknown,omitempty"`
 	MultiLicensePermissive *bool    `json:"multi_license_permissive,omitempty"`
+
+	// Operational Risk
+	OperationalRiskMinRisk OperationalRiskLevel                 `json:"op_risk_min_risk,omitempty"`
+	OperationalRiskCustom  *PolicyOperationalRiskCustomCriteria `json:"op_risk_custom,omitempty"`
+}
+
+// Custom operational risk criteria. A violation is generated for components which meet any of the conditions, or all of
+// them if UseAndCondition is set.
+type PolicyOperationalRiskCustomCriteria struct {
+	UseAndCondition               bool                 `json:"use_and_condition"`
+	IsEol                         bool                 `json:"is_eol,omitempty"`
+	ReleaseDateGreaterThanMonths  int                  `json:"release_date_greater_than_months,omitempty"`
+	NewerVersionsGreaterThan      int                  `json:"newer_versions_greater_than,omitempty"`
+	ReleaseCadencePerYearLessThan int                  `json:"release_cadence_per_year_less_than,omitempty"`
+	CommitsLessThan               int                  `json:"commits_less_than,omitempty"`
+	CommittersLessThan            int                  `json:"committers_less_than,omitempty"`
+	Risk                          OperationalRiskLevel `json:"risk,omitempty"`
 }
 
 type PolicyExposureCriteria struct {
@@ -95,6 +127,11 @@ type PolicyAction struct {
 	NotifyDeployer                 *bool               `json:"notify_deployer,omitempty"`
 	NotifyWatchRecipients          *bool               `json:"notify_watch_recipients,omitempty"`
 	CustomSeverity                 Severity            `json:"custom_severity,omitempty"`
+	BlockReleaseBundlePromotion    *bool               `json:"block_release_bundle_promotion,omitempty"`
+	FailPullRequest                *bool               `json:"fail_pull_request,omitempty"`
+	CreateTicketEnabled            *bool               `json:"create_ticket_enabled,omitempty"`
+	// The days to wait before failing builds. Requires FailBuild.
+	BuildFailureGracePeriodInDays int `json:"build_failure_grace_period_in_days,omitempty"`
 }
 
 type PolicyBlockDownload struct {
@@ -127,6 +164,11 @@ func CreateExposuresPolicyCriteria(minSeverity Severity, secrets, applications,
 	return criteria
 }
 
+// Create security policy criteria which generates violations for malicious packages
+func CreateMaliciousPackagePolicyCriteria() *PolicyCriteria {
+	return &PolicyCriteria{MaliciousPackage: true}
+}
+
 func CreateSastPolicyCriteria(minSeverity Severity) *PolicyCriteria {
 	return &PolicyCriteria{
 		Sast: &PolicySastCriteria{
@@ -165,6 +207,140 @@ func CreateLicensePolicyCriteria(allowedLicenses, allowUnknown, multiLicensePerm
 	return policyCriteria
 }
 
+// Create operational risk policy criteria with min risk
+func CreateOperationalRiskPolicyCriteria(minRisk OperationalRiskLevel) *PolicyCriteria {
+	return &PolicyCriteria{OperationalRiskMinRisk: minRisk}
+}
+
+// Create operational risk policy criteria with custom conditions
+func CreateCustomOperationalRiskPolicyCriteria(custom PolicyOperationalRiskCustomCriteria) *PolicyCriteria {
+	return &PolicyCriteria{OperationalRiskCustom: &custom}
+}
+
+// Validate checks that the rules of the policy match its type, and that their criteria and actions are valid.
+// Creating or updating a policy doesn't validate it, as Xray is the authority on which policies are valid. Call Validate
+// to catch mistakes before sending the policy.
+func (policyParams PolicyParams) Validate() error {
+	if policyParams.Name == "" {
+		return errorutils.CheckErrorf("a policy name is required")
+	}
+	if len(policyParams.Rules) == 0 {
+		return errorutils.CheckErrorf("policy '%s' must have at least one rule", policyParams.Name)
+	}
+	ruleNames := make(map[string]bool, len(policyParams.Rules))
+	priorities := make(map[int]bool, len(policyParams.Rules))
+	for _, rule := range policyParams.Rules {
+		if rule.Name == "" {
+			return errorutils.CheckErrorf("policy '%s' has a rule without a name", policyParams.Name)
+		}
+		if ruleNames[rule.Name] {
+			return errorutils.CheckErrorf("policy '%s' has more than one rule named '%s'", policyParams.Name, rule.Name)
+		}
+		ruleNames[rule.Name] = true
+		if rule.Priority != 0 {
+			if priorities[rule.Priority] {
+				return errorutils.CheckErrorf("policy '%s' has more than one rule with priority %d", policyParams.Name, rule.Priority)
+			}
+			priorities[rule.Priority] = true
+		}
+		if err := validatePolicyCriteria(policyParams.Type, rule.Criteria); err != nil {
+			return errorutils.CheckErrorf("invalid criteria of rule '%s': %s", rule.Name, err.Error())
+		}
+		if err := validatePolicyAction(rule.Actions); err != nil {
+			return errorutils.CheckErrorf("invalid actions of rule '%s': %s", rule.Name, err.Error())
+		}
+	}
+	return nil
+}
+
+func validatePolicyCriteria(policyType PolicyType, criteria PolicyCriteria) error {
+	isSecurity := criteria.MinSeverity != "" || criteria.CvssRange != nil || criteria.Exposures != nil || criteria.Sast != nil || criteria.MaliciousPackage
+	isLicense := criteria.AllowedLicenses != nil || criteria.BannedLicenses != nil
+	isOperationalRisk := criteria.OperationalRiskMinRisk != "" || criteria.OperationalRiskCustom != nil
+	switch policyType {
+	case Security:
+		if isLicense || isOperationalRisk {
+			return errorutils.CheckErrorf("security rules may only have security criteria")
+		}
+		return validateSecurityCriteria(criteria)
+	case License:
+		if isSecurity || isOperationalRisk {
+			return errorutils.CheckErrorf("license rules may only have license criteria")
+		}
+		return validateLicenseCriteria(criteria)
+	case OperationalRisk:
+		if isSecurity || isLicense {
+			return errorutils.CheckErrorf("operational risk rules may only have operational risk criteria")
+		}
+		return validateOperationalRiskCriteria(criteria)
+	default:
+		return errorutils.CheckErrorf("unsupported policy type '%s'", policyType)
+	}
+}
+
+func validateSecurityCriteria(criteria PolicyCriteria) error {
+	set := 0
+	for _, isSet := range []bool{criteria.MinSeverity != "", criteria.CvssRange != nil, criteria.Exposures != nil, criteria.Sast != nil, criteria.MaliciousPackage} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return errorutils.CheckErrorf("exactly one of min severity, CVSS range, exposures, SAST or malicious package is required")
+	}
+	if criteria.SkipNotApplicableCVEs && criteria.MinSeverity == "" && criteria.CvssRange == nil {
+		return errorutils.CheckErrorf("skipping not applicable CVEs requires a min severity or a CVSS range")
+	}
+	if criteria.MinSeverity != "" && !slices.Contains(severities, criteria.MinSeverity) {
+		return errorutils.CheckErrorf("unsupported severity '%s'", criteria.MinSeverity)
+	}
+	if cvssRange := criteria.CvssRange; cvssRange != nil {
+		if cvssRange.From < 0 || cvssRange.To > 10 || cvssRange.From > cvssRange.To {
+			return errorutils.CheckErrorf("the CVSS range must be within 0.0 and 10.0, got %.1f-%.1f", cvssRange.From, cvssRange.To)
+		}
+	}
+	return nil
+}
+
+func validateLicenseCriteria(criteria PolicyCriteria) error {
+	if (len(criteria.AllowedLicenses) == 0) == (len(criteria.BannedLicenses) == 0) {
+		return errorutils.CheckErrorf("exactly one of allowed licenses or banned licenses is required")
+	}
+	return nil
+}
+
+func validateOperationalRiskCriteria(criteria PolicyCriteria) error {
+	if (criteria.OperationalRiskMinRisk == "") == (criteria.OperationalRiskCustom == nil) {
+		return errorutils.CheckErrorf("exactly one of min risk or custom criteria is required")
+	}
+	if custom := criteria.OperationalRiskCustom; custom != nil {
+		if custom.ReleaseDateGreaterThanMonths < 0 || custom.NewerVersionsGreaterThan < 0 || custom.ReleaseCadencePerYearLessThan < 0 ||
+			custom.CommitsLessThan < 0 || custom.CommittersLessThan < 0 {
+			return errorutils.CheckErrorf("the custom criteria thresholds can't be negative")
+		}
+	}
+	return nil
+}
+
+func validatePolicyAction(action *PolicyAction) error {
+	if action == nil {
+		return nil
+	}
+	if slices.Contains(action.Webhooks, "") {
+		return errorutils.CheckErrorf("webhook names can't be empty")
+	}
+	if action.CustomSeverity != "" && !slices.Contains(severities, action.CustomSeverity) {
+		return errorutils.CheckErrorf("unsupported custom severity '%s'", action.CustomSeverity)
+	}
+	if action.BuildFailureGracePeriodInDays < 0 {
+		return errorutils.CheckErrorf("the build failure grace period can't be negative")
+	}
+	if action.BuildFailureGracePeriodInDays > 0 && (action.FailBuild == nil || !*action.FailBuild) {
+		return errorutils.CheckErrorf("a build failure grace period requires failing the build")
+	}
+	return nil
+}
+
 func CreatePolicyBody(policyParams PolicyParams) PolicyBody {
 	return PolicyBody{
 		Name:        policyParams.Name,
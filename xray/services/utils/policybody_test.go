@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyParamsValidate(t *testing.T) {
+	trueValue, falseValue := true, false
+	tests := []struct {
+		testName      string
+		policyType    PolicyType
+		rules         []PolicyRule
+		expectedError string
+	}{
+		{"security", Security, []PolicyRule{
+			{Name: "severity", Criteria: *CreateSeverityPolicyCriteria(High, true), Priority: 1},
+			{Name: "cvss", Criteria: *CreateCvssRangePolicyCriteria(7, 10), Priority: 2},
+			{Name: "malicious", Criteria: *CreateMaliciousPackagePolicyCriteria(), Priority: 3},
+			{Name: "exposures", Criteria: *CreateExposuresPolicyCriteria(Medium, true, false, false, true), Priority: 4},
+		}, ""},
+		{"license", License, []PolicyRule{{Name: "banned", Criteria: *CreateLicensePolicyCriteria(false, true, true, "GPL-3.0")}}, ""},
+		{"operational_risk", OperationalRisk, []PolicyRule{
+			{Name: "min-risk", Criteria: *CreateOperationalRiskPolicyCriteria(HighRisk), Priority: 1},
+			{Name: "custom", Criteria: *CreateCustomOperationalRiskPolicyCriteria(PolicyOperationalRiskCustomCriteria{IsEol: true, CommittersLessThan: 2, Risk: MediumRisk}), Priority: 2},
+		}, ""},
+		{"no_rules", Security, nil, "at least one rule"},
+		{"duplicate_rule_names", Security, []PolicyRule{
+			{Name: "rule", Criteria: *CreateSeverityPolicyCriteria(High, false)},
+			{Name: "rule", Criteria: *CreateSeverityPolicyCriteria(Low, false)},
+		}, "more than one rule named"},
+		{"duplicate_priorities", Security, []PolicyRule{
+			{Name: "rule1", Criteria: *CreateSeverityPolicyCriteria(High, false), Priority: 1},
+			{Name: "rule2", Criteria: *CreateSeverityPolicyCriteria(Low, false), Priority: 1},
+		}, "priority 1"},
+		{"license_criteria_in_security_policy", Security, []PolicyRule{{Name: "rule", Criteria: *CreateLicensePolicyCriteria(true, false, false, "MIT")}}, "only have security criteria"},
+		{"multiple_security_criteria", Security, []PolicyRule{{Name: "rule", Criteria: PolicyCriteria{MinSeverity: High, MaliciousPackage: true}}}, "exactly one of"},
+		{"invalid_cvss_range", Security, []PolicyRule{{Name: "rule", Criteria: *CreateCvssRangePolicyCriteria(8, 5)}}, "CVSS range"},
+		{"skip_not_applicable_without_severity", Security, []PolicyRule{{Name: "rule", Criteria: PolicyCriteria{MaliciousPackage: true, SkipNotApplicableCVEs: true}}}, "not applicable"},
+		{"no_licenses", License, []PolicyRule{{Name: "rule", Criteria: *CreateLicensePolicyCriteria(true, false, false)}}, "allowed licenses or banned licenses"},
+		{"operational_risk_min_and_custom", OperationalRisk, []PolicyRule{{Name: "rule", Criteria: PolicyCriteria{OperationalRiskMinRisk: LowRisk, OperationalRiskCustom: &PolicyOperationalRiskCustomCriteria{}}}}, "min risk or custom"},
+		{"unsupported_type", "other", []PolicyRule{{Name: "rule", Criteria: *CreateSeverityPolicyCriteria(High, false)}}, "unsupported policy type"},
+		{"actions", Security, []PolicyRule{{Name: "rule", Criteria: *CreateSeverityPolicyCriteria(High, false), Actions: &PolicyAction{
+			Webhooks:                      []string{"webhook"},
+			BlockDownload:                 PolicyBlockDownload{Active: &trueValue, Unscanned: &falseValue},
+			FailBuild:                     &trueValue,
+			BuildFailureGracePeriodInDays: 3,
+			CustomSeverity:                Critical,
+		}}}, ""},
+		{"grace_period_without_fail_build", Security, []PolicyRule{{Name: "rule", Criteria: *CreateSeverityPolicyCriteria(High, false), Actions: &PolicyAction{BuildFailureGracePeriodInDays: 3}}}, "grace period"},
+		{"empty_webhook", Security, []PolicyRule{{Name: "rule", Criteria: *CreateSeverityPolicyCriteria(High, false), Actions: &PolicyAction{Webhooks: []string{""}}}}, "webhook"},
+		{"invalid_custom_severity", Security, []PolicyRule{{Name: "rule", Criteria: *CreateSeverityPolicyCriteria(High, false), Actions: &PolicyAction{CustomSeverity: "Severe"}}}, "custom severity"},
+	}
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			err := PolicyParams{Name: "policy", Type: test.policyType, Rules: test.rules}.Validate()
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expectedError)
+			}
+		})
+	}
+}
@@ -42,6 +42,10 @@ type ViolationsFilters struct {
 	CreatedFrom string `json:"created_from,omitempty"`
 	// Filter for violations created up to this time. Valid value:  A timestamp in RFC 3339 format
 	CreatedUntil string `json:"created_until,omitempty"`
+	// Filter for violations created or updated as of this time. Valid value:  A timestamp in RFC 3339 format
+	UpdatedFrom string `json:"updated_from,omitempty"`
+	// Filter for violations created or updated up to this time. Valid value:  A timestamp in RFC 3339 format
+	UpdatedUntil string `json:"updated_until,omitempty"`
 	// Filter for violations resulting from the requested Issue ID.
 	// Valid values: strings representing the issue ID e.g: XRAY-94620, EXP-1552-00002, GPL-3.0, b1670bb2d3438da6213ed386577fd755bc, b8fdf85cab594e6a3717b4f182b07b
 	IssueId string `json:"issue_id,omitempty"`
@@ -126,6 +130,16 @@ func (vr ViolationsRequest) FilterByCreatedUntil(createdUntil string) Violations
 	return vr
 }
 
+func (vr ViolationsRequest) FilterByUpdatedFrom(updatedFrom string) ViolationsRequest {
+	vr.Filters.UpdatedFrom = updatedFrom
+	return vr
+}
+
+func (vr ViolationsRequest) FilterByUpdatedUntil(updatedUntil string) ViolationsRequest {
+	vr.Filters.UpdatedUntil = updatedUntil
+	return vr
+}
+
 func (vr ViolationsRequest) FilterByIssueId(issueId string) ViolationsRequest {
 	vr.Filters.IssueId = issueId
 	return vr
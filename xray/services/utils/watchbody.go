@@ -2,6 +2,8 @@
 package utils
 
 import (
+	"maps"
+	"slices"
 	"sort"
 
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
@@ -23,7 +25,26 @@ const (
 	// WatchRepositoriesByName is the option where repositories are selected by name to be watched
 	WatchRepositoriesByName WatchRepositoriesType = "byname"
 
+	// WatchProjectsAll is the option where all projects are watched
+	WatchProjectsAll WatchProjectsType = "all"
+	// WatchProjectsByName is the option where projects are selected by their keys to be watched
+	WatchProjectsByName WatchProjectsType = "byname"
+
+	// WatchReleaseBundlesAll is the option where all release bundles v2 are watched
+	WatchReleaseBundlesAll WatchReleaseBundlesType = "all"
+	// WatchReleaseBundlesByName is the option where release bundles v2 are selected by name to be watched
+	WatchReleaseBundlesByName WatchReleaseBundlesType = "byname"
+
 	WatchGitRepository = "gitRepository"
+
+	watchResourceAllRepos            = "all-repos"
+	watchResourceRepository          = "repository"
+	watchResourceAllBuilds           = "all-builds"
+	watchResourceBuild               = "build"
+	watchResourceAllProjects         = "all-projects"
+	watchResourceProject             = "project"
+	watchResourceAllReleaseBundlesV2 = "all-releaseBundlesV2"
+	watchResourceReleaseBundleV2     = "releaseBundleV2"
 )
 
 // WatchBuildType defines the type of filter for a builds on a watch
@@ -35,6 +56,12 @@ type WatchRepositoriesType string
 // WatchRepositoryType defines the type of Repository for a watch
 type WatchRepositoryType string
 
+// WatchProjectsType defines the type of filter for projects on a watch
+type WatchProjectsType string
+
+// WatchReleaseBundlesType defines the type of filter for release bundles v2 on a watch
+type WatchReleaseBundlesType string
+
 // NewWatchParams creates a new struct to configure an Xray watch
 func NewWatchParams() WatchParams {
 	return WatchParams{
@@ -49,6 +76,12 @@ func NewWatchParams() WatchParams {
 		Builds: WatchBuildsParams{
 			ByNames: make(map[string]WatchBuildsByNameParams, 0),
 		},
+		Projects: WatchProjectsParams{
+			ByNames: make(map[string]WatchProjectsByNameParams, 0),
+		},
+		ReleaseBundles: WatchReleaseBundlesParams{
+			ByNames: make(map[string]WatchReleaseBundlesByNameParams, 0),
+		},
 		Policies: make([]AssignedPolicy, 0),
 	}
 }
@@ -62,7 +95,10 @@ type WatchParams struct {
 	Repositories    WatchRepositoriesParams
 	GitRepositories WatchGitRepositoryParams
 	Builds          WatchBuildsParams
+	Projects        WatchProjectsParams
+	ReleaseBundles  WatchReleaseBundlesParams
 
+	// The policies are assigned in the given order, which is kept when fetching the watch.
 	Policies []AssignedPolicy
 
 	ProjectKey string
@@ -91,6 +127,9 @@ type WatchRepository struct {
 	BinMgrID string
 	RepoType WatchRepositoryType
 	Filters  watchFilters
+	// The path filters of this repository. If nil, the path filters of WatchRepositoriesParams are used.
+	// A fetched watch has them only if its repositories have different path filters.
+	PathFilters *WatchPathFilters
 }
 
 // WatchBuildsParams is a struct that stores the build configuration for watch
@@ -112,6 +151,47 @@ type WatchBuildsByNameParams struct {
 	BinMgrID string
 }
 
+// WatchProjectsParams is a struct that stores the projects configuration for watch
+type WatchProjectsParams struct {
+	Type    WatchProjectsType
+	All     WatchProjectsAllParams
+	ByNames map[string]WatchProjectsByNameParams
+}
+
+// WatchProjectsAllParams is used to define the parameters when a watch uses all projects.
+// The patterns are matched against the project keys.
+type WatchProjectsAllParams struct {
+	BinMgrID string
+	WatchPathFilters
+}
+
+// WatchProjectsByNameParams is used to define a specific project in a watch
+type WatchProjectsByNameParams struct {
+	// The project key
+	Name     string
+	BinMgrID string
+}
+
+// WatchReleaseBundlesParams is a struct that stores the release bundles v2 configuration for watch
+type WatchReleaseBundlesParams struct {
+	Type    WatchReleaseBundlesType
+	All     WatchReleaseBundlesAllParams
+	ByNames map[string]WatchReleaseBundlesByNameParams
+}
+
+// WatchReleaseBundlesAllParams is used to define the parameters when a watch uses all release bundles v2.
+// The patterns are matched against the release bundle names.
+type WatchReleaseBundlesAllParams struct {
+	BinMgrID string
+	WatchPathFilters
+}
+
+// WatchReleaseBundlesByNameParams is used to define a specific release bundle v2 in a watch
+type WatchReleaseBundlesByNameParams struct {
+	Name     string
+	BinMgrID string
+}
+
 // WatchPathFilters is used to define path filters on a repository or a build in a watch
 type WatchPathFilters struct {
 	ExcludePatterns []string `json:"ExcludePatterns"`
@@ -176,9 +256,13 @@ type ResourcesWatchesBody struct {
 }
 
 // CreateBody creates a payload to configure a Watch in Xray
-// This can configure repositories and builds
-// However, bundles are not supported.
+// This can configure repositories, builds, projects, release bundles v2 and git repositories.
+// Resources selected by name are sorted by name, so that the payload is consistent.
 func CreateBody(params WatchParams) (*WatchBody, error) {
+	if err := validateAssignedPolicies(params.Policies); err != nil {
+		return nil, err
+	}
+
 	payloadBody := WatchBody{
 		GeneralData: watchGeneralParams{
 			Name:        params.Name,
@@ -201,11 +285,35 @@ func CreateBody(params WatchParams) (*WatchBody, error) {
 		return nil, err
 	}
 
+	err = configureProjects(&payloadBody, params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = configureReleaseBundles(&payloadBody, params)
+	if err != nil {
+		return nil, err
+	}
+
 	configureGitRepositories(&payloadBody, params)
 
 	return &payloadBody, nil
 }
 
+func validateAssignedPolicies(policies []AssignedPolicy) error {
+	assigned := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		if policy.Name == "" || policy.Type == "" {
+			return errorutils.CheckErrorf("an assigned policy must have a name and a type")
+		}
+		if assigned[policy.Name] {
+			return errorutils.CheckErrorf("the policy '%s' is assigned more than once", policy.Name)
+		}
+		assigned[policy.Name] = true
+	}
+	return nil
+}
+
 func configureGitRepositories(payloadBody *WatchBody, params WatchParams) {
 	for _, gitRepoResource := range params.GitRepositories.Resources {
 		gitRepo := watchProjectResourcesElement{
@@ -224,25 +332,30 @@ func configureRepositories(payloadBody *WatchBody, params WatchParams) error {
 
 	case WatchRepositoriesAll:
 		allFilters := watchProjectResourcesElement{
-			Type:    "all-repos",
+			Type:    watchResourceAllRepos,
 			Filters: make([]watchFilter, 0),
 		}
 
-		allFilters.Filters = append(allFilters.Filters, createFilters(params.Repositories.All.Filters, params.Repositories)...)
+		allFilters.Filters = append(allFilters.Filters, createFilters(params.Repositories.All.Filters, params.Repositories.WatchPathFilters)...)
 
 		payloadBody.ProjectResources.Resources = append(payloadBody.ProjectResources.Resources, allFilters)
 
 	case WatchRepositoriesByName:
-		for _, repository := range params.Repositories.Repositories {
+		for _, key := range slices.Sorted(maps.Keys(params.Repositories.Repositories)) {
+			repository := params.Repositories.Repositories[key]
 			repo := watchProjectResourcesElement{
-				Type:     "repository",
+				Type:     watchResourceRepository,
 				Name:     repository.Name,
 				BinMgrID: repository.BinMgrID,
 				RepoType: repository.RepoType,
 				Filters:  make([]watchFilter, 0),
 			}
 
-			repo.Filters = append(repo.Filters, createFilters(repository.Filters, params.Repositories)...)
+			pathFilters := params.Repositories.WatchPathFilters
+			if repository.PathFilters != nil {
+				pathFilters = *repository.PathFilters
+			}
+			repo.Filters = append(repo.Filters, createFilters(repository.Filters, pathFilters)...)
 
 			payloadBody.ProjectResources.Resources = append(payloadBody.ProjectResources.Resources, repo)
 		}
@@ -255,7 +368,7 @@ func configureRepositories(payloadBody *WatchBody, params WatchParams) error {
 	return nil
 }
 
-func createFilters(filters watchFilters, repo WatchRepositoriesParams) []watchFilter {
+func createFilters(filters watchFilters, pathFilters WatchPathFilters) []watchFilter {
 	result := []watchFilter{}
 
 	for _, packageType := range filters.PackageTypes {
@@ -301,13 +414,10 @@ func createFilters(filters watchFilters, repo WatchRepositoriesParams) []watchFi
 		result = append(result, filter)
 	}
 
-	if len(repo.ExcludePatterns) != 0 || len(repo.IncludePatterns) != 0 {
+	if len(pathFilters.ExcludePatterns) != 0 || len(pathFilters.IncludePatterns) != 0 {
 		filter := watchFilter{
-			Type: "path-ant-patterns",
-			Value: WatchPathFilters{
-				ExcludePatterns: repo.ExcludePatterns,
-				IncludePatterns: repo.IncludePatterns,
-			},
+			Type:  "path-ant-patterns",
+			Value: createPathFiltersValue(pathFilters),
 		}
 		result = append(result, filter)
 	}
@@ -320,28 +430,18 @@ func configureBuilds(payloadBody *WatchBody, params WatchParams) error {
 	case WatchBuildAll:
 		allBuilds := watchProjectResourcesElement{
 			Name:     "All Builds",
-			Type:     "all-builds",
+			Type:     watchResourceAllBuilds,
 			BinMgrID: params.Builds.All.BinMgrID,
-			Filters:  []watchFilter{},
-		}
-
-		if params.Builds.All.ExcludePatterns != nil || params.Builds.All.IncludePatterns != nil {
-			filters := []watchFilter{{
-				Type: "ant-patterns",
-				Value: WatchPathFilters{
-					ExcludePatterns: params.Builds.All.ExcludePatterns,
-					IncludePatterns: params.Builds.All.IncludePatterns,
-				}},
-			}
-			allBuilds.Filters = filters
+			Filters:  createAntPatternsFilters(params.Builds.All.WatchPathFilters),
 		}
 
 		payloadBody.ProjectResources.Resources = append(payloadBody.ProjectResources.Resources, allBuilds)
 
 	case WatchBuildByName:
-		for _, byName := range params.Builds.ByNames {
+		for _, key := range slices.Sorted(maps.Keys(params.Builds.ByNames)) {
+			byName := params.Builds.ByNames[key]
 			build := watchProjectResourcesElement{
-				Type:     "build",
+				Type:     watchResourceBuild,
 				Name:     byName.Name,
 				BinMgrID: byName.BinMgrID,
 			}
@@ -357,62 +457,213 @@ func configureBuilds(payloadBody *WatchBody, params WatchParams) error {
 	return nil
 }
 
+func configureProjects(payloadBody *WatchBody, params WatchParams) error {
+	switch params.Projects.Type {
+	case WatchProjectsAll:
+		allProjects := watchProjectResourcesElement{
+			Name:     "All Projects",
+			Type:     watchResourceAllProjects,
+			BinMgrID: params.Projects.All.BinMgrID,
+			Filters:  createAntPatternsFilters(params.Projects.All.WatchPathFilters),
+		}
+		payloadBody.ProjectResources.Resources = append(payloadBody.ProjectResources.Resources, allProjects)
+
+	case WatchProjectsByName:
+		for _, key := range slices.Sorted(maps.Keys(params.Projects.ByNames)) {
+			byName := params.Projects.ByNames[key]
+			project := watchProjectResourcesElement{
+				Type:     watchResourceProject,
+				Name:     byName.Name,
+				BinMgrID: byName.BinMgrID,
+			}
+			payloadBody.ProjectResources.Resources = append(payloadBody.ProjectResources.Resources, project)
+		}
+	case "":
+		// Empty is fine
+	default:
+		return errorutils.CheckErrorf("invalid Projects Type. Must be " + string(WatchProjectsAll) + " or " + string(WatchProjectsByName))
+	}
+
+	return nil
+}
+
+func configureReleaseBundles(payloadBody *WatchBody, params WatchParams) error {
+	switch params.ReleaseBundles.Type {
+	case WatchReleaseBundlesAll:
+		allReleaseBundles := watchProjectResourcesElement{
+			Name:     "All Release Bundles v2",
+			Type:     watchResourceAllReleaseBundlesV2,
+			BinMgrID: params.ReleaseBundles.All.BinMgrID,
+			Filters:  createAntPatternsFilters(params.ReleaseBundles.All.WatchPathFilters),
+		}
+		payloadBody.ProjectResources.Resources = append(payloadBody.ProjectResources.Resources, allReleaseBundles)
+
+	case WatchReleaseBundlesByName:
+		for _, key := range slices.Sorted(maps.Keys(params.ReleaseBundles.ByNames)) {
+			byName := params.ReleaseBundles.ByNames[key]
+			releaseBundle := watchProjectResourcesElement{
+				Type:     watchResourceReleaseBundleV2,
+				Name:     byName.Name,
+				BinMgrID: byName.BinMgrID,
+			}
+			payloadBody.ProjectResources.Resources = append(payloadBody.ProjectResources.Resources, releaseBundle)
+		}
+	case "":
+		// Empty is fine
+	default:
+		return errorutils.CheckErrorf("invalid Release Bundles Type. Must be " + string(WatchReleaseBundlesAll) + " or " + string(WatchReleaseBundlesByName))
+	}
+
+	return nil
+}
+
+// Creates the filters of the "all" resources, which select the resources by their names.
+func createAntPatternsFilters(pathFilters WatchPathFilters) []watchFilter {
+	if pathFilters.ExcludePatterns == nil && pathFilters.IncludePatterns == nil {
+		return []watchFilter{}
+	}
+	return []watchFilter{{Type: "ant-patterns", Value: createPathFiltersValue(pathFilters)}}
+}
+
+// Xray rejects null patterns, so patterns which weren't set are sent as empty.
+func createPathFiltersValue(pathFilters WatchPathFilters) WatchPathFilters {
+	if pathFilters.ExcludePatterns == nil {
+		pathFilters.ExcludePatterns = []string{}
+	}
+	if pathFilters.IncludePatterns == nil {
+		pathFilters.IncludePatterns = []string{}
+	}
+	return pathFilters
+}
+
 // UnpackWatchBody unpacks a payload response from Xray.
 // It transforms the data into the params object so that a consumer can interact with a watch in a consistent way.
 func UnpackWatchBody(watch *WatchParams, body *WatchBody) {
 	for _, resource := range body.ProjectResources.Resources {
 		switch resource.Type {
 
-		case "all-repos":
+		case watchResourceAllRepos:
 			watch.Repositories.Type = WatchRepositoriesAll
-			unpackFilters(resource.Filters, &watch.Repositories.All.Filters, &watch.Repositories)
+			unpackFilters(resource.Filters, &watch.Repositories.All.Filters)
+			unpackPathFiltersOfType(resource.Filters, "path-ant-patterns", &watch.Repositories.WatchPathFilters)
 
-		case "repository":
+		case watchResourceRepository:
 			watch.Repositories.Type = WatchRepositoriesByName
 			repository := WatchRepository{
-				Name:     resource.Name,
-				BinMgrID: resource.BinMgrID,
-				RepoType: resource.RepoType,
+				Name:        resource.Name,
+				BinMgrID:    resource.BinMgrID,
+				RepoType:    resource.RepoType,
+				PathFilters: &WatchPathFilters{ExcludePatterns: []string{}, IncludePatterns: []string{}},
 			}
-			unpackFilters(resource.Filters, &repository.Filters, &watch.Repositories)
+			unpackFilters(resource.Filters, &repository.Filters)
+			unpackPathFiltersOfType(resource.Filters, "path-ant-patterns", repository.PathFilters)
 			watch.Repositories.Repositories[repository.Name] = repository
 
-		case "all-builds":
+		case watchResourceAllBuilds:
 			watch.Builds.Type = WatchBuildAll
 			watch.Builds.All.BinMgrID = resource.BinMgrID
+			unpackPathFiltersOfType(resource.Filters, "ant-patterns", &watch.Builds.All.WatchPathFilters)
 
-			for _, filter := range resource.Filters {
-				if filter.Type == "ant-patterns" {
-					pathFilters := filter.Value.(map[string]interface{})
-
-					if value, ok := pathFilters["ExcludePatterns"]; ok {
-						for _, path := range value.([]interface{}) {
-							watch.Builds.All.ExcludePatterns = append(watch.Builds.All.ExcludePatterns, path.(string))
-						}
-					}
-					if value, ok := pathFilters["IncludePatterns"]; ok {
-						for _, path := range value.([]interface{}) {
-							watch.Builds.All.IncludePatterns = append(watch.Builds.All.IncludePatterns, path.(string))
-						}
-					}
-				}
-			}
-
-		case "build":
+		case watchResourceBuild:
 			watch.Builds.Type = WatchBuildByName
 			watch.Builds.ByNames[resource.Name] = WatchBuildsByNameParams{
 				Name:     resource.Name,
 				BinMgrID: resource.BinMgrID,
 			}
+
+		case watchResourceAllProjects:
+			watch.Projects.Type = WatchProjectsAll
+			watch.Projects.All.BinMgrID = resource.BinMgrID
+			unpackPathFiltersOfType(resource.Filters, "ant-patterns", &watch.Projects.All.WatchPathFilters)
+
+		case watchResourceProject:
+			watch.Projects.Type = WatchProjectsByName
+			watch.Projects.ByNames[resource.Name] = WatchProjectsByNameParams{
+				Name:     resource.Name,
+				BinMgrID: resource.BinMgrID,
+			}
+
+		case watchResourceAllReleaseBundlesV2:
+			watch.ReleaseBundles.Type = WatchReleaseBundlesAll
+			watch.ReleaseBundles.All.BinMgrID = resource.BinMgrID
+			unpackPathFiltersOfType(resource.Filters, "ant-patterns", &watch.ReleaseBundles.All.WatchPathFilters)
+
+		case watchResourceReleaseBundleV2:
+			watch.ReleaseBundles.Type = WatchReleaseBundlesByName
+			watch.ReleaseBundles.ByNames[resource.Name] = WatchReleaseBundlesByNameParams{
+				Name:     resource.Name,
+				BinMgrID: resource.BinMgrID,
+			}
+
+		case WatchGitRepository:
+			watch.GitRepositories.Resources = append(watch.GitRepositories.Resources, resource.Name)
 		}
 	}
 
+	if watch.Repositories.Type == WatchRepositoriesByName {
+		unpackSharedPathFilters(&watch.Repositories)
+	}
+
 	// Sort all the properties so they are returned in a consistent format
 	sort.Strings(watch.Repositories.ExcludePatterns)
 	sort.Strings(watch.Repositories.IncludePatterns)
 }
 
-func unpackFilters(filters []watchFilter, output *watchFilters, repos *WatchRepositoriesParams) {
+// Xray stores the path filters in each repository of the watch.
+// If all the repositories have the same path filters, they are returned as the path filters of WatchRepositoriesParams,
+// as they were most likely defined. Otherwise, each repository keeps its own path filters.
+func unpackSharedPathFilters(repos *WatchRepositoriesParams) {
+	var shared *WatchPathFilters
+	for _, repository := range repos.Repositories {
+		if shared == nil {
+			shared = repository.PathFilters
+			continue
+		}
+		if !slices.Equal(shared.ExcludePatterns, repository.PathFilters.ExcludePatterns) ||
+			!slices.Equal(shared.IncludePatterns, repository.PathFilters.IncludePatterns) {
+			return
+		}
+	}
+	if shared == nil {
+		return
+	}
+	if len(shared.ExcludePatterns) > 0 {
+		repos.ExcludePatterns = shared.ExcludePatterns
+	}
+	if len(shared.IncludePatterns) > 0 {
+		repos.IncludePatterns = shared.IncludePatterns
+	}
+	for name, repository := range repos.Repositories {
+		repository.PathFilters = nil
+		repos.Repositories[name] = repository
+	}
+}
+
+func unpackPathFiltersOfType(filters []watchFilter, filterType string, output *WatchPathFilters) {
+	for _, filter := range filters {
+		if filter.Type == filterType {
+			unpackPathFilters(filter.Value, output)
+		}
+	}
+}
+
+func unpackPathFilters(value interface{}, output *WatchPathFilters) {
+	pathFilters := value.(map[string]interface{})
+	if patterns, ok := pathFilters["ExcludePatterns"].([]interface{}); ok {
+		for _, path := range patterns {
+			output.ExcludePatterns = append(output.ExcludePatterns, path.(string))
+		}
+	}
+	if patterns, ok := pathFilters["IncludePatterns"].([]interface{}); ok {
+		for _, path := range patterns {
+			output.IncludePatterns = append(output.IncludePatterns, path.(string))
+		}
+	}
+	sort.Strings(output.ExcludePatterns)
+	sort.Strings(output.IncludePatterns)
+}
+
+func unpackFilters(filters []watchFilter, output *watchFilters) {
 	// Initialize properties before looping through filters so that all properties are captured
 	output.Properties = map[string]string{}
 
@@ -436,29 +687,6 @@ func unpackFilters(filters []watchFilter, output *watchFilters, repos *WatchRepo
 			key := filterParams["key"].(string)
 			value := filterParams["value"].(string)
 			output.Properties[key] = value
-
-		case "path-ant-patterns":
-			// The path filters are defined once for repositories, either all, or by name
-			// However, in each repository, the data is stored in the filter.
-			// So, if we have 5 repositories, the exclude and include patterns will exist in 5 filters
-			// When unpacking, we only want to store them once, rather than 5 times.
-
-			pathFilters := filter.Value.(map[string]interface{})
-
-			if len(repos.ExcludePatterns) == 0 {
-				if val, ok := pathFilters["ExcludePatterns"]; ok {
-					for _, path := range val.([]interface{}) {
-						repos.ExcludePatterns = append(repos.ExcludePatterns, path.(string))
-					}
-				}
-			}
-			if len(repos.IncludePatterns) == 0 {
-				if val, ok := pathFilters["IncludePatterns"]; ok {
-					for _, path := range val.([]interface{}) {
-						repos.IncludePatterns = append(repos.IncludePatterns, path.(string))
-					}
-				}
-			}
 		}
 	}
 
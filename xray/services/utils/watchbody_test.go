@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -69,3 +70,106 @@ func TestWatchBuildTypeBad(t *testing.T) {
 	err := configureBuilds(&payloadBody, allPayload)
 	assert.Error(t, err)
 }
+
+func TestWatchProjectsTypeBad(t *testing.T) {
+	payloadBody := WatchBody{}
+	allPayload := WatchParams{}
+	allPayload.Projects.Type = "bad"
+	err := configureProjects(&payloadBody, allPayload)
+	assert.Error(t, err)
+}
+
+func TestWatchReleaseBundlesTypeBad(t *testing.T) {
+	payloadBody := WatchBody{}
+	allPayload := WatchParams{}
+	allPayload.ReleaseBundles.Type = "bad"
+	err := configureReleaseBundles(&payloadBody, allPayload)
+	assert.Error(t, err)
+}
+
+func TestWatchAssignedPoliciesValidation(t *testing.T) {
+	params := NewWatchParams()
+	params.Policies = []AssignedPolicy{{Name: "policy", Type: "security"}, {Name: "policy", Type: "license"}}
+	_, err := CreateBody(params)
+	assert.ErrorContains(t, err, "more than once")
+
+	params.Policies = []AssignedPolicy{{Name: "policy"}}
+	_, err = CreateBody(params)
+	assert.Error(t, err)
+}
+
+func TestWatchBodyRoundTrip(t *testing.T) {
+	params := NewWatchParams()
+	params.Name = "watch"
+	params.Repositories.Type = WatchRepositoriesByName
+	params.Repositories.ExcludePatterns = []string{"shared/**"}
+	params.Repositories.Repositories["repo1"] = NewWatchRepository("repo1", "default", WatchRepositoryLocal)
+	repo2 := NewWatchRepository("repo2", "default", WatchRepositoryRemote)
+	repo2.PathFilters = &WatchPathFilters{IncludePatterns: []string{"org/**"}}
+	params.Repositories.Repositories["repo2"] = repo2
+	params.Builds.Type = WatchBuildAll
+	params.Builds.All.BinMgrID = "default"
+	params.Builds.All.ExcludePatterns = []string{"test-*"}
+	params.Projects.Type = WatchProjectsByName
+	params.Projects.ByNames["proj"] = WatchProjectsByNameParams{Name: "proj", BinMgrID: "default"}
+	params.ReleaseBundles.Type = WatchReleaseBundlesAll
+	params.ReleaseBundles.All.BinMgrID = "default"
+	params.ReleaseBundles.All.IncludePatterns = []string{"prod-*"}
+	params.GitRepositories.Resources = []string{"github.com/org/repo"}
+	params.Policies = []AssignedPolicy{{Name: "second", Type: "license"}, {Name: "first", Type: "security"}}
+
+	body, err := CreateBody(params)
+	assert.NoError(t, err)
+	content, err := json.Marshal(body)
+	assert.NoError(t, err)
+	// Patterns which weren't set are sent as empty rather than null.
+	assert.Contains(t, string(content), `{"ExcludePatterns":["test-*"],"IncludePatterns":[]}`)
+	assert.NotContains(t, string(content), "null")
+
+	fetchedBody := &WatchBody{}
+	assert.NoError(t, json.Unmarshal(content, fetchedBody))
+	fetched := NewWatchParams()
+	fetched.Policies = fetchedBody.AssignedPolicies
+	UnpackWatchBody(&fetched, fetchedBody)
+
+	assert.Equal(t, params.Policies, fetched.Policies)
+	assert.Equal(t, WatchRepositoriesByName, fetched.Repositories.Type)
+	assert.Equal(t, &WatchPathFilters{ExcludePatterns: []string{"shared/**"}, IncludePatterns: []string{}}, fetched.Repositories.Repositories["repo1"].PathFilters)
+	assert.Equal(t, &WatchPathFilters{ExcludePatterns: []string{}, IncludePatterns: []string{"org/**"}}, fetched.Repositories.Repositories["repo2"].PathFilters)
+	// The repositories have different path filters, so they aren't returned as shared path filters.
+	assert.Empty(t, fetched.Repositories.ExcludePatterns)
+	assert.Equal(t, WatchBuildAll, fetched.Builds.Type)
+	assert.Equal(t, []string{"test-*"}, fetched.Builds.All.ExcludePatterns)
+	assert.Empty(t, fetched.Builds.All.IncludePatterns)
+	assert.Equal(t, WatchProjectsByName, fetched.Projects.Type)
+	assert.Equal(t, params.Projects.ByNames, fetched.Projects.ByNames)
+	assert.Equal(t, WatchReleaseBundlesAll, fetched.ReleaseBundles.Type)
+	assert.Equal(t, []string{"prod-*"}, fetched.ReleaseBundles.All.IncludePatterns)
+	assert.Equal(t, params.GitRepositories.Resources, fetched.GitRepositories.Resources)
+
+	// Updating the fetched watch keeps the repositories' path filters.
+	updatedBody, err := CreateBody(fetched)
+	assert.NoError(t, err)
+	assert.Equal(t, body.ProjectResources, updatedBody.ProjectResources)
+}
+
+func TestUnpackWatchBodySharedPathFilters(t *testing.T) {
+	params := NewWatchParams()
+	params.Repositories.Type = WatchRepositoriesByName
+	params.Repositories.ExcludePatterns = []string{"shared/**"}
+	params.Repositories.Repositories["repo1"] = NewWatchRepository("repo1", "default", WatchRepositoryLocal)
+	params.Repositories.Repositories["repo2"] = NewWatchRepository("repo2", "default", WatchRepositoryRemote)
+	body, err := CreateBody(params)
+	assert.NoError(t, err)
+	content, err := json.Marshal(body)
+	assert.NoError(t, err)
+
+	fetchedBody := &WatchBody{}
+	assert.NoError(t, json.Unmarshal(content, fetchedBody))
+	fetched := NewWatchParams()
+	UnpackWatchBody(&fetched, fetchedBody)
+	assert.Equal(t, []string{"shared/**"}, fetched.Repositories.ExcludePatterns)
+	assert.Empty(t, fetched.Repositories.IncludePatterns)
+	assert.Nil(t, fetched.Repositories.Repositories["repo1"].PathFilters)
+	assert.Nil(t, fetched.Repositories.Repositories["repo2"].PathFilters)
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
@@ -13,6 +14,8 @@ import (
 
 const (
 	violationsAPI = "api/v1/violations"
+
+	defaultViolationsSyncPageSize = 100
 )
 
 const (
@@ -65,6 +68,77 @@ func (vs *ViolationsService) GetViolations(params utils.ViolationsRequest) (resp
 	return response, nil
 }
 
+type ViolationsSyncParams struct {
+	// Additional filters. The time range of the violations is set by the sync.
+	Filters utils.ViolationsFilters
+	// Only the violations created or updated at or after this time are returned, usually the checkpoint returned by
+	// the previous sync. All the violations are returned if zero.
+	Since time.Time
+	// Default if not provided: 100
+	PageSize int
+}
+
+// Fetches the violations created or updated since a timestamp, and passes each of them to the handler, in the order of
+// their creation. Returns a checkpoint to pass as the Since parameter of the next sync, so each sync fetches only
+// the violations that changed since the previous one. The checkpoint is the client's time when the sync started.
+//
+// The violations are paginated by their creation time rather than by offsets, so the pages remain stable when
+// violations are created or updated during the sync. Those violations are fetched by the next sync.
+// A violation updated around the time of the checkpoint may be handled by two consecutive syncs, so the handler
+// should be idempotent.
+func (vs *ViolationsService) GetViolationsSince(params ViolationsSyncParams, handler func(violation XrayViolation) error) (checkpoint time.Time, err error) {
+	checkpoint = time.Now().UTC()
+	filters := params.Filters
+	if !params.Since.IsZero() {
+		filters.UpdatedFrom = params.Since.UTC().Format(time.RFC3339)
+	}
+	filters.UpdatedUntil = checkpoint.Format(time.RFC3339)
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultViolationsSyncPageSize
+	}
+	request := utils.ViolationsRequest{Filters: &filters, Pagination: &utils.PaginationOptions{OrderBy: "created", Direction: "asc", Limit: pageSize}}
+
+	// The IDs of the handled violations created at the cursor, which are returned again in the next page.
+	handledAtCursor := map[string]bool{}
+	for page := 1; ; {
+		request.Pagination.Offset = page
+		response, err := vs.GetViolations(request)
+		if err != nil {
+			return time.Time{}, err
+		}
+		for _, violation := range response.Violations {
+			if handledAtCursor[violationKey(violation)] {
+				continue
+			}
+			if violation.Created != filters.CreatedFrom {
+				filters.CreatedFrom = violation.Created
+				handledAtCursor = map[string]bool{}
+			}
+			if err = handler(violation); err != nil {
+				return time.Time{}, err
+			}
+			handledAtCursor[violationKey(violation)] = true
+		}
+		if len(response.Violations) < pageSize {
+			return checkpoint, nil
+		}
+		// A full page of violations created at the same time doesn't move the cursor, so the next page is requested.
+		if len(handledAtCursor) >= pageSize*page {
+			page++
+		} else {
+			page = 1
+		}
+	}
+}
+
+func violationKey(violation XrayViolation) string {
+	if violation.Id != "" {
+		return violation.Id
+	}
+	return violation.IssueId + "|" + violation.Watch + "|" + violation.Created
+}
+
 type ViolationsResponse struct {
 	Total      int             `json:"total_violations,omitempty"`
 	Violations []XrayViolation `json:"violations,omitempty"`
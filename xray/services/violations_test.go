@@ -0,0 +1,68 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/xray/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestViolationsService(t *testing.T, serverUrl string) *ViolationsService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	violationsService := NewViolationsService(client)
+	violationsService.XrayDetails = xrayDetails
+	return violationsService
+}
+
+func TestGetViolationsSince(t *testing.T) {
+	// Sorted by their creation time, as returned by Xray.
+	violations := []XrayViolation{
+		{Id: "1", Created: "2024-01-01T10:00:00Z"},
+		{Id: "2", Created: "2024-01-01T11:00:00Z"},
+		{Id: "3", Created: "2024-01-01T11:00:00Z"},
+		{Id: "4", Created: "2024-01-01T11:00:00Z"},
+		{Id: "5", Created: "2024-01-01T12:00:00Z"},
+	}
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+violationsAPI, r.URL.Path)
+		request := utils.ViolationsRequest{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, utils.SecurityViolation, request.Filters.Type)
+		assert.Equal(t, "2024-01-01T00:00:00Z", request.Filters.UpdatedFrom)
+		assert.NotEmpty(t, request.Filters.UpdatedUntil)
+		assert.Equal(t, "created", request.Pagination.OrderBy)
+
+		var matching []XrayViolation
+		for _, violation := range violations {
+			if violation.Created >= request.Filters.CreatedFrom {
+				matching = append(matching, violation)
+			}
+		}
+		start := min((request.Pagination.Offset-1)*request.Pagination.Limit, len(matching))
+		end := min(start+request.Pagination.Limit, len(matching))
+		content, err := json.Marshal(ViolationsResponse{Total: len(matching), Violations: matching[start:end]})
+		assert.NoError(t, err)
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	var handled []string
+	start := time.Now().UTC()
+	checkpoint, err := createTestViolationsService(t, server.URL).GetViolationsSince(ViolationsSyncParams{
+		Filters:  utils.ViolationsFilters{Type: utils.SecurityViolation},
+		Since:    since,
+		PageSize: 2,
+	}, func(violation XrayViolation) error {
+		handled = append(handled, violation.Id)
+		return nil
+	})
+	assert.NoError(t, err)
+	// Each violation is handled exactly once, although three of them were created at the same time.
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, handled)
+	assert.False(t, checkpoint.Before(start.Truncate(time.Second)))
+}
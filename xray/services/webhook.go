@@ -0,0 +1,196 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	webhooksAPIURL = "api/v1/webhooks"
+)
+
+type WebhookEventType string
+
+const (
+	SecurityViolationEvent        WebhookEventType = "security_violation"
+	LicenseViolationEvent         WebhookEventType = "license_violation"
+	OperationalRiskViolationEvent WebhookEventType = "operational_risk_violation"
+)
+
+// WebhookService manages the webhooks Xray notifies when a policy rule with a webhook action is violated
+type WebhookService struct {
+	client      *jfroghttpclient.JfrogHttpClient
+	XrayDetails auth.ServiceDetails
+}
+
+type WebhookAlreadyExistsError struct {
+	InnerError error
+}
+
+func (*WebhookAlreadyExistsError) Error() string {
+	return "Xray: Webhook already exists."
+}
+
+// NewWebhookService creates a new Xray Webhook Service
+func NewWebhookService(client *jfroghttpclient.JfrogHttpClient) *WebhookService {
+	return &WebhookService{client: client}
+}
+
+type WebhookParams struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// The URL the violations are posted to
+	Url      string `json:"url"`
+	UseProxy bool   `json:"use_proxy"`
+	// Credentials for a basic authentication to the URL
+	Username string `json:"user_name,omitempty"`
+	Password string `json:"password,omitempty"`
+	// Custom headers added to each request, for example an authorization token
+	Headers map[string]string `json:"headers,omitempty"`
+	// The violation types the webhook is notified about. All the types if empty.
+	EventTypes []WebhookEventType `json:"event_types,omitempty"`
+}
+
+func (wp *WebhookParams) validate() error {
+	if wp.Name == "" {
+		return errorutils.CheckErrorf("a webhook name is required")
+	}
+	if wp.Url == "" {
+		return errorutils.CheckErrorf("a URL is required for webhook '%s'", wp.Name)
+	}
+	return nil
+}
+
+func (ws *WebhookService) getWebhooksUrl() string {
+	return ws.XrayDetails.GetUrl() + webhooksAPIURL
+}
+
+func (ws *WebhookService) getWebhookUrl(name string) string {
+	return ws.getWebhooksUrl() + "/" + url.PathEscape(name)
+}
+
+// Create creates a new Xray webhook
+func (ws *WebhookService) Create(params WebhookParams) error {
+	if err := params.validate(); err != nil {
+		return err
+	}
+	content, err := json.Marshal(params)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpClientsDetails := ws.XrayDetails.CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+
+	log.Info(fmt.Sprintf("Creating a new webhook named %s on JFrog Xray...", params.Name))
+	resp, body, err := ws.client.SendPost(ws.getWebhooksUrl(), content, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated); err != nil {
+		if resp.StatusCode == http.StatusConflict {
+			return &WebhookAlreadyExistsError{InnerError: err}
+		}
+		return err
+	}
+	log.Debug("Xray response:", resp.Status)
+	log.Info("Done creating webhook.")
+	return nil
+}
+
+// Update replaces an existing Xray webhook by name
+func (ws *WebhookService) Update(params WebhookParams) error {
+	if err := params.validate(); err != nil {
+		return err
+	}
+	content, err := json.Marshal(params)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpClientsDetails := ws.XrayDetails.CreateHttpClientDetails()
+	httpClientsDetails.SetContentTypeApplicationJson()
+
+	log.Info("Updating webhook...")
+	resp, body, err := ws.client.SendPut(ws.getWebhookUrl(params.Name), content, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent); err != nil {
+		return err
+	}
+	log.Debug("Xray response:", resp.Status)
+	log.Info("Done updating webhook.")
+	return nil
+}
+
+// Delete deletes an Xray webhook by name
+func (ws *WebhookService) Delete(name string) error {
+	httpClientsDetails := ws.XrayDetails.CreateHttpClientDetails()
+	log.Info("Deleting webhook...")
+	resp, body, err := ws.client.SendDelete(ws.getWebhookUrl(name), nil, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent); err != nil {
+		return err
+	}
+	log.Debug("Xray response:", resp.Status)
+	log.Info("Done deleting webhook.")
+	return nil
+}
+
+// Get returns an Xray webhook by name. The password isn't returned.
+func (ws *WebhookService) Get(name string) (*WebhookParams, error) {
+	webhook := &WebhookParams{}
+	if err := ws.sendGet(ws.getWebhookUrl(name), webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// GetAll returns all the Xray webhooks
+func (ws *WebhookService) GetAll() ([]WebhookParams, error) {
+	var webhooks []WebhookParams
+	if err := ws.sendGet(ws.getWebhooksUrl(), &webhooks); err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// Test sends a test event from Xray to the webhook's URL.
+// An error is returned if Xray fails to deliver the event.
+func (ws *WebhookService) Test(name string) error {
+	httpClientsDetails := ws.XrayDetails.CreateHttpClientDetails()
+	log.Info(fmt.Sprintf("Sending a test event to webhook %s...", name))
+	resp, body, err := ws.client.SendPost(ws.getWebhookUrl(name)+"/test", nil, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent); err != nil {
+		return err
+	}
+	log.Debug("Xray response:", resp.Status)
+	return nil
+}
+
+func (ws *WebhookService) sendGet(requestUrl string, result any) error {
+	httpClientsDetails := ws.XrayDetails.CreateHttpClientDetails()
+	resp, body, _, err := ws.client.SendGet(requestUrl, true, &httpClientsDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	log.Debug("Xray response:", resp.Status)
+	if err = json.Unmarshal(body, result); err != nil {
+		return errorutils.CheckErrorf("couldn't parse JFrog Xray server webhooks response: %s", err.Error())
+	}
+	return nil
+}
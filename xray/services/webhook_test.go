@@ -0,0 +1,71 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestWebhookService(t *testing.T, serverUrl string) *WebhookService {
+	client, xrayDetails := newTestXrayClient(t, serverUrl)
+	webhookService := NewWebhookService(client)
+	webhookService.XrayDetails = xrayDetails
+	return webhookService
+}
+
+func TestCreateAndGetWebhook(t *testing.T) {
+	params := WebhookParams{
+		Name:       "slack notifications",
+		Url:        "https://hooks.example.com/xray",
+		Headers:    map[string]string{"Authorization": "Bearer token"},
+		EventTypes: []WebhookEventType{SecurityViolationEvent},
+	}
+	var created WebhookParams
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/"+webhooksAPIURL, r.URL.Path)
+			if created.Name != "" {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			assert.Equal(t, "/"+webhooksAPIURL+"/slack notifications", r.URL.Path)
+			content, err := json.Marshal(created)
+			assert.NoError(t, err)
+			_, _ = w.Write(content)
+		}
+	}))
+	defer server.Close()
+	webhookService := createTestWebhookService(t, server.URL)
+
+	assert.NoError(t, webhookService.Create(params))
+	assert.Equal(t, params, created)
+	var alreadyExistsErr *WebhookAlreadyExistsError
+	assert.ErrorAs(t, webhookService.Create(params), &alreadyExistsErr)
+
+	webhook, err := webhookService.Get(params.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, &params, webhook)
+
+	assert.ErrorContains(t, webhookService.Create(WebhookParams{Name: "no-url"}), "a URL is required")
+}
+
+func TestTestWebhook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		if r.URL.Path != "/"+webhooksAPIURL+"/valid/test" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"connection refused"}`))
+		}
+	}))
+	defer server.Close()
+	webhookService := createTestWebhookService(t, server.URL)
+	assert.NoError(t, webhookService.Test("valid"))
+	assert.ErrorContains(t, webhookService.Test("invalid"), "connection refused")
+}